@@ -1445,6 +1445,44 @@ func CheckResourceDisappears(ctx context.Context, provo *schema.Provider, resour
 	}
 }
 
+// ResourceFinderFunc reads the resource with the specified Terraform state.
+// It must return a retry.NotFoundError if the resource does not exist.
+// The service package generator generates finders for resources annotated with `@Testing(disappearsFinder=...)`.
+type ResourceFinderFunc func(context.Context, *conns.AWSClient, *terraform.InstanceState) error
+
+// resourceDisappearsTimeout is how long to wait for a deleted resource to no longer be found.
+const resourceDisappearsTimeout = 5 * time.Minute
+
+// CheckResourceDisappearsWithFinder deletes the specified resource like CheckResourceDisappears
+// and then waits until the finder no longer returns the resource.
+// This ensures that the subsequent refresh sees the out-of-band deletion even if the service is eventually consistent.
+func CheckResourceDisappearsWithFinder(ctx context.Context, provo *schema.Provider, resource *schema.Resource, finder ResourceFinderFunc, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if err := CheckResourceDisappears(ctx, provo, resource, n)(s); err != nil {
+			return err
+		}
+
+		return waitResourceDisappeared(ctx, provo.Meta().(*conns.AWSClient), s, finder, n, resourceDisappearsTimeout)
+	}
+}
+
+func waitResourceDisappeared(ctx context.Context, client *conns.AWSClient, s *terraform.State, finder ResourceFinderFunc, n string, timeout time.Duration) error {
+	rs, ok := s.RootModule().Resources[n]
+	if !ok {
+		return fmt.Errorf("resource not found: %s", n)
+	}
+
+	_, err := tfresource.RetryUntilNotFound(ctx, timeout, func() (interface{}, error) {
+		return nil, finder(ctx, client, rs.Primary)
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for resource (%s) to disappear: %w", n, err)
+	}
+
+	return nil
+}
+
 type TestCheckWithProviderFunc func(*terraform.State, *schema.Provider) error
 
 func CheckWithProviders(f TestCheckWithProviderFunc, providers *[]*schema.Provider) resource.TestCheckFunc {
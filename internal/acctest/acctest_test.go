@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestWaitResourceDisappeared(t *testing.T) {
+	t.Parallel()
+
+	const resourceName = "aws_test.test"
+	state := terraform.NewState()
+	state.RootModule().Resources[resourceName] = &terraform.ResourceState{
+		Type: "aws_test",
+		Primary: &terraform.InstanceState{
+			ID: "test-id",
+		},
+	}
+
+	var errFinder = errors.New("finder error")
+
+	testCases := map[string]struct {
+		resourceName string
+		finds        int
+		err          error
+		timeout      time.Duration
+		expectError  bool
+	}{
+		"not found": {
+			resourceName: resourceName,
+		},
+		"found then not found": {
+			resourceName: resourceName,
+			finds:        2,
+		},
+		"never not found": {
+			resourceName: resourceName,
+			finds:        -1,
+			timeout:      2 * time.Second,
+			expectError:  true,
+		},
+		"finder error": {
+			resourceName: resourceName,
+			err:          errFinder,
+			expectError:  true,
+		},
+		"resource not in state": {
+			resourceName: "aws_test.missing",
+			expectError:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			calls := 0
+			finder := func(_ context.Context, _ *conns.AWSClient, is *terraform.InstanceState) error {
+				calls++
+
+				if got, want := is.ID, "test-id"; got != want {
+					t.Errorf("ID = %q, want %q", got, want)
+				}
+
+				if testCase.err != nil {
+					return testCase.err
+				}
+
+				if testCase.finds < 0 || calls <= testCase.finds {
+					return nil
+				}
+
+				return &retry.NotFoundError{}
+			}
+
+			timeout := testCase.timeout
+			if timeout == 0 {
+				timeout = 1 * time.Minute
+			}
+
+			err := acctest.WaitResourceDisappeared(ctx, nil, state, finder, testCase.resourceName, timeout)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError %t", err, want)
+			}
+
+			if testCase.err != nil && !errors.Is(err, testCase.err) {
+				t.Errorf("error = %v, want %v", err, testCase.err)
+			}
+
+			if !testCase.expectError && calls != testCase.finds+1 {
+				t.Errorf("finder calls = %d, want %d", calls, testCase.finds+1)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 )
 
@@ -73,3 +74,15 @@ func CheckFrameworkResourceDisappears(ctx context.Context, provo *schema.Provide
 		return deleteFrameworkResource(ctx, factory, rs.Primary, provo.Meta())
 	}
 }
+
+// CheckFrameworkResourceDisappearsWithFinder deletes the specified resource like CheckFrameworkResourceDisappears
+// and then waits until the finder no longer returns the resource.
+func CheckFrameworkResourceDisappearsWithFinder(ctx context.Context, provo *schema.Provider, factory func(context.Context) (fwresource.ResourceWithConfigure, error), finder ResourceFinderFunc, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if err := CheckFrameworkResourceDisappears(ctx, provo, factory, n)(s); err != nil {
+			return err
+		}
+
+		return waitResourceDisappeared(ctx, provo.Meta().(*conns.AWSClient), s, finder, n, resourceDisappearsTimeout)
+	}
+}
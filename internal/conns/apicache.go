@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultAPICacheTTL is the default lifetime of APICache entries.
+const DefaultAPICacheTTL = 30 * time.Second
+
+// cacheableOperations are the idempotent, read-only AWS API operations whose responses may be cached,
+// keyed by service ID.
+var cacheableOperations = map[string]map[string]struct{}{
+	"EC2": {
+		"DescribeAvailabilityZones": {},
+		"DescribeRegions":           {},
+		"DescribeVpcAttribute":      {},
+	},
+	"STS": {
+		"GetCallerIdentity": {},
+	},
+}
+
+func isCacheableOperation(service, operation string) bool {
+	_, ok := cacheableOperations[service][operation]
+	return ok
+}
+
+// isReadOnlyOperation returns whether the named operation is, by AWS API naming convention, read-only.
+func isReadOnlyOperation(operation string) bool {
+	for _, prefix := range []string{"Describe", "Get", "List"} {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type apiCacheKey struct {
+	accountID string
+	region    string
+	service   string
+	operation string
+	inputType string // Distinguishes AWS SDK for Go v1 and v2 calls.
+	hash      string
+}
+
+type apiCacheEntry struct {
+	output  any
+	expires time.Time
+}
+
+// APICache is a read-through cache of the responses of frequently repeated, read-only AWS API calls.
+// Responses are cached per AWS account and Region.
+// Any successful mutating call to a service discards the cached responses for that service.
+// It is safe for concurrent use.
+type APICache struct {
+	mu        sync.Mutex
+	accountID string
+	entries   map[apiCacheKey]apiCacheEntry
+	now       func() time.Time
+	ttl       time.Duration
+}
+
+// NewAPICache returns a new, empty APICache whose entries live for the specified duration.
+func NewAPICache(ttl time.Duration) *APICache {
+	return &APICache{
+		entries: make(map[apiCacheKey]apiCacheEntry),
+		now:     time.Now,
+		ttl:     ttl,
+	}
+}
+
+// SetAccountID sets the AWS account ID that subsequently cached responses belong to.
+func (c *APICache) SetAccountID(accountID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.accountID = accountID
+}
+
+func (c *APICache) key(region, service, operation string, input any) (apiCacheKey, bool) {
+	c.mu.Lock()
+	accountID := c.accountID
+	c.mu.Unlock()
+
+	return newAPICacheKey(accountID, region, service, operation, input)
+}
+
+func newAPICacheKey(accountID, region, service, operation string, input any) (apiCacheKey, bool) {
+	b, err := json.Marshal(input)
+
+	if err != nil {
+		return apiCacheKey{}, false
+	}
+
+	t := reflect.TypeOf(input)
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var inputType string
+	if t != nil {
+		inputType = t.PkgPath() + "." + t.Name()
+	}
+
+	sum := sha256.Sum256(b)
+
+	return apiCacheKey{accountID: accountID, region: region, service: service, operation: operation, inputType: inputType, hash: hex.EncodeToString(sum[:])}, true
+}
+
+// get returns a copy of the cached response, so that callers may modify it.
+func (c *APICache) get(k apiCacheKey) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, ok := c.entries[k]
+
+	if !ok {
+		return nil, false
+	}
+
+	if c.now().After(v.expires) {
+		delete(c.entries, k)
+		return nil, false
+	}
+
+	return copyAPIOutput(v.output), true
+}
+
+// put caches a copy of the response, so that callers may modify it.
+func (c *APICache) put(k apiCacheKey, output any) {
+	output = copyAPIOutput(output)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[k] = apiCacheEntry{output: output, expires: c.now().Add(c.ttl)}
+}
+
+// copyAPIOutput returns a deep copy of an AWS SDK for Go v1 or v2 API operation output.
+// Unexported struct fields, e.g. response metadata, are copied shallowly.
+func copyAPIOutput(output any) any {
+	if output == nil {
+		return nil
+	}
+
+	return deepCopyValue(reflect.ValueOf(output)).Interface()
+}
+
+func deepCopyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopyValue(v.Elem()))
+
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+
+		for i := 0; i < v.NumField(); i++ {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(deepCopyValue(v.Field(i)))
+			}
+		}
+
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+		}
+
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopyValue(v.Elem()))
+
+		return c
+	default:
+		return v
+	}
+}
+
+// invalidate discards all cached responses for the specified service.
+func (c *APICache) invalidate(service string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k := range c.entries {
+		if k.service == service {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// Len returns the number of cached responses, including any that have expired but not yet been discarded.
+func (c *APICache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// apiCacheMiddleware is an AWS SDK for Go v2 Initialize middleware that serves cacheable operations
+// from an APICache.
+type apiCacheMiddleware struct {
+	cache *APICache
+}
+
+func (apiCacheMiddleware) ID() string {
+	return "TerraformAPICache"
+}
+
+func (m apiCacheMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	service, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+
+	if !isCacheableOperation(service, operation) {
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		if err == nil && !isReadOnlyOperation(operation) {
+			m.cache.invalidate(service)
+		}
+
+		return out, metadata, err
+	}
+
+	k, ok := m.cache.key(awsmiddleware.GetRegion(ctx), service, operation, in.Parameters)
+
+	if !ok {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	if v, ok := m.cache.get(k); ok {
+		tflog.Debug(ctx, "AWS API response served from cache", map[string]any{
+			"aws.service":   service,
+			"aws.operation": operation,
+		})
+
+		return middleware.InitializeOutput{Result: v}, middleware.Metadata{}, nil
+	}
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	if err == nil {
+		m.cache.put(k, out.Result)
+	}
+
+	return out, metadata, err
+}
+
+// addAPICacheMiddleware adds the API cache middleware to an AWS SDK for Go v2 API stack.
+// The middleware is placed after the operation's service metadata is registered.
+func addAPICacheMiddleware(cache *APICache) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(apiCacheMiddleware{cache: cache}, middleware.After)
+	}
+}
+
+// apiCacheBuildHandler returns an AWS SDK for Go v1 Build handler that serves cacheable operations
+// from an APICache. On a cache hit the request's remaining handlers are cleared so that no call is made.
+func apiCacheBuildHandler(cache *APICache) request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: "TerraformAPICacheBuild",
+		Fn: func(r *request_sdkv1.Request) {
+			if r.Error != nil || r.Operation == nil || !isCacheableOperation(r.ClientInfo.ServiceID, r.Operation.Name) {
+				return
+			}
+
+			k, ok := cache.key(aws_sdkv1.StringValue(r.Config.Region), r.ClientInfo.ServiceID, r.Operation.Name, r.Params)
+
+			if !ok {
+				return
+			}
+
+			v, ok := cache.get(k)
+
+			if !ok {
+				return
+			}
+
+			dst, src := reflect.ValueOf(r.Data), reflect.ValueOf(v)
+
+			if dst.Kind() != reflect.Pointer || dst.IsNil() || dst.Type() != src.Type() {
+				return
+			}
+
+			dst.Elem().Set(src.Elem())
+
+			tflog.Debug(r.Context(), "AWS API response served from cache", map[string]any{
+				"aws.service":   r.ClientInfo.ServiceID,
+				"aws.operation": r.Operation.Name,
+			})
+
+			r.Handlers.Sign.Clear()
+			r.Handlers.Send.Clear()
+			r.Handlers.UnmarshalMeta.Clear()
+			r.Handlers.ValidateResponse.Clear()
+			r.Handlers.Unmarshal.Clear()
+			r.Handlers.Complete.Clear()
+		},
+	}
+}
+
+// apiCacheCompleteHandler returns an AWS SDK for Go v1 Complete handler that stores the responses of
+// cacheable operations in, and discards responses on mutating operations from, an APICache.
+func apiCacheCompleteHandler(cache *APICache) request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: "TerraformAPICacheComplete",
+		Fn: func(r *request_sdkv1.Request) {
+			if r.Error != nil || r.Operation == nil {
+				return
+			}
+
+			service, operation := r.ClientInfo.ServiceID, r.Operation.Name
+
+			if !isCacheableOperation(service, operation) {
+				if !isReadOnlyOperation(operation) {
+					cache.invalidate(service)
+				}
+
+				return
+			}
+
+			if k, ok := cache.key(aws_sdkv1.StringValue(r.Config.Region), service, operation, r.Params); ok {
+				cache.put(k, r.Data)
+			}
+		},
+	}
+}
+
+// addAPICacheHandlers adds the API cache handlers to an AWS SDK for Go v1 session.
+func addAPICacheHandlers(sess *session_sdkv1.Session, cache *APICache) {
+	sess.Handlers.Build.PushBackNamed(apiCacheBuildHandler(cache))
+	sess.Handlers.Complete.PushBackNamed(apiCacheCompleteHandler(cache))
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	apiCacheTestAccountID   = "123456789012"
+	apiCacheTestRegion      = "us-west-2" //lintignore:AWSAT003
+	apiCacheTestOtherRegion = "us-east-1" //lintignore:AWSAT003
+)
+
+func TestAPICacheExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	c := NewAPICache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	k, ok := newAPICacheKey(apiCacheTestAccountID, apiCacheTestRegion, "EC2", "DescribeRegions", &ec2.DescribeRegionsInput{})
+	if !ok {
+		t.Fatal("no cache key")
+	}
+
+	c.put(k, &ec2.DescribeRegionsOutput{})
+
+	if _, ok := c.get(k); !ok {
+		t.Error("expected cache hit")
+	}
+
+	now = now.Add(2 * time.Minute)
+
+	if _, ok := c.get(k); ok {
+		t.Error("expected cache miss after expiry")
+	}
+
+	if got := c.Len(); got != 0 {
+		t.Errorf("Len = %d, want 0", got)
+	}
+}
+
+func TestAPICacheKey(t *testing.T) {
+	t.Parallel()
+
+	k1, _ := newAPICacheKey(apiCacheTestAccountID, apiCacheTestRegion, "EC2", "DescribeVpcAttribute", &ec2.DescribeVpcAttributeInput{Attribute: aws.String("enableDnsSupport"), VpcId: aws.String("vpc-1")})
+	k2, _ := newAPICacheKey(apiCacheTestAccountID, apiCacheTestRegion, "EC2", "DescribeVpcAttribute", &ec2.DescribeVpcAttributeInput{Attribute: aws.String("enableDnsSupport"), VpcId: aws.String("vpc-2")})
+	k3, _ := newAPICacheKey(apiCacheTestAccountID, apiCacheTestRegion, "EC2", "DescribeVpcAttribute", &ec2.DescribeVpcAttributeInput{Attribute: aws.String("enableDnsSupport"), VpcId: aws.String("vpc-1")})
+	k4, _ := newAPICacheKey(apiCacheTestAccountID, apiCacheTestRegion, "EC2", "DescribeVpcAttribute", &ec2_sdkv2.DescribeVpcAttributeInput{VpcId: aws.String("vpc-1")})
+
+	if k1 == k2 {
+		t.Error("keys for different inputs are equal")
+	}
+
+	if k1 != k3 {
+		t.Error("keys for equal inputs are different")
+	}
+
+	if k1 == k4 {
+		t.Error("keys for AWS SDK for Go v1 and v2 inputs are equal")
+	}
+
+	k5, _ := newAPICacheKey(apiCacheTestAccountID, apiCacheTestOtherRegion, "EC2", "DescribeVpcAttribute", &ec2.DescribeVpcAttributeInput{Attribute: aws.String("enableDnsSupport"), VpcId: aws.String("vpc-1")})
+	k6, _ := newAPICacheKey("210987654321", apiCacheTestRegion, "EC2", "DescribeVpcAttribute", &ec2.DescribeVpcAttributeInput{Attribute: aws.String("enableDnsSupport"), VpcId: aws.String("vpc-1")})
+
+	if k1 == k5 {
+		t.Error("keys for different Regions are equal")
+	}
+
+	if k1 == k6 {
+		t.Error("keys for different accounts are equal")
+	}
+}
+
+func TestAPICacheCopiesOutput(t *testing.T) {
+	t.Parallel()
+
+	c := NewAPICache(time.Minute)
+	k, _ := c.key(apiCacheTestRegion, "EC2", "DescribeAvailabilityZones", &ec2.DescribeAvailabilityZonesInput{})
+
+	output := &ec2.DescribeAvailabilityZonesOutput{
+		AvailabilityZones: []*ec2.AvailabilityZone{{ZoneName: aws.String("us-west-2a")}}, //lintignore:AWSAT003
+	}
+	c.put(k, output)
+
+	// Modifying the stored output doesn't modify the cached response.
+	output.AvailabilityZones[0].ZoneName = aws.String("modified")
+
+	v, ok := c.get(k)
+	if !ok {
+		t.Fatal("expected cache hit")
+	}
+
+	got := v.(*ec2.DescribeAvailabilityZonesOutput)
+	if want := "us-west-2a"; aws.StringValue(got.AvailabilityZones[0].ZoneName) != want { //lintignore:AWSAT003
+		t.Errorf("ZoneName = %q, want %q", aws.StringValue(got.AvailabilityZones[0].ZoneName), want)
+	}
+
+	// Modifying a returned response doesn't modify the cached response.
+	got.AvailabilityZones = nil
+
+	v, _ = c.get(k)
+	if got := len(v.(*ec2.DescribeAvailabilityZonesOutput).AvailabilityZones); got != 1 {
+		t.Errorf("len(AvailabilityZones) = %d, want 1", got)
+	}
+}
+
+func TestAPICacheMiddleware(t *testing.T) {
+	t.Parallel()
+
+	c := NewAPICache(time.Minute)
+	m := apiCacheMiddleware{cache: c}
+
+	var calls int
+	next := middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+		calls++
+		return middleware.InitializeOutput{Result: &ec2_sdkv2.DescribeAvailabilityZonesOutput{}}, middleware.Metadata{}, nil
+	})
+
+	invoke := func(operation string, params any) (middleware.InitializeOutput, middleware.Metadata, error) {
+		return awsmiddleware.RegisterServiceMetadata{ServiceID: "EC2", OperationName: operation}.HandleInitialize(context.Background(), middleware.InitializeInput{Parameters: params}, middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+			return m.HandleInitialize(ctx, in, next)
+		}))
+	}
+
+	for i := 0; i < 3; i++ {
+		out, _, err := invoke("DescribeAvailabilityZones", &ec2_sdkv2.DescribeAvailabilityZonesInput{})
+		if err != nil {
+			t.Fatalf("HandleInitialize error = %v", err)
+		}
+		if _, ok := out.Result.(*ec2_sdkv2.DescribeAvailabilityZonesOutput); !ok {
+			t.Fatalf("unexpected result type %T", out.Result)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+
+	// A mutating call invalidates the service's cached responses.
+	if _, _, err := invoke("ModifyAvailabilityZoneGroup", &ec2_sdkv2.ModifyAvailabilityZoneGroupInput{}); err != nil {
+		t.Fatalf("HandleInitialize error = %v", err)
+	}
+
+	if _, _, err := invoke("DescribeAvailabilityZones", &ec2_sdkv2.DescribeAvailabilityZonesInput{}); err != nil {
+		t.Fatalf("HandleInitialize error = %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestAPICacheHandlers(t *testing.T) {
+	t.Parallel()
+
+	c := NewAPICache(time.Minute)
+	build, complete := apiCacheBuildHandler(c), apiCacheCompleteHandler(c)
+
+	newRequest := func(data *ec2.DescribeAvailabilityZonesOutput) *request.Request {
+		r := &request.Request{
+			Data:      data,
+			Operation: &request.Operation{Name: "DescribeAvailabilityZones"},
+			Params:    &ec2.DescribeAvailabilityZonesInput{},
+		}
+		r.ClientInfo.ServiceID = "EC2"
+		r.Handlers.Send.PushBack(func(*request.Request) {})
+
+		return r
+	}
+
+	// Miss.
+	r := newRequest(&ec2.DescribeAvailabilityZonesOutput{
+		AvailabilityZones: []*ec2.AvailabilityZone{{ZoneName: aws.String("us-west-2a")}}, //lintignore:AWSAT003
+	})
+	build.Fn(r)
+	if r.Handlers.Send.Len() == 0 {
+		t.Fatal("Send handlers cleared on cache miss")
+	}
+	complete.Fn(r)
+
+	// Hit.
+	output := &ec2.DescribeAvailabilityZonesOutput{}
+	r = newRequest(output)
+	build.Fn(r)
+	if r.Handlers.Send.Len() != 0 {
+		t.Error("Send handlers not cleared on cache hit")
+	}
+	if got, want := len(output.AvailabilityZones), 1; got != want {
+		t.Errorf("len(AvailabilityZones) = %d, want %d", got, want)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/costestimation"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -24,29 +25,32 @@ import (
 )
 
 type AWSClient struct {
-	AccountID               string
-	DefaultTagsConfig       *tftags.DefaultConfig
-	DNSSuffix               string
-	IgnoreTagsConfig        *tftags.IgnoreConfig
-	MediaConvertAccountConn *mediaconvert_sdkv1.MediaConvert
-	Partition               string
-	Region                  string
-	ReverseDNSPrefix        string
-	ServicePackages         map[string]ServicePackage
-	Session                 *session_sdkv1.Session
-	TerraformVersion        string
-
-	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	AccountID                  string
+	CostEstimator              *costestimation.Estimator
+	DefaultTagsConfig          *tftags.DefaultConfig
+	DNSSuffix                  string
+	IgnoreTagsConfig           *tftags.IgnoreConfig
+	IgnoreTagsPermissionErrors bool
+	MediaConvertAccountConn    *mediaconvert_sdkv1.MediaConvert
+	Partition                  string
+	Region                     string
+	ReverseDNSPrefix           string
+	ServicePackages            map[string]ServicePackage
+	Session                    *session_sdkv1.Session
+	TerraformVersion           string
+
+	awsConfig                        *aws_sdkv2.Config
+	clients                          map[string]any
+	conns                            map[string]any
+	endpoints                        map[string]string // From provider configuration.
+	httpClient                       *http.Client
+	lock                             sync.Mutex
+	logger                           baselogging.Logger
+	s3DisableMultiRegionAccessPoints bool // From provider configuration.
+	s3ExpressClient                  *s3_sdkv2.Client
+	s3UsePathStyle                   bool   // From provider configuration.
+	s3USEast1RegionalEndpoint        string // From provider configuration.
+	stsRegion                        string // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -187,6 +191,7 @@ func (c *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
 	}
 	switch servicePackageName {
 	case names.S3:
+		m["s3_disable_multi_region_access_points"] = c.s3DisableMultiRegionAccessPoints
 		m["s3_use_path_style"] = c.s3UsePathStyle
 		// AWS SDK for Go v2 does not use the AWS_S3_US_EAST_1_REGIONAL_ENDPOINT environment variable during configuration.
 		// For compatibility, read it now.
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
@@ -18,6 +19,7 @@ import (
 	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/costestimation"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -25,40 +27,45 @@ import (
 )
 
 type Config struct {
-	AccessKey                      string
-	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
-	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
-	CustomCABundle                 string
-	DefaultTagsConfig              *tftags.DefaultConfig
-	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
-	EC2MetadataServiceEndpoint     string
-	EC2MetadataServiceEndpointMode string
-	Endpoints                      map[string]string
-	ForbiddenAccountIds            []string
-	HTTPProxy                      *string
-	HTTPSProxy                     *string
-	IgnoreTagsConfig               *tftags.IgnoreConfig
-	Insecure                       bool
-	MaxRetries                     int
-	NoProxy                        string
-	Profile                        string
-	Region                         string
-	RetryMode                      aws_sdkv2.RetryMode
-	S3UsePathStyle                 bool
-	S3USEast1RegionalEndpoint      string
-	SecretKey                      string
-	SharedConfigFiles              []string
-	SharedCredentialsFiles         []string
-	SkipCredsValidation            bool
-	SkipRegionValidation           bool
-	SkipRequestingAccountId        bool
-	STSRegion                      string
-	SuppressDebugLog               bool
-	TerraformVersion               string
-	Token                          string
-	UseDualStackEndpoint           bool
-	UseFIPSEndpoint                bool
+	AccessKey                        string
+	APICacheTTL                      time.Duration
+	AllowedAccountIds                []string
+	AssumeRole                       *awsbase.AssumeRole
+	AssumeRoleWithWebIdentity        *awsbase.AssumeRoleWithWebIdentity
+	CostEstimationOutputFile         string
+	CustomCABundle                   string
+	DefaultTagsConfig                *tftags.DefaultConfig
+	EC2MetadataServiceEnableState    imds_sdkv2.ClientEnableState
+	EC2MetadataServiceEndpoint       string
+	EC2MetadataServiceEndpointMode   string
+	Endpoints                        map[string]string
+	ForbiddenAccountIds              []string
+	HTTPProxy                        *string
+	HTTPSProxy                       *string
+	IgnoreTagsConfig                 *tftags.IgnoreConfig
+	IgnoreTagsPermissionErrors       bool
+	Insecure                         bool
+	MaxRetries                       int
+	NoProxy                          string
+	Profile                          string
+	Region                           string
+	RequiredCredentialSource         string
+	RetryMode                        aws_sdkv2.RetryMode
+	S3DisableMultiRegionAccessPoints bool
+	S3UsePathStyle                   bool
+	S3USEast1RegionalEndpoint        string
+	SecretKey                        string
+	SharedConfigFiles                []string
+	SharedCredentialsFiles           []string
+	SkipCredsValidation              bool
+	SkipRegionValidation             bool
+	SkipRequestingAccountId          bool
+	STSRegion                        string
+	SuppressDebugLog                 bool
+	TerraformVersion                 string
+	Token                            string
+	UseDualStackEndpoint             bool
+	UseFIPSEndpoint                  bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -144,6 +151,26 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// Report which credential source supplied credentials. Skipped when credentials are not otherwise validated
+	// so that configurations which never call AWS during Configure (e.g. mocks) are unaffected.
+	if c.RequiredCredentialSource != "" || !c.SkipCredsValidation {
+		diags = append(diags, inspectCredentialSource(ctx, cfg.Credentials, c.RequiredCredentialSource)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	// Count throttled requests so that heavy throttling can be surfaced as a diagnostic.
+	cfg.APIOptions = append(cfg.APIOptions, addThrottleCounterMiddleware)
+
+	// Optionally serve frequently repeated, read-only AWS API calls from a short-lived cache.
+	var apiCache *APICache
+	if c.APICacheTTL > 0 {
+		apiCache = NewAPICache(c.APICacheTTL)
+		cfg.APIOptions = append(cfg.APIOptions, addAPICacheMiddleware(apiCache))
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -168,6 +195,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	addThrottleCounterHandler(sess)
+
+	if apiCache != nil {
+		addAPICacheHandlers(sess, apiCache)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -184,6 +217,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
 	}
 
+	if apiCache != nil {
+		apiCache.SetAccountID(accountID)
+	}
+
 	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
 	if err != nil {
 		return nil, sdkdiag.AppendErrorf(diags, err.Error())
@@ -198,6 +235,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
+	client.IgnoreTagsPermissionErrors = c.IgnoreTagsPermissionErrors
 	client.Partition = partition
 	client.Region = c.Region
 	client.ReverseDNSPrefix = names.ReverseDNS(DNSSuffix)
@@ -205,12 +243,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
 
+	if c.CostEstimationOutputFile != "" {
+		client.CostEstimator = costestimation.New(c.CostEstimationOutputFile, c.Region, client.PricingClient)
+	}
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.s3DisableMultiRegionAccessPoints = c.S3DisableMultiRegionAccessPoints
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -74,6 +74,7 @@ func NewSessionForRegion(cfg *aws_sdkv1.Config, region, terraformVersion string)
 	apnInfo := StdUserAgentProducts(terraformVersion)
 
 	awsbasev1.SetSessionUserAgent(session, apnInfo, awsbase.UserAgentProducts{})
+	addThrottleCounterHandler(session)
 
 	return session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)}), nil
 }
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Credential sources, i.e. the link in the credential chain that supplied the provider's credentials.
+const (
+	CredentialSourceAssumeRole          = "assume_role"
+	CredentialSourceContainer           = "container"
+	CredentialSourceEC2InstanceMetadata = "ec2_instance_metadata"
+	CredentialSourceEnvironment         = "environment"
+	CredentialSourceProcess             = "process"
+	CredentialSourceSharedConfig        = "shared_config"
+	CredentialSourceSSO                 = "sso"
+	CredentialSourceStatic              = "static"
+	CredentialSourceWebIdentity         = "web_identity"
+)
+
+// CredentialSources returns all valid credential sources.
+func CredentialSources() []string {
+	return []string{
+		CredentialSourceAssumeRole,
+		CredentialSourceContainer,
+		CredentialSourceEC2InstanceMetadata,
+		CredentialSourceEnvironment,
+		CredentialSourceProcess,
+		CredentialSourceSharedConfig,
+		CredentialSourceSSO,
+		CredentialSourceStatic,
+		CredentialSourceWebIdentity,
+	}
+}
+
+// credentialProviderSources maps the Source reported by AWS SDK for Go v2 credentials providers to a credential source.
+// Shared config credentials append the file name to their Source, so matching is by prefix.
+var credentialProviderSources = []struct {
+	prefix string
+	source string
+}{
+	{"AssumeRoleProvider", CredentialSourceAssumeRole},
+	{"CredentialsEndpoint", CredentialSourceContainer}, // ECS task roles and EKS Pod Identity.
+	{"EC2RoleProvider", CredentialSourceEC2InstanceMetadata},
+	{"EnvConfigCredentials", CredentialSourceEnvironment},
+	{"ProcessProvider", CredentialSourceProcess},
+	{"SharedConfigCredentials", CredentialSourceSharedConfig},
+	{"SSOProvider", CredentialSourceSSO},
+	{"StaticCredentials", CredentialSourceStatic},
+	{"WebIdentityCredentials", CredentialSourceWebIdentity}, // Includes EKS IAM roles for service accounts (IRSA).
+}
+
+// CredentialSource returns the credential source for the specified credentials provider Source.
+// An empty string is returned if the provider is not recognized.
+func CredentialSource(providerSource string) string {
+	for _, v := range credentialProviderSources {
+		if strings.HasPrefix(providerSource, v.prefix) {
+			return v.source
+		}
+	}
+
+	return ""
+}
+
+// inspectCredentialSource retrieves credentials and logs which credential source supplied them.
+// If required is not empty, an error diagnostic is returned when the credentials come from a different source.
+func inspectCredentialSource(ctx context.Context, provider aws.CredentialsProvider, required string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if provider == nil {
+		if required != "" {
+			diags = append(diags, credentialSourceDiagnostic(required, "", "no credentials provider configured"))
+		}
+
+		return diags
+	}
+
+	creds, err := provider.Retrieve(ctx)
+
+	if err != nil {
+		tflog.Debug(ctx, "Unable to determine AWS credential source", map[string]any{
+			"error": err.Error(),
+		})
+
+		if required != "" {
+			diags = append(diags, credentialSourceDiagnostic(required, "", err.Error()))
+		}
+
+		return diags
+	}
+
+	source := CredentialSource(creds.Source)
+
+	tflog.Debug(ctx, "Resolved AWS credential source", map[string]any{
+		"tf_aws.credentials.source":          source,
+		"tf_aws.credentials.provider_source": creds.Source,
+	})
+
+	if required != "" && source != required {
+		diags = append(diags, credentialSourceDiagnostic(required, source, fmt.Sprintf("credentials provider %q", creds.Source)))
+	}
+
+	return diags
+}
+
+func credentialSourceDiagnostic(required, actual, reason string) diag.Diagnostic {
+	if actual == "" {
+		actual = "unknown"
+	}
+
+	detail := fmt.Sprintf("The provider requires credentials from the %q credential source, but they were supplied by the %q credential source (%s).", required, actual, reason)
+
+	if hint := containerCredentialsHint(required); hint != "" {
+		detail += "\n\n" + hint
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Unexpected AWS credential source",
+		Detail:   detail,
+	}
+}
+
+// containerCredentialsHint describes the environment that container credential sources depend on.
+func containerCredentialsHint(required string) string {
+	switch required {
+	case CredentialSourceContainer:
+		if os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI") == "" && os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI") == "" {
+			return "Neither AWS_CONTAINER_CREDENTIALS_FULL_URI nor AWS_CONTAINER_CREDENTIALS_RELATIVE_URI is set. " +
+				"For EKS Pod Identity, check that the Pod Identity Agent add-on is installed and that a pod identity association exists for the pod's service account."
+		}
+	case CredentialSourceWebIdentity:
+		if os.Getenv("AWS_ROLE_ARN") == "" || os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE") == "" {
+			return "AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE must both be set. " +
+				"For EKS IAM roles for service accounts (IRSA), check that the pod's service account is annotated with eks.amazonaws.com/role-arn."
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestCredentialSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"AssumeRoleProvider":   CredentialSourceAssumeRole,
+		"CredentialsEndpoint":  CredentialSourceContainer,
+		"EC2RoleProvider":      CredentialSourceEC2InstanceMetadata,
+		"EnvConfigCredentials": CredentialSourceEnvironment,
+		"ProcessProvider":      CredentialSourceProcess,
+		"SharedConfigCredentials: /home/user/.aws/credentials": CredentialSourceSharedConfig,
+		"SSOProvider":            CredentialSourceSSO,
+		"StaticCredentials":      CredentialSourceStatic,
+		"WebIdentityCredentials": CredentialSourceWebIdentity,
+		"CustomProvider":         "",
+		"":                       "",
+	}
+
+	for providerSource, want := range testCases {
+		providerSource, want := providerSource, want
+
+		t.Run(providerSource, func(t *testing.T) {
+			t.Parallel()
+
+			if got := CredentialSource(providerSource); got != want {
+				t.Errorf("CredentialSource(%q) = %q, want %q", providerSource, got, want)
+			}
+		})
+	}
+}
+
+func TestInspectCredentialSource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	provider := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{
+			AccessKeyID:     "AKIAEXAMPLE",
+			SecretAccessKey: "secret",
+			Source:          "WebIdentityCredentials",
+		}, nil
+	})
+
+	testCases := map[string]struct {
+		required  string
+		expectErr bool
+	}{
+		"not required": {},
+		"matching": {
+			required: CredentialSourceWebIdentity,
+		},
+		"mismatched": {
+			required:  CredentialSourceContainer,
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := inspectCredentialSource(ctx, provider, testCase.required)
+
+			if got, want := diags.HasError(), testCase.expectErr; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ThrottleWarningThreshold is the number of throttled attempts of a single AWS API operation,
+// within a single resource or data source operation, at or above which a warning diagnostic is emitted.
+const ThrottleWarningThreshold = 10
+
+type throttleCounterContextKeyType int
+
+var throttleCounterContextKey throttleCounterContextKeyType
+
+// ThrottledOperation is the number of throttled attempts of an AWS API operation.
+type ThrottledOperation struct {
+	Service   string
+	Operation string
+	Count     int
+}
+
+func (o ThrottledOperation) String() string {
+	return fmt.Sprintf("%s %s throttled %d times", o.Service, o.Operation, o.Count)
+}
+
+type throttledOperationKey struct {
+	service   string
+	operation string
+}
+
+// ThrottleCounter counts throttled AWS API attempts by service and operation.
+// It is safe for concurrent use.
+type ThrottleCounter struct {
+	mu     sync.Mutex
+	counts map[throttledOperationKey]int
+}
+
+// NewThrottleCounterContext returns a Context carrying a new, empty ThrottleCounter.
+func NewThrottleCounterContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, throttleCounterContextKey, &ThrottleCounter{
+		counts: make(map[throttledOperationKey]int),
+	})
+}
+
+// ThrottleCounterFromContext returns the ThrottleCounter carried in Context, if any.
+func ThrottleCounterFromContext(ctx context.Context) (*ThrottleCounter, bool) {
+	v, ok := ctx.Value(throttleCounterContextKey).(*ThrottleCounter)
+	return v, ok
+}
+
+func (c *ThrottleCounter) record(ctx context.Context, service, operation string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	k := throttledOperationKey{service: service, operation: operation}
+	c.counts[k]++
+
+	tflog.Debug(ctx, "AWS API request throttled", map[string]any{
+		"aws.service":   service,
+		"aws.operation": operation,
+		"count":         c.counts[k],
+	})
+}
+
+// Throttled returns the throttled operations with at least `threshold` throttled attempts,
+// most throttled first.
+func (c *ThrottleCounter) Throttled(threshold int) []ThrottledOperation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var ops []ThrottledOperation
+	for k, v := range c.counts {
+		if v >= threshold {
+			ops = append(ops, ThrottledOperation{Service: k.service, Operation: k.operation, Count: v})
+		}
+	}
+
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].Count != ops[j].Count {
+			return ops[i].Count > ops[j].Count
+		}
+		return ops[i].String() < ops[j].String()
+	})
+
+	return ops
+}
+
+// ThrottleWarningDetail returns the detail of the aggregated throttling warning diagnostic.
+func ThrottleWarningDetail(ops []ThrottledOperation) string {
+	var sb strings.Builder
+
+	for _, op := range ops {
+		fmt.Fprintf(&sb, "%s; consider raising the %s API request rate quota.\n", op, op.Service)
+	}
+	sb.WriteString("\nRequest rate quota increases can be requested via Service Quotas or AWS Support. ")
+	sb.WriteString("Alternatively reduce the number of concurrent operations with the -parallelism flag.")
+
+	return sb.String()
+}
+
+var isErrorThrottle = retry.IsErrorThrottles(retry.DefaultThrottles)
+
+// throttleCounterMiddleware is an AWS SDK for Go v2 Finalize middleware that runs once per request attempt
+// and records throttled attempts in any ThrottleCounter carried in Context.
+type throttleCounterMiddleware struct{}
+
+func (throttleCounterMiddleware) ID() string {
+	return "TerraformThrottleCounter"
+}
+
+func (throttleCounterMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	if err != nil && isErrorThrottle.IsErrorThrottle(err) == aws.TrueTernary {
+		if c, ok := ThrottleCounterFromContext(ctx); ok {
+			c.record(ctx, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx))
+		}
+	}
+
+	return out, metadata, err
+}
+
+// addThrottleCounterMiddleware adds the throttle counting middleware to an AWS SDK for Go v2 API stack.
+// The middleware is placed after the retry middleware so that every attempt is seen.
+func addThrottleCounterMiddleware(stack *middleware.Stack) error {
+	if err := stack.Finalize.Insert(throttleCounterMiddleware{}, "Retry", middleware.After); err != nil {
+		return stack.Finalize.Add(throttleCounterMiddleware{}, middleware.After)
+	}
+
+	return nil
+}
+
+// throttleCounterHandler is an AWS SDK for Go v1 Retry handler that records throttled attempts
+// in any ThrottleCounter carried in Context.
+var throttleCounterHandler = request_sdkv1.NamedHandler{
+	Name: "TerraformThrottleCounter",
+	Fn: func(r *request_sdkv1.Request) {
+		if r.Error == nil || !request_sdkv1.IsErrorThrottle(r.Error) {
+			return
+		}
+
+		ctx := r.Context()
+		if c, ok := ThrottleCounterFromContext(ctx); ok {
+			var operation string
+			if r.Operation != nil {
+				operation = r.Operation.Name
+			}
+			c.record(ctx, r.ClientInfo.ServiceID, operation)
+		}
+	},
+}
+
+// addThrottleCounterHandler adds the throttle counting handler to an AWS SDK for Go v1 session.
+func addThrottleCounterHandler(sess *session_sdkv1.Session) {
+	sess.Handlers.Retry.PushBackNamed(throttleCounterHandler)
+}
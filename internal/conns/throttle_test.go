@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestThrottleCounterThrottled(t *testing.T) {
+	t.Parallel()
+
+	ctx := NewThrottleCounterContext(context.Background())
+	c, ok := ThrottleCounterFromContext(ctx)
+	if !ok {
+		t.Fatal("no ThrottleCounter in Context")
+	}
+
+	for i := 0; i < 3; i++ {
+		c.record(ctx, "EC2", "DescribeSecurityGroups")
+	}
+	c.record(ctx, "IAM", "GetRole")
+	for i := 0; i < 5; i++ {
+		c.record(ctx, "S3", "HeadBucket")
+	}
+
+	testCases := []struct {
+		name      string
+		threshold int
+		expected  []ThrottledOperation
+	}{
+		{
+			name:      "all",
+			threshold: 1,
+			expected: []ThrottledOperation{
+				{Service: "S3", Operation: "HeadBucket", Count: 5},
+				{Service: "EC2", Operation: "DescribeSecurityGroups", Count: 3},
+				{Service: "IAM", Operation: "GetRole", Count: 1},
+			},
+		},
+		{
+			name:      "threshold",
+			threshold: 3,
+			expected: []ThrottledOperation{
+				{Service: "S3", Operation: "HeadBucket", Count: 5},
+				{Service: "EC2", Operation: "DescribeSecurityGroups", Count: 3},
+			},
+		},
+		{
+			name:      "none",
+			threshold: 10,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(c.Throttled(testCase.threshold), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestThrottleWarningDetail(t *testing.T) {
+	t.Parallel()
+
+	got := ThrottleWarningDetail([]ThrottledOperation{{Service: "EC2", Operation: "DescribeSecurityGroups", Count: 47}})
+
+	if want := "EC2 DescribeSecurityGroups throttled 47 times; consider raising the EC2 API request rate quota."; !strings.HasPrefix(got, want) {
+		t.Errorf("ThrottleWarningDetail = %q, want prefix %q", got, want)
+	}
+}
+
+func TestThrottleCounterMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{
+			name: "no error",
+		},
+		{
+			name: "not throttled",
+			err:  &smithy.GenericAPIError{Code: "AccessDenied"},
+		},
+		{
+			name:     "throttled",
+			err:      &smithy.GenericAPIError{Code: "Throttling"},
+			expected: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := awsmiddleware.SetServiceID(NewThrottleCounterContext(context.Background()), "EC2")
+			next := middleware.FinalizeHandlerFunc(func(ctx context.Context, in middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, testCase.err
+			})
+
+			_, _, err := throttleCounterMiddleware{}.HandleFinalize(ctx, middleware.FinalizeInput{}, next)
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("HandleFinalize error = %v, want %v", err, testCase.err)
+			}
+
+			c, _ := ThrottleCounterFromContext(ctx)
+			var got int
+			for _, v := range c.Throttled(1) {
+				got += v.Count
+			}
+			if got != testCase.expected {
+				t.Errorf("throttled count = %d, want %d", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestThrottleCounterHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx := NewThrottleCounterContext(context.Background())
+	r := &request.Request{
+		Error:       awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+		HTTPRequest: &http.Request{},
+		Operation:   &request.Operation{Name: "DescribeSecurityGroups"},
+	}
+	r.ClientInfo.ServiceID = "EC2"
+	r.SetContext(ctx)
+
+	throttleCounterHandler.Fn(r)
+
+	c, _ := ThrottleCounterFromContext(ctx)
+	if diff := cmp.Diff(c.Throttled(1), []ThrottledOperation{{Service: "EC2", Operation: "DescribeSecurityGroups", Count: 1}}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
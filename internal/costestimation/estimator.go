@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package costestimation annotates planned resources with pricing metadata
+// obtained from the AWS Price List Query API and writes it to a machine-readable JSON file.
+package costestimation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+const (
+	// FormatVersion is the version of the cost estimation output file format.
+	FormatVersion = "1.0"
+
+	currencyCode = "USD"
+)
+
+// ResourceData is the subset of Plugin SDK v2 schema.ResourceDiff and schema.ResourceData methods used by mappers.
+type ResourceData interface {
+	Get(key string) any
+	GetRawPlan() cty.Value
+	Id() string
+}
+
+// productsGetter is the subset of the Pricing API client used to look up product prices.
+type productsGetter interface {
+	GetProducts(context.Context, *pricing.GetProductsInput, ...func(*pricing.Options)) (*pricing.GetProductsOutput, error)
+}
+
+// Estimator records pricing metadata for planned resources and writes it to an output file.
+// An Estimator is safe for concurrent use.
+type Estimator struct {
+	conn       func(context.Context) productsGetter
+	outputFile string
+	region     string
+
+	lock      sync.Mutex
+	prices    map[string]*price
+	keys      map[string]int // Index into resources of each recorded resource, by resourceKey.
+	resources []*Resource
+}
+
+var (
+	// estimators are all Estimators created by this process, written by WriteAll.
+	estimators     []*Estimator
+	estimatorsLock sync.Mutex
+)
+
+// Output is the cost estimation output file.
+type Output struct {
+	FormatVersion    string      `json:"format_version"`
+	GeneratedAt      string      `json:"generated_at"`
+	Region           string      `json:"region"`
+	Currency         string      `json:"currency"`
+	Resources        []*Resource `json:"resources"`
+	TotalMonthlyCost float64     `json:"total_monthly_cost"`
+}
+
+// Resource is the pricing metadata for a single planned resource.
+type Resource struct {
+	ResourceType string   `json:"resource_type"`
+	ID           string   `json:"id,omitempty"`
+	Usage        []*Usage `json:"usage"`
+	MonthlyCost  float64  `json:"monthly_cost"`
+}
+
+// Usage is the pricing metadata for a single product SKU used by a planned resource.
+type Usage struct {
+	Description     string  `json:"description"`
+	ServiceCode     string  `json:"service_code"`
+	SKU             string  `json:"sku"`
+	ProductFamily   string  `json:"product_family"`
+	Unit            string  `json:"unit"`
+	PricePerUnit    float64 `json:"price_per_unit"`
+	MonthlyQuantity float64 `json:"monthly_quantity"`
+	MonthlyCost     float64 `json:"monthly_cost"`
+	UsageBased      bool    `json:"usage_based,omitempty"`
+}
+
+// New returns a new Estimator that writes to the specified output file when WriteAll is called.
+func New(outputFile, region string, conn func(context.Context) *pricing.Client) *Estimator {
+	e := &Estimator{
+		conn: func(ctx context.Context) productsGetter {
+			return conn(ctx)
+		},
+		outputFile: outputFile,
+		region:     region,
+		prices:     make(map[string]*price),
+		keys:       make(map[string]int),
+	}
+
+	estimatorsLock.Lock()
+	defer estimatorsLock.Unlock()
+
+	estimators = append(estimators, e)
+
+	return e
+}
+
+// WriteAll writes the output file of each Estimator that has recorded resources.
+// It is called once, when the provider process shuts down at the end of a Terraform graph walk.
+func WriteAll() error {
+	estimatorsLock.Lock()
+	defer estimatorsLock.Unlock()
+
+	var errs []error
+
+	for _, e := range estimators {
+		if err := e.write(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// HasMapper returns whether pricing metadata can be recorded for the specified resource type.
+func HasMapper(typeName string) bool {
+	_, ok := mappers[typeName]
+
+	return ok
+}
+
+// Record looks up the pricing metadata for the specified planned resource.
+func (e *Estimator) Record(ctx context.Context, typeName string, d ResourceData) error {
+	mapper, ok := mappers[typeName]
+	if !ok {
+		return nil
+	}
+
+	queries := mapper(d)
+	if len(queries) == 0 {
+		return nil
+	}
+
+	resource := &Resource{
+		ResourceType: typeName,
+		ID:           d.Id(),
+	}
+
+	for _, query := range queries {
+		price, err := e.findPrice(ctx, query)
+
+		if err != nil {
+			return fmt.Errorf("estimating cost of %s (%s): %w", typeName, query.description, err)
+		}
+
+		usage := &Usage{
+			Description:     query.description,
+			ServiceCode:     query.serviceCode,
+			SKU:             price.sku,
+			ProductFamily:   price.productFamily,
+			Unit:            price.unit,
+			PricePerUnit:    price.pricePerUnit,
+			MonthlyQuantity: query.monthlyQuantity,
+			MonthlyCost:     price.pricePerUnit * query.monthlyQuantity,
+			UsageBased:      query.usageBased,
+		}
+
+		resource.Usage = append(resource.Usage, usage)
+		resource.MonthlyCost += usage.MonthlyCost
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.addResource(resourceKey(typeName, d), resource)
+
+	return nil
+}
+
+// resourceKey returns a key that identifies the specified planned resource across repeated plans.
+// Terraform doesn't send resource addresses to providers, so existing resources are keyed by ID
+// and resources that are yet to be created by their planned values.
+func resourceKey(typeName string, d ResourceData) string {
+	if id := d.Id(); id != "" {
+		return typeName + "," + id
+	}
+
+	sum := sha256.Sum256([]byte(d.GetRawPlan().GoString()))
+
+	return typeName + ",plan:" + hex.EncodeToString(sum[:])
+}
+
+// addResource adds the specified resource, replacing any previously recorded resource with the same key.
+func (e *Estimator) addResource(key string, resource *Resource) {
+	if i, ok := e.keys[key]; ok {
+		e.resources[i] = resource
+
+		return
+	}
+
+	e.keys[key] = len(e.resources)
+	e.resources = append(e.resources, resource)
+}
+
+// write atomically replaces the output file with the currently recorded resources.
+// Nothing is written if no resources have been recorded.
+func (e *Estimator) write() error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if len(e.resources) == 0 {
+		return nil
+	}
+
+	output := &Output{
+		FormatVersion: FormatVersion,
+		GeneratedAt:   time.Now().UTC().Format(time.RFC3339),
+		Region:        e.region,
+		Currency:      currencyCode,
+		Resources:     e.resources,
+	}
+
+	for _, v := range e.resources {
+		output.TotalMonthlyCost += v.MonthlyCost
+	}
+
+	b, err := json.MarshalIndent(output, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("encoding cost estimation output: %w", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(e.outputFile), filepath.Base(e.outputFile)+".*")
+
+	if err != nil {
+		return fmt.Errorf("writing cost estimation output (%s): %w", e.outputFile, err)
+	}
+
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+
+		return fmt.Errorf("writing cost estimation output (%s): %w", e.outputFile, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("writing cost estimation output (%s): %w", e.outputFile, err)
+	}
+
+	if err := os.Rename(f.Name(), e.outputFile); err != nil {
+		return fmt.Errorf("writing cost estimation output (%s): %w", e.outputFile, err)
+	}
+
+	return nil
+}
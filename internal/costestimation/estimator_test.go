@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costestimation
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type mockProductsGetter struct {
+	inputs    []*pricing.GetProductsInput
+	priceList map[string]string // Keyed by service code.
+}
+
+func (m *mockProductsGetter) GetProducts(_ context.Context, input *pricing.GetProductsInput, _ ...func(*pricing.Options)) (*pricing.GetProductsOutput, error) {
+	m.inputs = append(m.inputs, input)
+
+	output := &pricing.GetProductsOutput{}
+	if v, ok := m.priceList[aws.ToString(input.ServiceCode)]; ok {
+		output.PriceList = []string{v}
+	}
+
+	return output, nil
+}
+
+const testPriceListItem = `{
+  "product": {
+    "productFamily": "Compute Instance",
+    "sku": "SKU123"
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU123.JRTCKXETXF": {
+        "priceDimensions": {
+          "SKU123.JRTCKXETXF.6YS6EN2CT7": {
+            "beginRange": "0",
+            "endRange": "Inf",
+            "pricePerUnit": {
+              "USD": "0.0500000000"
+            },
+            "unit": "Hrs"
+          }
+        }
+      }
+    }
+  }
+}`
+
+func TestParsePriceListItem(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input     string
+		expected  *price
+		expectErr bool
+	}{
+		"on-demand price": {
+			input: testPriceListItem,
+			expected: &price{
+				sku:           "SKU123",
+				productFamily: "Compute Instance",
+				unit:          "Hrs",
+				pricePerUnit:  0.05,
+			},
+		},
+		"tiered price": {
+			input: `{
+  "product": {"productFamily": "Storage", "sku": "SKU456"},
+  "terms": {"OnDemand": {"SKU456.JRTCKXETXF": {"priceDimensions": {
+    "SKU456.JRTCKXETXF.PGHJ3S3EYE": {"beginRange": "51200", "pricePerUnit": {"USD": "0.0220000000"}, "unit": "GB-Mo"},
+    "SKU456.JRTCKXETXF.D42MF2PVJS": {"beginRange": "0", "pricePerUnit": {"USD": "0.0230000000"}, "unit": "GB-Mo"}
+  }}}}
+}`,
+			expected: &price{
+				sku:           "SKU456",
+				productFamily: "Storage",
+				unit:          "GB-Mo",
+				pricePerUnit:  0.023,
+			},
+		},
+		"no on-demand price": {
+			input:     `{"product": {"sku": "SKU789"}, "terms": {}}`,
+			expectErr: true,
+		},
+		"invalid JSON": {
+			input:     `{`,
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parsePriceListItem(testCase.input)
+
+			if err != nil {
+				if !testCase.expectErr {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if testCase.expectErr {
+				t.Fatal("expected error")
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(price{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEstimatorRecord(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	outputFile := filepath.Join(t.TempDir(), "cost.json")
+	conn := &mockProductsGetter{
+		priceList: map[string]string{
+			"AmazonEC2": testPriceListItem,
+		},
+	}
+	e := &Estimator{
+		conn: func(context.Context) productsGetter {
+			return conn
+		},
+		outputFile: outputFile,
+		region:     "us-west-2", //lintignore:AWSAT003
+		prices:     make(map[string]*price),
+		keys:       make(map[string]int),
+	}
+
+	resourceSchema := map[string]*schema.Schema{
+		"instance_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"tenancy": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"root_block_device": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"volume_size": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"volume_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	d1 := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{
+		"instance_type": "t3.micro",
+	})
+	d1.SetId("i-11111111")
+	d2 := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{
+		"instance_type": "t3.micro",
+	})
+	d2.SetId("i-22222222")
+
+	for _, d := range []*schema.ResourceData{d1, d2, d1} {
+		if err := e.Record(ctx, "aws_instance", d); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, expected := len(conn.inputs), 1; got != expected {
+		t.Errorf("GetProducts calls: got %d, expected %d", got, expected)
+	}
+
+	if _, err := os.Stat(outputFile); !os.IsNotExist(err) {
+		t.Errorf("expected no output file before write, got: %s", err)
+	}
+
+	if err := e.write(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(outputFile)
+
+	if err != nil {
+		t.Fatalf("reading output file: %s", err)
+	}
+
+	var output Output
+	if err := json.Unmarshal(b, &output); err != nil {
+		t.Fatalf("decoding output file: %s", err)
+	}
+
+	if got, expected := len(output.Resources), 2; got != expected {
+		t.Fatalf("resources: got %d, expected %d", got, expected)
+	}
+
+	usage := &Usage{
+		Description:     "Instance usage (Linux, On-Demand)",
+		ServiceCode:     "AmazonEC2",
+		SKU:             "SKU123",
+		ProductFamily:   "Compute Instance",
+		Unit:            "Hrs",
+		PricePerUnit:    0.05,
+		MonthlyQuantity: hoursPerMonth,
+		MonthlyCost:     0.05 * hoursPerMonth,
+	}
+	expected := &Resource{
+		ResourceType: "aws_instance",
+		ID:           "i-11111111",
+		Usage:        []*Usage{usage},
+		MonthlyCost:  0.05 * hoursPerMonth,
+	}
+
+	if diff := cmp.Diff(output.Resources[0], expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, expected := output.TotalMonthlyCost, 2*0.05*hoursPerMonth; got != expected {
+		t.Errorf("total monthly cost: got %f, expected %f", got, expected)
+	}
+}
+
+func TestEstimatorRecordNoMapper(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	outputFile := filepath.Join(t.TempDir(), "cost.json")
+	e := New(outputFile, "us-west-2", nil) //lintignore:AWSAT003
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]any{})
+
+	if err := e.Record(ctx, "aws_vpc", d); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := os.Stat(outputFile); !os.IsNotExist(err) {
+		t.Errorf("expected no output file, got: %s", err)
+	}
+}
+
+// plannedResourceData is a resource that is yet to be created, with the specified planned values.
+type plannedResourceData struct {
+	*schema.ResourceData
+	plan cty.Value
+}
+
+func (d plannedResourceData) GetRawPlan() cty.Value {
+	return d.plan
+}
+
+func TestEstimatorRecordPlanned(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := &mockProductsGetter{
+		priceList: map[string]string{
+			"AmazonEC2": testPriceListItem,
+		},
+	}
+	e := &Estimator{
+		conn: func(context.Context) productsGetter {
+			return conn
+		},
+		region: "us-west-2", //lintignore:AWSAT003
+		prices: make(map[string]*price),
+		keys:   make(map[string]int),
+	}
+
+	resourceSchema := map[string]*schema.Schema{
+		"instance_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"root_block_device": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"volume_size": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"volume_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"tenancy": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+	planned := func(name string) ResourceData {
+		return plannedResourceData{
+			ResourceData: schema.TestResourceDataRaw(t, resourceSchema, map[string]any{
+				"instance_type": "t3.micro",
+			}),
+			plan: cty.ObjectVal(map[string]cty.Value{
+				"instance_type": cty.StringVal("t3.micro"),
+				"tags":          cty.MapVal(map[string]cty.Value{"Name": cty.StringVal(name)}),
+			}),
+		}
+	}
+
+	// The second resource is planned twice.
+	for _, d := range []ResourceData{planned("first"), planned("second"), planned("second")} {
+		if err := e.Record(ctx, "aws_instance", d); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, expected := len(e.resources), 2; got != expected {
+		t.Errorf("resources: got %d, expected %d", got, expected)
+	}
+
+	for _, v := range conn.inputs {
+		if got, expected := len(v.Filters), 7; got != expected {
+			t.Errorf("GetProducts filters: got %d, expected %d", got, expected)
+		}
+	}
+}
+
+func TestFindPriceDoesNotModifyQuery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := &mockProductsGetter{
+		priceList: map[string]string{
+			"AmazonEC2": testPriceListItem,
+		},
+	}
+	e := &Estimator{
+		conn: func(context.Context) productsGetter {
+			return conn
+		},
+		region: "us-west-2", //lintignore:AWSAT003
+		prices: make(map[string]*price),
+		keys:   make(map[string]int),
+	}
+
+	filters := map[string]string{
+		"instanceType": "t3.micro",
+	}
+	query := productQuery{
+		serviceCode: "AmazonEC2",
+		filters:     filters,
+	}
+
+	if _, err := e.findPrice(ctx, query); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := filters["regionCode"]; ok {
+		t.Error("expected query filters to be unmodified")
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costestimation
+
+// hoursPerMonth is the number of hours in a month used by AWS pricing calculations.
+const hoursPerMonth = 730
+
+// A mapperFunc maps a planned resource to the price list products it uses.
+// Resources whose products can't be determined at plan time, e.g. because of unknown values, map to no products.
+type mapperFunc func(ResourceData) []productQuery
+
+var mappers = map[string]mapperFunc{
+	"aws_db_instance": dbInstanceMapper,
+	"aws_instance":    instanceMapper,
+	"aws_s3_bucket":   bucketMapper,
+}
+
+// instanceMapper maps an EC2 instance to its On-Demand Linux compute hours and root EBS volume storage.
+func instanceMapper(d ResourceData) []productQuery {
+	instanceType := d.Get("instance_type").(string)
+	if instanceType == "" {
+		return nil
+	}
+
+	tenancy := "Shared"
+	switch d.Get("tenancy").(string) {
+	case "dedicated":
+		tenancy = "Dedicated"
+	case "host":
+		tenancy = "Host"
+	}
+
+	queries := []productQuery{
+		{
+			description: "Instance usage (Linux, On-Demand)",
+			serviceCode: "AmazonEC2",
+			filters: map[string]string{
+				"capacitystatus":  "Used",
+				"instanceType":    instanceType,
+				"marketoption":    "OnDemand",
+				"operatingSystem": "Linux",
+				"preInstalledSw":  "NA",
+				"tenancy":         tenancy,
+			},
+			monthlyQuantity: hoursPerMonth,
+		},
+	}
+
+	if volumeSize, volumeType := d.Get("root_block_device.0.volume_size").(int), d.Get("root_block_device.0.volume_type").(string); volumeSize > 0 && volumeType != "" {
+		queries = append(queries, productQuery{
+			description: "Root block device storage",
+			serviceCode: "AmazonEC2",
+			filters: map[string]string{
+				"productFamily": "Storage",
+				"volumeApiName": volumeType,
+			},
+			monthlyQuantity: float64(volumeSize),
+		})
+	}
+
+	return queries
+}
+
+var dbInstanceDatabaseEngines = map[string]string{
+	"mariadb":  "MariaDB",
+	"mysql":    "MySQL",
+	"postgres": "PostgreSQL",
+}
+
+var dbInstanceStorageVolumeTypes = map[string]string{
+	"gp2":      "General Purpose",
+	"gp3":      "General Purpose-GP3",
+	"io1":      "Provisioned IOPS",
+	"standard": "Magnetic",
+}
+
+// dbInstanceMapper maps an RDS DB instance to its On-Demand instance hours and allocated storage.
+// Only open source database engines are supported.
+func dbInstanceMapper(d ResourceData) []productQuery {
+	instanceClass := d.Get("instance_class").(string)
+	if instanceClass == "" {
+		return nil
+	}
+
+	databaseEngine, ok := dbInstanceDatabaseEngines[d.Get("engine").(string)]
+	if !ok {
+		return nil
+	}
+
+	deploymentOption := "Single-AZ"
+	if d.Get("multi_az").(bool) {
+		deploymentOption = "Multi-AZ"
+	}
+
+	queries := []productQuery{
+		{
+			description: "Database instance usage (On-Demand)",
+			serviceCode: "AmazonRDS",
+			filters: map[string]string{
+				"databaseEngine":   databaseEngine,
+				"deploymentOption": deploymentOption,
+				"instanceType":     instanceClass,
+				"productFamily":    "Database Instance",
+			},
+			monthlyQuantity: hoursPerMonth,
+		},
+	}
+
+	if allocatedStorage := d.Get("allocated_storage").(int); allocatedStorage > 0 {
+		if volumeType, ok := dbInstanceStorageVolumeTypes[d.Get("storage_type").(string)]; ok {
+			queries = append(queries, productQuery{
+				description: "Database storage",
+				serviceCode: "AmazonRDS",
+				filters: map[string]string{
+					"databaseEngine":   databaseEngine,
+					"deploymentOption": deploymentOption,
+					"productFamily":    "Database Storage",
+					"volumeType":       volumeType,
+				},
+				monthlyQuantity: float64(allocatedStorage),
+			})
+		}
+	}
+
+	return queries
+}
+
+// bucketMapper maps an S3 bucket to S3 Standard storage.
+// Storage is billed on usage, so only the price per unit is recorded.
+func bucketMapper(d ResourceData) []productQuery {
+	return []productQuery{
+		{
+			description: "Standard storage",
+			serviceCode: "AmazonS3",
+			filters: map[string]string{
+				"productFamily": "Storage",
+				"volumeType":    "Standard",
+			},
+			usageBased: true,
+		},
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costestimation
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDBInstanceMapper(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := map[string]*schema.Schema{
+		"allocated_storage": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"engine": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"instance_class": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"multi_az": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"storage_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	testCases := map[string]struct {
+		raw      map[string]any
+		expected []productQuery
+	}{
+		"unknown instance class": {
+			raw: map[string]any{
+				"engine": "mysql",
+			},
+		},
+		"unsupported engine": {
+			raw: map[string]any{
+				"engine":         "oracle-ee",
+				"instance_class": "db.t3.micro",
+			},
+		},
+		"instance only": {
+			raw: map[string]any{
+				"engine":         "postgres",
+				"instance_class": "db.t3.micro",
+			},
+			expected: []productQuery{
+				{
+					description: "Database instance usage (On-Demand)",
+					serviceCode: "AmazonRDS",
+					filters: map[string]string{
+						"databaseEngine":   "PostgreSQL",
+						"deploymentOption": "Single-AZ",
+						"instanceType":     "db.t3.micro",
+						"productFamily":    "Database Instance",
+					},
+					monthlyQuantity: hoursPerMonth,
+				},
+			},
+		},
+		"multi-AZ with storage": {
+			raw: map[string]any{
+				"allocated_storage": 20,
+				"engine":            "mysql",
+				"instance_class":    "db.m5.large",
+				"multi_az":          true,
+				"storage_type":      "gp3",
+			},
+			expected: []productQuery{
+				{
+					description: "Database instance usage (On-Demand)",
+					serviceCode: "AmazonRDS",
+					filters: map[string]string{
+						"databaseEngine":   "MySQL",
+						"deploymentOption": "Multi-AZ",
+						"instanceType":     "db.m5.large",
+						"productFamily":    "Database Instance",
+					},
+					monthlyQuantity: hoursPerMonth,
+				},
+				{
+					description: "Database storage",
+					serviceCode: "AmazonRDS",
+					filters: map[string]string{
+						"databaseEngine":   "MySQL",
+						"deploymentOption": "Multi-AZ",
+						"productFamily":    "Database Storage",
+						"volumeType":       "General Purpose-GP3",
+					},
+					monthlyQuantity: 20,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, resourceSchema, testCase.raw)
+			got := dbInstanceMapper(d)
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(productQuery{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceMapperTenancy(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := map[string]*schema.Schema{
+		"instance_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"tenancy": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"root_block_device": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"volume_size": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"volume_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		tenancy  string
+		expected string
+	}{
+		"default": {
+			expected: "Shared",
+		},
+		"dedicated": {
+			tenancy:  "dedicated",
+			expected: "Dedicated",
+		},
+		"host": {
+			tenancy:  "host",
+			expected: "Host",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{
+				"instance_type": "m5.large",
+				"tenancy":       testCase.tenancy,
+				"root_block_device": []any{
+					map[string]any{
+						"volume_size": 8,
+						"volume_type": "gp3",
+					},
+				},
+			})
+			got := instanceMapper(d)
+
+			if got, expected := len(got), 2; got != expected {
+				t.Fatalf("queries: got %d, expected %d", got, expected)
+			}
+
+			if got, expected := got[0].filters["tenancy"], testCase.expected; got != expected {
+				t.Errorf("tenancy: got %s, expected %s", got, expected)
+			}
+
+			if got, expected := got[1].monthlyQuantity, 8.0; got != expected {
+				t.Errorf("root block device quantity: got %f, expected %f", got, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costestimation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+)
+
+// The Price List Query API is only available in these Regions.
+var priceListRegions = []string{
+	"ap-south-1",   // lintignore:AWSAT003
+	"eu-central-1", // lintignore:AWSAT003
+	"us-east-1",    // lintignore:AWSAT003
+}
+
+const defaultPriceListRegion = "us-east-1" // lintignore:AWSAT003
+
+// productQuery identifies a product in the price list and the planned monthly quantity of its usage.
+type productQuery struct {
+	description     string
+	serviceCode     string
+	filters         map[string]string
+	monthlyQuantity float64
+	usageBased      bool // The monthly quantity can't be determined from configuration.
+}
+
+// cacheKey returns a key that uniquely identifies the queried product.
+func (q productQuery) cacheKey() string {
+	keys := make([]string, 0, len(q.filters))
+	for k := range q.filters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteString(q.serviceCode)
+	for _, k := range keys {
+		fmt.Fprintf(&sb, ",%s=%s", k, q.filters[k])
+	}
+
+	return sb.String()
+}
+
+// price is the On-Demand price of a single product.
+type price struct {
+	sku           string
+	productFamily string
+	unit          string
+	pricePerUnit  float64
+}
+
+// findPrice returns the On-Demand price of the queried product in the Estimator's Region.
+// Prices are cached for the lifetime of the Estimator.
+func (e *Estimator) findPrice(ctx context.Context, query productQuery) (*price, error) {
+	// Mappers may share filter maps between queries, so add the Region to a copy.
+	filters := maps.Clone(query.filters)
+	if filters == nil {
+		filters = make(map[string]string)
+	}
+	filters["regionCode"] = e.region
+	query.filters = filters
+	key := query.cacheKey()
+
+	e.lock.Lock()
+	v, ok := e.prices[key]
+	e.lock.Unlock()
+
+	if ok {
+		return v, nil
+	}
+
+	input := &pricing.GetProductsInput{
+		MaxResults:  aws.Int32(1),
+		ServiceCode: aws.String(query.serviceCode),
+	}
+
+	for k, v := range query.filters {
+		input.Filters = append(input.Filters, awstypes.Filter{
+			Field: aws.String(k),
+			Type:  awstypes.FilterTypeTermMatch,
+			Value: aws.String(v),
+		})
+	}
+
+	output, err := e.conn(ctx).GetProducts(ctx, input, func(o *pricing.Options) {
+		if !inPriceListRegion(o.Region) {
+			o.Region = defaultPriceListRegion
+		}
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Pricing Products: %w", err)
+	}
+
+	if len(output.PriceList) == 0 {
+		return nil, errors.New("no matching Pricing Products")
+	}
+
+	v, err = parsePriceListItem(output.PriceList[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	e.lock.Lock()
+	e.prices[key] = v
+	e.lock.Unlock()
+
+	return v, nil
+}
+
+func inPriceListRegion(region string) bool {
+	for _, v := range priceListRegions {
+		if v == region {
+			return true
+		}
+	}
+
+	return false
+}
+
+type priceListItem struct {
+	Product struct {
+		ProductFamily string `json:"productFamily"`
+		SKU           string `json:"sku"`
+	} `json:"product"`
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				BeginRange   string            `json:"beginRange"`
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+				Unit         string            `json:"unit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+// parsePriceListItem returns the first tier of the On-Demand price in the specified price list item.
+func parsePriceListItem(s string) (*price, error) {
+	var item priceListItem
+
+	if err := json.Unmarshal([]byte(s), &item); err != nil {
+		return nil, fmt.Errorf("decoding price list item: %w", err)
+	}
+
+	for _, term := range item.Terms.OnDemand {
+		for _, dimension := range term.PriceDimensions {
+			if dimension.BeginRange != "" && dimension.BeginRange != "0" {
+				continue
+			}
+
+			v, ok := dimension.PricePerUnit[currencyCode]
+			if !ok {
+				continue
+			}
+
+			pricePerUnit, err := strconv.ParseFloat(v, 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("parsing price per unit (%s): %w", v, err)
+			}
+
+			return &price{
+				sku:           item.Product.SKU,
+				productFamily: item.Product.ProductFamily,
+				unit:          dimension.Unit,
+				pricePerUnit:  pricePerUnit,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no On-Demand %s price for SKU (%s)", currencyCode, item.Product.SKU)
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+const (
+	errCodeUnauthorizedOperation = "UnauthorizedOperation"
+)
+
+// IsAccessDeniedError returns true if the error indicates that the caller lacks the IAM permissions
+// required to perform the operation.
+func IsAccessDeniedError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return errCodeContains(err, errCodeAccessDenied) ||
+		errCodeContains(err, errCodeAuthorizationError) ||
+		errCodeContains(err, errCodeUnauthorizedOperation)
+}
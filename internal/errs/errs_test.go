@@ -4,8 +4,10 @@
 package errs_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
@@ -51,3 +53,46 @@ func TestIsAErrorMessageContains(t *testing.T) {
 		t.Error("unexpected false")
 	}
 }
+
+func TestIsAccessDeniedError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {
+			err: nil,
+		},
+		"other error": {
+			err: errors.New("test"),
+		},
+		"AccessDenied": {
+			err:      awserr.New("AccessDenied", "test", nil),
+			expected: true,
+		},
+		"AccessDeniedException": {
+			err:      awserr.New("AccessDeniedException", "test", nil),
+			expected: true,
+		},
+		"UnauthorizedOperation": {
+			err:      awserr.New("UnauthorizedOperation", "test", nil),
+			expected: true,
+		},
+		"ValidationException": {
+			err: awserr.New("ValidationException", "test", nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := errs.IsAccessDeniedError(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsAccessDeniedError = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -38,6 +38,31 @@ func DiagnosticString(d diag.Diagnostic) string {
 	return buf.String()
 }
 
+// alreadyExistsErrorDiagnostic is an error Diagnostic raised when a resource's Create
+// fails because the remote object already exists.
+type alreadyExistsErrorDiagnostic struct {
+	diag.ErrorDiagnostic
+}
+
+// NewAlreadyExistsErrorDiagnostic returns an error Diagnostic indicating that the remote object already exists.
+// Resources that support adopting existing objects report Create conflicts with this Diagnostic.
+func NewAlreadyExistsErrorDiagnostic(summary, detail string) diag.Diagnostic {
+	return alreadyExistsErrorDiagnostic{
+		ErrorDiagnostic: diag.NewErrorDiagnostic(summary, detail),
+	}
+}
+
+// ContainsAlreadyExists returns true if any of the Diagnostics were created by NewAlreadyExistsErrorDiagnostic.
+func ContainsAlreadyExists(diags diag.Diagnostics) bool {
+	for _, d := range diags {
+		if _, ok := d.(alreadyExistsErrorDiagnostic); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 func NewResourceNotFoundWarningDiagnostic(err error) diag.Diagnostic {
 	return diag.NewWarningDiagnostic(
 		"AWS resource not found during refresh",
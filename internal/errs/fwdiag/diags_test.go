@@ -69,3 +69,49 @@ func TestDiagnosticsError(t *testing.T) {
 		})
 	}
 }
+
+func TestContainsAlreadyExists(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		diags    diag.Diagnostics
+		want     bool
+	}{
+		{
+			testName: "nil Diagnostics",
+		},
+		{
+			testName: "error Diagnostics",
+			diags:    diag.Diagnostics{diag.NewErrorDiagnostic("summary", "detail")},
+		},
+		{
+			testName: "already exists Diagnostics",
+			diags:    diag.Diagnostics{fwdiag.NewAlreadyExistsErrorDiagnostic("summary", "detail")},
+			want:     true,
+		},
+		{
+			testName: "mixed Diagnostics",
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("summary1", "detail1"),
+				fwdiag.NewAlreadyExistsErrorDiagnostic("summary2", "detail2"),
+			},
+			want: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := fwdiag.ContainsAlreadyExists(testCase.diags); got != testCase.want {
+				t.Errorf("got = %v, want = %v", got, testCase.want)
+			}
+
+			if testCase.want && !testCase.diags.HasError() {
+				t.Errorf("expected already exists Diagnostic to have error severity")
+			}
+		})
+	}
+}
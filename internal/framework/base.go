@@ -107,6 +107,14 @@ func (w *WithImportByID) ImportState(ctx context.Context, request resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
 }
 
+// ResourceWithAdoptExisting is implemented by resources which can adopt an existing remote object on Create.
+// The resource's schema must include the "adopt_existing" attribute (see AdoptExistingAttribute) and
+// Create must report conflicts using fwdiag.NewAlreadyExistsErrorDiagnostic.
+type ResourceWithAdoptExisting interface {
+	// AdoptExisting reads the existing remote object into the response's State.
+	AdoptExisting(context.Context, resource.CreateRequest, *resource.CreateResponse)
+}
+
 // DataSourceWithConfigure is a structure to be embedded within a DataSource that implements the DataSourceWithConfigure interface.
 type DataSourceWithConfigure struct {
 	withMeta
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PriorSchemaBuilder builds the schema of a prior resource version from the current schema.
+// Only the types of attributes and blocks are significant in a prior schema.
+type PriorSchemaBuilder struct {
+	schema schema.Schema
+}
+
+// NewPriorSchemaBuilder returns a builder initialized with a copy of the current schema.
+func NewPriorSchemaBuilder(current schema.Schema, version int64) *PriorSchemaBuilder {
+	return &PriorSchemaBuilder{
+		schema: schema.Schema{
+			Attributes: maps.Clone(current.Attributes),
+			Blocks:     maps.Clone(current.Blocks),
+			Version:    version,
+		},
+	}
+}
+
+// WithAttribute adds or replaces the named attribute.
+func (b *PriorSchemaBuilder) WithAttribute(name string, attribute schema.Attribute) *PriorSchemaBuilder {
+	if b.schema.Attributes == nil {
+		b.schema.Attributes = make(map[string]schema.Attribute)
+	}
+	b.schema.Attributes[name] = attribute
+	return b
+}
+
+// WithoutAttribute removes the named attribute.
+func (b *PriorSchemaBuilder) WithoutAttribute(name string) *PriorSchemaBuilder {
+	delete(b.schema.Attributes, name)
+	return b
+}
+
+// WithBlock adds or replaces the named block.
+func (b *PriorSchemaBuilder) WithBlock(name string, block schema.Block) *PriorSchemaBuilder {
+	if b.schema.Blocks == nil {
+		b.schema.Blocks = make(map[string]schema.Block)
+	}
+	b.schema.Blocks[name] = block
+	return b
+}
+
+// WithoutBlock removes the named block.
+func (b *PriorSchemaBuilder) WithoutBlock(name string) *PriorSchemaBuilder {
+	delete(b.schema.Blocks, name)
+	return b
+}
+
+// Build returns the prior schema.
+func (b *PriorSchemaBuilder) Build() *schema.Schema {
+	s := b.schema
+	return &s
+}
+
+// StateUpgradeStep modifies the top-level attribute (and block) values of a prior state.
+type StateUpgradeStep func(ctx context.Context, attributes map[string]tftypes.Value) error
+
+// NewStateUpgrader returns a StateUpgrader that reads the prior state using priorSchema, applies the steps
+// in order and writes the result as the current state.
+// Values for attributes that are in the current schema but not in the upgraded prior state are set to null,
+// and values that are not in the current schema are dropped.
+func NewStateUpgrader(priorSchema *schema.Schema, steps ...StateUpgradeStep) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		PriorSchema: priorSchema,
+		StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			if request.State == nil {
+				response.Diagnostics.AddError("Unable to upgrade resource state", "prior state is missing")
+				return
+			}
+
+			attributes := make(map[string]tftypes.Value)
+			if err := request.State.Raw.As(&attributes); err != nil {
+				response.Diagnostics.AddError("Unable to upgrade resource state", err.Error())
+				return
+			}
+
+			for _, step := range steps {
+				if err := step(ctx, attributes); err != nil {
+					response.Diagnostics.AddError("Unable to upgrade resource state", err.Error())
+					return
+				}
+			}
+
+			objectType, ok := response.State.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			if !ok {
+				response.Diagnostics.AddError("Unable to upgrade resource state", "current schema is not an object")
+				return
+			}
+
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attributeType := range objectType.AttributeTypes {
+				v, ok := attributes[name]
+				if !ok || v.IsNull() {
+					values[name] = tftypes.NewValue(attributeType, nil)
+					continue
+				}
+
+				if !v.Type().Equal(attributeType) {
+					response.Diagnostics.AddError("Unable to upgrade resource state", fmt.Sprintf("attribute %q: got type %s, want %s", name, v.Type(), attributeType))
+					return
+				}
+
+				values[name] = v
+			}
+
+			if err := tftypes.ValidateValue(objectType, values); err != nil {
+				response.Diagnostics.AddError("Unable to upgrade resource state", err.Error())
+				return
+			}
+
+			response.State.Raw = tftypes.NewValue(objectType, values)
+		},
+	}
+}
+
+// RenameAttribute moves the value of the from attribute to the to attribute.
+func RenameAttribute(from, to string) StateUpgradeStep {
+	return func(_ context.Context, attributes map[string]tftypes.Value) error {
+		v, ok := attributes[from]
+		if !ok {
+			return nil
+		}
+
+		if _, ok := attributes[to]; ok {
+			return fmt.Errorf("renaming attribute %q: attribute %q already exists", from, to)
+		}
+
+		attributes[to] = v
+		delete(attributes, from)
+
+		return nil
+	}
+}
+
+// RemoveAttribute drops the value of the named attribute.
+func RemoveAttribute(name string) StateUpgradeStep {
+	return func(_ context.Context, attributes map[string]tftypes.Value) error {
+		delete(attributes, name)
+
+		return nil
+	}
+}
+
+// ConvertAttribute replaces the value of the named attribute with the result of f.
+// f is not called for a missing attribute.
+func ConvertAttribute(name string, f func(context.Context, tftypes.Value) (tftypes.Value, error)) StateUpgradeStep {
+	return func(ctx context.Context, attributes map[string]tftypes.Value) error {
+		v, ok := attributes[name]
+		if !ok {
+			return nil
+		}
+
+		v, err := f(ctx, v)
+
+		if err != nil {
+			return fmt.Errorf("converting attribute %q: %w", name, err)
+		}
+
+		attributes[name] = v
+
+		return nil
+	}
+}
+
+// ConvertStringValue returns a converter from a String value to a value of type t using f.
+// Null and unknown values are converted to null and unknown values of type t.
+func ConvertStringValue(t tftypes.Type, f func(string) (any, error)) func(context.Context, tftypes.Value) (tftypes.Value, error) {
+	return func(_ context.Context, v tftypes.Value) (tftypes.Value, error) {
+		if v.IsNull() {
+			return tftypes.NewValue(t, nil), nil
+		}
+
+		if !v.IsKnown() {
+			return tftypes.NewValue(t, tftypes.UnknownValue), nil
+		}
+
+		var s string
+		if err := v.As(&s); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		value, err := f(s)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return tftypes.NewValue(t, value), nil
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+func TestStateUpgrader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	currentSchema := schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id":            schema.StringAttribute{Computed: true},
+			"description":   schema.StringAttribute{Optional: true},
+			"size":          schema.Int64Attribute{Optional: true},
+			"statement":     schema.StringAttribute{Optional: true},
+			"template_name": schema.StringAttribute{Optional: true},
+		},
+	}
+	priorSchema := framework.NewPriorSchemaBuilder(currentSchema, 0).
+		WithoutAttribute("statement").
+		WithAttribute("definition", schema.StringAttribute{Optional: true}).
+		WithAttribute("obsolete", schema.BoolAttribute{Optional: true}).
+		WithAttribute("size", schema.StringAttribute{Optional: true}).
+		Build()
+
+	priorType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":            tftypes.String,
+		"definition":    tftypes.String,
+		"description":   tftypes.String,
+		"obsolete":      tftypes.Bool,
+		"size":          tftypes.String,
+		"template_name": tftypes.String,
+	}}
+	currentType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":            tftypes.String,
+		"description":   tftypes.String,
+		"size":          tftypes.Number,
+		"statement":     tftypes.String,
+		"template_name": tftypes.String,
+	}}
+
+	if got, want := priorSchema.Type().TerraformType(ctx), tftypes.Type(priorType); !got.Equal(want) {
+		t.Fatalf("prior schema type = %s, want %s", got, want)
+	}
+
+	sizeToNumber := framework.ConvertStringValue(tftypes.Number, func(s string) (any, error) {
+		return strconv.ParseInt(s, 10, 64)
+	})
+
+	testCases := map[string]struct {
+		steps   []framework.StateUpgradeStep
+		prior   tftypes.Value
+		want    tftypes.Value
+		wantErr bool
+	}{
+		"upgrade": {
+			steps: []framework.StateUpgradeStep{
+				framework.RenameAttribute("definition", "statement"),
+				framework.RemoveAttribute("obsolete"),
+				framework.ConvertAttribute("size", sizeToNumber),
+			},
+			prior: tftypes.NewValue(priorType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "id-1"),
+				"definition":    tftypes.NewValue(tftypes.String, "permit(principal, action, resource);"),
+				"description":   tftypes.NewValue(tftypes.String, "test"),
+				"obsolete":      tftypes.NewValue(tftypes.Bool, true),
+				"size":          tftypes.NewValue(tftypes.String, "42"),
+				"template_name": tftypes.NewValue(tftypes.String, nil),
+			}),
+			want: tftypes.NewValue(currentType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "id-1"),
+				"description":   tftypes.NewValue(tftypes.String, "test"),
+				"size":          tftypes.NewValue(tftypes.Number, 42),
+				"statement":     tftypes.NewValue(tftypes.String, "permit(principal, action, resource);"),
+				"template_name": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"null values": {
+			steps: []framework.StateUpgradeStep{
+				framework.RenameAttribute("definition", "statement"),
+				framework.ConvertAttribute("size", sizeToNumber),
+			},
+			prior: tftypes.NewValue(priorType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "id-1"),
+				"definition":    tftypes.NewValue(tftypes.String, nil),
+				"description":   tftypes.NewValue(tftypes.String, nil),
+				"obsolete":      tftypes.NewValue(tftypes.Bool, nil),
+				"size":          tftypes.NewValue(tftypes.String, nil),
+				"template_name": tftypes.NewValue(tftypes.String, nil),
+			}),
+			want: tftypes.NewValue(currentType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "id-1"),
+				"description":   tftypes.NewValue(tftypes.String, nil),
+				"size":          tftypes.NewValue(tftypes.Number, nil),
+				"statement":     tftypes.NewValue(tftypes.String, nil),
+				"template_name": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"type mismatch": {
+			steps: []framework.StateUpgradeStep{
+				framework.RenameAttribute("definition", "statement"),
+			},
+			prior: tftypes.NewValue(priorType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "id-1"),
+				"definition":    tftypes.NewValue(tftypes.String, nil),
+				"description":   tftypes.NewValue(tftypes.String, nil),
+				"obsolete":      tftypes.NewValue(tftypes.Bool, nil),
+				"size":          tftypes.NewValue(tftypes.String, "42"),
+				"template_name": tftypes.NewValue(tftypes.String, nil),
+			}),
+			wantErr: true,
+		},
+		"conversion error": {
+			steps: []framework.StateUpgradeStep{
+				framework.ConvertAttribute("size", sizeToNumber),
+			},
+			prior: tftypes.NewValue(priorType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "id-1"),
+				"definition":    tftypes.NewValue(tftypes.String, nil),
+				"description":   tftypes.NewValue(tftypes.String, nil),
+				"obsolete":      tftypes.NewValue(tftypes.Bool, nil),
+				"size":          tftypes.NewValue(tftypes.String, "large"),
+				"template_name": tftypes.NewValue(tftypes.String, nil),
+			}),
+			wantErr: true,
+		},
+		"rename conflict": {
+			steps: []framework.StateUpgradeStep{
+				framework.RenameAttribute("definition", "description"),
+			},
+			prior: tftypes.NewValue(priorType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "id-1"),
+				"definition":    tftypes.NewValue(tftypes.String, nil),
+				"description":   tftypes.NewValue(tftypes.String, nil),
+				"obsolete":      tftypes.NewValue(tftypes.Bool, nil),
+				"size":          tftypes.NewValue(tftypes.String, nil),
+				"template_name": tftypes.NewValue(tftypes.String, nil),
+			}),
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			upgrader := framework.NewStateUpgrader(priorSchema, testCase.steps...)
+			request := resource.UpgradeStateRequest{
+				State: &tfsdk.State{
+					Raw:    testCase.prior,
+					Schema: *upgrader.PriorSchema,
+				},
+			}
+			response := resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Schema: currentSchema,
+				},
+			}
+
+			upgrader.StateUpgrader(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.wantErr; got != want {
+				t.Fatalf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+
+			if testCase.wantErr {
+				return
+			}
+
+			if diff := cmp.Diff(response.State.Raw, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
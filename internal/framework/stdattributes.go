@@ -5,6 +5,7 @@ package framework
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 )
@@ -18,6 +19,15 @@ func IDAttribute() schema.StringAttribute {
 	}
 }
 
+// AdoptExistingAttribute returns the `adopt_existing` attribute for resources that implement ResourceWithAdoptExisting.
+func AdoptExistingAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
 func ARNAttributeComputedOnly() schema.StringAttribute {
 	return schema.StringAttribute{
 		Computed: true,
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = canonicalizeIAMPrincipalFunction{}
+
+func NewCanonicalizeIAMPrincipalFunction() function.Function {
+	return &canonicalizeIAMPrincipalFunction{}
+}
+
+type canonicalizeIAMPrincipalFunction struct{}
+
+func (f canonicalizeIAMPrincipalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "canonicalize_iam_principal"
+}
+
+func (f canonicalizeIAMPrincipalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "canonicalize_iam_principal Function",
+		MarkdownDescription: "Converts an IAM principal Amazon Resource Name (ARN) to its canonical form. STS assumed-role session ARNs are converted to the ARN of the underlying IAM role and IAM role ARNs have any path removed. Other IAM principal ARNs are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "IAM principal Amazon Resource Name (ARN)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f canonicalizeIAMPrincipalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := canonicalizeIAMPrincipal(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// canonicalizeIAMPrincipal converts an IAM principal ARN to its canonical form.
+//
+// Supported IAM principals are:
+//   - AWS account: arn:aws:iam::123456789012:root
+//   - IAM user: arn:aws:iam::123456789012:user/Bob
+//   - IAM role: arn:aws:iam::123456789012:role/S3Access (path removed)
+//   - IAM assumed role: arn:aws:sts::123456789012:assumed-role/Accounting-Role/Mary (converted to IAM role)
+//   - Federated user: arn:aws:sts::123456789012:federated-user/Bob
+func canonicalizeIAMPrincipal(s string) (string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	switch v.Service {
+	case "iam":
+		switch parts[0] {
+		case "role":
+			return trimIAMRolePath(s)
+		case "root", "user":
+			return s, nil
+		}
+	case "sts":
+		switch parts[0] {
+		case "assumed-role":
+			// The role name is the second-to-last element, the session name the last.
+			if len(parts) < 3 {
+				return "", fmt.Errorf("assumed-role ARN (%s) has no role name", s)
+			}
+
+			return arn.ARN{
+				Partition: v.Partition,
+				Service:   "iam",
+				AccountID: v.AccountID,
+				Resource:  iamRoleResourcePrefix + parts[len(parts)-2],
+			}.String(), nil
+		case "federated-user":
+			return s, nil
+		}
+	}
+
+	return "", fmt.Errorf("%q is not a supported IAM principal ARN", s)
+}
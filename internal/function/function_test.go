@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"testing"
+)
+
+func TestTrimIAMRolePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		"no path": {
+			input:    "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			expected: "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+		},
+		"single path element": {
+			input:    "arn:aws:iam::123456789012:role/path/example", //lintignore:AWSAT005
+			expected: "arn:aws:iam::123456789012:role/example",      //lintignore:AWSAT005
+		},
+		"multiple path elements": {
+			input:    "arn:aws-cn:iam::123456789012:role/path/to/example", //lintignore:AWSAT005
+			expected: "arn:aws-cn:iam::123456789012:role/example",         //lintignore:AWSAT005
+		},
+		"not an ARN": {
+			input:   "example",
+			wantErr: true,
+		},
+		"IAM user": {
+			input:   "arn:aws:iam::123456789012:user/example", //lintignore:AWSAT005
+			wantErr: true,
+		},
+		"no role name": {
+			input:   "arn:aws:iam::123456789012:role/path/", //lintignore:AWSAT005
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := trimIAMRolePath(testCase.input)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, wantErr = %t", err, want)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeIAMPrincipal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		"account root": {
+			input:    "arn:aws:iam::123456789012:root", //lintignore:AWSAT005
+			expected: "arn:aws:iam::123456789012:root", //lintignore:AWSAT005
+		},
+		"IAM user": {
+			input:    "arn:aws:iam::123456789012:user/Bob", //lintignore:AWSAT005
+			expected: "arn:aws:iam::123456789012:user/Bob", //lintignore:AWSAT005
+		},
+		"IAM role with path": {
+			input:    "arn:aws:iam::123456789012:role/service-role/S3Access", //lintignore:AWSAT005
+			expected: "arn:aws:iam::123456789012:role/S3Access",              //lintignore:AWSAT005
+		},
+		"assumed role": {
+			input:    "arn:aws:sts::123456789012:assumed-role/Accounting-Role/Mary", //lintignore:AWSAT005
+			expected: "arn:aws:iam::123456789012:role/Accounting-Role",              //lintignore:AWSAT005
+		},
+		"assumed role GovCloud": {
+			input:    "arn:aws-us-gov:sts::123456789012:assumed-role/Accounting-Role/Mary", //lintignore:AWSAT005
+			expected: "arn:aws-us-gov:iam::123456789012:role/Accounting-Role",              //lintignore:AWSAT005
+		},
+		"federated user": {
+			input:    "arn:aws:sts::123456789012:federated-user/Bob", //lintignore:AWSAT005
+			expected: "arn:aws:sts::123456789012:federated-user/Bob", //lintignore:AWSAT005
+		},
+		"assumed role without session": {
+			input:   "arn:aws:sts::123456789012:assumed-role/Accounting-Role", //lintignore:AWSAT005
+			wantErr: true,
+		},
+		"IAM group": {
+			input:   "arn:aws:iam::123456789012:group/Admins", //lintignore:AWSAT005
+			wantErr: true,
+		},
+		"S3 bucket": {
+			input:   "arn:aws:s3:::example", //lintignore:AWSAT005
+			wantErr: true,
+		},
+		"not an ARN": {
+			input:   "Bob",
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := canonicalizeIAMPrincipal(testCase.input)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, wantErr = %t", err, want)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestServicePrincipalNameForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		service   string
+		partition string
+		expected  string
+	}{
+		{"ec2", "aws", "ec2.amazonaws.com"},
+		{"ec2", "aws-us-gov", "ec2.amazonaws.com"},
+		{"ec2", "aws-cn", "ec2.amazonaws.com"},
+		{"logs", "aws-cn", "logs.amazonaws.com.cn"},
+		{"ec2", "aws-iso", "ec2.c2s.ic.gov"},
+		{"ec2", "aws-iso-b", "ec2.sc2s.sgov.gov"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.service+"_"+testCase.partition, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := servicePrincipalNameForPartition(testCase.service, testCase.partition), testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestInspector2FilterCriteria(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    map[string][]string
+		expected string
+		wantErr  bool
+	}{
+		"empty": {
+			input:    map[string][]string{},
+			expected: `{}`,
+		},
+		"equals": {
+			input: map[string][]string{
+				"severity": {"CRITICAL", "HIGH"},
+			},
+			expected: `{"severity":[{"comparison":"EQUALS","value":"CRITICAL"},{"comparison":"EQUALS","value":"HIGH"}]}`,
+		},
+		"multiple fields": {
+			input: map[string][]string{
+				"awsAccountId": {"!123456789012"},
+				"resourceType": {"AWS_EC2*"},
+			},
+			expected: `{"awsAccountId":[{"comparison":"NOT_EQUALS","value":"123456789012"}],"resourceType":[{"comparison":"PREFIX","value":"AWS_EC2"}]}`,
+		},
+		"prefix not equals": {
+			input: map[string][]string{
+				"resourceType": {"!AWS_EC2*"},
+			},
+			wantErr: true,
+		},
+		"no values": {
+			input: map[string][]string{
+				"severity": {},
+			},
+			wantErr: true,
+		},
+		"empty value": {
+			input: map[string][]string{
+				"severity": {"!"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := inspector2FilterCriteria(testCase.input)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, wantErr = %t", err, want)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSecurityHubFindingFilters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    map[string][]string
+		expected string
+		wantErr  bool
+	}{
+		"equals": {
+			input: map[string][]string{
+				"AwsAccountId": {"123456789012"},
+			},
+			expected: `{"AwsAccountId":[{"Comparison":"EQUALS","Value":"123456789012"}]}`,
+		},
+		"all comparisons": {
+			input: map[string][]string{
+				"ProductName": {"GuardDuty", "!Inspector", "Config*", "!Macie*"},
+			},
+			expected: `{"ProductName":[{"Comparison":"EQUALS","Value":"GuardDuty"},{"Comparison":"NOT_EQUALS","Value":"Inspector"},{"Comparison":"PREFIX","Value":"Config"},{"Comparison":"PREFIX_NOT_EQUALS","Value":"Macie"}]}`,
+		},
+		"empty field name": {
+			input: map[string][]string{
+				"": {"example"},
+			},
+			wantErr: true,
+		},
+		"empty value": {
+			input: map[string][]string{
+				"ProductName": {"*"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := securityHubFindingFilters(testCase.input)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, wantErr = %t", err, want)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = inspector2FilterCriteriaFunction{}
+
+func NewInspector2FilterCriteriaFunction() function.Function {
+	return &inspector2FilterCriteriaFunction{}
+}
+
+type inspector2FilterCriteriaFunction struct{}
+
+func (f inspector2FilterCriteriaFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "inspector2_filter_criteria"
+}
+
+func (f inspector2FilterCriteriaFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "inspector2_filter_criteria Function",
+		MarkdownDescription: "Builds an Amazon Inspector filter criteria JSON document from a map of field names to string values.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "criteria",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				MarkdownDescription: "Map of filter criteria field names, e.g. `awsAccountId`, to lists of values",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f inspector2FilterCriteriaFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var criteria map[string][]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &criteria))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := inspector2FilterCriteria(criteria)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+type inspector2StringFilter struct {
+	Comparison string `json:"comparison"`
+	Value      string `json:"value"`
+}
+
+// inspector2FilterCriteria returns the JSON-encoded Amazon Inspector FilterCriteria for the specified string filters.
+// For example, {"severity": ["CRITICAL", "HIGH"]} becomes
+// {"severity":[{"comparison":"EQUALS","value":"CRITICAL"},{"comparison":"EQUALS","value":"HIGH"}]}.
+func inspector2FilterCriteria(criteria map[string][]string) (string, error) {
+	apiObject := make(map[string][]inspector2StringFilter, len(criteria))
+
+	for k, vs := range criteria {
+		if k == "" {
+			return "", fmt.Errorf("filter criteria field name must not be empty")
+		}
+		if len(vs) == 0 {
+			return "", fmt.Errorf("filter criteria field (%s) has no values", k)
+		}
+
+		for _, v := range vs {
+			comparison, value, err := parseStringFilter(v, false)
+			if err != nil {
+				return "", fmt.Errorf("filter criteria field (%s): %w", k, err)
+			}
+
+			apiObject[k] = append(apiObject[k], inspector2StringFilter{
+				Comparison: comparison,
+				Value:      value,
+			})
+		}
+	}
+
+	b, err := json.Marshal(apiObject)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = securityHubFindingFiltersFunction{}
+
+func NewSecurityHubFindingFiltersFunction() function.Function {
+	return &securityHubFindingFiltersFunction{}
+}
+
+type securityHubFindingFiltersFunction struct{}
+
+func (f securityHubFindingFiltersFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "securityhub_finding_filters"
+}
+
+func (f securityHubFindingFiltersFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "securityhub_finding_filters Function",
+		MarkdownDescription: "Builds an AWS Security Hub finding filters JSON document from a map of field names to string values.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "filters",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				MarkdownDescription: "Map of finding filter field names, e.g. `AwsAccountId`, to lists of values",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f securityHubFindingFiltersFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var filters map[string][]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &filters))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := securityHubFindingFilters(filters)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+type securityHubStringFilter struct {
+	Comparison string `json:"Comparison"`
+	Value      string `json:"Value"`
+}
+
+// securityHubFindingFilters returns the JSON-encoded AWS Security Hub AwsSecurityFindingFilters for the specified string filters.
+// For example, {"AwsAccountId": ["!123456789012"]} becomes
+// {"AwsAccountId":[{"Comparison":"NOT_EQUALS","Value":"123456789012"}]}.
+func securityHubFindingFilters(filters map[string][]string) (string, error) {
+	apiObject := make(map[string][]securityHubStringFilter, len(filters))
+
+	for k, vs := range filters {
+		if k == "" {
+			return "", fmt.Errorf("finding filter field name must not be empty")
+		}
+		if len(vs) == 0 {
+			return "", fmt.Errorf("finding filter field (%s) has no values", k)
+		}
+
+		for _, v := range vs {
+			comparison, value, err := parseStringFilter(v, true)
+			if err != nil {
+				return "", fmt.Errorf("finding filter field (%s): %w", k, err)
+			}
+
+			apiObject[k] = append(apiObject[k], securityHubStringFilter{
+				Comparison: comparison,
+				Value:      value,
+			})
+		}
+	}
+
+	b, err := json.Marshal(apiObject)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
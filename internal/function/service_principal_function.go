@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = servicePrincipalFunction{}
+
+func NewServicePrincipalFunction() function.Function {
+	return &servicePrincipalFunction{}
+}
+
+type servicePrincipalFunction struct{}
+
+func (f servicePrincipalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "service_principal"
+}
+
+func (f servicePrincipalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "service_principal Function",
+		MarkdownDescription: "Returns the IAM service principal name for an AWS service in a partition, e.g. `ec2.amazonaws.com`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service name, e.g. `ec2`",
+			},
+			function.StringParameter{
+				Name:                "partition",
+				MarkdownDescription: "Partition identifier, e.g. `aws` or `aws-cn`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f servicePrincipalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service, partition string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &service, &partition))
+	if resp.Error != nil {
+		return
+	}
+
+	if service == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "service must not be empty"))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, servicePrincipalNameForPartition(service, partition)))
+}
+
+// servicePrincipalNameForPartition returns the service principal name for the specified service and partition.
+func servicePrincipalNameForPartition(service, partition string) string {
+	switch partition {
+	case names.ISOPartitionID, names.ISOBPartitionID:
+		return fmt.Sprintf("%s.%s", service, names.DNSSuffixForPartition(partition))
+	case names.ChinaPartitionID:
+		// A handful of services still use the China DNS suffix in their principal names.
+		switch service {
+		case "codedeploy", "elasticmapreduce", "logs":
+			return fmt.Sprintf("%s.%s", service, names.DNSSuffixForPartition(partition))
+		}
+	}
+
+	return fmt.Sprintf("%s.%s", service, "amazonaws.com")
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String filter comparison operators shared by Amazon Inspector and AWS Security Hub.
+const (
+	stringFilterComparisonEquals          = "EQUALS"
+	stringFilterComparisonNotEquals       = "NOT_EQUALS"
+	stringFilterComparisonPrefix          = "PREFIX"
+	stringFilterComparisonPrefixNotEquals = "PREFIX_NOT_EQUALS"
+)
+
+const (
+	stringFilterNegationPrefix = "!"
+	stringFilterWildcardSuffix = "*"
+)
+
+// parseStringFilter returns the comparison operator and value for a simplified string filter.
+// A leading "!" negates the comparison and a trailing "*" makes it a prefix match, e.g.
+// "example" is EQUALS, "!example" is NOT_EQUALS, "example*" is PREFIX and "!example*" is PREFIX_NOT_EQUALS.
+func parseStringFilter(s string, allowPrefixNotEquals bool) (string, string, error) {
+	v := s
+	negated := strings.HasPrefix(v, stringFilterNegationPrefix)
+	if negated {
+		v = strings.TrimPrefix(v, stringFilterNegationPrefix)
+	}
+	prefix := strings.HasSuffix(v, stringFilterWildcardSuffix)
+	if prefix {
+		v = strings.TrimSuffix(v, stringFilterWildcardSuffix)
+	}
+
+	if v == "" {
+		return "", "", fmt.Errorf("filter value (%q) must not be empty", s)
+	}
+
+	switch {
+	case negated && prefix:
+		if !allowPrefixNotEquals {
+			return "", "", fmt.Errorf("filter value (%q) combines negation and prefix matching, which is not supported", s)
+		}
+		return stringFilterComparisonPrefixNotEquals, v, nil
+	case negated:
+		return stringFilterComparisonNotEquals, v, nil
+	case prefix:
+		return stringFilterComparisonPrefix, v, nil
+	default:
+		return stringFilterComparisonEquals, v, nil
+	}
+}
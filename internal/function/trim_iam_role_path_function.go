@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// iamRoleResourcePrefix is the resource prefix of an IAM role ARN.
+const iamRoleResourcePrefix = "role/"
+
+var _ function.Function = trimIAMRolePathFunction{}
+
+func NewTrimIAMRolePathFunction() function.Function {
+	return &trimIAMRolePathFunction{}
+}
+
+type trimIAMRolePathFunction struct{}
+
+func (f trimIAMRolePathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "trim_iam_role_path"
+}
+
+func (f trimIAMRolePathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "trim_iam_role_path Function",
+		MarkdownDescription: "Trims the path prefix from an IAM role Amazon Resource Name (ARN). This function can be used when services require role ARNs to be passed without a path.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "IAM role Amazon Resource Name (ARN)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f trimIAMRolePathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := trimIAMRolePath(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// trimIAMRolePath removes the path from an IAM role ARN.
+// For example, "arn:aws:iam::123456789012:role/path/to/example" becomes
+// "arn:aws:iam::123456789012:role/example".
+func trimIAMRolePath(s string) (string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	if v.Service != "iam" || !strings.HasPrefix(v.Resource, iamRoleResourcePrefix) {
+		return "", fmt.Errorf("%q is not an IAM role ARN", s)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(v.Resource, iamRoleResourcePrefix), "/")
+	name := parts[len(parts)-1]
+	if name == "" {
+		return "", fmt.Errorf("IAM role ARN (%s) has no role name", s)
+	}
+
+	v.Resource = iamRoleResourcePrefix + name
+
+	return v.String(), nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type TemplateData struct {
+	PackageName string
+	Aliases     []string
+}
+
+func main() {
+	const (
+		filename = `endpoints_schema_gen.go`
+	)
+	g := common.NewGenerator()
+
+	packageName := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating %s/%s", packageName, filename)
+
+	var tmpl string
+	switch packageName {
+	case "provider":
+		tmpl = sdkTmpl
+	case "fwprovider":
+		tmpl = frameworkTmpl
+	default:
+		g.Fatalf("unsupported package: %s", packageName)
+	}
+
+	td := TemplateData{
+		PackageName: packageName,
+		Aliases:     names.Aliases(),
+	}
+
+	sort.Strings(td.Aliases)
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("endpointsschema", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+//go:embed sdk.tmpl
+var sdkTmpl string
+
+//go:embed framework.tmpl
+var frameworkTmpl string
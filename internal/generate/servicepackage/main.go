@@ -25,7 +25,8 @@ import (
 
 func main() {
 	const (
-		filename = `service_package_gen.go`
+		filename           = `service_package_gen.go`
+		disappearsFilename = `disappears_gen_test.go`
 	)
 	g := common.NewGenerator()
 
@@ -105,6 +106,20 @@ func main() {
 			g.Fatalf("generating file (%s): %s", filename, err)
 		}
 
+		if finders := s.disappearsFinders(); len(finders) > 0 {
+			g.Infof("Generating internal/service/%s/%s", servicePackage, disappearsFilename)
+
+			d := g.NewGoFileDestination(disappearsFilename)
+
+			if err := d.WriteTemplate("disappears", disappearsTmpl, DisappearsDatum{ServiceDatum: s, Finders: finders}); err != nil {
+				g.Fatalf("error generating %s disappears finders: %s", p, err)
+			}
+
+			if err := d.Write(); err != nil {
+				g.Fatalf("generating file (%s): %s", disappearsFilename, err)
+			}
+		}
+
 		break
 	}
 }
@@ -115,6 +130,7 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	DisappearsFinder        string // Finder called with the resource ID by disappears tests, e.g. "findTopicByARN"
 }
 
 type ServiceDatum struct {
@@ -131,9 +147,53 @@ type ServiceDatum struct {
 	SDKResources         map[string]ResourceDatum
 }
 
+// DisappearsFinderExportName returns the name of the generated disappears test finder, e.g. "FindTopicForDisappears".
+func (d ResourceDatum) DisappearsFinderExportName() string {
+	return "Find" + strings.ReplaceAll(d.Name, " ", "") + "ForDisappears"
+}
+
+// ClientAccessor returns the name of the AWSClient method that returns the service's API client.
+func (s ServiceDatum) ClientAccessor() string {
+	if s.SDKVersion == "1" {
+		return s.ProviderNameUpper + "Conn"
+	}
+
+	return s.ProviderNameUpper + "Client"
+}
+
+// disappearsFinders returns the resources annotated with a disappears test finder, sorted by name.
+func (s ServiceDatum) disappearsFinders() []ResourceDatum {
+	var finders []ResourceDatum
+
+	for _, v := range s.FrameworkResources {
+		if v.DisappearsFinder != "" {
+			finders = append(finders, v)
+		}
+	}
+	for _, v := range s.SDKResources {
+		if v.DisappearsFinder != "" {
+			finders = append(finders, v)
+		}
+	}
+
+	sort.SliceStable(finders, func(i, j int) bool {
+		return finders[i].Name < finders[j].Name
+	})
+
+	return finders
+}
+
+type DisappearsDatum struct {
+	ServiceDatum
+	Finders []ResourceDatum
+}
+
 //go:embed file.tmpl
 var tmpl string
 
+//go:embed disappears.tmpl
+var disappearsTmpl string
+
 // Annotation processing.
 var (
 	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
@@ -215,6 +275,14 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.TagsResourceType = attr
 			}
 		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Testing" {
+			args := common.ParseArgs(m[3])
+
+			if attr, ok := args.Keyword["disappearsFinder"]; ok {
+				d.DisappearsFinder = attr
+			}
+		}
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -237,6 +305,11 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.frameworkDataSources = append(v.frameworkDataSources, d)
 				}
 			case "FrameworkResource":
+				if d.DisappearsFinder != "" && d.Name == "" {
+					v.err = multierror.Append(v.err, fmt.Errorf("disappears finder requires a name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if slices.ContainsFunc(v.frameworkResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.err = multierror.Append(v.err, fmt.Errorf("duplicate Framework Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
@@ -263,12 +336,17 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 
 				typeName := args.Positional[0]
 
+				if d.DisappearsFinder != "" && d.Name == "" {
+					v.err = multierror.Append(v.err, fmt.Errorf("disappears finder requires a name (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if _, ok := v.sdkResources[typeName]; ok {
 					v.err = multierror.Append(v.err, fmt.Errorf("duplicate SDK Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Tags", "Testing":
 				// Handled above.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
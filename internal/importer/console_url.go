@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ConsoleURL is the resource location parsed from an AWS Management Console URL.
+type ConsoleURL struct {
+	Partition string
+	Region    string
+	Service   string
+	ID        string
+}
+
+// consoleHostPartitions maps the AWS Management Console domain of each partition to the partition ID.
+var consoleHostPartitions = map[string]string{
+	"console.aws.amazon.com":       names.StandardPartitionID,
+	"console.amazonaws.cn":         names.ChinaPartitionID,
+	"console.amazonaws-us-gov.com": names.USGovCloudPartitionID,
+}
+
+var regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+// consoleDetailView is an AWS Management Console resource detail view and the fragment parameter that holds the resource ID,
+// e.g. #InstanceDetails:instanceId=i-0123456789abcdef0.
+type consoleDetailView struct {
+	view string
+	key  string
+}
+
+// consoleDetailViews are the supported resource detail views, keyed by console service.
+var consoleDetailViews = map[string][]consoleDetailView{
+	"ec2": {
+		{view: "InstanceDetails", key: "instanceId"},
+	},
+	"vpc": {
+		{view: "VpcDetails", key: "VpcId"},
+	},
+}
+
+// IsConsoleURL returns whether the specified import ID looks like an AWS Management Console URL.
+func IsConsoleURL(s string) bool {
+	return strings.HasPrefix(s, "https://")
+}
+
+// ParseConsoleURL parses an AWS Management Console URL for a resource, e.g.
+//
+//	https://us-east-1.console.aws.amazon.com/ec2/home?region=us-east-1#InstanceDetails:instanceId=i-0123456789abcdef0
+//	https://s3.console.aws.amazon.com/s3/buckets/example?region=us-east-1&tab=objects
+//
+// returning the partition, Region, console service and resource ID.
+func ParseConsoleURL(s string) (*ConsoleURL, error) {
+	u, err := url.Parse(s)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing AWS console URL (%s): %w", s, err)
+	}
+
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("AWS console URL (%s) must use https", s)
+	}
+
+	host := u.Hostname()
+	var partition, hostPrefix string
+
+	for domain, v := range consoleHostPartitions {
+		if host == domain {
+			partition = v
+			break
+		}
+
+		if prefix, ok := strings.CutSuffix(host, "."+domain); ok {
+			partition, hostPrefix = v, prefix
+			break
+		}
+	}
+
+	if partition == "" {
+		return nil, fmt.Errorf("AWS console URL (%s): unsupported host %q", s, host)
+	}
+
+	region := u.Query().Get("region")
+
+	if region == "" && regionRegexp.MatchString(hostPrefix) {
+		region = hostPrefix
+	}
+
+	if region == "" {
+		return nil, fmt.Errorf("AWS console URL (%s): unable to determine Region", s)
+	}
+
+	if v := names.PartitionForRegion(region); v != partition {
+		return nil, fmt.Errorf("AWS console URL (%s): Region (%s) is not in partition (%s)", s, region, partition)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	service := segments[0]
+
+	if service == "" {
+		return nil, fmt.Errorf("AWS console URL (%s): unable to determine service", s)
+	}
+
+	var id string
+
+	switch service {
+	case "s3":
+		// /s3/buckets/<bucket>.
+		if len(segments) >= 3 && segments[1] == "buckets" {
+			id = segments[2]
+		}
+	default:
+		// #<View>:<key>=<value>[;<key>=<value>...], e.g. #InstanceDetails:instanceId=i-0123456789abcdef0.
+		id, err = consoleURLFragmentID(service, u.Fragment)
+
+		if err != nil {
+			return nil, fmt.Errorf("AWS console URL (%s): %w", s, err)
+		}
+	}
+
+	if id == "" {
+		return nil, fmt.Errorf("AWS console URL (%s): unable to determine resource ID", s)
+	}
+
+	return &ConsoleURL{
+		Partition: partition,
+		Region:    region,
+		Service:   service,
+		ID:        id,
+	}, nil
+}
+
+// consoleURLFragmentID returns the resource ID from a console detail view fragment.
+// The view must be one of the service's supported detail views.
+func consoleURLFragmentID(service, fragment string) (string, error) {
+	views, ok := consoleDetailViews[service]
+
+	if !ok {
+		return "", fmt.Errorf("unsupported service (%s)", service)
+	}
+
+	view, params, _ := strings.Cut(fragment, ":")
+
+	for _, v := range views {
+		if v.view != view {
+			continue
+		}
+
+		for _, param := range strings.Split(params, ";") {
+			if k, id, ok := strings.Cut(param, "="); ok && k == v.key && id != "" {
+				return id, nil
+			}
+		}
+
+		return "", fmt.Errorf("%s view is missing %s", view, v.key)
+	}
+
+	return "", fmt.Errorf("unsupported %s view (%s)", service, view)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseConsoleURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		expected    *ConsoleURL
+		expectError bool
+	}{
+		"EC2 instance": {
+			input: "https://us-west-2.console.aws.amazon.com/ec2/home?region=us-west-2#InstanceDetails:instanceId=i-0123456789abcdef0",
+			expected: &ConsoleURL{
+				Partition: "aws",
+				Region:    "us-west-2",
+				Service:   "ec2",
+				ID:        "i-0123456789abcdef0",
+			},
+		},
+		"EC2 instance legacy": {
+			input: "https://console.aws.amazon.com/ec2/v2/home?region=eu-west-1#InstanceDetails:instanceId=i-0123456789abcdef0",
+			expected: &ConsoleURL{
+				Partition: "aws",
+				Region:    "eu-west-1",
+				Service:   "ec2",
+				ID:        "i-0123456789abcdef0",
+			},
+		},
+		"EC2 instance Region from host": {
+			input: "https://ap-southeast-2.console.aws.amazon.com/ec2/home#InstanceDetails:instanceId=i-0123456789abcdef0",
+			expected: &ConsoleURL{
+				Partition: "aws",
+				Region:    "ap-southeast-2",
+				Service:   "ec2",
+				ID:        "i-0123456789abcdef0",
+			},
+		},
+		"VPC multiple parameters": {
+			input: "https://console.aws.amazon.com/vpc/home?region=us-east-1#VpcDetails:VpcId=vpc-12345678;tab=details",
+			expected: &ConsoleURL{
+				Partition: "aws",
+				Region:    "us-east-1",
+				Service:   "vpc",
+				ID:        "vpc-12345678",
+			},
+		},
+		"S3 bucket": {
+			input: "https://s3.console.aws.amazon.com/s3/buckets/example-bucket?region=us-east-1&tab=objects",
+			expected: &ConsoleURL{
+				Partition: "aws",
+				Region:    "us-east-1",
+				Service:   "s3",
+				ID:        "example-bucket",
+			},
+		},
+		"GovCloud": {
+			input: "https://console.amazonaws-us-gov.com/ec2/home?region=us-gov-west-1#InstanceDetails:instanceId=i-0123456789abcdef0",
+			expected: &ConsoleURL{
+				Partition: "aws-us-gov",
+				Region:    "us-gov-west-1",
+				Service:   "ec2",
+				ID:        "i-0123456789abcdef0",
+			},
+		},
+		"China": {
+			input: "https://console.amazonaws.cn/ec2/home?region=cn-north-1#InstanceDetails:instanceId=i-0123456789abcdef0",
+			expected: &ConsoleURL{
+				Partition: "aws-cn",
+				Region:    "cn-north-1",
+				Service:   "ec2",
+				ID:        "i-0123456789abcdef0",
+			},
+		},
+		"partition mismatch": {
+			input:       "https://console.aws.amazon.com/ec2/home?region=cn-north-1#InstanceDetails:instanceId=i-0123456789abcdef0",
+			expectError: true,
+		},
+		"not https": {
+			input:       "http://console.aws.amazon.com/ec2/home?region=us-east-1#InstanceDetails:instanceId=i-0123456789abcdef0",
+			expectError: true,
+		},
+		"unsupported host": {
+			input:       "https://console.example.com/ec2/home?region=us-east-1#InstanceDetails:instanceId=i-0123456789abcdef0",
+			expectError: true,
+		},
+		"no Region": {
+			input:       "https://s3.console.aws.amazon.com/s3/buckets/example-bucket",
+			expectError: true,
+		},
+		"no ID": {
+			input:       "https://console.aws.amazon.com/ec2/home?region=us-east-1#Instances:",
+			expectError: true,
+		},
+		"VPC ID not first parameter": {
+			input: "https://console.aws.amazon.com/vpc/home?region=us-east-1#VpcDetails:tab=details;VpcId=vpc-12345678",
+			expected: &ConsoleURL{
+				Partition: "aws",
+				Region:    "us-east-1",
+				Service:   "vpc",
+				ID:        "vpc-12345678",
+			},
+		},
+		"EC2 unsupported view": {
+			input:       "https://console.aws.amazon.com/ec2/home?region=us-east-1#VolumeDetails:volumeId=vol-0123456789abcdef0",
+			expectError: true,
+		},
+		"EC2 missing key": {
+			input:       "https://console.aws.amazon.com/ec2/home?region=us-east-1#InstanceDetails:tab=details",
+			expectError: true,
+		},
+		"unsupported service": {
+			input:       "https://console.aws.amazon.com/rds/home?region=us-east-1#database:id=example",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseConsoleURL(testCase.input)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIDFromConsoleURL(t *testing.T) {
+	t.Parallel()
+
+	const input = "https://us-west-2.console.aws.amazon.com/ec2/home?region=us-west-2#InstanceDetails:instanceId=i-0123456789abcdef0"
+
+	if got, err := IDFromConsoleURL(input, "aws", "us-west-2", "ec2"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	} else if got != "i-0123456789abcdef0" {
+		t.Errorf("unexpected ID: %s", got)
+	}
+
+	if _, err := IDFromConsoleURL(input, "aws", "us-east-1", "ec2"); err == nil {
+		t.Error("expected Region mismatch error")
+	}
+
+	if _, err := IDFromConsoleURL(input, "aws", "us-west-2", "s3"); err == nil {
+		t.Error("expected service mismatch error")
+	}
+}
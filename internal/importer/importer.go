@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ConsoleURLOrPassthroughContext returns a StateContextFunc that accepts either a resource ID or
+// an AWS Management Console URL for one of the specified console services.
+// A console URL must be for the provider's configured partition and Region.
+func ConsoleURLOrPassthroughContext(services ...string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if !IsConsoleURL(d.Id()) {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		id, err := IDFromConsoleURL(d.Id(), meta.(*conns.AWSClient).Partition, meta.(*conns.AWSClient).Region, services...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// ImportStatePassthroughIDOrConsoleURL is the Terraform Plugin Framework equivalent of ConsoleURLOrPassthroughContext.
+// It is intended to be called from a resource's ImportState method and sets the "id" attribute.
+func ImportStatePassthroughIDOrConsoleURL(ctx context.Context, meta *conns.AWSClient, request resource.ImportStateRequest, response *resource.ImportStateResponse, services ...string) {
+	if IsConsoleURL(request.ID) {
+		id, err := IDFromConsoleURL(request.ID, meta.Partition, meta.Region, services...)
+
+		if err != nil {
+			response.Diagnostics.AddError("importing by AWS console URL", err.Error())
+
+			return
+		}
+
+		request.ID = id
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+// IDFromConsoleURL parses an AWS Management Console URL and returns the resource ID,
+// validating the console service, partition and Region.
+func IDFromConsoleURL(s, partition, region string, services ...string) (string, error) {
+	consoleURL, err := ParseConsoleURL(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !slices.Contains(services, consoleURL.Service) {
+		return "", fmt.Errorf("AWS console URL (%s): service (%s) is not supported for this resource", s, consoleURL.Service)
+	}
+
+	if consoleURL.Partition != partition {
+		return "", fmt.Errorf("AWS console URL (%s): partition (%s) does not match provider partition (%s)", s, consoleURL.Partition, partition)
+	}
+
+	if consoleURL.Region != region {
+		return "", fmt.Errorf("AWS console URL (%s): Region (%s) does not match provider Region (%s)", s, consoleURL.Region, region)
+	}
+
+	return consoleURL.ID, nil
+}
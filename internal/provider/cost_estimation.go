@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// costEstimationCustomizeDiff returns a CustomizeDiffFunc that runs any existing CustomizeDiffFunc
+// and then records the planned resource's pricing metadata if cost estimation is enabled.
+// Cost estimation is best effort and never fails a plan.
+func costEstimationCustomizeDiff(typeName string, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if f != nil {
+			if err := f(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		if v, ok := meta.(*conns.AWSClient); ok && v.CostEstimator != nil {
+			if err := v.CostEstimator.Record(ctx, typeName, d); err != nil {
+				tflog.Warn(ctx, "recording cost estimate", map[string]any{
+					"error": err.Error(),
+				})
+			}
+		}
+
+		return nil
+	}
+}
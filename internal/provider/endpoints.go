@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/agext/levenshtein"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	endpointEnvVarPrefix = "TF_AWS_"
+	endpointEnvVarSuffix = "_ENDPOINT"
+)
+
+// validateEndpointEnvVars returns a warning for each TF_AWS_<service>_ENDPOINT environment variable
+// in environ that doesn't correspond to a service.
+func validateEndpointEnvVars(environ []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var known []string
+	for _, pkg := range names.ProviderPackages() {
+		if v := names.TfAwsEnvVar(pkg); v != "" {
+			known = append(known, v)
+		}
+	}
+
+	for _, v := range environ {
+		name, _, _ := strings.Cut(v, "=")
+
+		if !strings.HasPrefix(name, endpointEnvVarPrefix) || !strings.HasSuffix(name, endpointEnvVarSuffix) {
+			continue
+		}
+
+		if slices.Contains(known, name) {
+			continue
+		}
+
+		detail := fmt.Sprintf("The environment variable %q does not configure the endpoint of any service and is ignored.", name)
+		if suggestion := nameSuggestion(name, known); suggestion != "" {
+			detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unknown service endpoint environment variable",
+			Detail:   detail,
+		})
+	}
+
+	return diags
+}
+
+// nameSuggestion returns the candidate closest to name, or "" if none is close enough to be a likely typo.
+func nameSuggestion(name string, candidates []string) string {
+	const maxDistance = 3
+
+	var (
+		suggestion string
+		best       = maxDistance + 1
+	)
+
+	for _, candidate := range candidates {
+		if d := levenshtein.Distance(name, candidate, nil); d < best || (d == best && candidate < suggestion) {
+			suggestion, best = candidate, d
+		}
+	}
+
+	return suggestion
+}
@@ -0,0 +1,1621 @@
+// Code generated by internal/generate/endpointsschema/main.go; DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// endpointsSchema returns the schema of the provider's endpoints block, with one attribute per service package or alias.
+func endpointsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"accessanalyzer": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"account": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"acm": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"acmpca": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"amg": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"amp": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"amplify": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"apigateway": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"apigatewayv2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"appautoscaling": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"appconfig": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"appfabric": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"appflow": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"appintegrations": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"appintegrationsservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"applicationautoscaling": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"applicationinsights": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"appmesh": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"appregistry": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"apprunner": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"appstream": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"appsync": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"athena": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"auditmanager": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"autoscaling": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"autoscalingplans": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"backup": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"batch": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"beanstalk": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"bedrock": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"budgets": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ce": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"chime": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"chimesdkmediapipelines": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"chimesdkvoice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cleanrooms": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloud9": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudcontrol": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudcontrolapi": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudformation": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudfront": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudhsm": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudhsmv2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudsearch": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudtrail": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudwatch": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudwatchevents": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudwatchevidently": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudwatchlog": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudwatchlogs": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudwatchobservabilityaccessmanager": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cloudwatchrum": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"codeartifact": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"codebuild": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"codecatalyst": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"codecommit": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"codedeploy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"codeguruprofiler": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"codegurureviewer": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"codepipeline": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"codestarconnections": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"codestarnotifications": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cognitoidentity": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cognitoidentityprovider": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cognitoidp": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"comprehend": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"computeoptimizer": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"config": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"configservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"connect": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"connectcases": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"controltower": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"costandusagereportservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"costexplorer": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"cur": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"customerprofiles": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"databasemigration": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"databasemigrationservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"dataexchange": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"datapipeline": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"datasync": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"dax": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"deploy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"detective": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"devicefarm": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"directconnect": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"directoryservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"dlm": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"dms": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"docdb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"docdbelastic": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ds": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"dynamodb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ec2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ecr": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ecrpublic": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ecs": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"efs": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"eks": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"elasticache": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"elasticbeanstalk": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"elasticloadbalancing": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"elasticloadbalancingv2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"elasticsearch": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"elasticsearchservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"elastictranscoder": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"elb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"elbv2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"emr": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"emrcontainers": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"emrserverless": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"es": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"eventbridge": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"events": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"evidently": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"finspace": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"firehose": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"fis": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"fms": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"fsx": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"gamelift": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"glacier": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"globalaccelerator": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"glue": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"grafana": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"greengrass": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"groundstation": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"guardduty": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"healthlake": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"iam": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"identitystore": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"imagebuilder": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"inspector": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"inspector2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"inspectorv2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"internetmonitor": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"iot": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"iotanalytics": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"iotevents": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ivs": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ivschat": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"kafka": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"kafkaconnect": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"kendra": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"keyspaces": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"kinesis": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"kinesisanalytics": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"kinesisanalyticsv2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"kinesisvideo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"kms": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"lakeformation": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"lambda": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"lex": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"lexmodelbuilding": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"lexmodelbuildingservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"lexmodels": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"lexmodelsv2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"lexv2models": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"licensemanager": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"lightsail": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"location": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"locationservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"logs": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"lookoutmetrics": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"macie2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"managedgrafana": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"mediaconnect": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"mediaconvert": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"medialive": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"mediapackage": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"mediapackagev2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"mediastore": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"memorydb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"mq": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"msk": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"mwaa": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"neptune": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"networkfirewall": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"networkmanager": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"oam": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"opensearch": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"opensearchingestion": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"opensearchserverless": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"opensearchservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"opsworks": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"organizations": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"osis": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"outposts": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"pcaconnectorad": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"pinpoint": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"pipes": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"polly": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"pricing": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"prometheus": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"prometheusservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"qldb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"quicksight": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ram": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"rbin": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"rds": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"recyclebin": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"redshift": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"redshiftdata": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"redshiftdataapiservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"redshiftserverless": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"resourceexplorer2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"resourcegroups": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"resourcegroupstagging": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"resourcegroupstaggingapi": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"rolesanywhere": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"route53": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"route53domains": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"route53recoverycontrolconfig": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"route53recoveryreadiness": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"route53resolver": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"rum": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"s3": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"s3api": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"s3control": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"s3outposts": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"sagemaker": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"scheduler": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"schemas": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"sdb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"secretsmanager": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"securityhub": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"securitylake": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"serverlessapplicationrepository": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"serverlessapprepo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"serverlessrepo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"servicecatalog": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"servicecatalogappregistry": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"servicediscovery": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"servicequotas": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ses": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"sesv2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"sfn": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"shield": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"signer": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"simpledb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"sns": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"sqs": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ssm": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ssmcontacts": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ssmincidents": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ssmquicksetup": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ssmsap": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"sso": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"ssoadmin": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"stepfunctions": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"storagegateway": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"sts": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"swf": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"synthetics": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"timestreamwrite": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"transcribe": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"transcribeservice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"transfer": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"verifiedpermissions": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"vpclattice": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"waf": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"wafregional": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"wafv2": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"wellarchitected": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"worklink": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"workspaces": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+				"xray": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Use this to override the default service endpoint URL",
+				},
+			},
+		},
+	}
+}
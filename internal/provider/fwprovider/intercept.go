@@ -14,6 +14,8 @@ import (
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -126,6 +128,11 @@ func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDRespon
 		}
 		for _, v := range reverse {
 			ctx, diags = v(ctx, request, response, meta, when, diags)
+
+			// An OnError interceptor may recover from the error, e.g. by adopting an existing resource.
+			if when == OnError && !diags.HasError() {
+				when = After
+			}
 		}
 
 		when = Finally
@@ -434,6 +441,13 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 						return ctx, diags
 					}
 
+					// Least-privilege roles may not be permitted to list tags.
+					if meta.IgnoreTagsPermissionErrors && errs.IsAccessDeniedError(err) {
+						diags.AddWarning(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+
+						return ctx, diags
+					}
+
 					if err != nil {
 						diags.AddError(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
 
@@ -578,3 +592,85 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// adoptExistingResourceInterceptor adopts an existing remote object into state when Create
+// fails because the object already exists and `adopt_existing` is set.
+type adoptExistingResourceInterceptor struct {
+	resource framework.ResourceWithAdoptExisting
+}
+
+func (r adoptExistingResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != OnError || !fwdiag.ContainsAlreadyExists(diags) {
+		return ctx, diags
+	}
+
+	var adoptExisting fwtypes.Bool
+	if d := request.Plan.GetAttribute(ctx, path.Root(names.AttrAdoptExisting), &adoptExisting); d.HasError() || !adoptExisting.ValueBool() {
+		return ctx, diags
+	}
+
+	response.Diagnostics = nil
+	r.resource.AdoptExisting(ctx, request, response)
+
+	if response.Diagnostics.HasError() {
+		return ctx, append(diags, response.Diagnostics...)
+	}
+
+	// Retain any warnings raised by Create.
+	diags = append(diags.Warnings(), response.Diagnostics...)
+	diags.AddWarning(
+		"Existing resource adopted",
+		"The resource already existed and has been adopted into Terraform state instead of being created. Any differences from the configuration will be shown in the next plan.",
+	)
+
+	return ctx, diags
+}
+
+func (r adoptExistingResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r adoptExistingResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r adoptExistingResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// throttleResourceInterceptor counts throttled AWS API requests made during a CRUD handler invocation
+// and emits a single aggregated warning if any API operation was throttled heavily.
+type throttleResourceInterceptor struct {
+	threshold int
+}
+
+func (r throttleResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, when, diags)
+}
+
+func (r throttleResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, when, diags)
+}
+
+func (r throttleResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, when, diags)
+}
+
+func (r throttleResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, when, diags)
+}
+
+func (r throttleResourceInterceptor) run(ctx context.Context, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = conns.NewThrottleCounterContext(ctx)
+	case Finally:
+		if counter, ok := conns.ThrottleCounterFromContext(ctx); ok {
+			if ops := counter.Throttled(r.threshold); len(ops) > 0 {
+				diags.AddWarning("AWS API requests were throttled heavily", conns.ThrottleWarningDetail(ops))
+			}
+		}
+	}
+
+	return ctx, diags
+}
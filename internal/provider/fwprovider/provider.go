@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,11 +18,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+var _ provider.ProviderWithFunctions = &fwprovider{}
+
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
 func New(primary interface{ Meta() interface{} }) provider.Provider {
@@ -75,6 +80,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"ignore_tags_permission_errors": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Treat access denied errors when listing resource tags as warnings. Useful for least-privilege roles that are not permitted to read tags.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
@@ -95,10 +104,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"required_credential_source": schema.StringAttribute{
+				Optional:    true,
+				Description: "The credential source that must supply credentials, e.g. `container` for EKS Pod Identity or `web_identity` for IRSA. Configuration fails if credentials come from any other source.",
+			},
 			"retry_mode": schema.StringAttribute{
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 			},
+			"s3_disable_multi_region_access_points": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set this to true to disable S3 Multi-Region Access Points.\nBy default, requests to Multi-Region Access Point ARNs are signed with SigV4a.\nSpecific to the Amazon S3 service.",
+			},
 			"s3_use_path_style": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Set this to true to enable the request to use path-style addressing,\ni.e., https://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\nuse virtual hosted bucket addressing when possible\n(https://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
@@ -157,6 +174,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"api_cache": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to cache the responses of frequently repeated, read-only AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ttl": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "How long cached responses are used for. Defaults to 30s.",
+						},
+					},
+				},
+			},
 			"assume_role": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -243,6 +275,20 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"cost_estimation": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to record pricing metadata for planned resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"output_file": schema.StringAttribute{
+							Required:    true,
+							Description: "Path of the JSON file that pricing metadata is written to.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -403,7 +449,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{
+				throttleResourceInterceptor{threshold: conns.ThrottleWarningThreshold},
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -433,6 +481,21 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v, ok := inner.(framework.ResourceWithAdoptExisting); ok {
+				// The resource has opted in to adopting existing remote objects.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrAdoptExisting]; !ok {
+					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrAdoptExisting, typeName))
+					continue
+				}
+
+				// Registered last so that it runs first after an unsuccessful Create.
+				interceptors = append(interceptors, adoptExistingResourceInterceptor{resource: v})
+			}
+
 			resources = append(resources, func() resource.Resource {
 				return newWrappedResource(bootstrapContext, inner, interceptors)
 			})
@@ -448,19 +511,17 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
-func endpointsBlock() schema.SetNestedBlock {
-	endpointsAttributes := make(map[string]schema.Attribute)
-
-	for _, serviceKey := range names.Aliases() {
-		endpointsAttributes[serviceKey] = schema.StringAttribute{
-			Optional:    true,
-			Description: "Use this to override the default service endpoint URL",
-		}
-	}
-
-	return schema.SetNestedBlock{
-		NestedObject: schema.NestedBlockObject{
-			Attributes: endpointsAttributes,
-		},
+// Functions returns a slice of functions to instantiate each Function
+// implementation.
+//
+// The function name is determined by the Function implementing its Metadata
+// method. All functions must have unique names.
+func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		tffunction.NewCanonicalizeIAMPrincipalFunction,
+		tffunction.NewInspector2FilterCriteriaFunction,
+		tffunction.NewSecurityHubFindingFiltersFunction,
+		tffunction.NewServicePrincipalFunction,
+		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -20,8 +20,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/costestimation"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retirements"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -47,8 +49,39 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_cache": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to cache the responses of frequently repeated, read-only AWS API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ttl": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "How long cached responses are used for. Defaults to 30s.",
+							ValidateFunc: validAPICacheTTL,
+						},
+					},
+				},
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"cost_estimation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to record pricing metadata for planned resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"output_file": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path of the JSON file that pricing metadata is written to.",
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -125,6 +158,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"ignore_tags_permission_errors": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Treat access denied errors when listing resource tags as warnings. " +
+					"Useful for least-privilege roles that are not permitted to read tags.",
+			},
 			"insecure": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -156,12 +195,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_credential_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(conns.CredentialSources(), false),
+				Description: "The credential source that must supply credentials, e.g. `container` for EKS Pod Identity " +
+					"or `web_identity` for IRSA. Configuration fails if credentials come from any other source.",
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
 					"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 			},
+			"s3_disable_multi_region_access_points": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Set this to true to disable S3 Multi-Region Access Points.\n" +
+					"By default, requests to Multi-Region Access Point ARNs are signed with SigV4a.\n" +
+					"Specific to the Amazon S3 service.",
+			},
 			"s3_use_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -289,7 +342,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Before | Finally,
+					why:         Read,
+					interceptor: throttleInterceptor{threshold: conns.ThrottleWarningThreshold},
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -315,6 +374,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if err := applyRetirementNotices(r, retirements.ForDataSource(typeName)); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -366,7 +430,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Before | Finally,
+					why:         AllOps,
+					interceptor: throttleInterceptor{threshold: conns.ThrottleWarningThreshold},
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -403,6 +473,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if err := applyRetirementNotices(r, retirements.ForResource(typeName)); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			if costestimation.HasMapper(typeName) {
+				r.CustomizeDiff = costEstimationCustomizeDiff(typeName, r.CustomizeDiff)
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -469,25 +548,28 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	config := conns.Config{
-		AccessKey:                      d.Get("access_key").(string),
-		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
-		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
-		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
-		Endpoints:                      make(map[string]string),
-		Insecure:                       d.Get("insecure").(bool),
-		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
-		Profile:                        d.Get("profile").(string),
-		Region:                         d.Get("region").(string),
-		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
-		SecretKey:                      d.Get("secret_key").(string),
-		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
-		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
-		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
-		STSRegion:                      d.Get("sts_region").(string),
-		TerraformVersion:               terraformVersion,
-		Token:                          d.Get("token").(string),
-		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
-		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		AccessKey:                        d.Get("access_key").(string),
+		CustomCABundle:                   d.Get("custom_ca_bundle").(string),
+		EC2MetadataServiceEndpoint:       d.Get("ec2_metadata_service_endpoint").(string),
+		EC2MetadataServiceEndpointMode:   d.Get("ec2_metadata_service_endpoint_mode").(string),
+		Endpoints:                        make(map[string]string),
+		IgnoreTagsPermissionErrors:       d.Get("ignore_tags_permission_errors").(bool),
+		Insecure:                         d.Get("insecure").(bool),
+		MaxRetries:                       25, // Set default here, not in schema (muxing with v6 provider).
+		Profile:                          d.Get("profile").(string),
+		Region:                           d.Get("region").(string),
+		RequiredCredentialSource:         d.Get("required_credential_source").(string),
+		S3DisableMultiRegionAccessPoints: d.Get("s3_disable_multi_region_access_points").(bool),
+		S3UsePathStyle:                   d.Get("s3_use_path_style").(bool),
+		SecretKey:                        d.Get("secret_key").(string),
+		SkipCredsValidation:              d.Get("skip_credentials_validation").(bool),
+		SkipRegionValidation:             d.Get("skip_region_validation").(bool),
+		SkipRequestingAccountId:          d.Get("skip_requesting_account_id").(bool),
+		STSRegion:                        d.Get("sts_region").(string),
+		TerraformVersion:                 terraformVersion,
+		Token:                            d.Get("token").(string),
+		UseDualStackEndpoint:             d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:                  d.Get("use_fips_endpoint").(bool),
 	}
 
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
@@ -524,10 +606,26 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("api_cache"); ok && len(v.([]interface{})) > 0 {
+		config.APICacheTTL = conns.DefaultAPICacheTTL
+
+		if tfMap, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["ttl"].(string); ok && v != "" {
+				config.APICacheTTL, _ = time.ParseDuration(v)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("cost_estimation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.CostEstimationOutputFile = v.([]interface{})[0].(map[string]interface{})["output_file"].(string)
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	diags = append(diags, validateEndpointEnvVars(os.Environ())...)
+
 	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
 		endpoints, err := expandEndpoints(ctx, v.(*schema.Set).List())
 
@@ -725,27 +823,6 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
-func endpointsSchema() *schema.Schema {
-	endpointsAttributes := make(map[string]*schema.Schema)
-
-	for _, serviceKey := range names.Aliases() {
-		endpointsAttributes[serviceKey] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "",
-			Description: "Use this to override the default service endpoint URL",
-		}
-	}
-
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: endpointsAttributes,
-		},
-	}
-}
-
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
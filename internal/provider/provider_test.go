@@ -9,6 +9,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/retirements"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -187,6 +189,35 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestValidateEndpointEnvVars(t *testing.T) {
+	t.Parallel()
+
+	environ := []string{
+		"HOME=/root",
+		"TF_AWS_STS_ENDPOINT=https://sts.fake.test",
+		"TF_AWS_STSS_ENDPOINT=https://sts.fake.test",
+		"TF_AWS_NOTASERVICENAME_ENDPOINT=https://fake.test",
+	}
+
+	diags := validateEndpointEnvVars(environ)
+
+	if got, want := len(diags), 2; got != want {
+		t.Fatalf("got %d diagnostics, want %d: %v", got, want, diags)
+	}
+
+	if diags.HasError() {
+		t.Errorf("unexpected error diagnostics: %v", diags)
+	}
+
+	if got, want := diags[0].Detail, `Did you mean "TF_AWS_STS_ENDPOINT"?`; !strings.Contains(got, want) {
+		t.Errorf("got detail %q, want it to contain %q", got, want)
+	}
+
+	if got := diags[1].Detail; strings.Contains(got, "Did you mean") {
+		t.Errorf("got unexpected suggestion in %q", got)
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -205,3 +236,97 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestApplyRetirementNotices(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"legacy": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+	notices := []retirements.Notice{
+		{TypeName: "aws_test", Feature: "Test", Migration: "Migrate.", URL: "https://example.com"},
+		{TypeName: "aws_test", Attribute: "legacy", Feature: "Legacy", Migration: "Migrate.", URL: "https://example.com"},
+	}
+
+	if err := applyRetirementNotices(r, notices); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := r.DeprecationMessage, notices[0].Message(); got != want {
+		t.Errorf("DeprecationMessage = %q, want %q", got, want)
+	}
+
+	if got, want := r.Schema["legacy"].Deprecated, notices[1].Message(); got != want {
+		t.Errorf("Deprecated = %q, want %q", got, want)
+	}
+
+	notices = []retirements.Notice{
+		{TypeName: "aws_test", Attribute: "missing", Feature: "Missing", Migration: "Migrate.", URL: "https://example.com"},
+	}
+
+	if err := applyRetirementNotices(r, notices); err == nil {
+		t.Error("expected error for missing attribute")
+	}
+}
+
+func TestApplyRetirementNoticesSchemaFunc(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"legacy": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			}
+		},
+	}
+	notices := []retirements.Notice{
+		{TypeName: "aws_test", Attribute: "legacy", Feature: "Legacy", Migration: "Migrate.", URL: "https://example.com"},
+	}
+
+	if err := applyRetirementNotices(r, notices); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := r.SchemaMap()["legacy"].Deprecated, notices[0].Message(); got != want {
+		t.Errorf("Deprecated = %q, want %q", got, want)
+	}
+
+	notices = []retirements.Notice{
+		{TypeName: "aws_test", Attribute: "missing", Feature: "Missing", Migration: "Migrate.", URL: "https://example.com"},
+	}
+
+	if err := applyRetirementNotices(r, notices); err == nil {
+		t.Error("expected error for missing attribute")
+	}
+}
+
+func TestRetirementNoticesSDKTypes(t *testing.T) {
+	t.Parallel()
+
+	p, err := New(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Retirement notices are only applied to Terraform Plugin SDK resources and data sources.
+	for _, notice := range retirements.All() {
+		if notice.DataSource {
+			if _, ok := p.DataSourcesMap[notice.TypeName]; !ok {
+				t.Errorf("retirement notice data source is not a Terraform Plugin SDK data source: %s", notice.TypeName)
+			}
+		} else {
+			if _, ok := p.ResourcesMap[notice.TypeName]; !ok {
+				t.Errorf("retirement notice resource is not a Terraform Plugin SDK resource: %s", notice.TypeName)
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/retirements"
+)
+
+// applyRetirementNotices marks the resource or data source, or the attributes of it, that use retired AWS features
+// as deprecated so that Terraform emits a migration warning at plan time.
+// Any deprecation message already defined on the resource or attribute takes precedence.
+func applyRetirementNotices(r *schema.Resource, notices []retirements.Notice) error {
+	var attributeNotices []retirements.Notice
+
+	for _, notice := range notices {
+		if notice.Attribute == "" {
+			if r.DeprecationMessage == "" {
+				r.DeprecationMessage = notice.Message()
+			}
+
+			continue
+		}
+
+		attributeNotices = append(attributeNotices, notice)
+	}
+
+	if len(attributeNotices) == 0 {
+		return nil
+	}
+
+	if err := deprecateRetiredAttributes(r.SchemaMap(), attributeNotices); err != nil {
+		return err
+	}
+
+	// A SchemaFunc returns a new schema on every call, so the notices must be applied on every call.
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			// The attributes were validated above.
+			_ = deprecateRetiredAttributes(m, attributeNotices)
+
+			return m
+		}
+	}
+
+	return nil
+}
+
+func deprecateRetiredAttributes(m map[string]*schema.Schema, notices []retirements.Notice) error {
+	for _, notice := range notices {
+		v, ok := m[notice.Attribute]
+
+		if !ok {
+			return fmt.Errorf("retirement notice attribute not found: %s.%s", notice.TypeName, notice.Attribute)
+		}
+
+		if v.Deprecated == "" {
+			v.Deprecated = notice.Message()
+		}
+	}
+
+	return nil
+}
@@ -141,6 +141,11 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 			}
 		}
 
+		// Least-privilege roles may not be permitted to list tags.
+		if meta.(*conns.AWSClient).IgnoreTagsPermissionErrors && errs.IsAccessDeniedError(err) {
+			return ctx, sdkdiag.AppendWarningf(diags, "listing tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
+		}
+
 		if err != nil {
 			return ctx, sdkdiag.AppendErrorf(diags, "listing tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
 		}
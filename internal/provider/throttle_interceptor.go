@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// throttleInterceptor counts throttled AWS API requests made during a CRUD handler invocation
+// and emits a single aggregated warning if any API operation was throttled heavily.
+type throttleInterceptor struct {
+	threshold int
+}
+
+func (r throttleInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = conns.NewThrottleCounterContext(ctx)
+	case Finally:
+		if counter, ok := conns.ThrottleCounterFromContext(ctx); ok {
+			if ops := counter.Throttled(r.threshold); len(ops) > 0 {
+				diags = append(diags, errs.NewWarningDiagnostic("AWS API requests were throttled heavily", conns.ThrottleWarningDetail(ops)))
+			}
+		}
+	}
+
+	return ctx, diags
+}
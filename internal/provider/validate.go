@@ -28,6 +28,21 @@ func validAssumeRoleDuration(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+func validAPICacheTTL(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration < time.Second || duration > 15*time.Minute {
+		errors = append(errors, fmt.Errorf("duration %q must be between 1 second (1s) and 15 minutes (15m), inclusive", k))
+	}
+
+	return
+}
+
 var validAssumeRoleSessionName = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
@@ -73,3 +73,50 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidAPICacheTTL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val:         "1",
+			expectedErr: regexache.MustCompile(`cannot be parsed as a duration`),
+		},
+		{
+			val:         "500ms",
+			expectedErr: regexache.MustCompile(`must be between 1 second \(1s\) and 15 minutes \(15m\)`),
+		},
+		{
+			val:         "1h",
+			expectedErr: regexache.MustCompile(`must be between 1 second \(1s\) and 15 minutes \(15m\)`),
+		},
+		{
+			val: "1s",
+		},
+		{
+			val: "30s",
+		},
+		{
+			val: "15m",
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validAPICacheTTL(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
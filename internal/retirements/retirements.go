@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package retirements describes AWS features that have been, or are being, retired by AWS.
+// The provider uses these notices to emit migration warnings at plan time for any
+// configuration that uses a retired feature.
+//
+// To add a notice, append an entry to the notices table. No changes to the affected
+// resources or data sources are required.
+// Notices are only applied to resources and data sources implemented with the Terraform Plugin SDK.
+package retirements
+
+import (
+	"fmt"
+)
+
+// Notice describes a retired AWS feature used by a resource or data source.
+type Notice struct {
+	// TypeName is the Terraform resource or data source type name, e.g. `aws_launch_configuration`.
+	TypeName string
+	// DataSource is true if the notice applies to the data source named TypeName rather than the resource.
+	DataSource bool
+	// Attribute is the top-level attribute that uses the retired feature.
+	// If empty, any use of the resource or data source uses the retired feature.
+	Attribute string
+	// Feature is the name of the retired AWS feature.
+	Feature string
+	// Migration describes the replacement for the retired feature.
+	Migration string
+	// URL links to AWS documentation about the retirement or migration.
+	URL string
+}
+
+// Message returns the warning message emitted for the notice.
+func (n Notice) Message() string {
+	return fmt.Sprintf("%s has been retired by AWS. %s For more information, see %s", n.Feature, n.Migration, n.URL)
+}
+
+// ForResource returns the notices for the specified resource type.
+func ForResource(typeName string) []Notice {
+	return find(typeName, false)
+}
+
+// ForDataSource returns the notices for the specified data source type.
+func ForDataSource(typeName string) []Notice {
+	return find(typeName, true)
+}
+
+// All returns all notices.
+func All() []Notice {
+	return notices
+}
+
+func find(typeName string, dataSource bool) []Notice {
+	var output []Notice
+
+	for _, v := range notices {
+		if v.TypeName == typeName && v.DataSource == dataSource {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
+
+const (
+	ec2ClassicFeature   = "EC2-Classic and ClassicLink"
+	ec2ClassicMigration = "Cache security groups are only supported on EC2-Classic; use VPC security groups (`security_group_ids`) instead."
+	ec2ClassicURL       = "https://aws.amazon.com/blogs/aws/ec2-classic-is-retiring-heres-how-to-prepare/"
+
+	elasticTranscoderFeature   = "Amazon Elastic Transcoder"
+	elasticTranscoderMigration = "Migrate to AWS Elemental MediaConvert."
+	elasticTranscoderURL       = "https://aws.amazon.com/blogs/media/migrating-workflows-from-amazon-elastic-transcoder-to-aws-elemental-mediaconvert/"
+
+	evidentlyFeature   = "Amazon CloudWatch Evidently"
+	evidentlyMigration = "Migrate to AWS AppConfig feature flags."
+	evidentlyURL       = "https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/CloudWatch-Evidently.html"
+
+	launchConfigurationFeature   = "Amazon EC2 Auto Scaling launch configurations"
+	launchConfigurationMigration = "Launch configurations are not available to new accounts; use launch templates (`aws_launch_template`) instead."
+	launchConfigurationURL       = "https://docs.aws.amazon.com/autoscaling/ec2/userguide/migrate-to-launch-templates.html"
+
+	opsWorksFeature   = "AWS OpsWorks Stacks"
+	opsWorksMigration = "Migrate to AWS Systems Manager Application Manager."
+	opsWorksURL       = "https://docs.aws.amazon.com/opsworks/latest/userguide/migrating-to-systems-manager.html"
+
+	qldbFeature   = "Amazon Quantum Ledger Database (QLDB)"
+	qldbMigration = "Migrate to Amazon Aurora PostgreSQL."
+	qldbURL       = "https://aws.amazon.com/blogs/database/migrate-an-amazon-qldb-ledger-to-amazon-aurora-postgresql/"
+
+	workLinkFeature   = "Amazon WorkLink"
+	workLinkMigration = "Migrate to Amazon WorkSpaces Secure Browser (`aws_workspacesweb_*` resources)."
+	workLinkURL       = "https://docs.aws.amazon.com/worklink/latest/ag/what-is.html"
+)
+
+// notices is the table of retired AWS features.
+var notices = []Notice{
+	{TypeName: "aws_autoscaling_group", Attribute: "launch_configuration", Feature: launchConfigurationFeature, Migration: launchConfigurationMigration, URL: launchConfigurationURL},
+	{TypeName: "aws_elasticache_replication_group", Attribute: "security_group_names", Feature: ec2ClassicFeature, Migration: ec2ClassicMigration, URL: ec2ClassicURL},
+	{TypeName: "aws_elastictranscoder_pipeline", Feature: elasticTranscoderFeature, Migration: elasticTranscoderMigration, URL: elasticTranscoderURL},
+	{TypeName: "aws_elastictranscoder_preset", Feature: elasticTranscoderFeature, Migration: elasticTranscoderMigration, URL: elasticTranscoderURL},
+	{TypeName: "aws_evidently_feature", Feature: evidentlyFeature, Migration: evidentlyMigration, URL: evidentlyURL},
+	{TypeName: "aws_evidently_launch", Feature: evidentlyFeature, Migration: evidentlyMigration, URL: evidentlyURL},
+	{TypeName: "aws_evidently_project", Feature: evidentlyFeature, Migration: evidentlyMigration, URL: evidentlyURL},
+	{TypeName: "aws_evidently_segment", Feature: evidentlyFeature, Migration: evidentlyMigration, URL: evidentlyURL},
+	{TypeName: "aws_launch_configuration", Feature: launchConfigurationFeature, Migration: launchConfigurationMigration, URL: launchConfigurationURL},
+	{TypeName: "aws_launch_configuration", DataSource: true, Feature: launchConfigurationFeature, Migration: launchConfigurationMigration, URL: launchConfigurationURL},
+	{TypeName: "aws_opsworks_application", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_custom_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_ecs_cluster_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_ganglia_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_haproxy_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_instance", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_java_app_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_memcached_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_mysql_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_nodejs_app_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_permission", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_php_app_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_rails_app_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_rds_db_instance", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_stack", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_static_web_layer", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_opsworks_user_profile", Feature: opsWorksFeature, Migration: opsWorksMigration, URL: opsWorksURL},
+	{TypeName: "aws_qldb_ledger", Feature: qldbFeature, Migration: qldbMigration, URL: qldbURL},
+	{TypeName: "aws_qldb_ledger", DataSource: true, Feature: qldbFeature, Migration: qldbMigration, URL: qldbURL},
+	{TypeName: "aws_qldb_stream", Feature: qldbFeature, Migration: qldbMigration, URL: qldbURL},
+	{TypeName: "aws_worklink_fleet", Feature: workLinkFeature, Migration: workLinkMigration, URL: workLinkURL},
+	{TypeName: "aws_worklink_website_certificate_authority_association", Feature: workLinkFeature, Migration: workLinkMigration, URL: workLinkURL},
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retirements
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNotices(t *testing.T) {
+	t.Parallel()
+
+	type key struct {
+		typeName   string
+		dataSource bool
+		attribute  string
+	}
+	seen := make(map[key]bool)
+
+	for _, v := range notices {
+		k := key{v.TypeName, v.DataSource, v.Attribute}
+		if seen[k] {
+			t.Errorf("duplicate notice: %+v", k)
+		}
+		seen[k] = true
+
+		if !strings.HasPrefix(v.TypeName, "aws_") {
+			t.Errorf("invalid type name: %s", v.TypeName)
+		}
+		if v.Feature == "" || v.Migration == "" {
+			t.Errorf("missing feature or migration: %s", v.TypeName)
+		}
+		if !strings.HasPrefix(v.URL, "https://") {
+			t.Errorf("invalid URL for %s: %s", v.TypeName, v.URL)
+		}
+	}
+}
+
+func TestForResource(t *testing.T) {
+	t.Parallel()
+
+	if got, want := len(ForResource("aws_launch_configuration")), 1; got != want {
+		t.Errorf("ForResource(aws_launch_configuration) returned %d notices, want %d", got, want)
+	}
+
+	if got, want := len(ForDataSource("aws_launch_configuration")), 1; got != want {
+		t.Errorf("ForDataSource(aws_launch_configuration) returned %d notices, want %d", got, want)
+	}
+
+	if got, want := len(ForResource("aws_elasticache_replication_group")), 1; got != want {
+		t.Errorf("ForResource(aws_elasticache_replication_group) returned %d notices, want %d", got, want)
+	}
+
+	if got := ForResource("aws_launch_template"); len(got) != 0 {
+		t.Errorf("ForResource(aws_launch_template) returned %d notices, want 0", len(got))
+	}
+
+	notices := ForResource("aws_autoscaling_group")
+	if got, want := len(notices), 1; got != want {
+		t.Fatalf("ForResource(aws_autoscaling_group) returned %d notices, want %d", got, want)
+	}
+	if got, want := notices[0].Attribute, "launch_configuration"; got != want {
+		t.Errorf("Attribute = %q, want %q", got, want)
+	}
+	if got, want := notices[0].Message(), "launch templates"; !strings.Contains(got, want) {
+		t.Errorf("Message() = %q, want it to contain %q", got, want)
+	}
+}
@@ -18,6 +18,9 @@ func TestAccAccount_serial(t *testing.T) {
 			"disappears": testAccAlternateContact_disappears,
 			"AccountID":  testAccAlternateContact_accountID,
 		},
+		"OrganizationAlternateContacts": {
+			"basic": testAccOrganizationAlternateContacts_basic,
+		},
 		"PrimaryContact": {
 			"basic": testAccPrimaryContact_basic,
 		},
@@ -0,0 +1,399 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_account_organization_alternate_contacts")
+func resourceOrganizationAlternateContacts() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationAlternateContactsCreate,
+		ReadWithoutTimeout:   resourceOrganizationAlternateContactsRead,
+		UpdateWithoutTimeout: resourceOrganizationAlternateContactsUpdate,
+		DeleteWithoutTimeout: resourceOrganizationAlternateContactsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"alternate_contact_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AlternateContactType](),
+			},
+			"email_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[\w+=,.-]+@[\w.-]+\.[\w]+`), "must be a valid email address"),
+			},
+			"exclude_account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"parent_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile("^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[0-9a-z]{8,32})$"), "must be an organization root or organizational unit ID"),
+				},
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9\s()+-]+$`), "must be a valid phone number"),
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+		},
+
+		CustomizeDiff: resourceOrganizationAlternateContactsCustomizeDiff,
+	}
+}
+
+func resourceOrganizationAlternateContactsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountIDs, err := findOrganizationAlternateContactsTargetAccountIDs(ctx, d, meta)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Account Organization Alternate Contacts: %s", err)
+	}
+
+	if err := putOrganizationAlternateContacts(ctx, d, meta, accountIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Account Organization Alternate Contacts: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	return append(diags, resourceOrganizationAlternateContactsRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationAlternateContactsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AccountClient(ctx)
+
+	accountIDs, err := findOrganizationAlternateContactsTargetAccountIDs(ctx, d, meta)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Account Organization Alternate Contacts (%s): %s", d.Id(), err)
+	}
+
+	contactType := d.Get("alternate_contact_type").(string)
+	callerAccountID := meta.(*conns.AWSClient).AccountID
+	var (
+		mu      sync.Mutex
+		current []string
+	)
+
+	// Only accounts whose alternate contact matches the configuration are recorded,
+	// so that drift or new accounts in the targeted parents cause a difference on the next plan.
+	err = forEachAccount(accountIDs, d.Get("max_concurrency").(int), func(accountID string) error {
+		output, err := findAlternateContactByTwoPartKey(ctx, conn, organizationAlternateContactAccountID(accountID, callerAccountID), contactType)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading account (%s): %w", accountID, err)
+		}
+
+		if alternateContactEqual(d, output) {
+			mu.Lock()
+			current = append(current, accountID)
+			mu.Unlock()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Account Organization Alternate Contacts (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_ids", current)
+
+	return diags
+}
+
+func resourceOrganizationAlternateContactsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountIDs, err := findOrganizationAlternateContactsTargetAccountIDs(ctx, d, meta)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Account Organization Alternate Contacts (%s): %s", d.Id(), err)
+	}
+
+	if err := putOrganizationAlternateContacts(ctx, d, meta, accountIDs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Account Organization Alternate Contacts (%s): %s", d.Id(), err)
+	}
+
+	// Remove the alternate contact from accounts that are no longer targeted.
+	o, _ := d.GetChange("account_ids")
+	var remove []string
+	for _, accountID := range flex.ExpandStringValueSet(o.(*schema.Set)) {
+		if !slices.Contains(accountIDs, accountID) {
+			remove = append(remove, accountID)
+		}
+	}
+
+	if err := deleteOrganizationAlternateContacts(ctx, d, meta, remove, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Account Organization Alternate Contacts (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceOrganizationAlternateContactsRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationAlternateContactsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Deleting Account Organization Alternate Contacts: %s", d.Id())
+	if err := deleteOrganizationAlternateContacts(ctx, d, meta, flex.ExpandStringValueSet(d.Get("account_ids").(*schema.Set)), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Account Organization Alternate Contacts (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceOrganizationAlternateContactsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("parent_ids") || !d.NewValueKnown("exclude_account_ids") {
+		return d.SetNewComputed("account_ids")
+	}
+
+	accountIDs, err := findOrganizationAlternateContactsTargetAccountIDs(ctx, d, meta)
+
+	if err != nil {
+		return err
+	}
+
+	// Accounts that have joined or left the targeted parents, or whose alternate contact has drifted, are updated.
+	if o := d.Get("account_ids").(*schema.Set); !o.Equal(flex.FlattenStringValueSet(accountIDs)) {
+		return d.SetNew("account_ids", accountIDs)
+	}
+
+	return nil
+}
+
+// findOrganizationAlternateContactsTargetAccountIDs returns the IDs of the active accounts in, or below, the configured parents
+// that are not excluded.
+func findOrganizationAlternateContactsTargetAccountIDs(ctx context.Context, d interface{ Get(string) any }, meta interface{}) ([]string, error) {
+	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
+
+	exclude := d.Get("exclude_account_ids").(*schema.Set)
+	seen := make(map[string]bool)
+	var output []string
+
+	for _, parentID := range flex.ExpandStringValueSet(d.Get("parent_ids").(*schema.Set)) {
+		accountIDs, err := tforganizations.FindActiveAccountIDsForParentAndBelow(ctx, conn, parentID)
+
+		if err != nil {
+			return nil, fmt.Errorf("listing accounts for parent (%s): %w", parentID, err)
+		}
+
+		for _, accountID := range accountIDs {
+			if seen[accountID] || exclude.Contains(accountID) {
+				continue
+			}
+
+			seen[accountID] = true
+			output = append(output, accountID)
+		}
+	}
+
+	return output, nil
+}
+
+func putOrganizationAlternateContacts(ctx context.Context, d *schema.ResourceData, meta interface{}, accountIDs []string, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).AccountClient(ctx)
+
+	callerAccountID := meta.(*conns.AWSClient).AccountID
+	contactType := d.Get("alternate_contact_type").(string)
+
+	return forEachAccount(accountIDs, d.Get("max_concurrency").(int), func(accountID string) error {
+		apiAccountID := organizationAlternateContactAccountID(accountID, callerAccountID)
+		input := &account.PutAlternateContactInput{
+			AlternateContactType: types.AlternateContactType(contactType),
+			EmailAddress:         aws.String(d.Get("email_address").(string)),
+			Name:                 aws.String(d.Get("name").(string)),
+			PhoneNumber:          aws.String(d.Get("phone_number").(string)),
+			Title:                aws.String(d.Get("title").(string)),
+		}
+
+		if apiAccountID != "" {
+			input.AccountId = aws.String(apiAccountID)
+		}
+
+		if _, err := conn.PutAlternateContact(ctx, input); err != nil {
+			return fmt.Errorf("putting account (%s) alternate contact: %w", accountID, err)
+		}
+
+		_, err := retry.Operation(func(ctx context.Context) (*types.AlternateContact, error) {
+			return findAlternateContactByTwoPartKey(ctx, conn, apiAccountID, contactType)
+		}).If(func(v *types.AlternateContact, err error) (bool, error) {
+			if tfresource.NotFound(err) {
+				return true, nil
+			}
+
+			if err != nil {
+				return false, err
+			}
+
+			return !alternateContactEqual(d, v), nil
+		}).Run(ctx, timeout)
+
+		if err != nil {
+			return fmt.Errorf("waiting for account (%s) alternate contact update: %w", accountID, err)
+		}
+
+		return nil
+	})
+}
+
+func deleteOrganizationAlternateContacts(ctx context.Context, d *schema.ResourceData, meta interface{}, accountIDs []string, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).AccountClient(ctx)
+
+	callerAccountID := meta.(*conns.AWSClient).AccountID
+	contactType := d.Get("alternate_contact_type").(string)
+
+	return forEachAccount(accountIDs, d.Get("max_concurrency").(int), func(accountID string) error {
+		apiAccountID := organizationAlternateContactAccountID(accountID, callerAccountID)
+		input := &account.DeleteAlternateContactInput{
+			AlternateContactType: types.AlternateContactType(contactType),
+		}
+
+		if apiAccountID != "" {
+			input.AccountId = aws.String(apiAccountID)
+		}
+
+		_, err := conn.DeleteAlternateContact(ctx, input)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting account (%s) alternate contact: %w", accountID, err)
+		}
+
+		_, err = retry.Operation(func(ctx context.Context) (*types.AlternateContact, error) {
+			return findAlternateContactByTwoPartKey(ctx, conn, apiAccountID, contactType)
+		}).UntilNotFound().Run(ctx, timeout)
+
+		if err != nil {
+			return fmt.Errorf("waiting for account (%s) alternate contact delete: %w", accountID, err)
+		}
+
+		return nil
+	})
+}
+
+// organizationAlternateContactAccountID returns the account ID to pass to the Account API.
+// The API rejects the caller's own account ID, which must instead be omitted.
+func organizationAlternateContactAccountID(accountID, callerAccountID string) string {
+	if accountID == callerAccountID {
+		return ""
+	}
+
+	return accountID
+}
+
+func alternateContactEqual(d *schema.ResourceData, v *types.AlternateContact) bool {
+	return d.Get("email_address").(string) == aws.ToString(v.EmailAddress) &&
+		d.Get("name").(string) == aws.ToString(v.Name) &&
+		d.Get("phone_number").(string) == aws.ToString(v.PhoneNumber) &&
+		d.Get("title").(string) == aws.ToString(v.Title)
+}
+
+// forEachAccount calls f for each account ID, running at most concurrency calls at a time.
+// The Account API has no batch operations for alternate contacts.
+func forEachAccount(accountIDs []string, concurrency int, f func(string) error) error {
+	var (
+		failures []error
+		mu       sync.Mutex
+		sem      = make(chan struct{}, max(concurrency, 1))
+		wg       sync.WaitGroup
+	)
+
+	for _, accountID := range accountIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(accountID); err != nil {
+				mu.Lock()
+				failures = append(failures, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(failures...)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccOrganizationAlternateContacts_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_account_organization_alternate_contacts.test"
+	domain := acctest.RandomDomainName()
+	emailAddress1 := acctest.RandomEmailAddress(domain)
+	emailAddress2 := acctest.RandomEmailAddress(domain)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccountEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationAlternateContactsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAlternateContactsConfig_basic(rName1, emailAddress1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationAlternateContactsExist(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "account_ids.#"),
+					resource.TestCheckResourceAttr(resourceName, "alternate_contact_type", "SECURITY"),
+					resource.TestCheckResourceAttr(resourceName, "email_address", emailAddress1),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "5"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "parent_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccOrganizationAlternateContactsConfig_basic(rName2, emailAddress2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationAlternateContactsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "email_address", emailAddress2),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationAlternateContactsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccountClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_account_organization_alternate_contacts" {
+				continue
+			}
+
+			for _, accountID := range testAccOrganizationAlternateContactsAccountIDs(rs) {
+				_, err := tfaccount.FindAlternateContactByTwoPartKey(ctx, conn, accountID, rs.Primary.Attributes["alternate_contact_type"])
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Account Organization Alternate Contact %s still exists in account %s", rs.Primary.ID, accountID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationAlternateContactsExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccountClient(ctx)
+
+		for _, accountID := range testAccOrganizationAlternateContactsAccountIDs(rs) {
+			if _, err := tfaccount.FindAlternateContactByTwoPartKey(ctx, conn, accountID, rs.Primary.Attributes["alternate_contact_type"]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccOrganizationAlternateContactsAccountIDs(rs *terraform.ResourceState) []string {
+	var accountIDs []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "account_ids.") && k != "account_ids.#" {
+			accountIDs = append(accountIDs, v)
+		}
+	}
+
+	return accountIDs
+}
+
+func testAccOrganizationAlternateContactsConfig_basic(rName, emailAddress string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_organizations_organization" "current" {}
+
+resource "aws_account_organization_alternate_contacts" "test" {
+  alternate_contact_type = "SECURITY"
+  parent_ids             = [data.aws_organizations_organization.current.roots[0].id]
+  exclude_account_ids    = [data.aws_caller_identity.current.account_id]
+
+  email_address = %[2]q
+  name          = %[1]q
+  phone_number  = "+17031235555"
+  title         = %[1]q
+}
+`, rName, emailAddress)
+}
@@ -32,6 +32,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAlternateContact,
 			TypeName: "aws_account_alternate_contact",
 		},
+		{
+			Factory:  resourceOrganizationAlternateContacts,
+			TypeName: "aws_account_organization_alternate_contacts",
+		},
 		{
 			Factory:  resourcePrimaryContact,
 			TypeName: "aws_account_primary_contact",
@@ -18,6 +18,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -66,6 +68,10 @@ func resourceCertificate() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -196,6 +202,35 @@ func resourceCertificate() *schema.Resource {
 					},
 				},
 			},
+			"route53_validation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_validation_records": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      60,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+				RequiredWith:  []string{"validation_method"},
+				ConflictsWith: []string{"certificate_authority_arn", "certificate_body", "certificate_chain", "private_key"},
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -323,6 +358,21 @@ func resourceCertificate() *schema.Resource {
 
 				return nil
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+				if v, ok := diff.GetOk("route53_validation"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+					return nil
+				}
+
+				if !diff.NewValueKnown("validation_method") {
+					return nil
+				}
+
+				if v := diff.Get("validation_method").(string); v != string(types.ValidationMethodDns) {
+					return fmt.Errorf("route53_validation is not valid for %s validation", v)
+				}
+
+				return nil
+			},
 			verify.SetTagsDiff,
 		),
 	}
@@ -399,10 +449,26 @@ func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, meta
 		d.SetId(aws.ToString(output.CertificateArn))
 	}
 
-	if _, err := waitCertificateDomainValidationsAvailable(ctx, conn, d.Id(), certificateDNSValidationAssignmentTimeout); err != nil {
+	certificate, err := waitCertificateDomainValidationsAvailable(ctx, conn, d.Id(), certificateDNSValidationAssignmentTimeout)
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ACM Certificate (%s) to be issued: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("route53_validation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		zoneID, ttl := tfMap["zone_id"].(string), int64(tfMap["ttl"].(int))
+		records := certificateValidationResourceRecords(certificate.DomainValidationOptions)
+
+		if err := changeCertificateValidationRecords(ctx, meta.(*conns.AWSClient).Route53Conn(ctx), zoneID, route53.ChangeActionUpsert, records, ttl); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ACM Certificate (%s) Route 53 validation records: %s", d.Id(), err)
+		}
+
+		if _, err := waitCertificateIssued(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ACM Certificate (%s) to be issued: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceCertificateRead(ctx, d, meta)...)
 }
 
@@ -556,9 +622,95 @@ func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "deleting ACM Certificate (%s): %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("route53_validation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		// Validation records are shared by all certificates for the same domain names, so only remove them on request.
+		if !tfMap["delete_validation_records"].(bool) {
+			return diags
+		}
+
+		zoneID, ttl := tfMap["zone_id"].(string), int64(tfMap["ttl"].(int))
+		var records []types.ResourceRecord
+
+		for _, tfMapRaw := range d.Get("domain_validation_options").(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			records = append(records, types.ResourceRecord{
+				Name:  aws.String(tfMap["resource_record_name"].(string)),
+				Type:  types.RecordType(tfMap["resource_record_type"].(string)),
+				Value: aws.String(tfMap["resource_record_value"].(string)),
+			})
+		}
+
+		err := changeCertificateValidationRecords(ctx, meta.(*conns.AWSClient).Route53Conn(ctx), zoneID, route53.ChangeActionDelete, records, ttl)
+
+		// Records that were already removed out-of-band cause the whole change batch to be rejected.
+		if tfawserr.ErrMessageContains(err, route53.ErrCodeInvalidChangeBatch, "not found") || tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting ACM Certificate (%s) Route 53 validation records: %s", d.Id(), err)
+		}
+	}
+
 	return diags
 }
 
+// certificateValidationResourceRecords returns the distinct DNS validation records for a certificate.
+// A wildcard domain and its apex share the same validation record.
+func certificateValidationResourceRecords(apiObjects []types.DomainValidation) []types.ResourceRecord {
+	var records []types.ResourceRecord
+	seen := make(map[string]bool)
+
+	for _, apiObject := range apiObjects {
+		v := apiObject.ResourceRecord
+
+		if v == nil {
+			continue
+		}
+
+		if name := aws.ToString(v.Name); !seen[name] {
+			seen[name] = true
+			records = append(records, *v)
+		}
+	}
+
+	return records
+}
+
+func changeCertificateValidationRecords(ctx context.Context, conn *route53.Route53, zoneID, action string, records []types.ResourceRecord, ttl int64) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	input := &route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String("Managed by Terraform"),
+		},
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	for _, v := range records {
+		input.ChangeBatch.Changes = append(input.ChangeBatch.Changes, &route53.Change{
+			Action: aws.String(action),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: v.Name,
+				ResourceRecords: []*route53.ResourceRecord{{
+					Value: v.Value,
+				}},
+				TTL:  aws.Int64(ttl),
+				Type: aws.String(string(v.Type)),
+			},
+		})
+	}
+
+	_, err := conn.ChangeResourceRecordSetsWithContext(ctx, input)
+
+	return err
+}
+
 func certificateValidationMethod(certificate *types.CertificateDetail) string {
 	if certificate.Type == types.CertificateTypeAmazonIssued {
 		for _, v := range certificate.DomainValidationOptions {
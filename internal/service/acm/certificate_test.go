@@ -117,6 +117,69 @@ func TestAccACMCertificate_dnsValidation(t *testing.T) {
 	})
 }
 
+func TestAccACMCertificate_route53Validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_acm_certificate.test"
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	wildcardDomain := fmt.Sprintf("*.%s", domain)
+	var v types.CertificateDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_route53Validation(rootDomain, domain, wildcardDomain, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domain),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "route53_validation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "route53_validation.0.delete_validation_records", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "route53_validation.0.zone_id", "data.aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "route53_validation.0.ttl", "60"),
+					resource.TestCheckResourceAttr(resourceName, "status", string(types.CertificateStatusIssued)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"route53_validation"},
+			},
+			{
+				Config: testAccCertificateConfig_route53Validation(rootDomain, domain, wildcardDomain, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "route53_validation.0.delete_validation_records", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccACMCertificate_route53ValidationEmailValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCertificateConfig_route53ValidationEmailValidation(rootDomain, domain),
+				ExpectError: regexache.MustCompile(`route53_validation is not valid for EMAIL validation`),
+			},
+		},
+	})
+}
+
 func TestAccACMCertificate_root(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_acm_certificate.test"
@@ -1772,6 +1835,44 @@ resource "aws_acm_certificate" "test" {
 `, domainName, validationMethod)
 }
 
+func testAccCertificateConfig_route53Validation(rootZoneDomain, domainName, subjectAlternativeName string, deleteValidationRecords bool) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[2]q
+  subject_alternative_names = [%[3]q]
+  validation_method         = "DNS"
+
+  route53_validation {
+    delete_validation_records = %[4]t
+    zone_id                   = data.aws_route53_zone.test.zone_id
+  }
+}
+`, rootZoneDomain, domainName, subjectAlternativeName, deleteValidationRecords)
+}
+
+func testAccCertificateConfig_route53ValidationEmailValidation(rootZoneDomain, domainName string) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[2]q
+  validation_method = "EMAIL"
+
+  route53_validation {
+    zone_id = data.aws_route53_zone.test.zone_id
+  }
+}
+`, rootZoneDomain, domainName)
+}
+
 func testAccCertificateConfig_validationOptions(rootDomainName, domainName string) string {
 	return fmt.Sprintf(`
 resource "aws_acm_certificate" "test" {
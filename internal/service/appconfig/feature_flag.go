@@ -0,0 +1,631 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	featureFlagConflictTimeout = 2 * time.Minute
+)
+
+// @SDKResource("aws_appconfig_feature_flag", name="Feature Flag")
+func ResourceFeatureFlag() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFeatureFlagCreate,
+		ReadWithoutTimeout:   resourceFeatureFlagRead,
+		UpdateWithoutTimeout: resourceFeatureFlagUpdate,
+		DeleteWithoutTimeout: resourceFeatureFlagDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9a-z]{4,7}`), ""),
+			},
+			"attribute": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enum": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"maximum": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"minimum": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z_-]{0,63}$`), ""),
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(featureFlagAttributeType_Values(), false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"configuration_profile_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9a-z]{4,7}`), ""),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"enabled": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"variant"},
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z_-]{0,63}$`), ""),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"variant": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"enabled"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_values": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"rule": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFeatureFlagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigConn(ctx)
+
+	appID := d.Get("application_id").(string)
+	profileID := d.Get("configuration_profile_id").(string)
+	key := d.Get("key").(string)
+	id := FeatureFlagCreateResourceID(appID, profileID, key)
+
+	flag, value, err := expandFeatureFlag(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppConfig Feature Flag (%s): %s", id, err)
+	}
+
+	err = updateFeatureFlags(ctx, conn, appID, profileID, func(flags *featureFlags) error {
+		if v, _, err := flags.get(key); err != nil {
+			return err
+		} else if v != nil {
+			return fmt.Errorf("flag already exists")
+		}
+
+		return flags.put(key, flag, value)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppConfig Feature Flag (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceFeatureFlagRead(ctx, d, meta)...)
+}
+
+func resourceFeatureFlagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigConn(ctx)
+
+	appID, profileID, key, err := FeatureFlagParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	version, flag, value, err := FindFeatureFlagByThreePartKey(ctx, conn, appID, profileID, key)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppConfig Feature Flag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppConfig Feature Flag (%s): %s", d.Id(), err)
+	}
+
+	attributes, err := flattenFeatureFlagAttributes(flag, value)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppConfig Feature Flag (%s): %s", d.Id(), err)
+	}
+
+	variants, err := flattenFeatureFlagVariants(flag, value.Variants)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppConfig Feature Flag (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", appID)
+	if err := d.Set("attribute", attributes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attribute: %s", err)
+	}
+	d.Set("configuration_profile_id", profileID)
+	d.Set("description", flag.Description)
+	d.Set("enabled", value.Enabled)
+	d.Set("key", key)
+	d.Set("name", flag.Name)
+	if err := d.Set("variant", variants); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting variant: %s", err)
+	}
+	d.Set("version_number", version)
+
+	return diags
+}
+
+func resourceFeatureFlagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigConn(ctx)
+
+	appID, profileID, key, err := FeatureFlagParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	flag, value, err := expandFeatureFlag(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating AppConfig Feature Flag (%s): %s", d.Id(), err)
+	}
+
+	err = updateFeatureFlags(ctx, conn, appID, profileID, func(flags *featureFlags) error {
+		return flags.put(key, flag, value)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating AppConfig Feature Flag (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFeatureFlagRead(ctx, d, meta)...)
+}
+
+func resourceFeatureFlagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigConn(ctx)
+
+	appID, profileID, key, err := FeatureFlagParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting AppConfig Feature Flag: %s", d.Id())
+	err = updateFeatureFlags(ctx, conn, appID, profileID, func(flags *featureFlags) error {
+		if v, _, err := flags.get(key); err != nil {
+			return err
+		} else if v == nil {
+			return tfresource.NewEmptyResultError(key)
+		}
+
+		flags.remove(key)
+
+		return nil
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppConfig Feature Flag (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const featureFlagResourceIDSeparator = "/"
+
+func FeatureFlagCreateResourceID(applicationID, configurationProfileID, key string) string {
+	parts := []string{applicationID, configurationProfileID, key}
+	id := strings.Join(parts, featureFlagResourceIDSeparator)
+
+	return id
+}
+
+func FeatureFlagParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, featureFlagResourceIDSeparator)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected ApplicationID%[2]sConfigurationProfileID%[2]sKey", id, featureFlagResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+// FindFeatureFlagByThreePartKey returns the flag with the specified key from the latest hosted
+// configuration version of a feature flags configuration profile, and that version's number.
+func FindFeatureFlagByThreePartKey(ctx context.Context, conn *appconfig.AppConfig, applicationID, configurationProfileID, key string) (int64, *featureFlag, *featureFlagValue, error) {
+	output, err := FindLatestHostedConfigurationVersion(ctx, conn, applicationID, configurationProfileID)
+
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	flags, err := newFeatureFlags(output.Content)
+
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	flag, value, err := flags.get(key)
+
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	if flag == nil {
+		return 0, nil, nil, tfresource.NewEmptyResultError(key)
+	}
+
+	return aws.Int64Value(output.VersionNumber), flag, value, nil
+}
+
+// updateFeatureFlags performs a read-modify-write of the latest hosted configuration version of a
+// feature flags configuration profile.
+// The version read is passed as the optimistic locking token when the new version is created,
+// and the whole operation is retried if another writer created a version in the meantime.
+func updateFeatureFlags(ctx context.Context, conn *appconfig.AppConfig, applicationID, configurationProfileID string, f func(*featureFlags) error) error {
+	mutexKey := applicationID + featureFlagResourceIDSeparator + configurationProfileID
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, featureFlagConflictTimeout, func() (interface{}, error) {
+		input := &appconfig.CreateHostedConfigurationVersionInput{
+			ApplicationId:          aws.String(applicationID),
+			ConfigurationProfileId: aws.String(configurationProfileID),
+			ContentType:            aws.String(featureFlagsContentType),
+		}
+		var content []byte
+
+		output, err := FindLatestHostedConfigurationVersion(ctx, conn, applicationID, configurationProfileID)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return nil, err
+		default:
+			content = output.Content
+			input.LatestVersionNumber = output.VersionNumber
+		}
+
+		flags, err := newFeatureFlags(content)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := f(flags); err != nil {
+			return nil, err
+		}
+
+		if input.Content, err = flags.content(); err != nil {
+			return nil, err
+		}
+
+		return conn.CreateHostedConfigurationVersionWithContext(ctx, input)
+	}, appconfig.ErrCodeConflictException)
+
+	return err
+}
+
+func expandFeatureFlag(d *schema.ResourceData) (*featureFlag, *featureFlagValue, error) {
+	flag := &featureFlag{
+		Description: d.Get("description").(string),
+		Name:        d.Get("name").(string),
+	}
+	value := &featureFlagValue{
+		Enabled: d.Get("enabled").(bool),
+	}
+
+	for _, tfMapRaw := range d.Get("attribute").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		attributeType := tfMap["type"].(string)
+
+		if flag.Attributes == nil {
+			flag.Attributes = map[string]featureFlagAttribute{}
+		}
+
+		constraints, err := expandFeatureFlagAttributeConstraints(tfMap)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("attribute (%s): %w", name, err)
+		}
+
+		flag.Attributes[name] = featureFlagAttribute{
+			Constraints: constraints,
+		}
+
+		if v := tfMap["value"].(string); v != "" {
+			raw, err := expandFeatureFlagAttributeValue(attributeType, v)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("attribute (%s): %w", name, err)
+			}
+
+			if value.AttributeValues == nil {
+				value.AttributeValues = map[string]json.RawMessage{}
+			}
+
+			value.AttributeValues[name] = raw
+		}
+	}
+
+	for _, tfMapRaw := range d.Get("variant").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		variant := featureFlagVariant{
+			Enabled: tfMap["enabled"].(bool),
+			Name:    tfMap["name"].(string),
+			Rule:    tfMap["rule"].(string),
+		}
+
+		for name, v := range tfMap["attribute_values"].(map[string]interface{}) {
+			attribute, ok := flag.Attributes[name]
+
+			if !ok {
+				return nil, nil, fmt.Errorf("variant (%s): attribute (%s) is not defined", variant.Name, name)
+			}
+
+			raw, err := expandFeatureFlagAttributeValue(attribute.Constraints.Type, v.(string))
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("variant (%s): attribute (%s): %w", variant.Name, name, err)
+			}
+
+			if variant.AttributeValues == nil {
+				variant.AttributeValues = map[string]json.RawMessage{}
+			}
+
+			variant.AttributeValues[name] = raw
+		}
+
+		value.Variants = append(value.Variants, variant)
+	}
+
+	return flag, value, nil
+}
+
+func expandFeatureFlagAttributeConstraints(tfMap map[string]interface{}) (featureFlagAttributeConstraints, error) {
+	attributeType := tfMap["type"].(string)
+	constraints := featureFlagAttributeConstraints{
+		Pattern:  tfMap["pattern"].(string),
+		Required: tfMap["required"].(bool),
+		Type:     attributeType,
+	}
+
+	if constraints.Pattern != "" && attributeType != featureFlagAttributeTypeString {
+		return constraints, fmt.Errorf("pattern is only supported for attributes of type %q", featureFlagAttributeTypeString)
+	}
+
+	for _, k := range []string{"minimum", "maximum"} {
+		v := tfMap[k].(string)
+
+		if v == "" {
+			continue
+		}
+
+		if attributeType != featureFlagAttributeTypeNumber {
+			return constraints, fmt.Errorf("%s is only supported for attributes of type %q", k, featureFlagAttributeTypeNumber)
+		}
+
+		raw, err := expandFeatureFlagAttributeValue(attributeType, v)
+
+		if err != nil {
+			return constraints, fmt.Errorf("%s: %w", k, err)
+		}
+
+		if k == "minimum" {
+			constraints.Minimum = raw
+		} else {
+			constraints.Maximum = raw
+		}
+	}
+
+	for _, v := range tfMap["enum"].([]interface{}) {
+		if attributeType != featureFlagAttributeTypeNumber && attributeType != featureFlagAttributeTypeString {
+			return constraints, fmt.Errorf("enum is only supported for attributes of type %q or %q", featureFlagAttributeTypeNumber, featureFlagAttributeTypeString)
+		}
+
+		v, _ := v.(string)
+		raw, err := expandFeatureFlagAttributeValue(attributeType, v)
+
+		if err != nil {
+			return constraints, fmt.Errorf("enum: %w", err)
+		}
+
+		constraints.Enum = append(constraints.Enum, raw)
+	}
+
+	return constraints, nil
+}
+
+func flattenFeatureFlagAttributes(flag *featureFlag, value *featureFlagValue) ([]interface{}, error) {
+	names := make([]string, 0, len(flag.Attributes))
+
+	for name := range flag.Attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	tfList := make([]interface{}, 0, len(names))
+
+	for _, name := range names {
+		attribute := flag.Attributes[name]
+		tfMap := map[string]interface{}{
+			"name":     name,
+			"required": attribute.Constraints.Required,
+			"pattern":  attribute.Constraints.Pattern,
+			"type":     attribute.Constraints.Type,
+		}
+
+		var enum []interface{}
+
+		for _, raw := range attribute.Constraints.Enum {
+			v, err := flattenFeatureFlagAttributeValue(attribute.Constraints.Type, raw)
+
+			if err != nil {
+				return nil, fmt.Errorf("attribute (%s): enum: %w", name, err)
+			}
+
+			enum = append(enum, v)
+		}
+
+		tfMap["enum"] = enum
+
+		for k, raw := range map[string]json.RawMessage{
+			"maximum": attribute.Constraints.Maximum,
+			"minimum": attribute.Constraints.Minimum,
+		} {
+			if raw == nil {
+				continue
+			}
+
+			v, err := flattenFeatureFlagAttributeValue(attribute.Constraints.Type, raw)
+
+			if err != nil {
+				return nil, fmt.Errorf("attribute (%s): %s: %w", name, k, err)
+			}
+
+			tfMap[k] = v
+		}
+
+		if raw, ok := value.AttributeValues[name]; ok {
+			v, err := flattenFeatureFlagAttributeValue(attribute.Constraints.Type, raw)
+
+			if err != nil {
+				return nil, fmt.Errorf("attribute (%s): %w", name, err)
+			}
+
+			tfMap["value"] = v
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
+
+func flattenFeatureFlagVariants(flag *featureFlag, variants []featureFlagVariant) ([]interface{}, error) {
+	tfList := make([]interface{}, 0, len(variants))
+
+	for _, variant := range variants {
+		attributeValues := map[string]interface{}{}
+
+		for name, raw := range variant.AttributeValues {
+			v, err := flattenFeatureFlagAttributeValue(flag.Attributes[name].Constraints.Type, raw)
+
+			if err != nil {
+				return nil, fmt.Errorf("variant (%s): attribute (%s): %w", variant.Name, name, err)
+			}
+
+			attributeValues[name] = v
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attribute_values": attributeValues,
+			"enabled":          variant.Enabled,
+			"name":             variant.Name,
+			"rule":             variant.Rule,
+		})
+	}
+
+	return tfList, nil
+}
@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappconfig "github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppConfigFeatureFlag_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_feature_flag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_appconfig_application.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_profile_id", "aws_appconfig_configuration_profile.test", "configuration_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "variant.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppConfigFeatureFlag_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_feature_flag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappconfig.ResourceFeatureFlag(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppConfigFeatureFlag_attributes(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_feature_flag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagConfig_attributes(rName, "10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"name":     "limit",
+						"maximum":  "100",
+						"minimum":  "1",
+						"required": "true",
+						"type":     "number",
+						"value":    "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"name":    "color",
+						"pattern": "^[a-z]+$",
+						"type":    "string",
+						"value":   "red",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"name":     "regions",
+						"required": "false",
+						"type":     "string[]",
+						"value":    `["us-east-1","us-west-2"]`,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFeatureFlagConfig_attributes(rName, "20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"name":  "limit",
+						"value": "20",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"name":     "regions",
+						"required": "false",
+						"type":     "string[]",
+						"value":    `["us-east-1","us-west-2"]`,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppConfigFeatureFlag_variants(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_feature_flag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagConfig_variants(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "variant.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.attribute_values.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.attribute_values.color", "blue"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.name", "beta"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.rule", `(ends_with $email "@example.com")`),
+					resource.TestCheckResourceAttr(resourceName, "variant.1.attribute_values.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variant.1.attribute_values.color", "green"),
+					resource.TestCheckResourceAttr(resourceName, "variant.1.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "variant.1.name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "variant.1.rule", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppConfigFeatureFlag_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_appconfig_feature_flag.test1"
+	resource2Name := "aws_appconfig_feature_flag.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resource1Name),
+					testAccCheckFeatureFlagExists(ctx, resource2Name),
+					resource.TestCheckResourceAttr(resource1Name, "key", "test1"),
+					resource.TestCheckResourceAttr(resource2Name, "key", "test2"),
+				),
+			},
+			{
+				// Writing one flag must not cause a diff in the other.
+				Config:   testAccFeatureFlagConfig_multiple(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFeatureFlagDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appconfig_feature_flag" {
+				continue
+			}
+
+			appID, profileID, key, err := tfappconfig.FeatureFlagParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, _, _, err = tfappconfig.FindFeatureFlagByThreePartKey(ctx, conn, appID, profileID, key)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppConfig Feature Flag %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFeatureFlagExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		appID, profileID, key, err := tfappconfig.FeatureFlagParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigConn(ctx)
+
+		_, _, _, err = tfappconfig.FindFeatureFlagByThreePartKey(ctx, conn, appID, profileID, key)
+
+		return err
+	}
+}
+
+func testAccFeatureFlagConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_name(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+  type           = "AWS.AppConfig.FeatureFlags"
+}
+`, rName))
+}
+
+func testAccFeatureFlagConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFeatureFlagConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_feature_flag" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  key                      = "test"
+  name                     = %[1]q
+  enabled                  = true
+}
+`, rName))
+}
+
+func testAccFeatureFlagConfig_attributes(rName, limit string) string {
+	return acctest.ConfigCompose(
+		testAccFeatureFlagConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_feature_flag" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  key                      = "test"
+  name                     = %[1]q
+  description              = %[1]q
+  enabled                  = true
+
+  attribute {
+    name     = "limit"
+    type     = "number"
+    required = true
+    minimum  = "1"
+    maximum  = "100"
+    value    = %[2]q
+  }
+
+  attribute {
+    name    = "color"
+    type    = "string"
+    enum    = ["red", "blue"]
+    pattern = "^[a-z]+$"
+    value   = "red"
+  }
+
+  attribute {
+    name  = "regions"
+    type  = "string[]"
+    value = jsonencode(["us-east-1", "us-west-2"])
+  }
+}
+`, rName, limit))
+}
+
+func testAccFeatureFlagConfig_variants(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFeatureFlagConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_feature_flag" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  key                      = "test"
+  name                     = %[1]q
+
+  attribute {
+    name = "color"
+    type = "string"
+  }
+
+  variant {
+    name    = "beta"
+    enabled = true
+    rule    = "(ends_with $email \"@example.com\")"
+
+    attribute_values = {
+      color = "blue"
+    }
+  }
+
+  variant {
+    name = "default"
+
+    attribute_values = {
+      color = "green"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccFeatureFlagConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFeatureFlagConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_feature_flag" "test1" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  key                      = "test1"
+  name                     = "%[1]s-1"
+  enabled                  = true
+}
+
+resource "aws_appconfig_feature_flag" "test2" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  key                      = "test2"
+  name                     = "%[1]s-2"
+  enabled                  = false
+}
+`, rName))
+}
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	featureFlagsContentType = "application/json"
+	featureFlagsVersion     = "1"
+)
+
+// featureFlags is a feature flags configuration document.
+// Only the members for individual flag keys are decoded so that flags managed
+// elsewhere (and members this provider doesn't model) are written back unchanged.
+type featureFlags struct {
+	document map[string]json.RawMessage
+	flags    map[string]json.RawMessage
+	values   map[string]json.RawMessage
+}
+
+type featureFlag struct {
+	Attributes  map[string]featureFlagAttribute `json:"attributes,omitempty"`
+	Description string                          `json:"description,omitempty"`
+	Name        string                          `json:"name"`
+}
+
+type featureFlagAttribute struct {
+	Constraints featureFlagAttributeConstraints `json:"constraints"`
+}
+
+type featureFlagAttributeConstraints struct {
+	Enum     []json.RawMessage `json:"enum,omitempty"`
+	Maximum  json.RawMessage   `json:"maximum,omitempty"`
+	Minimum  json.RawMessage   `json:"minimum,omitempty"`
+	Pattern  string            `json:"pattern,omitempty"`
+	Required bool              `json:"required,omitempty"`
+	Type     string            `json:"type"`
+}
+
+type featureFlagVariant struct {
+	AttributeValues map[string]json.RawMessage `json:"attributeValues,omitempty"`
+	Enabled         bool                       `json:"enabled"`
+	Name            string                     `json:"name"`
+	Rule            string                     `json:"rule,omitempty"`
+}
+
+// featureFlagValue is the value of a single flag: either a simple on/off value with
+// attribute values, or a list of variants selected by targeting rules.
+type featureFlagValue struct {
+	AttributeValues map[string]json.RawMessage
+	Enabled         bool
+	Variants        []featureFlagVariant
+}
+
+const (
+	featureFlagValueEnabled  = "enabled"
+	featureFlagValueVariants = "_variants"
+)
+
+func newFeatureFlags(content []byte) (*featureFlags, error) {
+	v := &featureFlags{
+		document: map[string]json.RawMessage{},
+		flags:    map[string]json.RawMessage{},
+		values:   map[string]json.RawMessage{},
+	}
+
+	if len(bytes.TrimSpace(content)) == 0 {
+		return v, nil
+	}
+
+	if err := json.Unmarshal(content, &v.document); err != nil {
+		return nil, fmt.Errorf("decoding feature flags: %w", err)
+	}
+
+	if raw, ok := v.document["flags"]; ok && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &v.flags); err != nil {
+			return nil, fmt.Errorf("decoding feature flags: flags: %w", err)
+		}
+	}
+
+	if raw, ok := v.document["values"]; ok && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &v.values); err != nil {
+			return nil, fmt.Errorf("decoding feature flags: values: %w", err)
+		}
+	}
+
+	return v, nil
+}
+
+// get returns the definition and value of the flag with the specified key.
+// The returned flag is nil if the document doesn't contain the key.
+func (v *featureFlags) get(key string) (*featureFlag, *featureFlagValue, error) {
+	raw, ok := v.flags[key]
+
+	if !ok {
+		return nil, nil, nil
+	}
+
+	var flag featureFlag
+
+	if err := json.Unmarshal(raw, &flag); err != nil {
+		return nil, nil, fmt.Errorf("decoding feature flag (%s): %w", key, err)
+	}
+
+	value := &featureFlagValue{}
+
+	if raw, ok := v.values[key]; ok && string(raw) != "null" {
+		members := map[string]json.RawMessage{}
+
+		if err := json.Unmarshal(raw, &members); err != nil {
+			return nil, nil, fmt.Errorf("decoding feature flag (%s) value: %w", key, err)
+		}
+
+		for k, raw := range members {
+			var err error
+
+			switch k {
+			case featureFlagValueEnabled:
+				err = json.Unmarshal(raw, &value.Enabled)
+			case featureFlagValueVariants:
+				err = json.Unmarshal(raw, &value.Variants)
+			default:
+				if value.AttributeValues == nil {
+					value.AttributeValues = map[string]json.RawMessage{}
+				}
+				value.AttributeValues[k] = raw
+			}
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("decoding feature flag (%s) value: %s: %w", key, k, err)
+			}
+		}
+	}
+
+	return &flag, value, nil
+}
+
+// put adds or replaces the flag with the specified key.
+func (v *featureFlags) put(key string, flag *featureFlag, value *featureFlagValue) error {
+	raw, err := json.Marshal(flag)
+
+	if err != nil {
+		return err
+	}
+
+	v.flags[key] = raw
+
+	members := map[string]json.RawMessage{}
+
+	for k, raw := range value.AttributeValues {
+		members[k] = raw
+	}
+
+	if len(value.Variants) > 0 {
+		if members[featureFlagValueVariants], err = json.Marshal(value.Variants); err != nil {
+			return err
+		}
+	} else {
+		if members[featureFlagValueEnabled], err = json.Marshal(value.Enabled); err != nil {
+			return err
+		}
+	}
+
+	if v.values[key], err = json.Marshal(members); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// remove deletes the flag with the specified key.
+func (v *featureFlags) remove(key string) {
+	delete(v.flags, key)
+	delete(v.values, key)
+}
+
+func (v *featureFlags) content() ([]byte, error) {
+	var err error
+
+	if _, ok := v.document["version"]; !ok {
+		if v.document["version"], err = json.Marshal(featureFlagsVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	if v.document["flags"], err = json.Marshal(v.flags); err != nil {
+		return nil, err
+	}
+
+	if v.document["values"], err = json.Marshal(v.values); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(v.document)
+}
+
+// expandFeatureFlagAttributeValue converts the string representation of an attribute value
+// in Terraform configuration to its JSON value for the specified attribute type.
+func expandFeatureFlagAttributeValue(attributeType, value string) (json.RawMessage, error) {
+	switch attributeType {
+	case featureFlagAttributeTypeBoolean:
+		b, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid boolean", value)
+		}
+
+		return json.Marshal(b)
+	case featureFlagAttributeTypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("%q is not a valid number", value)
+		}
+
+		return json.RawMessage(value), nil
+	case featureFlagAttributeTypeNumberArray:
+		var v []interface{}
+
+		decoder := json.NewDecoder(strings.NewReader(value))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&v); err != nil {
+			return nil, fmt.Errorf("%q is not a valid JSON array of numbers", value)
+		}
+
+		for _, v := range v {
+			if _, ok := v.(json.Number); !ok {
+				return nil, fmt.Errorf("%q is not a valid JSON array of numbers", value)
+			}
+		}
+
+		return json.Marshal(v)
+	case featureFlagAttributeTypeStringArray:
+		var v []string
+
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return nil, fmt.Errorf("%q is not a valid JSON array of strings", value)
+		}
+
+		return json.Marshal(v)
+	default:
+		return json.Marshal(value)
+	}
+}
+
+// flattenFeatureFlagAttributeValue is the inverse of expandFeatureFlagAttributeValue.
+func flattenFeatureFlagAttributeValue(attributeType string, raw json.RawMessage) (string, error) {
+	switch attributeType {
+	case featureFlagAttributeTypeString:
+		var v string
+
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return "", err
+		}
+
+		return v, nil
+	default:
+		var b bytes.Buffer
+
+		if err := json.Compact(&b, raw); err != nil {
+			return "", err
+		}
+
+		return b.String(), nil
+	}
+}
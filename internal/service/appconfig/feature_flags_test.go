@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeatureFlagsPutPreservesOtherFlags(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`{
+  "flags": {
+    "other": {"name": "Other", "_deprecation": {"status": "planned"}}
+  },
+  "values": {
+    "other": {"enabled": true, "_createdAt": "2024-01-01T00:00:00Z"}
+  },
+  "version": "1"
+}`)
+
+	flags, err := newFeatureFlags(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	value := &featureFlagValue{
+		AttributeValues: map[string]json.RawMessage{"limit": json.RawMessage(`10`)},
+		Enabled:         true,
+	}
+	flag := &featureFlag{
+		Attributes: map[string]featureFlagAttribute{
+			"limit": {Constraints: featureFlagAttributeConstraints{Type: featureFlagAttributeTypeNumber}},
+		},
+		Name: "Test",
+	}
+
+	if err := flags.put("test", flag, value); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := flags.content()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"flags":{"other":{"name": "Other", "_deprecation": {"status": "planned"}},"test":{"attributes":{"limit":{"constraints":{"type":"number"}}},"name":"Test"}},"values":{"other":{"enabled": true, "_createdAt": "2024-01-01T00:00:00Z"},"test":{"enabled":true,"limit":10}},"version":"1"}`
+
+	if !jsonEqual(t, got, []byte(want)) {
+		t.Errorf("content = %s, want %s", got, want)
+	}
+
+	flags, err = newFeatureFlags(got)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	gotFlag, gotValue, err := flags.get("test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if gotFlag == nil || gotFlag.Name != "Test" || gotFlag.Attributes["limit"].Constraints.Type != featureFlagAttributeTypeNumber {
+		t.Errorf("flag = %+v", gotFlag)
+	}
+
+	if !gotValue.Enabled || string(gotValue.AttributeValues["limit"]) != "10" {
+		t.Errorf("value = %+v", gotValue)
+	}
+
+	flags.remove("test")
+
+	if gotFlag, _, _ := flags.get("test"); gotFlag != nil {
+		t.Errorf("flag not removed")
+	}
+
+	if gotFlag, _, _ := flags.get("other"); gotFlag == nil {
+		t.Errorf("other flag removed")
+	}
+}
+
+func TestFeatureFlagsPutVariants(t *testing.T) {
+	t.Parallel()
+
+	flags, err := newFeatureFlags(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	value := &featureFlagValue{
+		Variants: []featureFlagVariant{
+			{Enabled: true, Name: "beta", Rule: `(ends_with $email "@example.com")`},
+			{Name: "default"},
+		},
+	}
+
+	if err := flags.put("test", &featureFlag{Name: "Test"}, value); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := flags.content()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"flags":{"test":{"name":"Test"}},"values":{"test":{"_variants":[{"enabled":true,"name":"beta","rule":"(ends_with $email \"@example.com\")"},{"enabled":false,"name":"default"}]}},"version":"1"}`
+
+	if !jsonEqual(t, got, []byte(want)) {
+		t.Errorf("content = %s, want %s", got, want)
+	}
+}
+
+func TestFeatureFlagAttributeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		attributeType string
+		value         string
+		want          string
+		wantErr       bool
+	}{
+		{attributeType: featureFlagAttributeTypeBoolean, value: "true", want: `true`},
+		{attributeType: featureFlagAttributeTypeBoolean, value: "yes", wantErr: true},
+		{attributeType: featureFlagAttributeTypeNumber, value: "1.5", want: `1.5`},
+		{attributeType: featureFlagAttributeTypeNumber, value: "one", wantErr: true},
+		{attributeType: featureFlagAttributeTypeNumberArray, value: "[1, 2]", want: `[1,2]`},
+		{attributeType: featureFlagAttributeTypeNumberArray, value: `["1"]`, wantErr: true},
+		{attributeType: featureFlagAttributeTypeString, value: "blue", want: `"blue"`},
+		{attributeType: featureFlagAttributeTypeStringArray, value: `["a", "b"]`, want: `["a","b"]`},
+		{attributeType: featureFlagAttributeTypeStringArray, value: "a", wantErr: true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.attributeType+"/"+testCase.value, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandFeatureFlagAttributeValue(testCase.attributeType, testCase.value)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != testCase.want {
+				t.Errorf("expand = %s, want %s", got, testCase.want)
+			}
+
+			value, err := flattenFeatureFlagAttributeValue(testCase.attributeType, got)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if want := testCase.value; testCase.attributeType == featureFlagAttributeTypeNumberArray || testCase.attributeType == featureFlagAttributeTypeStringArray {
+				if value != testCase.want {
+					t.Errorf("flatten = %s, want %s", value, testCase.want)
+				}
+			} else if value != want {
+				t.Errorf("flatten = %s, want %s", value, want)
+			}
+		})
+	}
+}
+
+func TestFeatureFlagAttributeConstraints(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfMap   map[string]interface{}
+		want    string
+		wantErr bool
+	}{
+		"type only": {
+			tfMap: map[string]interface{}{"type": featureFlagAttributeTypeBoolean},
+			want:  `{"type":"boolean"}`,
+		},
+		"number range": {
+			tfMap: map[string]interface{}{"type": featureFlagAttributeTypeNumber, "required": true, "minimum": "0", "maximum": "100"},
+			want:  `{"maximum":100,"minimum":0,"required":true,"type":"number"}`,
+		},
+		"number enum": {
+			tfMap: map[string]interface{}{"type": featureFlagAttributeTypeNumber, "enum": []interface{}{"1", "2.5"}},
+			want:  `{"enum":[1,2.5],"type":"number"}`,
+		},
+		"string enum and pattern": {
+			tfMap: map[string]interface{}{"type": featureFlagAttributeTypeString, "enum": []interface{}{"red", "blue"}, "pattern": "^[a-z]+$"},
+			want:  `{"enum":["red","blue"],"pattern":"^[a-z]+$","type":"string"}`,
+		},
+		"invalid minimum": {
+			tfMap:   map[string]interface{}{"type": featureFlagAttributeTypeNumber, "minimum": "zero"},
+			wantErr: true,
+		},
+		"pattern on number": {
+			tfMap:   map[string]interface{}{"type": featureFlagAttributeTypeNumber, "pattern": "^1$"},
+			wantErr: true,
+		},
+		"maximum on string": {
+			tfMap:   map[string]interface{}{"type": featureFlagAttributeTypeString, "maximum": "10"},
+			wantErr: true,
+		},
+		"enum on boolean": {
+			tfMap:   map[string]interface{}{"type": featureFlagAttributeTypeBoolean, "enum": []interface{}{"true"}},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tfMap := map[string]interface{}{
+				"enum":     []interface{}{},
+				"maximum":  "",
+				"minimum":  "",
+				"pattern":  "",
+				"required": false,
+			}
+			for k, v := range testCase.tfMap {
+				tfMap[k] = v
+			}
+
+			got, err := expandFeatureFlagAttributeConstraints(tfMap)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			b, err := json.Marshal(got)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(b) != testCase.want {
+				t.Errorf("got %s, want %s", b, testCase.want)
+			}
+		})
+	}
+}
+
+func jsonEqual(t *testing.T, a, b []byte) bool {
+	t.Helper()
+
+	var va, vb interface{}
+
+	if err := json.Unmarshal(a, &va); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := json.Unmarshal(b, &vb); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ja, _ := json.Marshal(va)
+	jb, _ := json.Marshal(vb)
+
+	return string(ja) == string(jb)
+}
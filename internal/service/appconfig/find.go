@@ -56,3 +56,65 @@ func FindExtensionAssociationById(ctx context.Context, conn *appconfig.AppConfig
 
 	return out, nil
 }
+
+// FindLatestHostedConfigurationVersion returns the hosted configuration version with the highest version number.
+func FindLatestHostedConfigurationVersion(ctx context.Context, conn *appconfig.AppConfig, applicationID, configurationProfileID string) (*appconfig.GetHostedConfigurationVersionOutput, error) {
+	input := &appconfig.ListHostedConfigurationVersionsInput{
+		ApplicationId:          aws.String(applicationID),
+		ConfigurationProfileId: aws.String(configurationProfileID),
+	}
+	var latest int64
+
+	err := conn.ListHostedConfigurationVersionsPagesWithContext(ctx, input, func(page *appconfig.ListHostedConfigurationVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil && aws.Int64Value(v.VersionNumber) > latest {
+				latest = aws.Int64Value(v.VersionNumber)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if latest == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	in := &appconfig.GetHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(applicationID),
+		ConfigurationProfileId: aws.String(configurationProfileID),
+		VersionNumber:          aws.Int64(latest),
+	}
+	out, err := conn.GetHostedConfigurationVersionWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -99,6 +99,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceExtensionAssociation,
 			TypeName: "aws_appconfig_extension_association",
 		},
+		{
+			Factory:  ResourceFeatureFlag,
+			TypeName: "aws_appconfig_feature_flag",
+			Name:     "Feature Flag",
+		},
 		{
 			Factory:  ResourceHostedConfigurationVersion,
 			TypeName: "aws_appconfig_hosted_configuration_version",
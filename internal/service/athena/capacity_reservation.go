@@ -0,0 +1,409 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_athena_capacity_reservation", name="Capacity Reservation")
+// @Tags(identifierAttribute="arn")
+func resourceCapacityReservation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapacityReservationCreate,
+		ReadWithoutTimeout:   resourceCapacityReservationRead,
+		UpdateWithoutTimeout: resourceCapacityReservationUpdate,
+		DeleteWithoutTimeout: resourceCapacityReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocated_dpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_assignment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"workgroup_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must contain only alphanumeric characters, periods, underscores, and hyphens"),
+				),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_dpus": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.IntAtLeast(24),
+					validation.IntDivisibleBy(4),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCapacityReservationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &athena.CreateCapacityReservationInput{
+		Name:       aws.String(name),
+		Tags:       getTagsIn(ctx),
+		TargetDpus: aws.Int32(int32(d.Get("target_dpus").(int))),
+	}
+
+	_, err := conn.CreateCapacityReservation(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Athena Capacity Reservation (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitCapacityReservationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Athena Capacity Reservation (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("capacity_assignment"); ok && len(v.([]interface{})) > 0 {
+		if err := putCapacityAssignmentConfiguration(ctx, conn, d.Id(), v.([]interface{})); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceCapacityReservationRead(ctx, d, meta)...)
+}
+
+func resourceCapacityReservationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaClient(ctx)
+
+	reservation, err := findCapacityReservationByName(ctx, conn, d.Id())
+
+	if err == nil && reservation.Status == types.CapacityReservationStatusCancelled {
+		err = &retry.NotFoundError{
+			Message: string(reservation.Status),
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Athena Capacity Reservation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	assignments, err := findCapacityAssignmentConfigurationByName(ctx, conn, d.Id())
+
+	if err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading Athena Capacity Reservation (%s) capacity assignment configuration: %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "athena",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("capacity-reservation/%s", d.Id()),
+	}
+	d.Set("allocated_dpus", reservation.AllocatedDpus)
+	d.Set("arn", arn.String())
+	if assignments != nil {
+		if err := d.Set("capacity_assignment", flattenCapacityAssignments(assignments.CapacityAssignments)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting capacity_assignment: %s", err)
+		}
+	} else {
+		d.Set("capacity_assignment", nil)
+	}
+	d.Set("name", reservation.Name)
+	d.Set("status", reservation.Status)
+	d.Set("target_dpus", reservation.TargetDpus)
+
+	return diags
+}
+
+func resourceCapacityReservationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaClient(ctx)
+
+	if d.HasChange("target_dpus") {
+		input := &athena.UpdateCapacityReservationInput{
+			Name:       aws.String(d.Id()),
+			TargetDpus: aws.Int32(int32(d.Get("target_dpus").(int))),
+		}
+
+		_, err := conn.UpdateCapacityReservation(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Athena Capacity Reservation (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitCapacityReservationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Athena Capacity Reservation (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("capacity_assignment") {
+		if err := putCapacityAssignmentConfiguration(ctx, conn, d.Id(), d.Get("capacity_assignment").([]interface{})); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceCapacityReservationRead(ctx, d, meta)...)
+}
+
+func resourceCapacityReservationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaClient(ctx)
+
+	// A capacity reservation must be cancelled before it can be deleted.
+	log.Printf("[DEBUG] Cancelling Athena Capacity Reservation: %s", d.Id())
+	_, err := conn.CancelCapacityReservation(ctx, &athena.CancelCapacityReservationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil && !errs.IsAErrorMessageContains[*types.InvalidRequestException](err, "CANCELLED") {
+		return sdkdiag.AppendErrorf(diags, "cancelling Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCapacityReservationCancelled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Athena Capacity Reservation (%s) cancel: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Athena Capacity Reservation: %s", d.Id())
+	_, err = conn.DeleteCapacityReservation(ctx, &athena.DeleteCapacityReservationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func putCapacityAssignmentConfiguration(ctx context.Context, conn *athena.Client, name string, tfList []interface{}) error {
+	input := &athena.PutCapacityAssignmentConfigurationInput{
+		CapacityAssignments:     expandCapacityAssignments(tfList),
+		CapacityReservationName: aws.String(name),
+	}
+
+	_, err := conn.PutCapacityAssignmentConfiguration(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("putting Athena Capacity Reservation (%s) capacity assignment configuration: %w", name, err)
+	}
+
+	return nil
+}
+
+func findCapacityReservationByName(ctx context.Context, conn *athena.Client, name string) (*types.CapacityReservation, error) {
+	input := &athena.GetCapacityReservationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityReservation(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*types.InvalidRequestException](err, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityReservation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CapacityReservation, nil
+}
+
+func findCapacityAssignmentConfigurationByName(ctx context.Context, conn *athena.Client, name string) (*types.CapacityAssignmentConfiguration, error) {
+	input := &athena.GetCapacityAssignmentConfigurationInput{
+		CapacityReservationName: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityAssignmentConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*types.InvalidRequestException](err, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityAssignmentConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CapacityAssignmentConfiguration, nil
+}
+
+func statusCapacityReservation(ctx context.Context, conn *athena.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCapacityReservationByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCapacityReservationActive(ctx context.Context, conn *athena.Client, name string, timeout time.Duration) (*types.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.CapacityReservationStatusPending, types.CapacityReservationStatusUpdatePending),
+		Target:  enum.Slice(types.CapacityReservationStatusActive),
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.CapacityReservation); ok {
+		if output.Status == types.CapacityReservationStatusFailed && output.LastAllocation != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s", aws.ToString(output.LastAllocation.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCapacityReservationCancelled(ctx context.Context, conn *athena.Client, name string, timeout time.Duration) (*types.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.CapacityReservationStatusActive, types.CapacityReservationStatusCancelling, types.CapacityReservationStatusPending, types.CapacityReservationStatusUpdatePending),
+		Target:  enum.Slice(types.CapacityReservationStatusCancelled),
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCapacityAssignments(tfList []interface{}) []types.CapacityAssignment {
+	apiObjects := []types.CapacityAssignment{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.CapacityAssignment{}
+
+		if v, ok := tfMap["workgroup_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.WorkGroupNames = flex.ExpandStringValueSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCapacityAssignments(apiObjects []types.CapacityAssignment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"workgroup_names": apiObject.WorkGroupNames,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAthenaCapacityReservation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var reservation types.CapacityReservation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_basic(rName, 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "allocated_dpus", "24"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "athena", fmt.Sprintf("capacity-reservation/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", string(types.CapacityReservationStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_dpus", "24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationConfig_basic(rName, 28),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "target_dpus", "28"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaCapacityReservation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var reservation types.CapacityReservation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_basic(rName, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName, &reservation),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfathena.ResourceCapacityReservation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAthenaCapacityReservation_capacityAssignment(t *testing.T) {
+	ctx := acctest.Context(t)
+	var reservation types.CapacityReservation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_capacityAssignment(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.0.workgroup_names.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationConfig_capacityAssignment(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.0.workgroup_names.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaCapacityReservation_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var reservation types.CapacityReservation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCapacityReservationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityReservationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_athena_capacity_reservation" {
+				continue
+			}
+
+			output, err := tfathena.FindCapacityReservationByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output.Status == types.CapacityReservationStatusCancelled {
+				continue
+			}
+
+			return fmt.Errorf("Athena Capacity Reservation %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCapacityReservationExists(ctx context.Context, n string, v *types.CapacityReservation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaClient(ctx)
+
+		output, err := tfathena.FindCapacityReservationByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCapacityReservationConfig_basic(rName string, targetDPUs int) string {
+	return fmt.Sprintf(`
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = %[2]d
+}
+`, rName, targetDPUs)
+}
+
+func testAccCapacityReservationConfig_capacityAssignment(rName string, workGroupCount int) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = 24
+
+  capacity_assignment {
+    workgroup_names = aws_athena_workgroup.test[*].name
+  }
+}
+`, rName, workGroupCount)
+}
+
+func testAccCapacityReservationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = 24
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCapacityReservationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = 24
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
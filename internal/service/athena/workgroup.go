@@ -88,6 +88,55 @@ func resourceWorkGroup() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"identity_center_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_identity_center": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"identity_center_instance_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"managed_query_results_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"encryption_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kms_key": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"publish_cloudwatch_metrics_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -199,7 +248,12 @@ func resourceWorkGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Description = aws.String(v.(string))
 	}
 
-	_, err := conn.CreateWorkGroup(ctx, input)
+	var optFns []func(*athena.Options)
+	if v := expandManagedQueryResultsConfiguration(d.Get("configuration").([]interface{})); v != nil {
+		optFns = append(optFns, withWorkGroupConfigurationMemberRequest("Configuration", "ManagedQueryResultsConfiguration", v))
+	}
+
+	_, err := conn.CreateWorkGroup(ctx, input, optFns...)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Athena WorkGroup (%s): %s", name, err)
@@ -227,7 +281,7 @@ func resourceWorkGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AthenaClient(ctx)
 
-	wg, err := findWorkGroupByName(ctx, conn, d.Id())
+	output, err := findWorkGroupOutputByName(ctx, conn, d.Id(), withManagedQueryResultsConfigurationResponse)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Athena WorkGroup (%s) not found, removing from state", d.Id())
@@ -239,6 +293,7 @@ func resourceWorkGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading Athena WorkGroup (%s): %s", d.Id(), err)
 	}
 
+	wg := output.WorkGroup
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Region:    meta.(*conns.AWSClient).Region,
@@ -247,7 +302,7 @@ func resourceWorkGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 		Resource:  fmt.Sprintf("workgroup/%s", d.Id()),
 	}
 	d.Set("arn", arn.String())
-	if err := d.Set("configuration", flattenWorkGroupConfiguration(wg.Configuration)); err != nil {
+	if err := d.Set("configuration", flattenWorkGroupConfiguration(wg.Configuration, managedQueryResultsConfigurationFromMetadata(output.ResultMetadata))); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
 	}
 	d.Set("description", wg.Description)
@@ -266,9 +321,14 @@ func resourceWorkGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		input := &athena.UpdateWorkGroupInput{
 			WorkGroup: aws.String(d.Get("name").(string)),
 		}
+		var optFns []func(*athena.Options)
 
 		if d.HasChange("configuration") {
 			input.ConfigurationUpdates = expandWorkGroupConfigurationUpdates(d.Get("configuration").([]interface{}))
+
+			if d.HasChange("configuration.0.managed_query_results_configuration") {
+				optFns = append(optFns, withWorkGroupConfigurationMemberRequest("ConfigurationUpdates", "ManagedQueryResultsConfigurationUpdates", expandManagedQueryResultsConfigurationUpdates(d.Get("configuration").([]interface{}))))
+			}
 		}
 
 		if d.HasChange("description") {
@@ -279,7 +339,7 @@ func resourceWorkGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			input.State = types.WorkGroupState(d.Get("state").(string))
 		}
 
-		_, err := conn.UpdateWorkGroup(ctx, input)
+		_, err := conn.UpdateWorkGroup(ctx, input, optFns...)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Athena WorkGroup (%s): %s", d.Id(), err)
@@ -311,11 +371,21 @@ func resourceWorkGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func findWorkGroupByName(ctx context.Context, conn *athena.Client, name string) (*types.WorkGroup, error) {
+	output, err := findWorkGroupOutputByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.WorkGroup, nil
+}
+
+func findWorkGroupOutputByName(ctx context.Context, conn *athena.Client, name string, optFns ...func(*athena.Options)) (*athena.GetWorkGroupOutput, error) {
 	input := &athena.GetWorkGroupInput{
 		WorkGroup: aws.String(name),
 	}
 
-	output, err := conn.GetWorkGroup(ctx, input)
+	output, err := conn.GetWorkGroup(ctx, input, optFns...)
 
 	if errs.IsAErrorMessageContains[*types.InvalidRequestException](err, "is not found") {
 		return nil, &retry.NotFoundError{
@@ -332,7 +402,7 @@ func findWorkGroupByName(ctx context.Context, conn *athena.Client, name string)
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.WorkGroup, nil
+	return output, nil
 }
 
 func expandWorkGroupConfiguration(l []interface{}) *types.WorkGroupConfiguration {
@@ -360,6 +430,10 @@ func expandWorkGroupConfiguration(l []interface{}) *types.WorkGroupConfiguration
 		configuration.ExecutionRole = aws.String(v)
 	}
 
+	if v, ok := m["identity_center_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.IdentityCenterConfiguration = expandWorkGroupIdentityCenterConfiguration(v)
+	}
+
 	if v, ok := m["publish_cloudwatch_metrics_enabled"].(bool); ok {
 		configuration.PublishCloudWatchMetricsEnabled = aws.Bool(v)
 	}
@@ -391,6 +465,86 @@ func expandWorkGroupEngineVersion(l []interface{}) *types.EngineVersion {
 	return engineVersion
 }
 
+func expandWorkGroupIdentityCenterConfiguration(l []interface{}) *types.IdentityCenterConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	identityCenterConfiguration := &types.IdentityCenterConfiguration{}
+
+	if v, ok := m["enable_identity_center"].(bool); ok {
+		identityCenterConfiguration.EnableIdentityCenter = aws.Bool(v)
+	}
+
+	if v, ok := m["identity_center_instance_arn"].(string); ok && v != "" {
+		identityCenterConfiguration.IdentityCenterInstanceArn = aws.String(v)
+	}
+
+	return identityCenterConfiguration
+}
+
+func expandManagedQueryResultsConfiguration(l []interface{}) *managedQueryResultsConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	v, ok := l[0].(map[string]interface{})["managed_query_results_configuration"].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	m := v[0].(map[string]interface{})
+
+	configuration := &managedQueryResultsConfiguration{}
+
+	if v, ok := m["enabled"].(bool); ok {
+		configuration.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := m["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.EncryptionConfiguration = expandManagedQueryResultsEncryptionConfiguration(v)
+	}
+
+	return configuration
+}
+
+func expandManagedQueryResultsConfigurationUpdates(l []interface{}) *managedQueryResultsConfigurationUpdates {
+	configurationUpdates := &managedQueryResultsConfigurationUpdates{
+		Enabled:                       aws.Bool(false),
+		RemoveEncryptionConfiguration: aws.Bool(true),
+	}
+
+	if configuration := expandManagedQueryResultsConfiguration(l); configuration != nil {
+		configurationUpdates.Enabled = configuration.Enabled
+
+		if configuration.EncryptionConfiguration != nil {
+			configurationUpdates.EncryptionConfiguration = configuration.EncryptionConfiguration
+			configurationUpdates.RemoveEncryptionConfiguration = nil
+		}
+	}
+
+	return configurationUpdates
+}
+
+func expandManagedQueryResultsEncryptionConfiguration(l []interface{}) *managedQueryResultsEncryptionConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	encryptionConfiguration := &managedQueryResultsEncryptionConfiguration{}
+
+	if v, ok := m["kms_key"].(string); ok && v != "" {
+		encryptionConfiguration.KmsKey = aws.String(v)
+	}
+
+	return encryptionConfiguration
+}
+
 func expandWorkGroupConfigurationUpdates(l []interface{}) *types.WorkGroupConfigurationUpdates {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -517,19 +671,21 @@ func expandWorkGroupEncryptionConfiguration(l []interface{}) *types.EncryptionCo
 	return encryptionConfiguration
 }
 
-func flattenWorkGroupConfiguration(configuration *types.WorkGroupConfiguration) []interface{} {
+func flattenWorkGroupConfiguration(configuration *types.WorkGroupConfiguration, managedQueryResultsConfiguration *managedQueryResultsConfiguration) []interface{} {
 	if configuration == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"bytes_scanned_cutoff_per_query":     aws.ToInt64(configuration.BytesScannedCutoffPerQuery),
-		"enforce_workgroup_configuration":    aws.ToBool(configuration.EnforceWorkGroupConfiguration),
-		"engine_version":                     flattenWorkGroupEngineVersion(configuration.EngineVersion),
-		"execution_role":                     aws.ToString(configuration.ExecutionRole),
-		"publish_cloudwatch_metrics_enabled": aws.ToBool(configuration.PublishCloudWatchMetricsEnabled),
-		"result_configuration":               flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
-		"requester_pays_enabled":             aws.ToBool(configuration.RequesterPaysEnabled),
+		"bytes_scanned_cutoff_per_query":      aws.ToInt64(configuration.BytesScannedCutoffPerQuery),
+		"enforce_workgroup_configuration":     aws.ToBool(configuration.EnforceWorkGroupConfiguration),
+		"engine_version":                      flattenWorkGroupEngineVersion(configuration.EngineVersion),
+		"execution_role":                      aws.ToString(configuration.ExecutionRole),
+		"identity_center_configuration":       flattenWorkGroupIdentityCenterConfiguration(configuration.IdentityCenterConfiguration),
+		"managed_query_results_configuration": flattenManagedQueryResultsConfiguration(managedQueryResultsConfiguration),
+		"publish_cloudwatch_metrics_enabled":  aws.ToBool(configuration.PublishCloudWatchMetricsEnabled),
+		"result_configuration":                flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
+		"requester_pays_enabled":              aws.ToBool(configuration.RequesterPaysEnabled),
 	}
 
 	return []interface{}{m}
@@ -548,6 +704,38 @@ func flattenWorkGroupEngineVersion(engineVersion *types.EngineVersion) []interfa
 	return []interface{}{m}
 }
 
+func flattenWorkGroupIdentityCenterConfiguration(identityCenterConfiguration *types.IdentityCenterConfiguration) []interface{} {
+	if identityCenterConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enable_identity_center":       aws.ToBool(identityCenterConfiguration.EnableIdentityCenter),
+		"identity_center_instance_arn": aws.ToString(identityCenterConfiguration.IdentityCenterInstanceArn),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenManagedQueryResultsConfiguration(configuration *managedQueryResultsConfiguration) []interface{} {
+	// A disabled configuration without encryption settings is equivalent to no configuration.
+	if configuration == nil || (!aws.ToBool(configuration.Enabled) && configuration.EncryptionConfiguration == nil) {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.ToBool(configuration.Enabled),
+	}
+
+	if v := configuration.EncryptionConfiguration; v != nil {
+		m["encryption_configuration"] = []interface{}{map[string]interface{}{
+			"kms_key": aws.ToString(v.KmsKey),
+		}}
+	}
+
+	return []interface{}{m}
+}
+
 func flattenWorkGroupResultConfiguration(resultConfiguration *types.ResultConfiguration) []interface{} {
 	if resultConfiguration == nil {
 		return []interface{}{}
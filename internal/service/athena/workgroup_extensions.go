@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// Managed query results storage is not modeled by the version of AWS SDK for Go v2 in use,
+// so the workgroup configuration members are added to request bodies and extracted from
+// response bodies by middleware.
+
+type managedQueryResultsConfiguration struct {
+	Enabled                 *bool                                       `json:"Enabled,omitempty"`
+	EncryptionConfiguration *managedQueryResultsEncryptionConfiguration `json:"EncryptionConfiguration,omitempty"`
+}
+
+type managedQueryResultsConfigurationUpdates struct {
+	Enabled                       *bool                                       `json:"Enabled,omitempty"`
+	EncryptionConfiguration       *managedQueryResultsEncryptionConfiguration `json:"EncryptionConfiguration,omitempty"`
+	RemoveEncryptionConfiguration *bool                                       `json:"RemoveEncryptionConfiguration,omitempty"`
+}
+
+type managedQueryResultsEncryptionConfiguration struct {
+	KmsKey *string `json:"KmsKey,omitempty"`
+}
+
+type managedQueryResultsConfigurationKey struct{}
+
+// withWorkGroupConfigurationMemberRequest returns an API option that merges the specified member
+// into the named configuration object of the serialized CreateWorkGroup or UpdateWorkGroup request body.
+func withWorkGroupConfigurationMemberRequest(configurationKey, memberKey string, apiObject interface{}) func(*athena.Options) {
+	return func(o *athena.Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Build.Add(middleware.BuildMiddlewareFunc("WorkGroupConfigurationMemberRequest", func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (middleware.BuildOutput, middleware.Metadata, error) {
+				request, ok := in.Request.(*smithyhttp.Request)
+
+				if !ok {
+					return middleware.BuildOutput{}, middleware.Metadata{}, fmt.Errorf("unexpected transport type (%T)", in.Request)
+				}
+
+				body := map[string]json.RawMessage{}
+
+				if stream := request.GetStream(); stream != nil {
+					b, err := io.ReadAll(stream)
+
+					if err != nil {
+						return middleware.BuildOutput{}, middleware.Metadata{}, err
+					}
+
+					if len(b) > 0 {
+						if err := json.Unmarshal(b, &body); err != nil {
+							return middleware.BuildOutput{}, middleware.Metadata{}, err
+						}
+					}
+				}
+
+				configuration := map[string]json.RawMessage{}
+
+				if v, ok := body[configurationKey]; ok {
+					if err := json.Unmarshal(v, &configuration); err != nil {
+						return middleware.BuildOutput{}, middleware.Metadata{}, err
+					}
+				}
+
+				v, err := json.Marshal(apiObject)
+
+				if err != nil {
+					return middleware.BuildOutput{}, middleware.Metadata{}, err
+				}
+
+				configuration[memberKey] = v
+
+				if body[configurationKey], err = json.Marshal(configuration); err != nil {
+					return middleware.BuildOutput{}, middleware.Metadata{}, err
+				}
+
+				b, err := json.Marshal(body)
+
+				if err != nil {
+					return middleware.BuildOutput{}, middleware.Metadata{}, err
+				}
+
+				request, err = request.SetStream(bytes.NewReader(b))
+
+				if err != nil {
+					return middleware.BuildOutput{}, middleware.Metadata{}, err
+				}
+
+				in.Request = request
+
+				return next.HandleBuild(ctx, in)
+			}), middleware.Before)
+		})
+	}
+}
+
+// withManagedQueryResultsConfigurationResponse returns an API option that extracts the managed query
+// results configuration from the raw GetWorkGroup response body into the operation's result metadata.
+func withManagedQueryResultsConfigurationResponse(o *athena.Options) {
+	o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+		return stack.Deserialize.Insert(middleware.DeserializeMiddlewareFunc("ManagedQueryResultsConfigurationResponse", func(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleDeserialize(ctx, in)
+
+			if err != nil {
+				return out, metadata, err
+			}
+
+			response, ok := out.RawResponse.(*smithyhttp.Response)
+
+			if !ok || response.StatusCode < 200 || response.StatusCode >= 300 {
+				return out, metadata, err
+			}
+
+			b, err := io.ReadAll(response.Body)
+
+			if err != nil {
+				return out, metadata, err
+			}
+
+			response.Body = io.NopCloser(bytes.NewReader(b))
+
+			var body struct {
+				WorkGroup *struct {
+					Configuration *struct {
+						ManagedQueryResultsConfiguration *managedQueryResultsConfiguration `json:"ManagedQueryResultsConfiguration"`
+					} `json:"Configuration"`
+				} `json:"WorkGroup"`
+			}
+
+			if err := json.Unmarshal(b, &body); err == nil && body.WorkGroup != nil && body.WorkGroup.Configuration != nil && body.WorkGroup.Configuration.ManagedQueryResultsConfiguration != nil {
+				metadata.Set(managedQueryResultsConfigurationKey{}, body.WorkGroup.Configuration.ManagedQueryResultsConfiguration)
+			}
+
+			return out, metadata, nil
+		}), "OperationDeserializer", middleware.After)
+	})
+}
+
+func managedQueryResultsConfigurationFromMetadata(metadata middleware.Metadata) *managedQueryResultsConfiguration {
+	if v, ok := metadata.Get(managedQueryResultsConfigurationKey{}).(*managedQueryResultsConfiguration); ok {
+		return v
+	}
+
+	return nil
+}
@@ -65,3 +65,82 @@ func TestFlattenStepAdjustments(t *testing.T) {
 		t.Fatalf("expected scaling_adjustment to be 1, but got %d", result["scaling_adjustment"])
 	}
 }
+
+func TestValidatePredictiveScalingMetricDataQueries(t *testing.T) {
+	t.Parallel()
+
+	metricStat := []interface{}{map[string]interface{}{
+		"metric": []interface{}{map[string]interface{}{
+			"metric_name": "CPUUtilization",
+			"namespace":   "AWS/EC2",
+		}},
+		"stat": "Sum",
+	}}
+
+	testCases := map[string]struct {
+		queries     []interface{}
+		expectError bool
+	}{
+		"single expression": {
+			queries: []interface{}{
+				map[string]interface{}{"id": "load", "expression": "TIME_SERIES(100)", "metric_stat": []interface{}{}, "return_data": true},
+			},
+		},
+		"math expression": {
+			queries: []interface{}{
+				map[string]interface{}{"id": "m1", "expression": "", "metric_stat": metricStat, "return_data": false},
+				map[string]interface{}{"id": "m2", "expression": "", "metric_stat": metricStat, "return_data": false},
+				map[string]interface{}{"id": "e1", "expression": "m1 / m2", "metric_stat": []interface{}{}, "return_data": true},
+			},
+		},
+		"expression and metric_stat": {
+			queries: []interface{}{
+				map[string]interface{}{"id": "m1", "expression": "TIME_SERIES(1)", "metric_stat": metricStat, "return_data": true},
+			},
+			expectError: true,
+		},
+		"neither expression nor metric_stat": {
+			queries: []interface{}{
+				map[string]interface{}{"id": "m1", "expression": "", "metric_stat": []interface{}{}, "return_data": true},
+			},
+			expectError: true,
+		},
+		"multiple return_data": {
+			queries: []interface{}{
+				map[string]interface{}{"id": "m1", "expression": "", "metric_stat": metricStat, "return_data": true},
+				map[string]interface{}{"id": "e1", "expression": "m1 * 2", "metric_stat": []interface{}{}, "return_data": true},
+			},
+			expectError: true,
+		},
+		"no return_data": {
+			queries: []interface{}{
+				map[string]interface{}{"id": "m1", "expression": "", "metric_stat": metricStat, "return_data": false},
+			},
+			expectError: true,
+		},
+		"duplicate id": {
+			queries: []interface{}{
+				map[string]interface{}{"id": "m1", "expression": "", "metric_stat": metricStat, "return_data": false},
+				map[string]interface{}{"id": "m1", "expression": "TIME_SERIES(1)", "metric_stat": []interface{}{}, "return_data": true},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validatePredictiveScalingMetricDataQueries(testCase.queries)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
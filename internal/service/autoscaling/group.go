@@ -15,6 +15,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -29,6 +30,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tfelb "github.com/hashicorp/terraform-provider-aws/internal/service/elb"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -859,6 +861,11 @@ func ResourceGroup() *schema.Resource {
 				},
 				ConflictsWith: []string{"load_balancers", "target_group_arns"},
 			},
+			"validate_instance_types": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"vpc_zone_identifier": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -925,6 +932,7 @@ func ResourceGroup() *schema.Resource {
 			launchTemplateCustomDiff("launch_template", "launch_template.0.name"),
 			launchTemplateCustomDiff("mixed_instances_policy", "mixed_instances_policy.0.launch_template.0.launch_template_specification.0.launch_template_name"),
 			launchTemplateCustomDiff("mixed_instances_policy", "mixed_instances_policy.0.launch_template.0.override"),
+			instanceTypesCustomizeDiff,
 		),
 	}
 }
@@ -993,6 +1001,93 @@ func launchTemplateCustomDiff(baseAttribute, subAttribute string) schema.Customi
 	}
 }
 
+// instanceTypesCustomizeDiff checks, when enabled, that every instance type the group could launch is compatible
+// with the AMI and network interfaces configured in the group's launch template.
+func instanceTypesCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_instance_types").(bool) {
+		return nil
+	}
+
+	var launchTemplateID, launchTemplateName, version string
+	var instanceTypes []string
+
+	if v, ok := diff.GetOk("launch_template"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if !diff.NewValueKnown("launch_template") {
+			return nil
+		}
+
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		launchTemplateID, launchTemplateName, version = tfMap["id"].(string), tfMap["name"].(string), tfMap["version"].(string)
+	} else if v, ok := diff.GetOk("mixed_instances_policy.0.launch_template.0.launch_template_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if !diff.NewValueKnown("mixed_instances_policy") {
+			return nil
+		}
+
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		launchTemplateID, launchTemplateName, version = tfMap["launch_template_id"].(string), tfMap["launch_template_name"].(string), tfMap["version"].(string)
+
+		for _, tfMapRaw := range diff.Get("mixed_instances_policy.0.launch_template.0.override").([]interface{}) {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				if v, ok := tfMap["instance_type"].(string); ok && v != "" {
+					instanceTypes = append(instanceTypes, v)
+				}
+			}
+		}
+	} else {
+		return nil
+	}
+
+	// The launch template may not exist until apply.
+	if launchTemplateID == launchTemplateIDUnknown {
+		return nil
+	}
+
+	if version == "" {
+		version = "$Default"
+	}
+
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		Versions: aws.StringSlice([]string{version}),
+	}
+
+	if launchTemplateID != "" {
+		input.LaunchTemplateId = aws.String(launchTemplateID)
+	} else if launchTemplateName != "" {
+		input.LaunchTemplateName = aws.String(launchTemplateName)
+		launchTemplateID = launchTemplateName
+	} else {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	launchTemplateVersion, err := tfec2.FindLaunchTemplateVersion(ctx, conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Launch Template (%s) version (%s): %w", launchTemplateID, version, err)
+	}
+
+	data := launchTemplateVersion.LaunchTemplateData
+
+	if data == nil {
+		return nil
+	}
+
+	if len(instanceTypes) == 0 && aws.StringValue(data.InstanceType) != "" {
+		instanceTypes = append(instanceTypes, aws.StringValue(data.InstanceType))
+	}
+
+	var errs []error
+
+	for _, instanceType := range instanceTypes {
+		if err := tfec2.ValidateInstanceTypeCompatibility(ctx, conn, instanceType, aws.StringValue(data.ImageId), len(data.NetworkInterfaces)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AutoScalingConn(ctx)
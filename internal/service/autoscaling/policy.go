@@ -111,7 +111,7 @@ func ResourcePolicy() *schema.Resource {
 										Type:          schema.TypeList,
 										Optional:      true,
 										MaxItems:      1,
-										ConflictsWith: []string{"predictive_scaling_configuration.0.metric_specification.0.predefined_load_metric_specification"},
+										ConflictsWith: []string{"predictive_scaling_configuration.0.metric_specification.0.predefined_load_metric_specification", "predictive_scaling_configuration.0.metric_specification.0.predefined_metric_pair_specification"},
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"metric_data_queries": func() *schema.Schema {
@@ -125,7 +125,7 @@ func ResourcePolicy() *schema.Resource {
 										Type:          schema.TypeList,
 										Optional:      true,
 										MaxItems:      1,
-										ConflictsWith: []string{"predictive_scaling_configuration.0.metric_specification.0.predefined_load_metric_specification"},
+										ConflictsWith: []string{"predictive_scaling_configuration.0.metric_specification.0.predefined_load_metric_specification", "predictive_scaling_configuration.0.metric_specification.0.predefined_metric_pair_specification"},
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"metric_data_queries": func() *schema.Schema {
@@ -139,7 +139,7 @@ func ResourcePolicy() *schema.Resource {
 										Type:          schema.TypeList,
 										Optional:      true,
 										MaxItems:      1,
-										ConflictsWith: []string{"predictive_scaling_configuration.0.metric_specification.0.predefined_scaling_metric_specification"},
+										ConflictsWith: []string{"predictive_scaling_configuration.0.metric_specification.0.predefined_scaling_metric_specification", "predictive_scaling_configuration.0.metric_specification.0.predefined_metric_pair_specification"},
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"metric_data_queries": func() *schema.Schema {
@@ -417,7 +417,68 @@ func ResourcePolicy() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: predictiveScalingMetricDataQueriesCustomizeDiff,
+	}
+}
+
+// predictiveScalingMetricDataQueriesCustomizeDiff checks that the metric data queries of each predictive scaling
+// customized metric specification form a valid metric math expression.
+func predictiveScalingMetricDataQueriesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("predictive_scaling_configuration") {
+		return nil
 	}
+
+	for _, k := range []string{"customized_capacity_metric_specification", "customized_load_metric_specification", "customized_scaling_metric_specification"} {
+		v, ok := diff.GetOk(fmt.Sprintf("predictive_scaling_configuration.0.metric_specification.0.%s.0.metric_data_queries", k))
+
+		if !ok {
+			continue
+		}
+
+		if err := validatePredictiveScalingMetricDataQueries(v.([]interface{})); err != nil {
+			return fmt.Errorf("predictive_scaling_configuration %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+func validatePredictiveScalingMetricDataQueries(tfList []interface{}) error {
+	ids := make(map[string]struct{})
+	returnData := 0
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		id := tfMap["id"].(string)
+
+		if _, ok := ids[id]; ok {
+			return fmt.Errorf("duplicate metric_data_queries id (%s)", id)
+		}
+		ids[id] = struct{}{}
+
+		hasExpression := tfMap["expression"].(string) != ""
+		hasMetricStat := len(tfMap["metric_stat"].([]interface{})) > 0
+
+		if hasExpression == hasMetricStat {
+			return fmt.Errorf("metric_data_queries (%s): exactly one of expression or metric_stat must be specified", id)
+		}
+
+		if tfMap["return_data"].(bool) {
+			returnData++
+		}
+	}
+
+	if returnData != 1 {
+		return fmt.Errorf("exactly one of metric_data_queries must have return_data set to true, got %d", returnData)
+	}
+
+	return nil
 }
 
 // All predictive scaling customized metrics shares same metric data query schema
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -267,6 +268,24 @@ func TestAccAutoScalingPolicy_predictiveScalingCustom(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingPolicy_predictiveScalingCustomInvalidMetricDataQueries(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_predictiveScalingCustomInvalidMetricDataQueries(rName),
+				ExpectError: regexache.MustCompile(`exactly one of metric_data_queries must have return_data set to true`),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingPolicy_predictiveScalingRemoved(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v autoscaling.ScalingPolicy
@@ -720,6 +739,43 @@ resource "aws_autoscaling_policy" "test" {
 `, rName))
 }
 
+func testAccPolicyConfig_predictiveScalingCustomInvalidMetricDataQueries(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyConfigBase(rName), fmt.Sprintf(`
+resource "aws_autoscaling_policy" "test" {
+  name                   = "%[1]s-predictive"
+  policy_type            = "PredictiveScaling"
+  autoscaling_group_name = aws_autoscaling_group.test.name
+  predictive_scaling_configuration {
+    metric_specification {
+      target_value = 32
+      customized_capacity_metric_specification {
+        metric_data_queries {
+          id = "capacity_sum"
+          metric_stat {
+            metric {
+              namespace   = "namespace_bar"
+              metric_name = "metric_name_bar"
+            }
+            stat = "Sum"
+          }
+        }
+        metric_data_queries {
+          id         = "capacity"
+          expression = "capacity_sum / 2"
+        }
+      }
+      customized_load_metric_specification {
+        metric_data_queries {
+          id         = "load_metric"
+          expression = "TIME_SERIES(100)"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccPolicyConfig_predictiveScalingCustom(rName string) string {
 	return acctest.ConfigCompose(testAccPolicyConfigBase(rName), fmt.Sprintf(`
 resource "aws_autoscaling_policy" "test" {
@@ -5,6 +5,7 @@ package backup
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
@@ -113,3 +114,70 @@ func FindVaultByName(ctx context.Context, conn *backup.Backup, name string) (*ba
 
 	return output, nil
 }
+
+func FindLogicallyAirGappedVaultByName(ctx context.Context, conn *backup.Backup, name string) (*backup.DescribeBackupVaultOutput, error) {
+	output, err := FindVaultByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if vaultType := aws.StringValue(output.VaultType); vaultType != backup.VaultTypeLogicallyAirGappedBackupVault {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("Backup Vault (%s) has type %s", name, vaultType),
+		}
+	}
+
+	return output, nil
+}
+
+func FindRestoreTestingPlanByName(ctx context.Context, conn *backup.Backup, name string) (*backup.RestoreTestingPlanForGet, error) {
+	input := &backup.GetRestoreTestingPlanInput{
+		RestoreTestingPlanName: aws.String(name),
+	}
+
+	output, err := conn.GetRestoreTestingPlanWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RestoreTestingPlan == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RestoreTestingPlan, nil
+}
+
+func FindRestoreTestingSelectionByTwoPartKey(ctx context.Context, conn *backup.Backup, restoreTestingPlanName, restoreTestingSelectionName string) (*backup.RestoreTestingSelectionForGet, error) {
+	input := &backup.GetRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(restoreTestingPlanName),
+		RestoreTestingSelectionName: aws.String(restoreTestingSelectionName),
+	}
+
+	output, err := conn.GetRestoreTestingSelectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RestoreTestingSelection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RestoreTestingSelection, nil
+}
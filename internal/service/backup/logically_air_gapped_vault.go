@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_backup_logically_air_gapped_vault", name="Logically Air Gapped Vault")
+// @Tags(identifierAttribute="arn")
+func ResourceLogicallyAirGappedVault() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLogicallyAirGappedVaultCreate,
+		ReadWithoutTimeout:   resourceLogicallyAirGappedVaultRead,
+		UpdateWithoutTimeout: resourceLogicallyAirGappedVaultUpdate,
+		DeleteWithoutTimeout: resourceLogicallyAirGappedVaultDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(7, 36500),
+			},
+			"min_retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(7, 36500),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 50),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]*$`), "must consist of letters, numbers, and hyphens."),
+				),
+			},
+			"recovery_points": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLogicallyAirGappedVaultCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &backup.CreateLogicallyAirGappedBackupVaultInput{
+		BackupVaultName:  aws.String(name),
+		BackupVaultTags:  getTagsIn(ctx),
+		CreatorRequestId: aws.String(id.UniqueId()),
+		MaxRetentionDays: aws.Int64(int64(d.Get("max_retention_days").(int))),
+		MinRetentionDays: aws.Int64(int64(d.Get("min_retention_days").(int))),
+	}
+
+	output, err := conn.CreateLogicallyAirGappedBackupVaultWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Backup Logically Air Gapped Vault (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.BackupVaultName))
+
+	// The vault state is only reported by the create response, so wait for the vault to become describable.
+	if aws.StringValue(output.VaultState) == backup.VaultStateCreating {
+		if _, err := tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+			return FindLogicallyAirGappedVaultByName(ctx, conn, d.Id())
+		}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Backup Logically Air Gapped Vault (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceLogicallyAirGappedVaultRead(ctx, d, meta)...)
+}
+
+func resourceLogicallyAirGappedVaultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	output, err := FindLogicallyAirGappedVaultByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Logically Air Gapped Vault (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Backup Logically Air Gapped Vault (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.BackupVaultArn)
+	d.Set("max_retention_days", output.MaxRetentionDays)
+	d.Set("min_retention_days", output.MinRetentionDays)
+	d.Set("name", output.BackupVaultName)
+	d.Set("recovery_points", output.NumberOfRecoveryPoints)
+
+	return diags
+}
+
+func resourceLogicallyAirGappedVaultUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceLogicallyAirGappedVaultRead(ctx, d, meta)...)
+}
+
+func resourceLogicallyAirGappedVaultDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BackupConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Backup Logically Air Gapped Vault: %s", d.Id())
+	_, err := conn.DeleteBackupVaultWithContext(ctx, &backup.DeleteBackupVaultInput{
+		BackupVaultName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Backup Logically Air Gapped Vault (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
 )
@@ -26,6 +28,19 @@ func RegisterSweepers() {
 		F:    sweepReportPlan,
 	})
 
+	resource.AddTestSweepers("aws_backup_restore_testing_plan", &resource.Sweeper{
+		Name: "aws_backup_restore_testing_plan",
+		F:    sweepRestoreTestingPlans,
+		Dependencies: []string{
+			"aws_backup_restore_testing_selection",
+		},
+	})
+
+	resource.AddTestSweepers("aws_backup_restore_testing_selection", &resource.Sweeper{
+		Name: "aws_backup_restore_testing_selection",
+		F:    sweepRestoreTestingSelections,
+	})
+
 	resource.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
 		Name: "aws_backup_vault_lock_configuration",
 		F:    sweepVaultLockConfiguration,
@@ -138,6 +153,111 @@ func sweepReportPlan(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepRestoreTestingPlans(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("Error getting client: %w", err)
+	}
+	conn := client.BackupConn(ctx)
+	input := &backup.ListRestoreTestingPlansInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListRestoreTestingPlansPagesWithContext(ctx, input, func(page *backup.ListRestoreTestingPlansOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, restoreTestingPlan := range page.RestoreTestingPlans {
+			r := ResourceRestoreTestingPlan()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(restoreTestingPlan.RestoreTestingPlanName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Backup Restore Testing Plans sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Backup Restore Testing Plans for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Backup Restore Testing Plans for %s: %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func sweepRestoreTestingSelections(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("Error getting client: %w", err)
+	}
+	conn := client.BackupConn(ctx)
+	input := &backup.ListRestoreTestingPlansInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListRestoreTestingPlansPagesWithContext(ctx, input, func(page *backup.ListRestoreTestingPlansOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, restoreTestingPlan := range page.RestoreTestingPlans {
+			planName := aws.StringValue(restoreTestingPlan.RestoreTestingPlanName)
+			input := &backup.ListRestoreTestingSelectionsInput{
+				RestoreTestingPlanName: aws.String(planName),
+			}
+
+			err := conn.ListRestoreTestingSelectionsPagesWithContext(ctx, input, func(page *backup.ListRestoreTestingSelectionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, restoreTestingSelection := range page.RestoreTestingSelections {
+					r := ResourceRestoreTestingSelection()
+					d := r.Data(nil)
+					d.SetId(errs.Must(flex.FlattenResourceId([]string{planName, aws.StringValue(restoreTestingSelection.RestoreTestingSelectionName)}, restoreTestingSelectionResourceIDPartCount, false)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Backup Restore Testing Selections (%s) for %s: %w", planName, region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Backup Restore Testing Selections sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Backup Restore Testing Plans for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Backup Restore Testing Selections for %s: %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
 func sweepVaultLockConfiguration(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -244,3 +244,34 @@ func FindTypeRegistrationByToken(ctx context.Context, conn *cloudformation.Cloud
 
 	return output, nil
 }
+
+func FindStackInstanceSummaries(ctx context.Context, conn *cloudformation.CloudFormation, input *cloudformation.ListStackInstancesInput) ([]*cloudformation.StackInstanceSummary, error) {
+	var output []*cloudformation.StackInstanceSummary
+
+	err := conn.ListStackInstancesPagesWithContext(ctx, input, func(page *cloudformation.ListStackInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Summaries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeStackSetNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -33,6 +33,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceStack,
 			TypeName: "aws_cloudformation_stack",
 		},
+		{
+			Factory:  DataSourceStackSetDriftDetection,
+			TypeName: "aws_cloudformation_stack_set_drift_detection",
+			Name:     "Stack Set Drift Detection",
+		},
 		{
 			Factory:  DataSourceType,
 			TypeName: "aws_cloudformation_type",
@@ -54,6 +59,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Stack Set",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceStackSetDriftDetection,
+			TypeName: "aws_cloudformation_stack_set_drift_detection",
+			Name:     "Stack Set Drift Detection",
+		},
 		{
 			Factory:  ResourceStackSetInstance,
 			TypeName: "aws_cloudformation_stack_set_instance",
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_cloudformation_stack_set_drift_detection", name="Stack Set Drift Detection")
+func ResourceStackSetDriftDetection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStackSetDriftDetectionCreate,
+		ReadWithoutTimeout:   resourceStackSetDriftDetectionRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"call_as": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cloudformation.CallAsSelf,
+				ValidateFunc: validation.StringInSlice(cloudformation.CallAs_Values(), false),
+			},
+			"drift_detection_details": stackSetDriftDetectionDetailsSchema(),
+			"end_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_preferences": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_tolerance_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.IntAtLeast(0),
+							ConflictsWith: []string{"operation_preferences.0.failure_tolerance_percentage"},
+						},
+						"failure_tolerance_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.IntBetween(0, 100),
+							ConflictsWith: []string{"operation_preferences.0.failure_tolerance_count"},
+						},
+						"max_concurrent_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.IntAtLeast(1),
+							ConflictsWith: []string{"operation_preferences.0.max_concurrent_percentage"},
+						},
+						"max_concurrent_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.IntBetween(1, 100),
+							ConflictsWith: []string{"operation_preferences.0.max_concurrent_count"},
+						},
+						"region_concurrency_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(cloudformation.RegionConcurrencyType_Values(), false),
+						},
+						"region_order": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z-]{1,128}$`), ""),
+							},
+						},
+					},
+				},
+			},
+			"stack_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func stackSetDriftDetectionDetailsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"drift_detection_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"drift_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"drifted_stack_instances_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"failed_stack_instances_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"in_progress_stack_instances_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"in_sync_stack_instances_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"last_drift_check_timestamp": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"total_stack_instances_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceStackSetDriftDetectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
+
+	stackSetName := d.Get("stack_set_name").(string)
+	input := &cloudformation.DetectStackSetDriftInput{
+		OperationId:  aws.String(id.UniqueId()),
+		StackSetName: aws.String(stackSetName),
+	}
+
+	callAs := d.Get("call_as").(string)
+	if v, ok := d.GetOk("call_as"); ok {
+		input.CallAs = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.DetectStackSetDriftWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "detecting CloudFormation StackSet (%s) drift: %s", stackSetName, err)
+	}
+
+	operationID := aws.StringValue(output.OperationId)
+	d.SetId(StackSetDriftDetectionCreateResourceID(stackSetName, operationID))
+
+	if _, err := WaitStackSetOperationSucceeded(ctx, conn, stackSetName, operationID, callAs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation StackSet (%s) drift detection (%s): %s", stackSetName, operationID, err)
+	}
+
+	return append(diags, resourceStackSetDriftDetectionRead(ctx, d, meta)...)
+}
+
+func resourceStackSetDriftDetectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
+
+	stackSetName, operationID, err := StackSetDriftDetectionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	callAs := d.Get("call_as").(string)
+
+	operation, err := FindStackSetOperationByStackSetNameAndOperationID(ctx, conn, stackSetName, operationID, callAs)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFormation StackSet Drift Detection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet Drift Detection (%s): %s", d.Id(), err)
+	}
+
+	if action := aws.StringValue(operation.Action); action != cloudformation.StackSetOperationActionDetectDrift {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet Drift Detection (%s): unexpected operation action (%s)", d.Id(), action)
+	}
+
+	if err := d.Set("drift_detection_details", flattenStackSetDriftDetectionDetails(operation.StackSetDriftDetectionDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting drift_detection_details: %s", err)
+	}
+	if operation.EndTimestamp != nil {
+		d.Set("end_timestamp", aws.TimeValue(operation.EndTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("end_timestamp", nil)
+	}
+	d.Set("operation_id", operationID)
+	d.Set("stack_set_name", stackSetName)
+	d.Set("status", operation.Status)
+
+	return diags
+}
+
+const stackSetDriftDetectionResourceIDSeparator = ","
+
+func StackSetDriftDetectionCreateResourceID(stackSetName, operationID string) string {
+	parts := []string{stackSetName, operationID}
+	id := strings.Join(parts, stackSetDriftDetectionResourceIDSeparator)
+
+	return id
+}
+
+func StackSetDriftDetectionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, stackSetDriftDetectionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected STACKSETNAME%[2]sOPERATIONID", id, stackSetDriftDetectionResourceIDSeparator)
+}
+
+func flattenStackSetDriftDetectionDetails(apiObject *cloudformation.StackSetDriftDetectionDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"drift_detection_status":            aws.StringValue(apiObject.DriftDetectionStatus),
+		"drift_status":                      aws.StringValue(apiObject.DriftStatus),
+		"drifted_stack_instances_count":     aws.Int64Value(apiObject.DriftedStackInstancesCount),
+		"failed_stack_instances_count":      aws.Int64Value(apiObject.FailedStackInstancesCount),
+		"in_progress_stack_instances_count": aws.Int64Value(apiObject.InProgressStackInstancesCount),
+		"in_sync_stack_instances_count":     aws.Int64Value(apiObject.InSyncStackInstancesCount),
+		"total_stack_instances_count":       aws.Int64Value(apiObject.TotalStackInstancesCount),
+	}
+
+	if v := apiObject.LastDriftCheckTimestamp; v != nil {
+		tfMap["last_drift_check_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
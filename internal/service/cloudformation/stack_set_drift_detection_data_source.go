@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_cloudformation_stack_set_drift_detection", name="Stack Set Drift Detection")
+func DataSourceStackSetDriftDetection() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStackSetDriftDetectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"call_as": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cloudformation.CallAsSelf,
+				ValidateFunc: validation.StringInSlice(cloudformation.CallAs_Values(), false),
+			},
+			"drift_detection_details": stackSetDriftDetectionDetailsSchema(),
+			"drift_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cloudformation.StackDriftStatus_Values(), false),
+			},
+			"operation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"stack_instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"detailed_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"drift_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_drift_check_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_operation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organizational_unit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"stack_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceStackSetDriftDetectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
+
+	stackSetName := d.Get("stack_set_name").(string)
+	callAs := d.Get("call_as").(string)
+
+	stackSet, err := FindStackSetByName(ctx, conn, stackSetName, callAs)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet (%s): %s", stackSetName, err)
+	}
+
+	input := &cloudformation.ListStackInstancesInput{
+		StackSetName: aws.String(stackSetName),
+	}
+
+	if callAs != "" {
+		input.CallAs = aws.String(callAs)
+	}
+
+	if v, ok := d.GetOk("drift_status"); ok {
+		input.Filters = append(input.Filters, &cloudformation.StackInstanceFilter{
+			Name:   aws.String(cloudformation.StackInstanceFilterNameDriftStatus),
+			Values: aws.String(v.(string)),
+		})
+	}
+
+	if v, ok := d.GetOk("operation_id"); ok {
+		input.Filters = append(input.Filters, &cloudformation.StackInstanceFilter{
+			Name:   aws.String(cloudformation.StackInstanceFilterNameLastOperationId),
+			Values: aws.String(v.(string)),
+		})
+	}
+
+	summaries, err := FindStackInstanceSummaries(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing CloudFormation StackSet (%s) Instances: %s", stackSetName, err)
+	}
+
+	d.SetId(aws.StringValue(stackSet.StackSetId))
+	if err := d.Set("drift_detection_details", flattenStackSetDriftDetectionDetails(stackSet.StackSetDriftDetectionDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting drift_detection_details: %s", err)
+	}
+	if err := d.Set("stack_instances", flattenStackInstanceDriftSummaries(summaries)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting stack_instances: %s", err)
+	}
+
+	return diags
+}
+
+func flattenStackInstanceDriftSummaries(apiObjects []*cloudformation.StackInstanceSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"account_id":             aws.StringValue(apiObject.Account),
+			"drift_status":           aws.StringValue(apiObject.DriftStatus),
+			"last_operation_id":      aws.StringValue(apiObject.LastOperationId),
+			"organizational_unit_id": aws.StringValue(apiObject.OrganizationalUnitId),
+			"region":                 aws.StringValue(apiObject.Region),
+			"stack_id":               aws.StringValue(apiObject.StackId),
+			"status":                 aws.StringValue(apiObject.Status),
+			"status_reason":          aws.StringValue(apiObject.StatusReason),
+		}
+
+		if v := apiObject.StackInstanceStatus; v != nil {
+			tfMap["detailed_status"] = aws.StringValue(v.DetailedStatus)
+		}
+
+		if v := apiObject.LastDriftCheckTimestamp; v != nil {
+			tfMap["last_drift_check_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+)
+
+func TestAccCloudFormationStackSetDriftDetection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var operation cloudformation.StackSetOperation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set_drift_detection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetDriftDetectionConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetDriftDetectionExists(ctx, resourceName, &operation),
+					resource.TestCheckResourceAttr(resourceName, "call_as", "SELF"),
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_details.0.drift_detection_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_details.0.drift_status", "IN_SYNC"),
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_details.0.in_sync_stack_instances_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_details.0.total_stack_instances_count", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_timestamp"),
+					resource.TestCheckResourceAttrSet(resourceName, "operation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_set_name", "aws_cloudformation_stack_set.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUCCEEDED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"call_as", "triggers"},
+			},
+			{
+				Config: testAccStackSetDriftDetectionConfig_basic(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetDriftDetectionRecreated(ctx, resourceName, &operation),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStackSetDriftDetectionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_stack_set_drift_detection.test"
+	resourceName := "aws_cloudformation_stack_set_drift_detection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetDriftDetectionDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "drift_detection_details.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "drift_detection_details.0.drift_status", "IN_SYNC"),
+					resource.TestCheckResourceAttr(dataSourceName, "stack_instances.#", "1"),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "stack_instances.0.account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "stack_instances.0.drift_status", "IN_SYNC"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "stack_instances.0.last_drift_check_timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stack_instances.0.last_operation_id", resourceName, "operation_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "stack_instances.0.region", acctest.Region()),
+					resource.TestCheckResourceAttrSet(dataSourceName, "stack_instances.0.stack_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStackSetDriftDetectionExists(ctx context.Context, n string, v *cloudformation.StackSetOperation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		stackSetName, operationID, err := tfcloudformation.StackSetDriftDetectionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn(ctx)
+
+		output, err := tfcloudformation.FindStackSetOperationByStackSetNameAndOperationID(ctx, conn, stackSetName, operationID, rs.Primary.Attributes["call_as"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckStackSetDriftDetectionRecreated(ctx context.Context, n string, i *cloudformation.StackSetOperation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var v cloudformation.StackSetOperation
+
+		if err := testAccCheckStackSetDriftDetectionExists(ctx, n, &v)(s); err != nil {
+			return err
+		}
+
+		if aws.StringValue(v.OperationId) == aws.StringValue(i.OperationId) {
+			return fmt.Errorf("CloudFormation StackSet Drift Detection (%s) not recreated", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccStackSetDriftDetectionConfig_basic(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_drift_detection" "test" {
+  stack_set_name = aws_cloudformation_stack_set_instance.test.stack_set_name
+
+  triggers = {
+    run = %[1]q
+  }
+}
+`, trigger))
+}
+
+func testAccStackSetDriftDetectionDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStackSetDriftDetectionConfig_basic(rName, "1"), `
+data "aws_cloudformation_stack_set_drift_detection" "test" {
+  stack_set_name = aws_cloudformation_stack_set_drift_detection.test.stack_set_name
+  operation_id   = aws_cloudformation_stack_set_drift_detection.test.operation_id
+}
+`)
+}
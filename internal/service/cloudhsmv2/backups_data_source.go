@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudhsmv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_cloudhsm_v2_backups")
+func DataSourceBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"copy_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delete_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hsm_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"never_expires": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"source_backup": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_cluster": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(backupFilterName_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sort_ascending": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func backupFilterName_Values() []string {
+	return []string{
+		"backupIds",
+		"clusterIds",
+		"neverExpires",
+		"sourceBackupIds",
+		"states",
+	}
+}
+
+func dataSourceBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Conn(ctx)
+
+	input := &cloudhsmv2.DescribeBackupsInput{}
+
+	if v, ok := d.GetOk("filter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = expandBackupFilters(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("sort_ascending"); ok {
+		input.SortAscending = aws.Bool(v.(bool))
+	}
+
+	backups, err := findBackups(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudHSM v2 Backups: %s", err)
+	}
+
+	var backupIDs []string
+	for _, v := range backups {
+		backupIDs = append(backupIDs, aws.StringValue(v.BackupId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("backups", flattenBackups(backups)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting backups: %s", err)
+	}
+	d.Set("ids", backupIDs)
+
+	return diags
+}
+
+func expandBackupFilters(tfList []interface{}) map[string][]*string {
+	apiObject := make(map[string][]*string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		apiObject[name] = append(apiObject[name], flex.ExpandStringSet(tfMap["values"].(*schema.Set))...)
+	}
+
+	return apiObject
+}
+
+func flattenBackups(apiObjects []*cloudhsmv2.Backup) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":            aws.StringValue(apiObject.BackupArn),
+			"backup_id":      aws.StringValue(apiObject.BackupId),
+			"backup_state":   aws.StringValue(apiObject.BackupState),
+			"cluster_id":     aws.StringValue(apiObject.ClusterId),
+			"hsm_type":       aws.StringValue(apiObject.HsmType),
+			"mode":           aws.StringValue(apiObject.Mode),
+			"never_expires":  aws.BoolValue(apiObject.NeverExpires),
+			"source_backup":  aws.StringValue(apiObject.SourceBackup),
+			"source_cluster": aws.StringValue(apiObject.SourceCluster),
+			"source_region":  aws.StringValue(apiObject.SourceRegion),
+		}
+
+		if v := apiObject.CopyTimestamp; v != nil {
+			tfMap["copy_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.CreateTimestamp; v != nil {
+			tfMap["create_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.DeleteTimestamp; v != nil {
+			tfMap["delete_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
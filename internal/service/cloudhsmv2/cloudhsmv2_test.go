@@ -14,9 +14,13 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Cluster": {
-			"basic":      testAccCluster_basic,
-			"disappears": testAccCluster_disappears,
-			"tags":       testAccCluster_tags,
+			"backupRetentionPolicy": testAccCluster_backupRetentionPolicy,
+			"basic":                 testAccCluster_basic,
+			"disappears":            testAccCluster_disappears,
+			"hsm2mMedium":           testAccCluster_hsm2mMedium,
+			"hsmTypeUpdate":         testAccCluster_hsmTypeUpdate,
+			"modeInvalid":           testAccCluster_modeInvalid,
+			"tags":                  testAccCluster_tags,
 		},
 		"Hsm": {
 			"availabilityZone": testAccHSM_AvailabilityZone,
@@ -25,7 +29,8 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 			"ipAddress":        testAccHSM_IPAddress,
 		},
 		"DataSource": {
-			"basic": testAccDataSourceCluster_basic,
+			"basic":   testAccDataSourceCluster_basic,
+			"backups": testAccDataSourceBackups_basic,
 		},
 	}
 
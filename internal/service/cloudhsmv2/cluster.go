@@ -5,13 +5,18 @@ package cloudhsmv2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
+	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	cloudhsmv2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -43,6 +48,26 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"backup_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudhsmv2.BackupRetentionType_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(?:[7-9]|[1-9]\d|[12]\d{2}|3[0-6]\d|37[0-9])$`), "must be between 7 and 379"),
+						},
+					},
+				},
+			},
 			"cluster_certificates": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -82,8 +107,14 @@ func ResourceCluster() *schema.Resource {
 			"hsm_type": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ValidateFunc: validation.StringInSlice(hsmType_Values(), false),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"hsm1.medium"}, false),
+				ValidateFunc: validation.StringInSlice(cloudhsmv2.ClusterMode_Values(), false),
 			},
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -108,7 +139,27 @@ func ResourceCluster() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			checkClusterMode,
+			checkClusterHSMTypeChange,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+const (
+	hsmTypeHSM1Medium  = "hsm1.medium"
+	hsmTypeHSM2MMedium = "hsm2m.medium"
+)
+
+const (
+	clusterStateModifyInProgress = "MODIFY_IN_PROGRESS"
+)
+
+func hsmType_Values() []string {
+	return []string{
+		hsmTypeHSM1Medium,
+		hsmTypeHSM2MMedium,
 	}
 }
 
@@ -122,6 +173,14 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		TagList:   getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BackupRetentionPolicy = expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("mode"); ok {
+		input.Mode = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("source_backup_identifier"); ok {
 		input.SourceBackupId = aws.String(v.(string))
 	}
@@ -162,12 +221,16 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading CloudHSMv2 Cluster (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("backup_retention_policy", flattenBackupRetentionPolicy(cluster.BackupRetentionPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting backup_retention_policy: %s", err)
+	}
 	if err := d.Set("cluster_certificates", flattenCertificates(cluster)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cluster_certificates: %s", err)
 	}
 	d.Set("cluster_id", cluster.ClusterId)
 	d.Set("cluster_state", cluster.State)
 	d.Set("hsm_type", cluster.HsmType)
+	d.Set("mode", cluster.Mode)
 	d.Set("security_group_id", cluster.SecurityGroup)
 	d.Set("source_backup_identifier", cluster.SourceBackupId)
 	var subnetIDs []string
@@ -184,8 +247,39 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Conn(ctx)
+
+	if d.HasChange("backup_retention_policy") {
+		if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &cloudhsmv2.ModifyClusterInput{
+				BackupRetentionPolicy: expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{})),
+				ClusterId:             aws.String(d.Id()),
+			}
+
+			_, err := conn.ModifyClusterWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("hsm_type") {
+		input := &cloudhsmv2_sdkv2.ModifyClusterInput{
+			ClusterId: aws_sdkv2.String(d.Id()),
+			HsmType:   aws_sdkv2.String(d.Get("hsm_type").(string)),
+		}
+
+		_, err := meta.(*conns.AWSClient).CloudHSMV2Client(ctx).ModifyCluster(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudHSMv2 Cluster (%s) HSM type: %s", d.Id(), err)
+		}
 
-	// Tags only.
+		if _, err := waitClusterHSMTypeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudHSMv2 Cluster (%s) HSM type update: %s", d.Id(), err)
+		}
+	}
 
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
@@ -214,6 +308,61 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+// checkClusterMode validates the cluster mode against the HSM type at plan time.
+// Only hsm2m.medium clusters can be created in non-FIPS mode.
+func checkClusterMode(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if mode, hsmType := d.Get("mode").(string), d.Get("hsm_type").(string); mode == cloudhsmv2.ClusterModeNonFips && hsmType != hsmTypeHSM2MMedium {
+		return fmt.Errorf(`"mode" %q requires "hsm_type" %q, got %q`, mode, hsmTypeHSM2MMedium, hsmType)
+	}
+
+	return nil
+}
+
+// checkClusterHSMTypeChange validates an in-place HSM type change at plan time.
+// Clusters can only be migrated from hsm1.medium to hsm2m.medium.
+func checkClusterHSMTypeChange(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("hsm_type") {
+		return nil
+	}
+
+	if o, n := d.GetChange("hsm_type"); o.(string) != hsmTypeHSM1Medium || n.(string) != hsmTypeHSM2MMedium {
+		return fmt.Errorf(`"hsm_type" can only be changed from %q to %q, got %q to %q`, hsmTypeHSM1Medium, hsmTypeHSM2MMedium, o, n)
+	}
+
+	return nil
+}
+
+func expandBackupRetentionPolicy(tfMap map[string]interface{}) *cloudhsmv2.BackupRetentionPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudhsmv2.BackupRetentionPolicy{}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBackupRetentionPolicy(apiObject *cloudhsmv2.BackupRetentionPolicy) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"type":  aws.StringValue(apiObject.Type),
+		"value": aws.StringValue(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenCertificates(apiObject *cloudhsmv2.Cluster) []map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
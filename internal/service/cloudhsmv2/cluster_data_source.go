@@ -57,6 +57,10 @@ func DataSourceCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"security_group_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -100,6 +104,7 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting cluster_certificates: %s", err)
 	}
 	d.Set("cluster_state", cluster.State)
+	d.Set("mode", cluster.Mode)
 	d.Set("security_group_id", cluster.SecurityGroup)
 	var subnetIDs []string
 	for _, v := range cluster.SubnetMapping {
@@ -30,6 +30,7 @@ func testAccDataSourceCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "cluster_state", "UNINITIALIZED"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_id", resourceName, "cluster_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_state", resourceName, "cluster_state"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mode", resourceName, "mode"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "security_group_id", resourceName, "security_group_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "subnet_ids.#", resourceName, "subnet_ids.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", resourceName, "vpc_id"),
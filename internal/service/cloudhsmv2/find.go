@@ -110,3 +110,27 @@ func FindHSMByTwoPartKey(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, hsmID
 
 	return nil, &retry.NotFoundError{}
 }
+
+func findBackups(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, input *cloudhsmv2.DescribeBackupsInput) ([]*cloudhsmv2.Backup, error) {
+	var output []*cloudhsmv2.Backup
+
+	err := conn.DescribeBackupsPagesWithContext(ctx, input, func(page *cloudhsmv2.DescribeBackupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Backups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -56,6 +56,27 @@ func waitClusterDeleted(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id str
 	return nil, err
 }
 
+func waitClusterHSMTypeUpdated(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{clusterStateModifyInProgress},
+		Target:     []string{cloudhsmv2.ClusterStateActive},
+		Refresh:    statusCluster(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitClusterUninitialized(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Cluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{cloudhsmv2.ClusterStateCreateInProgress, cloudhsmv2.ClusterStateInitializeInProgress},
@@ -41,6 +41,58 @@ func ResourceDomain() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"analysis_scheme": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analysis_options": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"algorithmic_stemming": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(cloudsearch.AlgorithmicStemming_Values(), false),
+									},
+									"japanese_tokenization_dictionary": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
+									},
+									"stemming_dictionary": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
+									},
+									"stopwords": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
+									},
+									"synonyms": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
+									},
+								},
+							},
+						},
+						"language": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudsearch.AnalysisSchemeLanguage_Values(), false),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[a-z][0-9a-z_]{0,63}$`), "must begin with a lowercase letter and be no more than 64 lowercase letters, digits or underscores"),
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -236,28 +288,24 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	if v, ok := d.GetOk("index_field"); ok && v.(*schema.Set).Len() > 0 {
-		err := defineIndexFields(ctx, conn, d.Id(), v.(*schema.Set).List())
-
-		if err != nil {
+	// Analysis schemes must be defined before the index fields that reference them.
+	if v, ok := d.GetOk("analysis_scheme"); ok && v.(*schema.Set).Len() > 0 {
+		if err := defineAnalysisSchemes(ctx, conn, d.Id(), v.(*schema.Set).List()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating CloudSearch Domain (%s): %s", name, err)
 		}
+	}
 
-		log.Printf("[DEBUG] Indexing CloudSearch Domain documents: %s", d.Id())
-		_, err = conn.IndexDocumentsWithContext(ctx, &cloudsearch.IndexDocumentsInput{
-			DomainName: aws.String(d.Id()),
-		})
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "indexing CloudSearch Domain (%s) documents: %s", d.Id(), err)
+	if v, ok := d.GetOk("index_field"); ok && v.(*schema.Set).Len() > 0 {
+		if err := defineIndexFields(ctx, conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating CloudSearch Domain (%s): %s", name, err)
 		}
 	}
 
-	// TODO: Status.RequiresIndexDocuments = true?
-
-	_, err = waitDomainActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err := indexDocumentsIfRequired(ctx, conn, d.Id(), false); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudSearch Domain (%s): %s", name, err)
+	}
 
-	if err != nil {
+	if err := waitDomainOptionsActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for CloudSearch Domain (%s) create: %s", d.Id(), err)
 	}
 
@@ -280,6 +328,16 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s): %s", d.Id(), err)
 	}
 
+	analysisSchemes, err := findAnalysisSchemeStatusesByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) analysis schemes: %s", d.Id(), err)
+	}
+
+	if err := d.Set("analysis_scheme", flattenAnalysisSchemeStatuses(analysisSchemes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting analysis_scheme: %s", err)
+	}
+
 	d.Set("arn", domainStatus.ARN)
 	d.Set("domain_id", domainStatus.DomainId)
 	d.Set("name", domainStatus.DomainName)
@@ -409,6 +467,45 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	// New and modified analysis schemes are defined before index fields so that they can be referenced.
+	// Removed analysis schemes are deleted after index fields so that they are no longer referenced.
+	var removedAnalysisSchemeNames []string
+
+	if d.HasChange("analysis_scheme") {
+		o, n := d.GetChange("analysis_scheme")
+		old := o.(*schema.Set)
+		new := n.(*schema.Set)
+
+		if v := new.Difference(old); v.Len() > 0 {
+			if err := defineAnalysisSchemes(ctx, conn, d.Id(), v.List()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating CloudSearch Domain (%s): %s", d.Id(), err)
+			}
+
+			requiresIndexDocuments = true
+		}
+
+		newNames := make(map[string]struct{})
+		for _, tfMapRaw := range new.List() {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				newNames[tfMap["name"].(string)] = struct{}{}
+			}
+		}
+
+		for _, tfMapRaw := range old.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if name, _ := tfMap["name"].(string); name != "" {
+				if _, ok := newNames[name]; !ok {
+					removedAnalysisSchemeNames = append(removedAnalysisSchemeNames, name)
+				}
+			}
+		}
+	}
+
 	if d.HasChange("index_field") {
 		o, n := d.GetChange("index_field")
 		old := o.(*schema.Set)
@@ -451,20 +548,28 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	if requiresIndexDocuments {
-		log.Printf("[DEBUG] Indexing CloudSearch Domain documents: %s", d.Id())
-		_, err := conn.IndexDocumentsWithContext(ctx, &cloudsearch.IndexDocumentsInput{
-			DomainName: aws.String(d.Id()),
-		})
+	for _, name := range removedAnalysisSchemeNames {
+		input := &cloudsearch.DeleteAnalysisSchemeInput{
+			AnalysisSchemeName: aws.String(name),
+			DomainName:         aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Deleting CloudSearch Domain analysis scheme: %s", input)
+		_, err := conn.DeleteAnalysisSchemeWithContext(ctx, input)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "indexing CloudSearch Domain (%s) documents: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "deleting CloudSearch Domain (%s) analysis scheme (%s): %s", d.Id(), name, err)
 		}
+
+		requiresIndexDocuments = true
 	}
 
-	_, err := waitDomainActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	// All configuration changes are applied in a single indexing cycle.
+	if err := indexDocumentsIfRequired(ctx, conn, d.Id(), requiresIndexDocuments); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating CloudSearch Domain (%s): %s", d.Id(), err)
+	}
 
-	if err != nil {
+	if err := waitDomainOptionsActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for CloudSearch Domain (%s) update: %s", d.Id(), err)
 	}
 
@@ -553,6 +658,65 @@ func defineIndexFields(ctx context.Context, conn *cloudsearch.CloudSearch, domai
 	return nil
 }
 
+func defineAnalysisSchemes(ctx context.Context, conn *cloudsearch.CloudSearch, domainName string, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandAnalysisScheme(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		input := &cloudsearch.DefineAnalysisSchemeInput{
+			AnalysisScheme: apiObject,
+			DomainName:     aws.String(domainName),
+		}
+
+		log.Printf("[DEBUG] Defining CloudSearch Domain analysis scheme: %s", input)
+		_, err := conn.DefineAnalysisSchemeWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("defining CloudSearch Domain (%s) analysis scheme (%s): %w", domainName, aws.StringValue(apiObject.AnalysisSchemeName), err)
+		}
+	}
+
+	return nil
+}
+
+// indexDocumentsIfRequired starts a single indexing cycle if one is required, either because
+// the caller knows of a configuration change requiring it or because the domain reports it.
+func indexDocumentsIfRequired(ctx context.Context, conn *cloudsearch.CloudSearch, domainName string, required bool) error {
+	if !required {
+		domainStatus, err := FindDomainStatusByName(ctx, conn, domainName)
+
+		if err != nil {
+			return fmt.Errorf("reading CloudSearch Domain (%s): %w", domainName, err)
+		}
+
+		required = aws.BoolValue(domainStatus.RequiresIndexDocuments)
+	}
+
+	if !required {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Indexing CloudSearch Domain documents: %s", domainName)
+	_, err := conn.IndexDocumentsWithContext(ctx, &cloudsearch.IndexDocumentsInput{
+		DomainName: aws.String(domainName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("indexing CloudSearch Domain (%s) documents: %w", domainName, err)
+	}
+
+	return nil
+}
+
 func FindDomainStatusByName(ctx context.Context, conn *cloudsearch.CloudSearch, name string) (*cloudsearch.DomainStatus, error) {
 	input := &cloudsearch.DescribeDomainsInput{
 		DomainNames: aws.StringSlice([]string{name}),
@@ -678,6 +842,31 @@ func findScalingParametersStatusByName(ctx context.Context, conn *cloudsearch.Cl
 	return output.ScalingParameters, nil
 }
 
+func findAnalysisSchemeStatusesByName(ctx context.Context, conn *cloudsearch.CloudSearch, name string) ([]*cloudsearch.AnalysisSchemeStatus, error) {
+	input := &cloudsearch.DescribeAnalysisSchemesInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.DescribeAnalysisSchemesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudsearch.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnalysisSchemes, nil
+}
+
 func statusDomainDeleting(ctx context.Context, conn *cloudsearch.CloudSearch, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindDomainStatusByName(ctx, conn, name)
@@ -727,6 +916,100 @@ func waitDomainActive(ctx context.Context, conn *cloudsearch.CloudSearch, name s
 	return nil, err
 }
 
+func statusAvailabilityOptionsState(ctx context.Context, conn *cloudsearch.CloudSearch, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAvailabilityOptionsStatusByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.State), nil
+	}
+}
+
+func statusScalingParametersState(ctx context.Context, conn *cloudsearch.CloudSearch, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findScalingParametersStatusByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.State), nil
+	}
+}
+
+func waitAvailabilityOptionsActive(ctx context.Context, conn *cloudsearch.CloudSearch, name string, timeout time.Duration) (*cloudsearch.AvailabilityOptionsStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{cloudsearch.OptionStateProcessing, cloudsearch.OptionStateRequiresIndexDocuments},
+		Target:  []string{cloudsearch.OptionStateActive},
+		Refresh: statusAvailabilityOptionsState(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudsearch.AvailabilityOptionsStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitScalingParametersActive(ctx context.Context, conn *cloudsearch.CloudSearch, name string, timeout time.Duration) (*cloudsearch.ScalingParametersStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{cloudsearch.OptionStateProcessing, cloudsearch.OptionStateRequiresIndexDocuments},
+		Target:  []string{cloudsearch.OptionStateActive},
+		Refresh: statusScalingParametersState(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudsearch.ScalingParametersStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitDomainOptionsActive waits for the domain to finish processing and for its
+// availability options and scaling parameters to become active.
+func waitDomainOptionsActive(ctx context.Context, conn *cloudsearch.CloudSearch, name string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	if _, err := waitDomainActive(ctx, conn, name, timeout); err != nil {
+		return err
+	}
+
+	if _, err := waitAvailabilityOptionsActive(ctx, conn, name, time.Until(deadline)); err != nil {
+		return fmt.Errorf("availability options: %w", err)
+	}
+
+	if _, err := waitScalingParametersActive(ctx, conn, name, time.Until(deadline)); err != nil {
+		return fmt.Errorf("scaling parameters: %w", err)
+	}
+
+	return nil
+}
+
 func waitDomainDeleted(ctx context.Context, conn *cloudsearch.CloudSearch, name string, timeout time.Duration) (*cloudsearch.DomainStatus, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{"true"},
@@ -744,6 +1027,136 @@ func waitDomainDeleted(ctx context.Context, conn *cloudsearch.CloudSearch, name
 	return nil, err
 }
 
+func expandAnalysisScheme(tfMap map[string]interface{}) *cloudsearch.AnalysisScheme {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudsearch.AnalysisScheme{}
+
+	if v, ok := tfMap["analysis_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AnalysisOptions = expandAnalysisOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["language"].(string); ok && v != "" {
+		apiObject.AnalysisSchemeLanguage = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.AnalysisSchemeName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAnalysisOptions(tfMap map[string]interface{}) *cloudsearch.AnalysisOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudsearch.AnalysisOptions{}
+
+	if v, ok := tfMap["algorithmic_stemming"].(string); ok && v != "" {
+		apiObject.AlgorithmicStemming = aws.String(v)
+	}
+
+	if v, ok := tfMap["japanese_tokenization_dictionary"].(string); ok && v != "" {
+		apiObject.JapaneseTokenizationDictionary = aws.String(v)
+	}
+
+	if v, ok := tfMap["stemming_dictionary"].(string); ok && v != "" {
+		apiObject.StemmingDictionary = aws.String(v)
+	}
+
+	if v, ok := tfMap["stopwords"].(string); ok && v != "" {
+		apiObject.Stopwords = aws.String(v)
+	}
+
+	if v, ok := tfMap["synonyms"].(string); ok && v != "" {
+		apiObject.Synonyms = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAnalysisScheme(apiObject *cloudsearch.AnalysisScheme) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := flattenAnalysisOptions(apiObject.AnalysisOptions); v != nil {
+		tfMap["analysis_options"] = []interface{}{v}
+	}
+
+	if v := apiObject.AnalysisSchemeLanguage; v != nil {
+		tfMap["language"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AnalysisSchemeName; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAnalysisOptions(apiObject *cloudsearch.AnalysisOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AlgorithmicStemming; v != nil {
+		tfMap["algorithmic_stemming"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.JapaneseTokenizationDictionary; v != nil {
+		tfMap["japanese_tokenization_dictionary"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StemmingDictionary; v != nil {
+		tfMap["stemming_dictionary"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Stopwords; v != nil {
+		tfMap["stopwords"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Synonyms; v != nil {
+		tfMap["synonyms"] = aws.StringValue(v)
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return tfMap
+}
+
+func flattenAnalysisSchemeStatuses(apiObjects []*cloudsearch.AnalysisSchemeStatus) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.Options == nil {
+			continue
+		}
+
+		if apiObject.Status != nil && aws.BoolValue(apiObject.Status.PendingDeletion) {
+			continue
+		}
+
+		tfList = append(tfList, flattenAnalysisScheme(apiObject.Options))
+	}
+
+	return tfList
+}
+
 func expandDomainEndpointOptions(tfMap map[string]interface{}) *cloudsearch.DomainEndpointOptions {
 	if tfMap == nil {
 		return nil
@@ -221,6 +221,64 @@ func TestAccCloudSearchDomain_sourceFields(t *testing.T) {
 	})
 }
 
+func TestAccCloudSearchDomain_analysisSchemes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudsearch.DomainStatus
+	resourceName := "aws_cloudsearch_domain.test"
+	rName := testAccDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudsearch.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudsearch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_analysisSchemes(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDomainExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "analysis_scheme.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "analysis_scheme.*", map[string]string{
+						"name":               "custom_en",
+						"language":           "en",
+						"analysis_options.#": "1",
+						"analysis_options.0.algorithmic_stemming": "light",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "index_field.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "index_field.*", map[string]string{
+						"name":            "text_test",
+						"type":            "text",
+						"analysis_scheme": "custom_en",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfig_analysisSchemesUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDomainExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "analysis_scheme.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "analysis_scheme.*", map[string]string{
+						"name":     "custom_fr",
+						"language": "fr",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "index_field.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "index_field.*", map[string]string{
+						"name":            "text_test",
+						"type":            "text",
+						"analysis_scheme": "custom_fr",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.0.desired_replication_count", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudSearchDomain_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v cloudsearch.DomainStatus
@@ -523,3 +581,50 @@ resource "aws_cloudsearch_domain" "test" {
 }
 `, rName)
 }
+
+func testAccDomainConfig_analysisSchemes(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+
+  analysis_scheme {
+    name     = "custom_en"
+    language = "en"
+
+    analysis_options {
+      algorithmic_stemming = "light"
+      stopwords            = jsonencode(["a", "an", "the"])
+    }
+  }
+
+  index_field {
+    name            = "text_test"
+    type            = "text"
+    analysis_scheme = "custom_en"
+  }
+}
+`, rName)
+}
+
+func testAccDomainConfig_analysisSchemesUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+
+  analysis_scheme {
+    name     = "custom_fr"
+    language = "fr"
+  }
+
+  index_field {
+    name            = "text_test"
+    type            = "text"
+    analysis_scheme = "custom_fr"
+  }
+
+  scaling_parameters {
+    desired_replication_count = 2
+  }
+}
+`, rName)
+}
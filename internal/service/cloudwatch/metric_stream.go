@@ -5,6 +5,7 @@ package cloudwatch
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -46,7 +48,10 @@ func ResourceMetricStream() *schema.Resource {
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceMetricStreamCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -133,7 +138,7 @@ func ResourceMetricStream() *schema.Resource {
 			"output_format": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 255),
+				ValidateFunc: validation.StringInSlice(cloudwatch.MetricStreamOutputFormat_Values(), false),
 			},
 			"role_arn": {
 				Type:         schema.TypeString,
@@ -372,6 +377,32 @@ func resourceMetricStreamDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+func resourceMetricStreamCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("output_format") || !d.NewValueKnown("statistics_configuration") {
+		return nil
+	}
+
+	// OpenTelemetry output formats support only percentile additional statistics.
+	if d.Get("output_format").(string) == cloudwatch.MetricStreamOutputFormatJson {
+		return nil
+	}
+
+	for _, tfMapRaw := range d.Get("statistics_configuration").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, v := range flex.ExpandStringValueSet(tfMap["additional_statistics"].(*schema.Set)) {
+			if !metricStreamPercentileStatisticRegexp.MatchString(v) {
+				return fmt.Errorf("additional statistic %q is not supported with output format %q; only percentile statistics are supported", v, d.Get("output_format").(string))
+			}
+		}
+	}
+
+	return nil
+}
+
 func FindMetricStreamByName(ctx context.Context, conn *cloudwatch.CloudWatch, name string) (*cloudwatch.GetMetricStreamOutput, error) {
 	input := &cloudwatch.GetMetricStreamInput{
 		Name: aws.String(name),
@@ -452,6 +483,8 @@ func waitMetricStreamRunning(ctx context.Context, conn *cloudwatch.CloudWatch, n
 	return nil, err
 }
 
+var metricStreamPercentileStatisticRegexp = regexache.MustCompile(`^p(\d{1,2})(\.\d{0,10})?$`)
+
 func validateMetricStreamName(v interface{}, k string) (ws []string, errors []error) {
 	return validation.All(
 		validation.StringLenBetween(1, 255),
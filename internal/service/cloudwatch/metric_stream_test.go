@@ -456,6 +456,38 @@ func TestAccCloudWatchMetricStream_additional_statistics(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricStream_additionalStatisticsOpenTelemetry(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, "opentelemetry1.0", "IQM"),
+				ExpectError: regexache.MustCompile(`additional statistic "IQM" is not supported with output format "opentelemetry1.0"`),
+			},
+			{
+				Config: testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, "opentelemetry1.0", "p99.9"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "output_format", "opentelemetry1.0"),
+					resource.TestCheckResourceAttr(resourceName, "statistics_configuration.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchMetricStream_includeLinkedAccountsMetrics(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cloudwatch_metric_stream.test"
@@ -867,6 +899,30 @@ resource "aws_cloudwatch_metric_stream" "test" {
 `, rName, stat)
 }
 
+func testAccMetricStreamConfig_additionalStatisticsOutputFormat(rName, outputFormat, stat string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/MyRole"
+  firehose_arn  = "arn:${data.aws_partition.current.partition}:firehose:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:deliverystream/MyFirehose"
+  output_format = %[2]q
+
+  statistics_configuration {
+    additional_statistics = [%[3]q]
+
+    include_metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+    }
+  }
+}
+`, rName, outputFormat, stat)
+}
+
 func testAccMetricStreamConfig_includeLinkedAccountsMetrics(rName string, include bool) string {
 	return acctest.ConfigCompose(testAccMetricStreamConfig_base(rName), fmt.Sprintf(`
 resource "aws_cloudwatch_metric_stream" "test" {
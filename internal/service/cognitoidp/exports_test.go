@@ -7,4 +7,6 @@ package cognitoidp
 var (
 	ResourceUserPoolClient        = newResourceUserPoolClient
 	ResourceManagedUserPoolClient = newResourceManagedUserPoolClient
+
+	FindUserPoolByID = findUserPoolByID
 )
@@ -6,8 +6,8 @@ package cognitoidp
 import (
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
 )
 
 func TestUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
@@ -15,18 +15,18 @@ func TestUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 
 	cases := []struct {
 		Name     string
-		Input    *cognitoidentityprovider.SchemaAttributeType
+		Input    *awstypes.SchemaAttributeType
 		Expected bool
 	}{
 		{
 			Name: "birthday standard",
-			Input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			Input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeString,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(true),
 				Name:                   aws.String("birthdate"),
 				Required:               aws.Bool(false),
-				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 					MaxLength: aws.String("10"),
 					MinLength: aws.String("10"),
 				},
@@ -35,13 +35,13 @@ func TestUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 		},
 		{
 			Name: "birthday non-standard DeveloperOnlyAttribute",
-			Input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			Input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeString,
 				DeveloperOnlyAttribute: aws.Bool(true),
 				Mutable:                aws.Bool(true),
 				Name:                   aws.String("birthdate"),
 				Required:               aws.Bool(false),
-				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 					MaxLength: aws.String("10"),
 					MinLength: aws.String("10"),
 				},
@@ -50,13 +50,13 @@ func TestUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 		},
 		{
 			Name: "birthday non-standard Mutable",
-			Input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			Input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeString,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(false),
 				Name:                   aws.String("birthdate"),
 				Required:               aws.Bool(false),
-				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 					MaxLength: aws.String("10"),
 					MinLength: aws.String("10"),
 				},
@@ -65,13 +65,13 @@ func TestUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 		},
 		{
 			Name: "non-standard Name",
-			Input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			Input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeString,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(true),
 				Name:                   aws.String("non-existent"),
 				Required:               aws.Bool(false),
-				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 					MaxLength: aws.String("10"),
 					MinLength: aws.String("10"),
 				},
@@ -80,13 +80,13 @@ func TestUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 		},
 		{
 			Name: "birthday non-standard Required",
-			Input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			Input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeString,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(true),
 				Name:                   aws.String("birthdate"),
 				Required:               aws.Bool(true),
-				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 					MaxLength: aws.String("10"),
 					MinLength: aws.String("10"),
 				},
@@ -95,13 +95,13 @@ func TestUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 		},
 		{
 			Name: "birthday non-standard StringAttributeConstraints.Max",
-			Input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			Input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeString,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(true),
 				Name:                   aws.String("birthdate"),
 				Required:               aws.Bool(false),
-				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 					MaxLength: aws.String("999"),
 					MinLength: aws.String("10"),
 				},
@@ -110,13 +110,13 @@ func TestUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 		},
 		{
 			Name: "birthday non-standard StringAttributeConstraints.Min",
-			Input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			Input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeString,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(true),
 				Name:                   aws.String("birthdate"),
 				Required:               aws.Bool(false),
-				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 					MaxLength: aws.String("10"),
 					MinLength: aws.String("999"),
 				},
@@ -125,8 +125,8 @@ func TestUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 		},
 		{
 			Name: "email_verified standard",
-			Input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+			Input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeBoolean,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(true),
 				Name:                   aws.String("email_verified"),
@@ -136,12 +136,12 @@ func TestUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 		},
 		{
 			Name: "updated_at standard",
-			Input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeNumber),
+			Input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeNumber,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(true),
 				Name:                   aws.String("updated_at"),
-				NumberAttributeConstraints: &cognitoidentityprovider.NumberAttributeConstraintsType{
+				NumberAttributeConstraints: &awstypes.NumberAttributeConstraintsType{
 					MinValue: aws.String("0"),
 				},
 				Required: aws.Bool(false),
@@ -167,28 +167,28 @@ func TestSkipFlatteningStringAttributeContraints(t *testing.T) {
 
 	cases := []struct {
 		name       string
-		configured []*cognitoidentityprovider.SchemaAttributeType
-		input      *cognitoidentityprovider.SchemaAttributeType
+		configured []awstypes.SchemaAttributeType
+		input      *awstypes.SchemaAttributeType
 		want       bool
 	}{
 		{
 			name: "config omitted",
-			configured: []*cognitoidentityprovider.SchemaAttributeType{
+			configured: []awstypes.SchemaAttributeType{
 				{
-					AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+					AttributeDataType:      awstypes.AttributeDataTypeString,
 					DeveloperOnlyAttribute: aws.Bool(false),
 					Mutable:                aws.Bool(false),
 					Name:                   aws.String("email"),
 					Required:               aws.Bool(true),
 				},
 			},
-			input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeString,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(false),
 				Name:                   aws.String("email"),
 				Required:               aws.Bool(true),
-				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 					MaxLength: aws.String("2048"),
 					MinLength: aws.String("0"),
 				},
@@ -197,26 +197,26 @@ func TestSkipFlatteningStringAttributeContraints(t *testing.T) {
 		},
 		{
 			name: "config set",
-			configured: []*cognitoidentityprovider.SchemaAttributeType{
+			configured: []awstypes.SchemaAttributeType{
 				{
-					AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+					AttributeDataType:      awstypes.AttributeDataTypeString,
 					DeveloperOnlyAttribute: aws.Bool(false),
 					Mutable:                aws.Bool(false),
 					Name:                   aws.String("email"),
 					Required:               aws.Bool(true),
-					StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+					StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 						MaxLength: aws.String("2048"),
 						MinLength: aws.String("0"),
 					},
 				},
 			},
-			input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeString,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(false),
 				Name:                   aws.String("email"),
 				Required:               aws.Bool(true),
-				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 					MaxLength: aws.String("2048"),
 					MinLength: aws.String("0"),
 				},
@@ -225,26 +225,26 @@ func TestSkipFlatteningStringAttributeContraints(t *testing.T) {
 		},
 		{
 			name: "config set with diff",
-			configured: []*cognitoidentityprovider.SchemaAttributeType{
+			configured: []awstypes.SchemaAttributeType{
 				{
-					AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+					AttributeDataType:      awstypes.AttributeDataTypeString,
 					DeveloperOnlyAttribute: aws.Bool(false),
 					Mutable:                aws.Bool(false),
 					Name:                   aws.String("email"),
 					Required:               aws.Bool(true),
-					StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+					StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 						MaxLength: aws.String("1024"),
 						MinLength: aws.String("5"),
 					},
 				},
 			},
-			input: &cognitoidentityprovider.SchemaAttributeType{
-				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			input: &awstypes.SchemaAttributeType{
+				AttributeDataType:      awstypes.AttributeDataTypeString,
 				DeveloperOnlyAttribute: aws.Bool(false),
 				Mutable:                aws.Bool(false),
 				Name:                   aws.String("email"),
 				Required:               aws.Bool(true),
-				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
 					MaxLength: aws.String("2048"),
 					MinLength: aws.String("0"),
 				},
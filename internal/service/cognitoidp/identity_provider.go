@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -36,11 +38,14 @@ func ResourceIdentityProvider() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceIdentityProviderCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"attribute_mapping": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressServerDefaultMapKeys("attribute_mapping", identityProviderDefaultAttributeMappings),
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -60,9 +65,10 @@ func ResourceIdentityProvider() *schema.Resource {
 			},
 
 			"provider_details": {
-				Type:     schema.TypeMap,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:             schema.TypeMap,
+				Required:         true,
+				DiffSuppressFunc: suppressServerDefaultMapKeys("provider_details", identityProviderDefaultProviderDetails),
+				Elem:             &schema.Schema{Type: schema.TypeString},
 			},
 
 			"provider_name": {
@@ -241,6 +247,78 @@ func resourceIdentityProviderDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+// identityProviderDefaultAttributeMappings are attribute mappings that Cognito adds when they are not configured.
+var identityProviderDefaultAttributeMappings = []string{
+	"username",
+}
+
+// identityProviderDefaultProviderDetails are provider details that Cognito populates when they are not configured,
+// from the SAML metadata document or by OIDC discovery.
+var identityProviderDefaultProviderDetails = []string{
+	"ActiveEncryptionCertificate",
+	"SLORedirectBindingURI",
+	"SSORedirectBindingURI",
+	"attributes_url",
+	"attributes_url_add_attributes",
+	"authorize_url",
+	"jwks_uri",
+	"oidc_issuer",
+	"token_request_method",
+	"token_url",
+}
+
+// suppressServerDefaultMapKeys returns a DiffSuppressFunc for a map attribute that ignores the removal of keys
+// whose values Cognito supplies when they are absent from the configuration.
+func suppressServerDefaultMapKeys(attr string, keys []string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if k == attr+".%" {
+			o, n := d.GetChange(attr)
+			om, nm := o.(map[string]interface{}), n.(map[string]interface{})
+
+			for _, key := range keys {
+				if _, ok := nm[key]; !ok {
+					delete(om, key)
+				}
+			}
+
+			return reflect.DeepEqual(om, nm)
+		}
+
+		return new == "" && slices.Contains(keys, strings.TrimPrefix(k, attr+"."))
+	}
+}
+
+// identityProviderSAMLProviderDetails are the SAML provider details that take a fixed set of values.
+var identityProviderSAMLProviderDetails = map[string][]string{
+	"EncryptedResponses":      {"false", "true"},
+	"IDPInit":                 {"false", "true"},
+	"IDPSignout":              {"false", "true"},
+	"RequestSigningAlgorithm": {"rsa-sha256"},
+}
+
+func resourceIdentityProviderCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	providerType := d.Get("provider_type").(string)
+
+	for k, v := range d.Get("provider_details").(map[string]interface{}) {
+		values, ok := identityProviderSAMLProviderDetails[k]
+
+		if !ok {
+			continue
+		}
+
+		if providerType != cognitoidentityprovider.IdentityProviderTypeTypeSaml {
+			return fmt.Errorf("provider_details.%s is only supported for %s identity providers", k, cognitoidentityprovider.IdentityProviderTypeTypeSaml)
+		}
+
+		// Unknown values are checked at apply time by the API.
+		if v, ok := v.(string); ok && v != "" && !slices.Contains(values, v) {
+			return fmt.Errorf("provider_details.%s must be one of %q, got: %q", k, values, v)
+		}
+	}
+
+	return nil
+}
+
 func DecodeIdentityProviderID(id string) (string, string, error) {
 	idParts := strings.Split(id, ":")
 	if len(idParts) != 2 {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -118,6 +119,101 @@ func TestAccCognitoIDPIdentityProvider_idpIdentifiers(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIDPIdentityProvider_serverDefaults(t *testing.T) {
+	ctx := acctest.Context(t)
+	var identityProvider cognitoidentityprovider.IdentityProviderType
+	resourceName := "aws_cognito_identity_provider.test"
+	userPoolName := fmt.Sprintf("tf-acc-cognito-user-pool-%s", sdkacctest.RandString(7))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderConfig_serverDefaults(userPoolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(ctx, resourceName, &identityProvider),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.email", "email"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.username", "sub"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.authorize_scopes", "email"),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_details.authorize_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_details.token_url"),
+				),
+			},
+			{
+				Config:   testAccIdentityProviderConfig_serverDefaults(userPoolName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPIdentityProvider_saml(t *testing.T) {
+	ctx := acctest.Context(t)
+	var identityProvider cognitoidentityprovider.IdentityProviderType
+	resourceName := "aws_cognito_identity_provider.test"
+	userPoolName := fmt.Sprintf("tf-acc-cognito-user-pool-%s", sdkacctest.RandString(7))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderConfig_saml(userPoolName, "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(ctx, resourceName, &identityProvider),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.email", "email"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.EncryptedResponses", "false"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.IDPInit", "true"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.IDPSignout", "true"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.RequestSigningAlgorithm", "rsa-sha256"),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_details.SSORedirectBindingURI"),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", "SAML"),
+				),
+			},
+			{
+				Config: testAccIdentityProviderConfig_saml(userPoolName, "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(ctx, resourceName, &identityProvider),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_details.ActiveEncryptionCertificate"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.EncryptedResponses", "true"),
+				),
+			},
+			{
+				Config:   testAccIdentityProviderConfig_saml(userPoolName, "true"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPIdentityProvider_samlProviderDetailsInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	userPoolName := fmt.Sprintf("tf-acc-cognito-user-pool-%s", sdkacctest.RandString(7))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityProviderConfig_samlProviderDetailsNotSAML(userPoolName),
+				ExpectError: regexache.MustCompile(`provider_details.IDPInit is only supported for SAML identity providers`),
+			},
+			{
+				Config:      testAccIdentityProviderConfig_saml(userPoolName, "yes"),
+				ExpectError: regexache.MustCompile(`provider_details.EncryptedResponses must be one of`),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIDPIdentityProvider_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var identityProvider cognitoidentityprovider.IdentityProviderType
@@ -319,3 +415,77 @@ resource "aws_cognito_identity_provider" "test" {
 }
 `, userPoolName, attribute)
 }
+
+func testAccIdentityProviderConfig_serverDefaults(userPoolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name                     = %[1]q
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "test" {
+  user_pool_id  = aws_cognito_user_pool.test.id
+  provider_name = "Google"
+  provider_type = "Google"
+
+  provider_details = {
+    authorize_scopes = "email"
+    client_id        = "test-url.apps.googleusercontent.com"
+    client_secret    = "client_secret"
+  }
+
+  attribute_mapping = {
+    email = "email"
+  }
+}
+`, userPoolName)
+}
+
+func testAccIdentityProviderConfig_saml(userPoolName, encryptedResponses string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name                     = %[1]q
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "test" {
+  user_pool_id  = aws_cognito_user_pool.test.id
+  provider_name = "SAML"
+  provider_type = "SAML"
+
+  provider_details = {
+    EncryptedResponses      = %[2]q
+    IDPInit                 = "true"
+    IDPSignout              = "true"
+    MetadataFile            = file("./test-fixtures/saml-metadata.xml")
+    RequestSigningAlgorithm = "rsa-sha256"
+  }
+
+  attribute_mapping = {
+    email = "email"
+  }
+}
+`, userPoolName, encryptedResponses)
+}
+
+func testAccIdentityProviderConfig_samlProviderDetailsNotSAML(userPoolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name                     = %[1]q
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "test" {
+  user_pool_id  = aws_cognito_user_pool.test.id
+  provider_name = "Google"
+  provider_type = "Google"
+
+  provider_details = {
+    authorize_scopes = "email"
+    client_id        = "test-url.apps.googleusercontent.com"
+    client_secret    = "client_secret"
+    IDPInit          = "true"
+  }
+}
+`, userPoolName)
+}
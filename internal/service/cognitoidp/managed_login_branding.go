@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_cognito_managed_login_branding", name="Managed Login Branding")
+func ResourceManagedLoginBranding() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceManagedLoginBrandingCreate,
+		ReadWithoutTimeout:   resourceManagedLoginBrandingRead,
+		UpdateWithoutTimeout: resourceManagedLoginBrandingUpdate,
+		DeleteWithoutTimeout: resourceManagedLoginBrandingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asset": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      40,
+				ConflictsWith: []string{"use_cognito_provided_values"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+						"category": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AssetCategoryType](),
+						},
+						"color_mode": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ColorSchemeModeType](),
+						},
+						"extension": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AssetExtensionType](),
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"managed_login_branding_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				ConflictsWith: []string{"use_cognito_provided_values"},
+			},
+			"use_cognito_provided_values": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"asset", "settings"},
+			},
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	managedLoginBrandingResourceIDPartCount = 2
+)
+
+func resourceManagedLoginBrandingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	userPoolID, clientID := d.Get("user_pool_id").(string), d.Get("client_id").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{userPoolID, clientID}, managedLoginBrandingResourceIDPartCount, false))
+	input := &cognitoidentityprovider.CreateManagedLoginBrandingInput{
+		ClientId:                 aws.String(clientID),
+		UseCognitoProvidedValues: d.Get("use_cognito_provided_values").(bool),
+		UserPoolId:               aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("asset"); ok && v.(*schema.Set).Len() > 0 {
+		assets, err := expandAssetTypes(v.(*schema.Set).List())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Assets = assets
+	}
+
+	if v, ok := d.GetOk("settings"); ok {
+		settings, err := expandManagedLoginBrandingSettings(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Settings = settings
+	}
+
+	_, err := conn.CreateManagedLoginBranding(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Cognito Managed Login Branding (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceManagedLoginBrandingRead(ctx, d, meta)...)
+}
+
+func resourceManagedLoginBrandingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), managedLoginBrandingResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	userPoolID, clientID := parts[0], parts[1]
+	mlb, err := FindManagedLoginBrandingByTwoPartKey(ctx, conn, userPoolID, clientID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cognito Managed Login Branding (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cognito Managed Login Branding (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("asset", flattenAssetTypes(mlb.Assets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting asset: %s", err)
+	}
+	d.Set("client_id", clientID)
+	d.Set("creation_date", aws.ToTime(mlb.CreationDate).Format(time.RFC3339))
+	d.Set("last_modified_date", aws.ToTime(mlb.LastModifiedDate).Format(time.RFC3339))
+	d.Set("managed_login_branding_id", mlb.ManagedLoginBrandingId)
+	if mlb.Settings != nil {
+		settings, err := flattenManagedLoginBrandingSettings(mlb.Settings)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("settings", settings)
+	} else {
+		d.Set("settings", nil)
+	}
+	d.Set("use_cognito_provided_values", mlb.UseCognitoProvidedValues)
+	d.Set("user_pool_id", mlb.UserPoolId)
+
+	return diags
+}
+
+func resourceManagedLoginBrandingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), managedLoginBrandingResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	userPoolID := parts[0]
+	input := &cognitoidentityprovider.UpdateManagedLoginBrandingInput{
+		ManagedLoginBrandingId:   aws.String(d.Get("managed_login_branding_id").(string)),
+		UseCognitoProvidedValues: d.Get("use_cognito_provided_values").(bool),
+		UserPoolId:               aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("asset"); ok && v.(*schema.Set).Len() > 0 {
+		assets, err := expandAssetTypes(v.(*schema.Set).List())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Assets = assets
+	}
+
+	if v, ok := d.GetOk("settings"); ok {
+		settings, err := expandManagedLoginBrandingSettings(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Settings = settings
+	}
+
+	_, err = conn.UpdateManagedLoginBranding(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Cognito Managed Login Branding (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceManagedLoginBrandingRead(ctx, d, meta)...)
+}
+
+func resourceManagedLoginBrandingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), managedLoginBrandingResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	userPoolID := parts[0]
+
+	log.Printf("[DEBUG] Deleting Cognito Managed Login Branding: %s", d.Id())
+	_, err = conn.DeleteManagedLoginBranding(ctx, &cognitoidentityprovider.DeleteManagedLoginBrandingInput{
+		ManagedLoginBrandingId: aws.String(d.Get("managed_login_branding_id").(string)),
+		UserPoolId:             aws.String(userPoolID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Cognito Managed Login Branding (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindManagedLoginBrandingByTwoPartKey(ctx context.Context, conn *cognitoidentityprovider.Client, userPoolID, clientID string) (*awstypes.ManagedLoginBrandingType, error) {
+	input := &cognitoidentityprovider.DescribeManagedLoginBrandingByClientInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	output, err := conn.DescribeManagedLoginBrandingByClient(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ManagedLoginBranding == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ManagedLoginBranding, nil
+}
+
+func expandManagedLoginBrandingSettings(s string) (document.Interface, error) {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, fmt.Errorf("decoding settings: %w", err)
+	}
+
+	return document.NewLazyDocument(v), nil
+}
+
+func flattenManagedLoginBrandingSettings(apiObject document.Interface) (string, error) {
+	b, err := apiObject.MarshalSmithyDocument()
+
+	if err != nil {
+		return "", fmt.Errorf("encoding settings: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func expandAssetTypes(tfList []interface{}) ([]awstypes.AssetType, error) {
+	var apiObjects []awstypes.AssetType
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.AssetType{
+			Category:  awstypes.AssetCategoryType(tfMap["category"].(string)),
+			ColorMode: awstypes.ColorSchemeModeType(tfMap["color_mode"].(string)),
+			Extension: awstypes.AssetExtensionType(tfMap["extension"].(string)),
+		}
+
+		if v, ok := tfMap["bytes"].(string); ok && v != "" {
+			b, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return nil, fmt.Errorf("decoding asset (%s) bytes: %w", apiObject.Category, err)
+			}
+
+			apiObject.Bytes = b
+		}
+
+		if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+			apiObject.ResourceId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func flattenAssetTypes(apiObjects []awstypes.AssetType) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"category":   apiObject.Category,
+			"color_mode": apiObject.ColorMode,
+			"extension":  apiObject.Extension,
+		}
+
+		if v := apiObject.Bytes; len(v) > 0 {
+			tfMap["bytes"] = base64.StdEncoding.EncodeToString(v)
+		}
+
+		if v := apiObject.ResourceId; v != nil {
+			tfMap["resource_id"] = aws.ToString(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
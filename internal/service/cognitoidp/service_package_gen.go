@@ -5,6 +5,8 @@ package cognitoidp
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	cognitoidentityprovider_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	cognitoidentityprovider_sdkv1 "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
@@ -57,6 +59,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceIdentityProvider,
 			TypeName: "aws_cognito_identity_provider",
 		},
+		{
+			Factory:  ResourceManagedLoginBranding,
+			TypeName: "aws_cognito_managed_login_branding",
+			Name:     "Managed Login Branding",
+		},
 		{
 			Factory:  ResourceResourceServer,
 			TypeName: "aws_cognito_resource_server",
@@ -105,6 +112,17 @@ func (p *servicePackage) NewConn(ctx context.Context, config map[string]any) (*c
 	return cognitoidentityprovider_sdkv1.New(sess.Copy(&aws_sdkv1.Config{Endpoint: aws_sdkv1.String(config["endpoint"].(string))})), nil
 }
 
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*cognitoidentityprovider_sdkv2.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+
+	return cognitoidentityprovider_sdkv2.NewFromConfig(cfg, func(o *cognitoidentityprovider_sdkv2.Options) {
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.BaseEndpoint = aws_sdkv2.String(endpoint)
+		}
+	}), nil
+}
+
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
@@ -5,16 +5,21 @@ package controltower
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
 	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -23,6 +28,7 @@ import (
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_controltower_control", name="Control")
@@ -30,6 +36,7 @@ func resourceControl() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceControlCreate,
 		ReadWithoutTimeout:   resourceControlRead,
+		UpdateWithoutTimeout: resourceControlUpdate,
 		DeleteWithoutTimeout: resourceControlDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -38,16 +45,43 @@ func resourceControl() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"control_identifier": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
 			"target_identifier": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -71,6 +105,16 @@ func resourceControlCreate(ctx context.Context, d *schema.ResourceData, meta int
 		TargetIdentifier:  aws.String(targetIdentifier),
 	}
 
+	if v, ok := d.GetOk("parameters"); ok && v.(*schema.Set).Len() > 0 {
+		parameters, err := expandEnabledControlParameters(v.(*schema.Set).List())
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Parameters = parameters
+	}
+
 	output, err := conn.EnableControl(ctx, input)
 
 	if err != nil {
@@ -109,12 +153,60 @@ func resourceControlRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading ControlTower Control (%s): %s", d.Id(), err)
 	}
 
+	arn := aws.ToString(output.Arn)
+	enabledControl, err := findEnabledControlByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ControlTower Control (%s): %s", d.Id(), err)
+	}
+
+	parameters, err := flattenEnabledControlParameterSummaries(enabledControl.Parameters)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set(names.AttrARN, arn)
 	d.Set("control_identifier", output.ControlIdentifier)
+	if err := d.Set("parameters", parameters); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
 	d.Set("target_identifier", targetIdentifier)
 
 	return diags
 }
 
+func resourceControlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	if d.HasChange("parameters") {
+		parameters, err := expandEnabledControlParameters(d.Get("parameters").(*schema.Set).List())
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &controltower.UpdateEnabledControlInput{
+			EnabledControlIdentifier: aws.String(d.Get(names.AttrARN).(string)),
+			Parameters:               parameters,
+		}
+
+		output, err := conn.UpdateEnabledControl(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ControlTower Control (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Control (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceControlRead(ctx, d, meta)...)
+}
+
 func resourceControlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -158,6 +250,31 @@ func findEnabledControlByTwoPartKey(ctx context.Context, conn *controltower.Clie
 	})
 }
 
+func findEnabledControlByARN(ctx context.Context, conn *controltower.Client, arn string) (*types.EnabledControlDetails, error) {
+	input := &controltower.GetEnabledControlInput{
+		EnabledControlIdentifier: aws.String(arn),
+	}
+
+	output, err := conn.GetEnabledControl(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EnabledControlDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnabledControlDetails, nil
+}
+
 func findEnabledControl(ctx context.Context, conn *controltower.Client, input *controltower.ListEnabledControlsInput, filter tfslices.Predicate[*types.EnabledControlSummary]) (*types.EnabledControlSummary, error) {
 	output, err := findEnabledControls(ctx, conn, input, filter)
 
@@ -256,3 +373,54 @@ func waitOperationSucceeded(ctx context.Context, conn *controltower.Client, id s
 
 	return nil, err
 }
+
+func expandEnabledControlParameters(tfList []interface{}) ([]types.EnabledControlParameter, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	var apiObjects []types.EnabledControlParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var value interface{}
+		if err := json.Unmarshal([]byte(tfMap["value"].(string)), &value); err != nil {
+			return nil, fmt.Errorf("decoding parameter (%s) value: %w", tfMap["key"].(string), err)
+		}
+
+		apiObjects = append(apiObjects, types.EnabledControlParameter{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: document.NewLazyDocument(value),
+		})
+	}
+
+	return apiObjects, nil
+}
+
+func flattenEnabledControlParameterSummaries(apiObjects []types.EnabledControlParameterSummary) ([]interface{}, error) {
+	if len(apiObjects) == 0 {
+		return nil, nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		b, err := apiObject.Value.MarshalSmithyDocument()
+
+		if err != nil {
+			return nil, fmt.Errorf("encoding parameter (%s) value: %w", aws.ToString(apiObject.Key), err)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   aws.ToString(apiObject.Key),
+			"value": string(b),
+		})
+	}
+
+	return tfList, nil
+}
@@ -28,6 +28,26 @@ func dataSourceControls() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"control_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"target_identifier": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -53,10 +73,35 @@ func dataSourceControlsRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading ControlTower Controls (%s): %s", targetIdentifier, err)
 	}
 
+	var parameters []interface{}
+
+	for _, v := range controls {
+		enabledControl, err := findEnabledControlByARN(ctx, conn, aws.ToString(v.Arn))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ControlTower Control (%s): %s", aws.ToString(v.Arn), err)
+		}
+
+		tfList, err := flattenEnabledControlParameterSummaries(enabledControl.Parameters)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		for _, tfMapRaw := range tfList {
+			tfMap := tfMapRaw.(map[string]interface{})
+			tfMap["control_identifier"] = aws.ToString(v.ControlIdentifier)
+			parameters = append(parameters, tfMap)
+		}
+	}
+
 	d.SetId(targetIdentifier)
 	d.Set("enabled_controls", tfslices.ApplyToAll(controls, func(v *types.EnabledControlSummary) string {
 		return aws.ToString(v.ControlIdentifier)
 	}))
+	if err := d.Set("parameters", parameters); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
 
 	return diags
 }
@@ -33,6 +33,7 @@ func TestAccControlTowerControlsDataSource_basic(t *testing.T) {
 				Config: testAccControlsDataSourceConfig_id(ouName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(dataSourceName, "enabled_controls.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "parameters.#"),
 				),
 			},
 		},
@@ -5,7 +5,9 @@ package controltower
 
 // Exports for use in tests only.
 var (
-	ResourceControl = resourceControl
+	ResourceBaseline = resourceBaseline
+	ResourceControl  = resourceControl
 
+	FindEnabledBaselineByARN       = findEnabledBaselineByARN
 	FindEnabledControlByTwoPartKey = findEnabledControlByTwoPartKey
 )
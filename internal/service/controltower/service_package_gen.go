@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceBaseline,
+			TypeName: "aws_controltower_baseline",
+			Name:     "Baseline",
+		},
 		{
 			Factory:  resourceControl,
 			TypeName: "aws_controltower_control",
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cur
+
+const (
+	billingReportsLegacyAccountID  = "386209384616"
+	billingReportsServicePrincipal = "billingreports.amazonaws.com"
+)
+
+const (
+	errCodeNoSuchBucketPolicy = "NoSuchBucketPolicy"
+)
+
+// reportDefinitionBucketPolicyActions are the actions the billing reports service must be allowed to
+// perform on a report's S3 bucket.
+var reportDefinitionBucketPolicyActions = []string{
+	"s3:GetBucketAcl",
+	"s3:GetBucketPolicy",
+	"s3:PutObject",
+}
@@ -18,6 +18,8 @@ func TestAccCUR_serial(t *testing.T) {
 		"ReportDefinition": {
 			"basic":                 testAccReportDefinition_basic,
 			"disappears":            testAccReportDefinition_disappears,
+			"s3Prefix":              testAccReportDefinition_s3Prefix,
+			"checkS3Bucket":         testAccReportDefinition_checkS3BucketPrerequisites,
 			"textOrCsv":             testAccReportDefinition_textOrCSV,
 			"parquet":               testAccReportDefinition_parquet,
 			"athena":                testAccReportDefinition_athena,
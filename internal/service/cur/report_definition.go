@@ -5,19 +5,26 @@ package cur
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"slices"
 
 	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	cur "github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 )
 
 // @SDKResource("aws_cur_report_definition")
@@ -31,11 +38,63 @@ func ResourceReportDefinition() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeReportDefinitionDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"check_s3_bucket_prerequisites": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"data_export": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query_statement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_output_configurations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"compression": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"format": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"output_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"overwrite": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"table_configurations": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"report_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -75,9 +134,14 @@ func ResourceReportDefinition() *schema.Resource {
 				Required: true,
 			},
 			"s3_prefix": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 256),
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 256),
+					validation.StringDoesNotMatch(regexache.MustCompile(`^/`), "must not begin with a slash"),
+					validation.StringDoesNotMatch(regexache.MustCompile(`//`), "must not contain consecutive slashes"),
+					validation.StringDoesNotMatch(regexache.MustCompile(`\s`), "must not contain whitespace"),
+				),
 			},
 			"s3_region": {
 				Type:         schema.TypeString,
@@ -199,6 +263,9 @@ func resourceReportDefinitionRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("time_unit", reportDefinition.TimeUnit)
 	d.Set("format", reportDefinition.Format)
 	d.Set("compression", reportDefinition.Compression)
+	if err := d.Set("data_export", flattenReportDefinitionDataExport(reportDefinition)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_export: %s", err)
+	}
 	d.Set("additional_schema_elements", aws.StringValueSlice(reportDefinition.AdditionalSchemaElements))
 	d.Set("s3_bucket", reportDefinition.S3Bucket)
 	d.Set("s3_prefix", reportDefinition.S3Prefix)
@@ -283,6 +350,136 @@ func resourceReportDefinitionDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+// customizeReportDefinitionDiff optionally checks that the report's S3 bucket exists in s3_region
+// and has a bucket policy that allows the billing reports service to deliver reports to it.
+func customizeReportDefinitionDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("check_s3_bucket_prerequisites").(bool) {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("check_s3_bucket_prerequisites", "s3_bucket", "s3_region") {
+		return nil
+	}
+
+	// The bucket may be created in the same apply.
+	if !diff.NewValueKnown("s3_bucket") || !diff.NewValueKnown("s3_region") {
+		return nil
+	}
+
+	bucket := diff.Get("s3_bucket").(string)
+	region := diff.Get("s3_region").(string)
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucketRegion, err := manager.GetBucketRegion(ctx, conn, bucket, func(o *s3.Options) {
+		o.UsePathStyle = meta.(*conns.AWSClient).S3UsePathStyle()
+	})
+
+	if err != nil {
+		return fmt.Errorf("checking S3 Bucket (%s) prerequisites: reading location: %w", bucket, err)
+	}
+
+	if bucketRegion != region {
+		return fmt.Errorf("checking S3 Bucket (%s) prerequisites: bucket is in region %s, not s3_region (%s)", bucket, bucketRegion, region)
+	}
+
+	output, err := conn.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws_sdkv2.String(bucket),
+	}, func(o *s3.Options) {
+		o.Region = bucketRegion
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucketPolicy) {
+		return fmt.Errorf("checking S3 Bucket (%s) prerequisites: bucket has no bucket policy", bucket)
+	}
+
+	if err != nil {
+		return fmt.Errorf("checking S3 Bucket (%s) prerequisites: reading bucket policy: %w", bucket, err)
+	}
+
+	if err := checkReportDefinitionBucketPolicy(aws_sdkv2.ToString(output.Policy)); err != nil {
+		return fmt.Errorf("checking S3 Bucket (%s) prerequisites: %w", bucket, err)
+	}
+
+	return nil
+}
+
+// checkReportDefinitionBucketPolicy checks that a bucket policy allows the billing reports
+// service to perform the actions required to deliver reports.
+func checkReportDefinitionBucketPolicy(policy string) error {
+	var doc tfiam.IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return fmt.Errorf("parsing bucket policy: %w", err)
+	}
+
+	var allowed []string
+
+	for _, statement := range doc.Statements {
+		if statement == nil || statement.Effect != "Allow" {
+			continue
+		}
+
+		if !slices.ContainsFunc(statement.Principals, isBillingReportsPrincipal) {
+			continue
+		}
+
+		allowed = append(allowed, policyStringList(statement.Actions)...)
+	}
+
+	for _, action := range reportDefinitionBucketPolicyActions {
+		if !slices.ContainsFunc(allowed, func(v string) bool {
+			return v == "*" || v == "s3:*" || v == action
+		}) {
+			return fmt.Errorf("bucket policy does not allow %s to perform %s", billingReportsServicePrincipal, action)
+		}
+	}
+
+	return nil
+}
+
+// isBillingReportsPrincipal returns whether a policy principal includes the billing reports service,
+// either as the service principal or as the legacy billing reports AWS account.
+func isBillingReportsPrincipal(principal tfiam.IAMPolicyStatementPrincipal) bool {
+	switch principal.Type {
+	case "*":
+		return true
+	case "AWS":
+		return slices.ContainsFunc(policyStringList(principal.Identifiers), func(v string) bool {
+			if v == "*" || v == billingReportsLegacyAccountID {
+				return true
+			}
+
+			principalARN, err := arn.Parse(v)
+
+			return err == nil && principalARN.AccountID == billingReportsLegacyAccountID
+		})
+	case "Service":
+		return slices.Contains(policyStringList(principal.Identifiers), billingReportsServicePrincipal)
+	default:
+		return false
+	}
+}
+
+// policyStringList returns the values of a policy element that may be a string or a list of strings.
+func policyStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
 func CheckReportDefinitionPropertyCombination(additionalArtifacts []string, compression string, format string, prefix string, reportVersioning string) error {
 	// perform various combination checks, AWS API unhelpfully just returns an empty ValidationException
 	// these combinations have been determined from the Create Report AWS Console Web Form
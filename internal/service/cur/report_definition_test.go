@@ -6,9 +6,12 @@ package cur_test
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	cur "github.com/aws/aws-sdk-go/service/costandusagereportservice"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -46,6 +49,12 @@ func testAccReportDefinition_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "s3_prefix", ""),
 					resource.TestCheckResourceAttrPair(resourceName, "s3_region", s3BucketResourceName, "region"),
 					resource.TestCheckResourceAttr(resourceName, "additional_artifacts.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data_export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_export.0.s3_output_configurations.0.compression", "GZIP"),
+					resource.TestCheckResourceAttr(resourceName, "data_export.0.s3_output_configurations.0.format", "TEXT_OR_CSV"),
+					resource.TestCheckResourceAttr(resourceName, "data_export.0.table_configurations.INCLUDE_RESOURCES", "TRUE"),
+					resource.TestCheckResourceAttr(resourceName, "data_export.0.table_configurations.TIME_GRANULARITY", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "data_export.0.table_name", "COST_AND_USAGE_REPORT"),
 				),
 			},
 			{
@@ -73,6 +82,72 @@ func testAccReportDefinition_basic(t *testing.T) {
 	})
 }
 
+func testAccReportDefinition_s3Prefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cur.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReportDefinitionConfig_basic(reportName, bucketName, "/test"),
+				ExpectError: regexache.MustCompile(`must not begin with a slash`),
+			},
+			{
+				Config:      testAccReportDefinitionConfig_basic(reportName, bucketName, "test//prefix"),
+				ExpectError: regexache.MustCompile(`must not contain consecutive slashes`),
+			},
+			{
+				Config:      testAccReportDefinitionConfig_basic(reportName, bucketName, "test prefix"),
+				ExpectError: regexache.MustCompile(`must not contain whitespace`),
+			},
+		},
+	})
+}
+
+func testAccReportDefinition_checkS3BucketPrerequisites(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cur_report_definition.test"
+	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cur.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportDefinitionConfig_checkS3BucketPrerequisitesBucket(bucketName, false),
+			},
+			{
+				Config:      testAccReportDefinitionConfig_checkS3BucketPrerequisites(reportName, bucketName, false),
+				ExpectError: regexache.MustCompile(`bucket has no bucket policy`),
+			},
+			{
+				Config: testAccReportDefinitionConfig_checkS3BucketPrerequisitesBucket(bucketName, true),
+			},
+			{
+				Config: testAccReportDefinitionConfig_checkS3BucketPrerequisites(reportName, bucketName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReportDefinitionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check_s3_bucket_prerequisites", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"check_s3_bucket_prerequisites"},
+			},
+		},
+	})
+}
+
 func testAccReportDefinition_textOrCSV(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cur_report_definition.test"
@@ -426,6 +501,67 @@ resource "aws_cur_report_definition" "test" {
 `, reportName, bucketName, prefix)
 }
 
+func testAccReportDefinitionConfig_checkS3BucketPrerequisitesBucket(bucketName string, withPolicy bool) string {
+	config := fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, bucketName)
+
+	if !withPolicy {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, `
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "billingreports.amazonaws.com"
+      }
+      Action = [
+        "s3:GetBucketAcl",
+        "s3:GetBucketPolicy",
+      ]
+      Resource = aws_s3_bucket.test.arn
+      }, {
+      Effect = "Allow"
+      Principal = {
+        Service = "billingreports.amazonaws.com"
+      }
+      Action   = "s3:PutObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+`)
+}
+
+// The report definition refers to the bucket by name so that its prerequisites can be checked at plan time.
+func testAccReportDefinitionConfig_checkS3BucketPrerequisites(reportName, bucketName string, withPolicy bool) string {
+	return acctest.ConfigCompose(testAccReportDefinitionConfig_checkS3BucketPrerequisitesBucket(bucketName, withPolicy), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_cur_report_definition" "test" {
+  report_name                   = %[1]q
+  time_unit                     = "DAILY"
+  format                        = "textORcsv"
+  compression                   = "GZIP"
+  additional_schema_elements    = ["RESOURCES"]
+  s3_bucket                     = %[2]q
+  s3_region                     = data.aws_region.current.name
+  check_s3_bucket_prerequisites = true
+}
+`, reportName, bucketName))
+}
+
 func testAccReportDefinitionConfig_additional(reportName string, bucketName string, bucketPrefix string, format string, compression string, additionalArtifacts []string, refreshClosedReports bool, reportVersioning string) string {
 	artifactsStr := strings.Join(additionalArtifacts, "\", \"")
 
@@ -713,3 +849,229 @@ func TestCheckDefinitionPropertyCombination(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckReportDefinitionBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy      string
+		shouldError bool
+	}{
+		"service principal": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "billingreports.amazonaws.com"},
+      "Action": ["s3:GetBucketAcl", "s3:GetBucketPolicy"],
+      "Resource": "arn:aws:s3:::example"
+    },
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "billingreports.amazonaws.com"},
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::example/*"
+    }
+  ]
+}`,
+		},
+		"legacy account principal": {
+			policy: `{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::386209384616:root"},
+      "Action": ["s3:GetBucketAcl", "s3:GetBucketPolicy", "s3:PutObject"],
+      "Resource": ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+    }
+  ]
+}`,
+		},
+		"wildcard action": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": ["billingreports.amazonaws.com", "logging.s3.amazonaws.com"]},
+      "Action": "s3:*",
+      "Resource": ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+    }
+  ]
+}`,
+		},
+		"missing action": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "billingreports.amazonaws.com"},
+      "Action": ["s3:GetBucketAcl", "s3:GetBucketPolicy"],
+      "Resource": "arn:aws:s3:::example"
+    }
+  ]
+}`,
+			shouldError: true,
+		},
+		"other principal": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "logging.s3.amazonaws.com"},
+      "Action": ["s3:GetBucketAcl", "s3:GetBucketPolicy", "s3:PutObject"],
+      "Resource": ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+    }
+  ]
+}`,
+			shouldError: true,
+		},
+		"deny": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Principal": {"Service": "billingreports.amazonaws.com"},
+      "Action": ["s3:GetBucketAcl", "s3:GetBucketPolicy", "s3:PutObject"],
+      "Resource": ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+    }
+  ]
+}`,
+			shouldError: true,
+		},
+		"invalid JSON": {
+			policy:      `{`,
+			shouldError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcur.CheckReportDefinitionBucketPolicy(testCase.policy)
+
+			if testCase.shouldError && err == nil {
+				t.Error("expected error, got none")
+			} else if !testCase.shouldError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestFlattenReportDefinitionDataExport(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		reportDefinition        *cur.ReportDefinition
+		expectedCompression     string
+		expectedFormat          string
+		expectedOverwrite       string
+		expectedTable           map[string]interface{}
+		expectedColumnsIncluded []string
+		expectedColumnsExcluded []string
+	}{
+		"text or CSV": {
+			reportDefinition: &cur.ReportDefinition{
+				AdditionalSchemaElements: aws.StringSlice([]string{cur.SchemaElementResources}),
+				Compression:              aws.String(cur.CompressionFormatZip),
+				Format:                   aws.String(cur.ReportFormatTextOrcsv),
+				ReportVersioning:         aws.String(cur.ReportVersioningCreateNewReport),
+				TimeUnit:                 aws.String(cur.TimeUnitHourly),
+			},
+			expectedCompression: "GZIP",
+			expectedFormat:      "TEXT_OR_CSV",
+			expectedOverwrite:   "CREATE_NEW_REPORT",
+			expectedTable: map[string]interface{}{
+				"INCLUDE_MANUAL_DISCOUNT_COMPATIBILITY": "FALSE",
+				"INCLUDE_RESOURCES":                     "TRUE",
+				"INCLUDE_SPLIT_COST_ALLOCATION_DATA":    "FALSE",
+				"TIME_GRANULARITY":                      "HOURLY",
+			},
+			expectedColumnsIncluded: []string{"identity_line_item_id", "line_item_resource_id"},
+			expectedColumnsExcluded: []string{"split_line_item_split_cost"},
+		},
+		"parquet": {
+			reportDefinition: &cur.ReportDefinition{
+				AdditionalSchemaElements: aws.StringSlice([]string{cur.SchemaElementSplitCostAllocationData, cur.SchemaElementManualDiscountCompatibility}),
+				Compression:              aws.String(cur.CompressionFormatParquet),
+				Format:                   aws.String(cur.ReportFormatParquet),
+				ReportVersioning:         aws.String(cur.ReportVersioningOverwriteReport),
+				TimeUnit:                 aws.String(cur.TimeUnitDaily),
+			},
+			expectedCompression: "PARQUET",
+			expectedFormat:      "PARQUET",
+			expectedOverwrite:   "OVERWRITE_REPORT",
+			expectedTable: map[string]interface{}{
+				"INCLUDE_MANUAL_DISCOUNT_COMPATIBILITY": "TRUE",
+				"INCLUDE_RESOURCES":                     "FALSE",
+				"INCLUDE_SPLIT_COST_ALLOCATION_DATA":    "TRUE",
+				"TIME_GRANULARITY":                      "DAILY",
+			},
+			expectedColumnsIncluded: []string{"identity_line_item_id", "split_line_item_split_cost"},
+			expectedColumnsExcluded: []string{"line_item_resource_id"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tfList := tfcur.FlattenReportDefinitionDataExport(testCase.reportDefinition)
+
+			if len(tfList) != 1 {
+				t.Fatalf("expected 1 element, got %d", len(tfList))
+			}
+
+			tfMap := tfList[0].(map[string]interface{})
+
+			if got, want := tfMap["table_name"], "COST_AND_USAGE_REPORT"; got != want {
+				t.Errorf("table_name: got %q, want %q", got, want)
+			}
+
+			if got, want := tfMap["table_configurations"], testCase.expectedTable; !reflect.DeepEqual(got, want) {
+				t.Errorf("table_configurations: got %v, want %v", got, want)
+			}
+
+			s3OutputConfigurations := tfMap["s3_output_configurations"].([]interface{})[0].(map[string]interface{})
+
+			if got, want := s3OutputConfigurations["compression"], testCase.expectedCompression; got != want {
+				t.Errorf("compression: got %q, want %q", got, want)
+			}
+
+			if got, want := s3OutputConfigurations["format"], testCase.expectedFormat; got != want {
+				t.Errorf("format: got %q, want %q", got, want)
+			}
+
+			if got, want := s3OutputConfigurations["overwrite"], testCase.expectedOverwrite; got != want {
+				t.Errorf("overwrite: got %q, want %q", got, want)
+			}
+
+			queryStatement := tfMap["query_statement"].(string)
+
+			if !strings.HasPrefix(queryStatement, "SELECT ") || !strings.HasSuffix(queryStatement, " FROM COST_AND_USAGE_REPORT") {
+				t.Errorf("unexpected query_statement: %s", queryStatement)
+			}
+
+			for _, column := range testCase.expectedColumnsIncluded {
+				if !strings.Contains(queryStatement, " "+column+",") && !strings.Contains(queryStatement, " "+column+" ") {
+					t.Errorf("query_statement does not select %s", column)
+				}
+			}
+
+			for _, column := range testCase.expectedColumnsExcluded {
+				if strings.Contains(queryStatement, column) {
+					t.Errorf("query_statement selects %s", column)
+				}
+			}
+		})
+	}
+}
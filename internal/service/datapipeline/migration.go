@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datapipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+)
+
+// pipelineDeprecationMessage is attached to the Data Pipeline resources, steering users towards the migration data source.
+const pipelineDeprecationMessage = `AWS Data Pipeline is no longer available to new customers. ` +
+	`Use the aws_datapipeline_pipeline_migration data source to generate AWS Step Functions and Amazon MWAA equivalents of existing pipelines.`
+
+// migrationActivity is a Data Pipeline activity object, as needed to render equivalent workflows.
+type migrationActivity struct {
+	command   string
+	dependsOn []string
+	id        string
+	name      string
+	typ       string
+}
+
+// migrationPipeline is the subset of a Data Pipeline definition that can be translated to another workflow service.
+type migrationPipeline struct {
+	activities []migrationActivity
+	// schedulePeriod is the Data Pipeline period of the default schedule, e.g. "1 days", or "" for on-demand pipelines.
+	schedulePeriod string
+}
+
+var (
+	schedulePeriodRegexp  = regexache.MustCompile(`^\s*(\d+)\s+(minute|hour|day|week|month|year)s?\s*$`)
+	parameterRefRegexp    = regexache.MustCompile(`#\{\s*(my[0-9A-Za-z_]+)\s*\}`)
+	identifierCharsRegexp = regexache.MustCompile(`[^0-9A-Za-z_]`)
+)
+
+// newMigrationPipeline extracts the activities and schedule from a pipeline definition.
+// Activities are returned in dependency order. Parameter references in activity commands are replaced by their values.
+func newMigrationPipeline(output *datapipeline.GetPipelineDefinitionOutput) (*migrationPipeline, error) {
+	parameters := migrationParameterValues(output.ParameterObjects, output.ParameterValues)
+	objects := make(map[string]*datapipeline.PipelineObject)
+	var activities []migrationActivity
+
+	for _, object := range output.PipelineObjects {
+		if object == nil {
+			continue
+		}
+
+		id := aws.StringValue(object.Id)
+		objects[id] = object
+		typ := pipelineObjectStringField(object, "type")
+
+		if !strings.HasSuffix(typ, "Activity") {
+			continue
+		}
+
+		activity := migrationActivity{
+			dependsOn: pipelineObjectRefFields(object, "dependsOn"),
+			id:        id,
+			name:      aws.StringValue(object.Name),
+			typ:       typ,
+		}
+
+		if v := pipelineObjectStringField(object, "command"); v != "" {
+			activity.command = parameterRefRegexp.ReplaceAllStringFunc(v, func(s string) string {
+				if v, ok := parameters[parameterRefRegexp.FindStringSubmatch(s)[1]]; ok {
+					return v
+				}
+
+				return s
+			})
+		}
+
+		activities = append(activities, activity)
+	}
+
+	activities, err := sortMigrationActivities(activities)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := &migrationPipeline{
+		activities: activities,
+	}
+
+	if object, ok := objects["Default"]; ok && !strings.EqualFold(pipelineObjectStringField(object, "scheduleType"), "ondemand") {
+		if refs := pipelineObjectRefFields(object, "schedule"); len(refs) > 0 {
+			if schedule, ok := objects[refs[0]]; ok {
+				pipeline.schedulePeriod = pipelineObjectStringField(schedule, "period")
+			}
+		}
+	}
+
+	return pipeline, nil
+}
+
+// migrationParameterValues returns the value of each pipeline parameter, falling back to the parameter's default.
+func migrationParameterValues(parameterObjects []*datapipeline.ParameterObject, parameterValues []*datapipeline.ParameterValue) map[string]string {
+	m := make(map[string]string)
+
+	for _, object := range parameterObjects {
+		if object == nil {
+			continue
+		}
+
+		for _, attribute := range object.Attributes {
+			if attribute != nil && aws.StringValue(attribute.Key) == "default" {
+				m[aws.StringValue(object.Id)] = aws.StringValue(attribute.StringValue)
+			}
+		}
+	}
+
+	for _, value := range parameterValues {
+		if value != nil {
+			m[aws.StringValue(value.Id)] = aws.StringValue(value.StringValue)
+		}
+	}
+
+	return m
+}
+
+func pipelineObjectStringField(object *datapipeline.PipelineObject, key string) string {
+	for _, field := range object.Fields {
+		if field != nil && aws.StringValue(field.Key) == key && field.StringValue != nil {
+			return aws.StringValue(field.StringValue)
+		}
+	}
+
+	return ""
+}
+
+func pipelineObjectRefFields(object *datapipeline.PipelineObject, key string) []string {
+	var refs []string
+
+	for _, field := range object.Fields {
+		if field != nil && aws.StringValue(field.Key) == key && field.RefValue != nil {
+			refs = append(refs, aws.StringValue(field.RefValue))
+		}
+	}
+
+	sort.Strings(refs)
+
+	return refs
+}
+
+// sortMigrationActivities orders activities so that each follows the activities it depends on.
+// Dependencies on objects that are not activities are dropped.
+func sortMigrationActivities(activities []migrationActivity) ([]migrationActivity, error) {
+	byID := make(map[string]migrationActivity, len(activities))
+	for _, activity := range activities {
+		byID[activity.id] = activity
+	}
+
+	inDegree := make(map[string]int, len(activities))
+	dependents := make(map[string][]string)
+
+	for i, activity := range activities {
+		var dependsOn []string
+
+		for _, id := range activity.dependsOn {
+			if _, ok := byID[id]; ok {
+				dependsOn = append(dependsOn, id)
+				dependents[id] = append(dependents[id], activity.id)
+			}
+		}
+
+		activities[i].dependsOn = dependsOn
+		byID[activity.id] = activities[i]
+		inDegree[activity.id] = len(dependsOn)
+	}
+
+	var ready []string
+	for id, n := range inDegree {
+		if n == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	var sorted []migrationActivity
+
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		id := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, byID[id])
+
+		for _, dependent := range dependents[id] {
+			inDegree[dependent]--
+			if inDegree[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(sorted) != len(activities) {
+		return nil, fmt.Errorf("pipeline activities contain a dependency cycle")
+	}
+
+	return sorted, nil
+}
+
+type stepFunctionsState struct {
+	Comment string `json:",omitempty"`
+	End     bool   `json:",omitempty"`
+	Next    string `json:",omitempty"`
+	Type    string
+}
+
+type stepFunctionsDefinition struct {
+	Comment string
+	StartAt string
+	States  map[string]stepFunctionsState
+}
+
+// renderStepFunctionsDefinition renders an Amazon States Language skeleton that runs the pipeline's activities in dependency order.
+// Each activity becomes a Pass state, to be replaced with a Task state doing the equivalent work.
+func renderStepFunctionsDefinition(pipelineID string, pipeline *migrationPipeline) (string, error) {
+	definition := stepFunctionsDefinition{
+		Comment: fmt.Sprintf("Generated from AWS Data Pipeline %s.", pipelineID),
+		States:  make(map[string]stepFunctionsState),
+	}
+
+	if pipeline.schedulePeriod != "" {
+		definition.Comment += fmt.Sprintf(" Run every %s with an Amazon EventBridge Scheduler schedule.", pipeline.schedulePeriod)
+	}
+
+	if len(pipeline.activities) == 0 {
+		definition.StartAt = "Succeed"
+		definition.States["Succeed"] = stepFunctionsState{Type: "Succeed"}
+	}
+
+	for i, activity := range pipeline.activities {
+		if i == 0 {
+			definition.StartAt = activity.id
+		}
+
+		state := stepFunctionsState{
+			Comment: fmt.Sprintf("TODO: replace with a Task state equivalent to %s %s.", activity.typ, activity.id),
+			Type:    "Pass",
+		}
+
+		if activity.command != "" {
+			state.Comment += fmt.Sprintf(" Command: %s", activity.command)
+		}
+
+		if i == len(pipeline.activities)-1 {
+			state.End = true
+		} else {
+			state.Next = pipeline.activities[i+1].id
+		}
+
+		definition.States[activity.id] = state
+	}
+
+	b, err := json.MarshalIndent(definition, "", "  ")
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// renderAirflowDAG renders an Apache Airflow DAG skeleton, suitable for Amazon MWAA, that preserves the pipeline's activity dependencies.
+// Shell command activities become BashOperator tasks; all other activities become EmptyOperator placeholders.
+func renderAirflowDAG(pipelineID, pipelineName string, pipeline *migrationPipeline) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Generated from AWS Data Pipeline %s.\n", pipelineID)
+	b.WriteString("from datetime import datetime, timedelta\n\n")
+	b.WriteString("from airflow import DAG\n")
+	b.WriteString("from airflow.operators.bash import BashOperator\n")
+	b.WriteString("from airflow.operators.empty import EmptyOperator\n\n")
+	b.WriteString("with DAG(\n")
+	fmt.Fprintf(&b, "    dag_id=%s,\n", strconv.Quote(airflowIdentifier(pipelineName)))
+	fmt.Fprintf(&b, "    schedule=%s,\n", airflowSchedule(pipeline.schedulePeriod))
+	b.WriteString("    start_date=datetime(2024, 1, 1),\n")
+	b.WriteString("    catchup=False,\n")
+	b.WriteString(") as dag:\n")
+
+	if len(pipeline.activities) == 0 {
+		b.WriteString("    pass\n")
+
+		return b.String()
+	}
+
+	variables := make(map[string]string, len(pipeline.activities))
+	used := make(map[string]bool, len(pipeline.activities))
+
+	for _, activity := range pipeline.activities {
+		variable := airflowIdentifier(activity.id)
+		for i := 2; used[variable]; i++ {
+			variable = fmt.Sprintf("%s_%d", airflowIdentifier(activity.id), i)
+		}
+		used[variable] = true
+		variables[activity.id] = variable
+
+		if activity.typ == "ShellCommandActivity" && activity.command != "" {
+			fmt.Fprintf(&b, "    %s = BashOperator(task_id=%s, bash_command=%s)\n", variable, strconv.Quote(variable), strconv.Quote(activity.command))
+		} else {
+			fmt.Fprintf(&b, "    # TODO: replace with an operator equivalent to %s %s.\n", activity.typ, activity.id)
+			fmt.Fprintf(&b, "    %s = EmptyOperator(task_id=%s)\n", variable, strconv.Quote(variable))
+		}
+	}
+
+	var edges []string
+
+	for _, activity := range pipeline.activities {
+		for _, id := range activity.dependsOn {
+			edges = append(edges, fmt.Sprintf("    %s >> %s\n", variables[id], variables[activity.id]))
+		}
+	}
+
+	if len(edges) > 0 {
+		b.WriteString("\n")
+		for _, edge := range edges {
+			b.WriteString(edge)
+		}
+	}
+
+	return b.String()
+}
+
+// airflowIdentifier converts a Data Pipeline name or ID to a valid Python identifier and Airflow ID.
+func airflowIdentifier(s string) string {
+	s = identifierCharsRegexp.ReplaceAllString(s, "_")
+
+	if s == "" || (s[0] >= '0' && s[0] <= '9') {
+		s = "_" + s
+	}
+
+	return s
+}
+
+// airflowSchedule converts a Data Pipeline schedule period to an Airflow schedule expression.
+func airflowSchedule(period string) string {
+	if period == "" {
+		return "None"
+	}
+
+	match := schedulePeriodRegexp.FindStringSubmatch(period)
+
+	if match == nil {
+		return fmt.Sprintf("None  # TODO: unsupported Data Pipeline period %s", strconv.Quote(period))
+	}
+
+	n, unit := match[1], match[2]
+
+	switch unit {
+	case "month", "year":
+		if n == "1" {
+			return fmt.Sprintf(`"@%sly"`, unit)
+		}
+
+		return fmt.Sprintf("None  # TODO: every %s %ss", n, unit)
+	default:
+		return fmt.Sprintf("timedelta(%ss=%s)", unit, n)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datapipeline
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+)
+
+func testMigrationPipelineObject(id, typ string, fields ...*datapipeline.Field) *datapipeline.PipelineObject {
+	if typ != "" {
+		fields = append(fields, &datapipeline.Field{Key: aws.String("type"), StringValue: aws.String(typ)})
+	}
+
+	return &datapipeline.PipelineObject{
+		Fields: fields,
+		Id:     aws.String(id),
+		Name:   aws.String(id),
+	}
+}
+
+func TestNewMigrationPipeline(t *testing.T) {
+	t.Parallel()
+
+	output := &datapipeline.GetPipelineDefinitionOutput{
+		ParameterObjects: []*datapipeline.ParameterObject{
+			{
+				Id: aws.String("myCommand"),
+				Attributes: []*datapipeline.ParameterAttribute{
+					{Key: aws.String("default"), StringValue: aws.String("echo default")},
+				},
+			},
+		},
+		ParameterValues: []*datapipeline.ParameterValue{
+			{Id: aws.String("myCommand"), StringValue: aws.String("echo hello")},
+		},
+		PipelineObjects: []*datapipeline.PipelineObject{
+			testMigrationPipelineObject("Default", "",
+				&datapipeline.Field{Key: aws.String("schedule"), RefValue: aws.String("DefaultSchedule")},
+				&datapipeline.Field{Key: aws.String("scheduleType"), StringValue: aws.String("cron")},
+			),
+			testMigrationPipelineObject("DefaultSchedule", "Schedule",
+				&datapipeline.Field{Key: aws.String("period"), StringValue: aws.String("1 days")},
+			),
+			testMigrationPipelineObject("Load", "CopyActivity",
+				&datapipeline.Field{Key: aws.String("dependsOn"), RefValue: aws.String("Transform")},
+			),
+			testMigrationPipelineObject("Transform", "ShellCommandActivity",
+				&datapipeline.Field{Key: aws.String("command"), StringValue: aws.String("#{myCommand} && #{@scheduledStartTime}")},
+				&datapipeline.Field{Key: aws.String("dependsOn"), RefValue: aws.String("Extract")},
+				&datapipeline.Field{Key: aws.String("runsOn"), RefValue: aws.String("Ec2Instance")},
+			),
+			testMigrationPipelineObject("Extract", "ShellCommandActivity",
+				&datapipeline.Field{Key: aws.String("command"), StringValue: aws.String("echo extract")},
+			),
+			testMigrationPipelineObject("Ec2Instance", "Ec2Resource"),
+		},
+	}
+
+	pipeline, err := newMigrationPipeline(output)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := pipeline.schedulePeriod, "1 days"; got != want {
+		t.Errorf("schedulePeriod = %q, want %q", got, want)
+	}
+
+	var ids []string
+	for _, activity := range pipeline.activities {
+		ids = append(ids, activity.id)
+	}
+
+	if got, want := strings.Join(ids, ","), "Extract,Transform,Load"; got != want {
+		t.Errorf("activities = %q, want %q", got, want)
+	}
+
+	if got, want := pipeline.activities[1].command, "echo hello && #{@scheduledStartTime}"; got != want {
+		t.Errorf("command = %q, want %q", got, want)
+	}
+
+	definition, err := renderStepFunctionsDefinition("df-0123456789", pipeline)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, want := range []string{`"StartAt": "Extract"`, `"Next": "Transform"`, `"Next": "Load"`, `"End": true`, "every 1 days"} {
+		if !strings.Contains(definition, want) {
+			t.Errorf("Step Functions definition missing %q:\n%s", want, definition)
+		}
+	}
+
+	dag := renderAirflowDAG("df-0123456789", "my-pipeline", pipeline)
+
+	for _, want := range []string{
+		`dag_id="my_pipeline"`,
+		"schedule=timedelta(days=1)",
+		`Extract = BashOperator(task_id="Extract", bash_command="echo extract")`,
+		`Load = EmptyOperator(task_id="Load")`,
+		"Extract >> Transform",
+		"Transform >> Load",
+	} {
+		if !strings.Contains(dag, want) {
+			t.Errorf("Airflow DAG missing %q:\n%s", want, dag)
+		}
+	}
+}
+
+func TestNewMigrationPipeline_cycle(t *testing.T) {
+	t.Parallel()
+
+	output := &datapipeline.GetPipelineDefinitionOutput{
+		PipelineObjects: []*datapipeline.PipelineObject{
+			testMigrationPipelineObject("A", "ShellCommandActivity",
+				&datapipeline.Field{Key: aws.String("dependsOn"), RefValue: aws.String("B")},
+			),
+			testMigrationPipelineObject("B", "ShellCommandActivity",
+				&datapipeline.Field{Key: aws.String("dependsOn"), RefValue: aws.String("A")},
+			),
+		},
+	}
+
+	if _, err := newMigrationPipeline(output); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestAirflowSchedule(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"":           "None",
+		"15 minutes": "timedelta(minutes=15)",
+		"1 hour":     "timedelta(hours=1)",
+		"2 weeks":    "timedelta(weeks=2)",
+		"1 month":    `"@monthly"`,
+		"1 years":    `"@yearly"`,
+	}
+
+	for period, want := range testCases {
+		if got := airflowSchedule(period); got != want {
+			t.Errorf("airflowSchedule(%q) = %q, want %q", period, got, want)
+		}
+	}
+}
@@ -31,6 +31,9 @@ func ResourcePipeline() *schema.Resource {
 		ReadWithoutTimeout:   resourcePipelineRead,
 		UpdateWithoutTimeout: resourcePipelineUpdate,
 		DeleteWithoutTimeout: resourcePipelineDelete,
+
+		DeprecationMessage: pipelineDeprecationMessage,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -29,6 +29,9 @@ func ResourcePipelineDefinition() *schema.Resource {
 		ReadWithoutTimeout:   resourcePipelineDefinitionRead,
 		UpdateWithoutTimeout: resourcePipelineDefinitionPut,
 		DeleteWithoutTimeout: schema.NoopContext,
+
+		DeprecationMessage: pipelineDeprecationMessage,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datapipeline
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_datapipeline_pipeline_migration")
+func DataSourcePipelineMigration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePipelineMigrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"activity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"depends_on": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"airflow_dag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pipeline_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"schedule_period": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"step_functions_definition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePipelineMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DataPipelineConn(ctx)
+
+	pipelineID := d.Get("pipeline_id").(string)
+
+	pipeline, err := PipelineRetrieve(ctx, pipelineID, conn)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "describing DataPipeline Pipeline (%s): %s", pipelineID, err)
+	}
+
+	output, err := conn.GetPipelineDefinitionWithContext(ctx, &datapipeline.GetPipelineDefinitionInput{
+		PipelineId: aws.String(pipelineID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting DataPipeline Definition (%s): %s", pipelineID, err)
+	}
+
+	migration, err := newMigrationPipeline(output)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataPipeline Definition (%s): %s", pipelineID, err)
+	}
+
+	definition, err := renderStepFunctionsDefinition(pipelineID, migration)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "rendering Step Functions definition for DataPipeline Pipeline (%s): %s", pipelineID, err)
+	}
+
+	d.SetId(pipelineID)
+	if err := d.Set("activity", flattenMigrationActivities(migration.activities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting activity: %s", err)
+	}
+	d.Set("airflow_dag", renderAirflowDAG(pipelineID, aws.StringValue(pipeline.Name), migration))
+	d.Set("name", pipeline.Name)
+	d.Set("schedule_period", migration.schedulePeriod)
+	d.Set("step_functions_definition", definition)
+
+	return diags
+}
+
+func flattenMigrationActivities(activities []migrationActivity) []interface{} {
+	tfList := make([]interface{}, 0, len(activities))
+
+	for _, activity := range activities {
+		tfList = append(tfList, map[string]interface{}{
+			"command":    activity.command,
+			"depends_on": activity.dependsOn,
+			"id":         activity.id,
+			"name":       activity.name,
+			"type":       activity.typ,
+		})
+	}
+
+	return tfList
+}
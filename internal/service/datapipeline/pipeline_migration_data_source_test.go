@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datapipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDataPipelinePipelineMigrationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_datapipeline_pipeline_migration.test"
+	resourceName := "aws_datapipeline_pipeline.default"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDefinitionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, datapipeline.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineMigrationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "activity.0.id", "Extract"),
+					resource.TestCheckResourceAttr(dataSourceName, "activity.0.command", "echo extract"),
+					resource.TestCheckResourceAttr(dataSourceName, "activity.1.id", "Load"),
+					resource.TestCheckResourceAttr(dataSourceName, "activity.1.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "activity.1.depends_on.0", "Extract"),
+					resource.TestMatchResourceAttr(dataSourceName, "airflow_dag", regexache.MustCompile(`Extract >> Load`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "pipeline_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "schedule_period", "1 days"),
+					resource.TestMatchResourceAttr(dataSourceName, "step_functions_definition", regexache.MustCompile(`"StartAt": "Extract"`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccPipelineMigrationDataSourceConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "aws_datapipeline_pipeline" "default" {
+  name = %[1]q
+}
+
+resource "aws_datapipeline_pipeline_definition" "test" {
+  pipeline_id = aws_datapipeline_pipeline.default.id
+
+  pipeline_object {
+    id   = "Default"
+    name = "Default"
+
+    field {
+      key       = "schedule"
+      ref_value = "DefaultSchedule"
+    }
+    field {
+      key          = "scheduleType"
+      string_value = "cron"
+    }
+    field {
+      key          = "workerGroup"
+      string_value = "workerGroup"
+    }
+  }
+  pipeline_object {
+    id   = "DefaultSchedule"
+    name = "DefaultSchedule"
+
+    field {
+      key          = "period"
+      string_value = "1 days"
+    }
+    field {
+      key          = "startAt"
+      string_value = "FIRST_ACTIVATION_DATE_TIME"
+    }
+    field {
+      key          = "type"
+      string_value = "Schedule"
+    }
+  }
+  pipeline_object {
+    id   = "Extract"
+    name = "Extract"
+
+    field {
+      key          = "command"
+      string_value = "echo extract"
+    }
+    field {
+      key          = "type"
+      string_value = "ShellCommandActivity"
+    }
+  }
+  pipeline_object {
+    id   = "Load"
+    name = "Load"
+
+    field {
+      key       = "dependsOn"
+      ref_value = "Extract"
+    }
+    field {
+      key          = "type"
+      string_value = "CopyActivity"
+    }
+  }
+}
+
+data "aws_datapipeline_pipeline_migration" "test" {
+  pipeline_id = aws_datapipeline_pipeline_definition.test.pipeline_id
+}
+`, name)
+}
@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourcePipelineDefinition,
 			TypeName: "aws_datapipeline_pipeline_definition",
 		},
+		{
+			Factory:  DataSourcePipelineMigration,
+			TypeName: "aws_datapipeline_pipeline_migration",
+		},
 	}
 }
 
@@ -7,17 +7,21 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/YakDriver/regexache"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/datasync"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -65,7 +69,7 @@ func ResourceTask() *schema.Resource {
 						"filter_type": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice(datasync.FilterType_Values(), false),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.FilterType](), false),
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -83,7 +87,7 @@ func ResourceTask() *schema.Resource {
 						"filter_type": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice(datasync.FilterType_Values(), false),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.FilterType](), false),
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -106,8 +110,8 @@ func ResourceTask() *schema.Resource {
 						"atime": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.AtimeBestEffort,
-							ValidateFunc: validation.StringInSlice(datasync.Atime_Values(), false),
+							Default:      string(awstypes.AtimeBestEffort),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.Atime](), false),
 						},
 						"bytes_per_second": {
 							Type:         schema.TypeInt,
@@ -118,80 +122,80 @@ func ResourceTask() *schema.Resource {
 						"gid": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.GidIntValue,
-							ValidateFunc: validation.StringInSlice(datasync.Gid_Values(), false),
+							Default:      string(awstypes.GidIntValue),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.Gid](), false),
 						},
 						"log_level": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.LogLevelOff,
-							ValidateFunc: validation.StringInSlice(datasync.LogLevel_Values(), false),
+							Default:      string(awstypes.LogLevelOff),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.LogLevel](), false),
 						},
 						"mtime": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.MtimePreserve,
-							ValidateFunc: validation.StringInSlice(datasync.Mtime_Values(), false),
+							Default:      string(awstypes.MtimePreserve),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.Mtime](), false),
 						},
 						"object_tags": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.ObjectTagsPreserve,
-							ValidateFunc: validation.StringInSlice(datasync.ObjectTags_Values(), false),
+							Default:      string(awstypes.ObjectTagsPreserve),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.ObjectTags](), false),
 						},
 						"overwrite_mode": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.OverwriteModeAlways,
-							ValidateFunc: validation.StringInSlice(datasync.OverwriteMode_Values(), false),
+							Default:      string(awstypes.OverwriteModeAlways),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.OverwriteMode](), false),
 						},
 						"posix_permissions": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.PosixPermissionsPreserve,
-							ValidateFunc: validation.StringInSlice(datasync.PosixPermissions_Values(), false),
+							Default:      string(awstypes.PosixPermissionsPreserve),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.PosixPermissions](), false),
 						},
 						"preserve_deleted_files": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.PreserveDeletedFilesPreserve,
-							ValidateFunc: validation.StringInSlice(datasync.PreserveDeletedFiles_Values(), false),
+							Default:      string(awstypes.PreserveDeletedFilesPreserve),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.PreserveDeletedFiles](), false),
 						},
 						"preserve_devices": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.PreserveDevicesNone,
-							ValidateFunc: validation.StringInSlice(datasync.PreserveDevices_Values(), false),
+							Default:      string(awstypes.PreserveDevicesNone),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.PreserveDevices](), false),
 						},
 						"security_descriptor_copy_flags": {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: validation.StringInSlice(datasync.SmbSecurityDescriptorCopyFlags_Values(), false),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.SmbSecurityDescriptorCopyFlags](), false),
 						},
 						"task_queueing": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.TaskQueueingEnabled,
-							ValidateFunc: validation.StringInSlice(datasync.TaskQueueing_Values(), false),
+							Default:      string(awstypes.TaskQueueingEnabled),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.TaskQueueing](), false),
 						},
 						"transfer_mode": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.TransferModeChanged,
-							ValidateFunc: validation.StringInSlice(datasync.TransferMode_Values(), false),
+							Default:      string(awstypes.TransferModeChanged),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.TransferMode](), false),
 						},
 						"uid": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.UidIntValue,
-							ValidateFunc: validation.StringInSlice(datasync.Uid_Values(), false),
+							Default:      string(awstypes.UidIntValue),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.Uid](), false),
 						},
 						"verify_mode": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      datasync.VerifyModePointInTimeConsistent,
-							ValidateFunc: validation.StringInSlice(datasync.VerifyMode_Values(), false),
+							Default:      string(awstypes.VerifyModePointInTimeConsistent),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.VerifyMode](), false),
 						},
 					},
 				},
@@ -222,6 +226,13 @@ func ResourceTask() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"task_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(enum.Values[awstypes.TaskMode](), false),
+			},
 			"task_report_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -254,12 +265,12 @@ func ResourceTask() *schema.Resource {
 						"s3_object_versioning": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice(datasync.ObjectVersionIds_Values(), false),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.ObjectVersionIds](), false),
 						},
 						"output_type": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice(datasync.ReportOutputType_Values(), false),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.ReportOutputType](), false),
 						},
 						"report_overrides": {
 							Type:     schema.TypeList,
@@ -270,22 +281,22 @@ func ResourceTask() *schema.Resource {
 									"deleted_override": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										ValidateFunc: validation.StringInSlice(enum.Values[awstypes.ReportLevel](), false),
 									},
 									"skipped_override": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										ValidateFunc: validation.StringInSlice(enum.Values[awstypes.ReportLevel](), false),
 									},
 									"transferred_override": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										ValidateFunc: validation.StringInSlice(enum.Values[awstypes.ReportLevel](), false),
 									},
 									"verified_override": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										ValidateFunc: validation.StringInSlice(enum.Values[awstypes.ReportLevel](), false),
 									},
 								},
 							},
@@ -293,26 +304,32 @@ func ResourceTask() *schema.Resource {
 						"report_level": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+							ValidateFunc: validation.StringInSlice(enum.Values[awstypes.ReportLevel](), false),
 						},
 					},
 				},
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customizeTaskModeDiff,
+			customizeTaskOptionsDiff,
+			customizeTaskScheduleDiff,
+			customizeTaskFiltersDiff,
+		),
 	}
 }
 
 func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).DataSyncConn(ctx)
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
 
 	input := &datasync.CreateTaskInput{
 		DestinationLocationArn: aws.String(d.Get("destination_location_arn").(string)),
 		Options:                expandOptions(d.Get("options").([]interface{})),
 		SourceLocationArn:      aws.String(d.Get("source_location_arn").(string)),
-		Tags:                   getTagsIn(ctx),
+		Tags:                   getTagsInV2(ctx),
 	}
 
 	if v, ok := d.GetOk("cloudwatch_log_group_arn"); ok {
@@ -331,6 +348,10 @@ func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Name = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("task_mode"); ok {
+		input.TaskMode = awstypes.TaskMode(v.(string))
+	}
+
 	if v, ok := d.GetOk("task_report_config"); ok {
 		input.TaskReportConfig = expandTaskReportConfig(v.([]interface{}))
 	}
@@ -339,13 +360,13 @@ func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Schedule = expandTaskSchedule(v.([]interface{}))
 	}
 
-	output, err := conn.CreateTaskWithContext(ctx, input)
+	output, err := conn.CreateTask(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating DataSync Task: %s", err)
 	}
 
-	d.SetId(aws.StringValue(output.TaskArn))
+	d.SetId(aws.ToString(output.TaskArn))
 
 	if _, err := waitTaskAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DataSync Task (%s) creation: %s", d.Id(), err)
@@ -356,7 +377,7 @@ func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 func resourceTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).DataSyncConn(ctx)
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
 
 	output, err := FindTaskByARN(ctx, conn, d.Id())
 
@@ -390,13 +411,19 @@ func resourceTaskRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return sdkdiag.AppendErrorf(diags, "setting task_report_config: %s", err)
 	}
 	d.Set("source_location_arn", output.SourceLocationArn)
+	if taskMode := output.TaskMode; taskMode != "" {
+		d.Set("task_mode", taskMode)
+	} else {
+		// Tasks created before task modes were introduced don't report one.
+		d.Set("task_mode", awstypes.TaskModeBasic)
+	}
 
 	return diags
 }
 
 func resourceTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).DataSyncConn(ctx)
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &datasync.UpdateTaskInput{
@@ -431,7 +458,7 @@ func resourceTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.TaskReportConfig = expandTaskReportConfig(d.Get("task_report_config").([]interface{}))
 		}
 
-		if _, err := conn.UpdateTaskWithContext(ctx, input); err != nil {
+		if _, err := conn.UpdateTask(ctx, input); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating DataSync Task (%s): %s", d.Id(), err)
 		}
 	}
@@ -441,14 +468,14 @@ func resourceTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 func resourceTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).DataSyncConn(ctx)
+	conn := meta.(*conns.AWSClient).DataSyncClient(ctx)
 
 	log.Printf("[DEBUG] Deleting DataSync Task: %s", d.Id())
-	_, err := conn.DeleteTaskWithContext(ctx, &datasync.DeleteTaskInput{
+	_, err := conn.DeleteTask(ctx, &datasync.DeleteTaskInput{
 		TaskArn: aws.String(d.Id()),
 	})
 
-	if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
 		return diags
 	}
 
@@ -459,14 +486,14 @@ func resourceTaskDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
-func FindTaskByARN(ctx context.Context, conn *datasync.DataSync, arn string) (*datasync.DescribeTaskOutput, error) {
+func FindTaskByARN(ctx context.Context, conn *datasync.Client, arn string) (*datasync.DescribeTaskOutput, error) {
 	input := &datasync.DescribeTaskInput{
 		TaskArn: aws.String(arn),
 	}
 
-	output, err := conn.DescribeTaskWithContext(ctx, input)
+	output, err := conn.DescribeTask(ctx, input)
 
-	if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -484,7 +511,7 @@ func FindTaskByARN(ctx context.Context, conn *datasync.DataSync, arn string) (*d
 	return output, nil
 }
 
-func statusTask(ctx context.Context, conn *datasync.DataSync, arn string) retry.StateRefreshFunc {
+func statusTask(ctx context.Context, conn *datasync.Client, arn string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindTaskByARN(ctx, conn, arn)
 
@@ -496,14 +523,14 @@ func statusTask(ctx context.Context, conn *datasync.DataSync, arn string) retry.
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.Status), nil
+		return output, string(output.Status), nil
 	}
 }
 
-func waitTaskAvailable(ctx context.Context, conn *datasync.DataSync, arn string, timeout time.Duration) (*datasync.DescribeTaskOutput, error) {
+func waitTaskAvailable(ctx context.Context, conn *datasync.Client, arn string, timeout time.Duration) (*datasync.DescribeTaskOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{datasync.TaskStatusCreating, datasync.TaskStatusUnavailable},
-		Target:  []string{datasync.TaskStatusAvailable, datasync.TaskStatusRunning},
+		Pending: enum.Slice(awstypes.TaskStatusCreating, awstypes.TaskStatusUnavailable),
+		Target:  enum.Slice(awstypes.TaskStatusAvailable, awstypes.TaskStatusRunning),
 		Refresh: statusTask(ctx, conn, arn),
 		Timeout: timeout,
 	}
@@ -511,7 +538,7 @@ func waitTaskAvailable(ctx context.Context, conn *datasync.DataSync, arn string,
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*datasync.DescribeTaskOutput); ok {
-		if errorCode, errorDetail := aws.StringValue(output.ErrorCode), aws.StringValue(output.ErrorDetail); errorCode != "" && errorDetail != "" {
+		if errorCode, errorDetail := aws.ToString(output.ErrorCode), aws.ToString(output.ErrorDetail); errorCode != "" && errorDetail != "" {
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", errorCode, errorDetail))
 		}
 
@@ -521,41 +548,127 @@ func waitTaskAvailable(ctx context.Context, conn *datasync.DataSync, arn string,
 	return nil, err
 }
 
-func flattenOptions(options *datasync.Options) []interface{} {
+func customizeTaskModeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("task_mode").(string) != string(awstypes.TaskModeEnhanced) {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("options"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	// Point-in-time verification and bandwidth limits aren't supported by Enhanced mode tasks.
+	if v := diff.Get("options.0.verify_mode").(string); v == string(awstypes.VerifyModePointInTimeConsistent) {
+		return fmt.Errorf("options.0.verify_mode %q is not supported when task_mode is %q", v, awstypes.TaskModeEnhanced)
+	}
+
+	if v := diff.Get("options.0.bytes_per_second").(int); v != -1 {
+		return fmt.Errorf("options.0.bytes_per_second must be -1 (unlimited) when task_mode is %q", awstypes.TaskModeEnhanced)
+	}
+
+	return nil
+}
+
+func customizeTaskOptionsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("options"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	// https://docs.aws.amazon.com/datasync/latest/userguide/API_Options.html.
+	atime, mtime := diff.Get("options.0.atime").(string), diff.Get("options.0.mtime").(string)
+
+	if atime == string(awstypes.AtimeBestEffort) && mtime != string(awstypes.MtimePreserve) {
+		return fmt.Errorf("options.0.mtime must be %q when options.0.atime is %q", awstypes.MtimePreserve, atime)
+	}
+
+	if atime == string(awstypes.AtimeNone) && mtime != string(awstypes.MtimeNone) {
+		return fmt.Errorf("options.0.mtime must be %q when options.0.atime is %q", awstypes.MtimeNone, atime)
+	}
+
+	// Deleted files can only be detected by comparing the source and destination locations.
+	if v := diff.Get("options.0.preserve_deleted_files").(string); v == string(awstypes.PreserveDeletedFilesRemove) {
+		if transferMode := diff.Get("options.0.transfer_mode").(string); transferMode == string(awstypes.TransferModeAll) {
+			return fmt.Errorf("options.0.preserve_deleted_files %q is not supported when options.0.transfer_mode is %q", v, transferMode)
+		}
+	}
+
+	return nil
+}
+
+func customizeTaskScheduleDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v := diff.Get("schedule.0.schedule_expression").(string)
+
+	if v == "" {
+		return nil
+	}
+
+	// Tasks can run at most once an hour.
+	m := regexache.MustCompile(`^rate\(\s*(\d+)\s+minutes?\s*\)$`).FindStringSubmatch(v)
+
+	if m == nil {
+		return nil
+	}
+
+	if n, err := strconv.Atoi(m[1]); err == nil && n < 60 {
+		return fmt.Errorf("schedule.0.schedule_expression %q must not run the task more often than once an hour", v)
+	}
+
+	return nil
+}
+
+func customizeTaskFiltersDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"excludes", "includes"} {
+		for i, tfMapRaw := range diff.Get(k).([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if tfMap["value"].(string) != "" && tfMap["filter_type"].(string) == "" {
+				return fmt.Errorf("%s.%d.filter_type must be set when %s.%d.value is set", k, i, k, i)
+			}
+		}
+	}
+
+	return nil
+}
+
+func flattenOptions(options *awstypes.Options) []interface{} {
 	if options == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"atime":                          aws.StringValue(options.Atime),
-		"bytes_per_second":               aws.Int64Value(options.BytesPerSecond),
-		"gid":                            aws.StringValue(options.Gid),
-		"log_level":                      aws.StringValue(options.LogLevel),
-		"mtime":                          aws.StringValue(options.Mtime),
-		"object_tags":                    aws.StringValue(options.ObjectTags),
-		"overwrite_mode":                 aws.StringValue(options.OverwriteMode),
-		"posix_permissions":              aws.StringValue(options.PosixPermissions),
-		"preserve_deleted_files":         aws.StringValue(options.PreserveDeletedFiles),
-		"preserve_devices":               aws.StringValue(options.PreserveDevices),
-		"security_descriptor_copy_flags": aws.StringValue(options.SecurityDescriptorCopyFlags),
-		"task_queueing":                  aws.StringValue(options.TaskQueueing),
-		"transfer_mode":                  aws.StringValue(options.TransferMode),
-		"uid":                            aws.StringValue(options.Uid),
-		"verify_mode":                    aws.StringValue(options.VerifyMode),
+		"atime":                          options.Atime,
+		"bytes_per_second":               aws.ToInt64(options.BytesPerSecond),
+		"gid":                            options.Gid,
+		"log_level":                      options.LogLevel,
+		"mtime":                          options.Mtime,
+		"object_tags":                    options.ObjectTags,
+		"overwrite_mode":                 options.OverwriteMode,
+		"posix_permissions":              options.PosixPermissions,
+		"preserve_deleted_files":         options.PreserveDeletedFiles,
+		"preserve_devices":               options.PreserveDevices,
+		"security_descriptor_copy_flags": options.SecurityDescriptorCopyFlags,
+		"task_queueing":                  options.TaskQueueing,
+		"transfer_mode":                  options.TransferMode,
+		"uid":                            options.Uid,
+		"verify_mode":                    options.VerifyMode,
 	}
 
 	return []interface{}{m}
 }
 
-func flattenTaskReportConfig(options *datasync.TaskReportConfig) []interface{} {
+func flattenTaskReportConfig(options *awstypes.TaskReportConfig) []interface{} {
 	if options == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"s3_object_versioning": aws.StringValue(options.ObjectVersionIds),
-		"output_type":          aws.StringValue(options.OutputType),
-		"report_level":         aws.StringValue(options.ReportLevel),
+		"s3_object_versioning": options.ObjectVersionIds,
+		"output_type":          options.OutputType,
+		"report_level":         options.ReportLevel,
 		"s3_destination":       flattenTaskReportConfigS3Destination(options.Destination.S3),
 		"report_overrides":     flattenTaskReportConfigReportOverrides(options.Overrides),
 	}
@@ -563,56 +676,56 @@ func flattenTaskReportConfig(options *datasync.TaskReportConfig) []interface{} {
 	return []interface{}{m}
 }
 
-func flattenTaskReportConfigReportOverrides(options *datasync.ReportOverrides) []interface{} {
+func flattenTaskReportConfigReportOverrides(options *awstypes.ReportOverrides) []interface{} {
 	if options == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"deleted_override":     aws.StringValue(options.Deleted.ReportLevel),
-		"skipped_override":     aws.StringValue(options.Skipped.ReportLevel),
-		"transferred_override": aws.StringValue(options.Transferred.ReportLevel),
-		"verified_override":    aws.StringValue(options.Verified.ReportLevel),
+		"deleted_override":     options.Deleted.ReportLevel,
+		"skipped_override":     options.Skipped.ReportLevel,
+		"transferred_override": options.Transferred.ReportLevel,
+		"verified_override":    options.Verified.ReportLevel,
 	}
 
 	return []interface{}{m}
 }
 
-func flattenTaskReportConfigS3Destination(options *datasync.ReportDestinationS3) []interface{} {
+func flattenTaskReportConfigS3Destination(options *awstypes.ReportDestinationS3) []interface{} {
 	if options == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"bucket_access_role_arn": aws.StringValue(options.BucketAccessRoleArn),
-		"s3_bucket_arn":          aws.StringValue(options.S3BucketArn),
-		"subdirectory":           aws.StringValue(options.Subdirectory),
+		"bucket_access_role_arn": aws.ToString(options.BucketAccessRoleArn),
+		"s3_bucket_arn":          aws.ToString(options.S3BucketArn),
+		"subdirectory":           aws.ToString(options.Subdirectory),
 	}
 
 	return []interface{}{m}
 }
 
-func expandOptions(l []interface{}) *datasync.Options {
+func expandOptions(l []interface{}) *awstypes.Options {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
 	m := l[0].(map[string]interface{})
 
-	options := &datasync.Options{
-		Atime:                aws.String(m["atime"].(string)),
-		Gid:                  aws.String(m["gid"].(string)),
-		LogLevel:             aws.String(m["log_level"].(string)),
-		Mtime:                aws.String(m["mtime"].(string)),
-		ObjectTags:           aws.String(m["object_tags"].(string)),
-		OverwriteMode:        aws.String(m["overwrite_mode"].(string)),
-		PreserveDeletedFiles: aws.String(m["preserve_deleted_files"].(string)),
-		PreserveDevices:      aws.String(m["preserve_devices"].(string)),
-		PosixPermissions:     aws.String(m["posix_permissions"].(string)),
-		TaskQueueing:         aws.String(m["task_queueing"].(string)),
-		TransferMode:         aws.String(m["transfer_mode"].(string)),
-		Uid:                  aws.String(m["uid"].(string)),
-		VerifyMode:           aws.String(m["verify_mode"].(string)),
+	options := &awstypes.Options{
+		Atime:                awstypes.Atime(m["atime"].(string)),
+		Gid:                  awstypes.Gid(m["gid"].(string)),
+		LogLevel:             awstypes.LogLevel(m["log_level"].(string)),
+		Mtime:                awstypes.Mtime(m["mtime"].(string)),
+		ObjectTags:           awstypes.ObjectTags(m["object_tags"].(string)),
+		OverwriteMode:        awstypes.OverwriteMode(m["overwrite_mode"].(string)),
+		PreserveDeletedFiles: awstypes.PreserveDeletedFiles(m["preserve_deleted_files"].(string)),
+		PreserveDevices:      awstypes.PreserveDevices(m["preserve_devices"].(string)),
+		PosixPermissions:     awstypes.PosixPermissions(m["posix_permissions"].(string)),
+		TaskQueueing:         awstypes.TaskQueueing(m["task_queueing"].(string)),
+		TransferMode:         awstypes.TransferMode(m["transfer_mode"].(string)),
+		Uid:                  awstypes.Uid(m["uid"].(string)),
+		VerifyMode:           awstypes.VerifyMode(m["verify_mode"].(string)),
 	}
 
 	if v, ok := m["bytes_per_second"].(int); ok && v != 0 {
@@ -620,64 +733,63 @@ func expandOptions(l []interface{}) *datasync.Options {
 	}
 
 	if v, ok := m["security_descriptor_copy_flags"].(string); ok && v != "" {
-		options.SecurityDescriptorCopyFlags = aws.String(v)
+		options.SecurityDescriptorCopyFlags = awstypes.SmbSecurityDescriptorCopyFlags(v)
 	}
 
 	return options
 }
 
-func expandTaskSchedule(l []interface{}) *datasync.TaskSchedule {
+func expandTaskSchedule(l []interface{}) *awstypes.TaskSchedule {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
 	m := l[0].(map[string]interface{})
 
-	schedule := &datasync.TaskSchedule{
+	schedule := &awstypes.TaskSchedule{
 		ScheduleExpression: aws.String(m["schedule_expression"].(string)),
 	}
 
 	return schedule
 }
 
-func flattenTaskSchedule(schedule *datasync.TaskSchedule) []interface{} {
+func flattenTaskSchedule(schedule *awstypes.TaskSchedule) []interface{} {
 	if schedule == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"schedule_expression": aws.StringValue(schedule.ScheduleExpression),
+		"schedule_expression": aws.ToString(schedule.ScheduleExpression),
 	}
 
 	return []interface{}{m}
 }
 
-func expandTaskReportConfig(l []interface{}) *datasync.TaskReportConfig {
+func expandTaskReportConfig(l []interface{}) *awstypes.TaskReportConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
-	reportConfig := &datasync.TaskReportConfig{}
 
 	m := l[0].(map[string]interface{})
 
-	dest := m["s3_destination"].([]interface{})
-	reportConfig = reportConfig.SetDestination(expandTaskReportDestination(dest))
-	reportConfig = reportConfig.SetObjectVersionIds(m["s3_object_versioning"].(string))
-	reportConfig = reportConfig.SetOutputType(m["output_type"].(string))
-	reportConfig = reportConfig.SetReportLevel(m["report_level"].(string))
-	o := m["report_overrides"].([]interface{})
-	reportConfig = reportConfig.SetOverrides(expandTaskReportOverrides(o))
+	reportConfig := &awstypes.TaskReportConfig{
+		Destination:      expandTaskReportDestination(m["s3_destination"].([]interface{})),
+		ObjectVersionIds: awstypes.ObjectVersionIds(m["s3_object_versioning"].(string)),
+		OutputType:       awstypes.ReportOutputType(m["output_type"].(string)),
+		Overrides:        expandTaskReportOverrides(m["report_overrides"].([]interface{})),
+		ReportLevel:      awstypes.ReportLevel(m["report_level"].(string)),
+	}
 
 	return reportConfig
 }
 
-func expandTaskReportDestination(l []interface{}) *datasync.ReportDestination {
+func expandTaskReportDestination(l []interface{}) *awstypes.ReportDestination {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 	m := l[0].(map[string]interface{})
-	return &datasync.ReportDestination{
-		S3: &datasync.ReportDestinationS3{
+	return &awstypes.ReportDestination{
+		S3: &awstypes.ReportDestinationS3{
 			BucketAccessRoleArn: aws.String(m["bucket_access_role_arn"].(string)),
 			S3BucketArn:         aws.String(m["s3_bucket_arn"].(string)),
 			Subdirectory:        aws.String(m["subdirectory"].(string)),
@@ -685,37 +797,37 @@ func expandTaskReportDestination(l []interface{}) *datasync.ReportDestination {
 	}
 }
 
-func expandTaskReportOverrides(l []interface{}) *datasync.ReportOverrides {
+func expandTaskReportOverrides(l []interface{}) *awstypes.ReportOverrides {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 	m := l[0].(map[string]interface{})
-	return &datasync.ReportOverrides{
-		Deleted: &datasync.ReportOverride{
-			ReportLevel: aws.String(m["deleted_override"].(string)),
+	return &awstypes.ReportOverrides{
+		Deleted: &awstypes.ReportOverride{
+			ReportLevel: awstypes.ReportLevel(m["deleted_override"].(string)),
 		},
-		Skipped: &datasync.ReportOverride{
-			ReportLevel: aws.String(m["skipped_override"].(string)),
+		Skipped: &awstypes.ReportOverride{
+			ReportLevel: awstypes.ReportLevel(m["skipped_override"].(string)),
 		},
-		Transferred: &datasync.ReportOverride{
-			ReportLevel: aws.String(m["transferred_override"].(string)),
+		Transferred: &awstypes.ReportOverride{
+			ReportLevel: awstypes.ReportLevel(m["transferred_override"].(string)),
 		},
-		Verified: &datasync.ReportOverride{
-			ReportLevel: aws.String(m["verified_override"].(string)),
+		Verified: &awstypes.ReportOverride{
+			ReportLevel: awstypes.ReportLevel(m["verified_override"].(string)),
 		},
 	}
 }
 
-func expandFilterRules(l []interface{}) []*datasync.FilterRule {
-	filterRules := []*datasync.FilterRule{}
+func expandFilterRules(l []interface{}) []awstypes.FilterRule {
+	filterRules := []awstypes.FilterRule{}
 
 	for _, mRaw := range l {
 		if mRaw == nil {
 			continue
 		}
 		m := mRaw.(map[string]interface{})
-		filterRule := &datasync.FilterRule{
-			FilterType: aws.String(m["filter_type"].(string)),
+		filterRule := awstypes.FilterRule{
+			FilterType: awstypes.FilterType(m["filter_type"].(string)),
 			Value:      aws.String(m["value"].(string)),
 		}
 		filterRules = append(filterRules, filterRule)
@@ -724,13 +836,13 @@ func expandFilterRules(l []interface{}) []*datasync.FilterRule {
 	return filterRules
 }
 
-func flattenFilterRules(filterRules []*datasync.FilterRule) []interface{} {
+func flattenFilterRules(filterRules []awstypes.FilterRule) []interface{} {
 	l := []interface{}{}
 
 	for _, filterRule := range filterRules {
 		m := map[string]interface{}{
-			"filter_type": aws.StringValue(filterRule.FilterType),
-			"value":       aws.StringValue(filterRule.Value),
+			"filter_type": filterRule.FilterType,
+			"value":       aws.ToString(filterRule.Value),
 		}
 		l = append(l, m)
 	}
@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	datasync_sdkv2 "github.com/aws/aws-sdk-go-v2/service/datasync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -23,7 +25,7 @@ import (
 
 func TestAccDataSyncTask_basic(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1 datasync.DescribeTaskOutput
+	var task1 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSyncDestinationLocationResourceName := "aws_datasync_location_s3.test"
 	dataSyncSourceLocationResourceName := "aws_datasync_location_nfs.test"
@@ -64,6 +66,7 @@ func TestAccDataSyncTask_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "schedule.#", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "source_location_arn", dataSyncSourceLocationResourceName, "arn"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "task_mode", "BASIC"),
 				),
 			},
 			{
@@ -77,7 +80,7 @@ func TestAccDataSyncTask_basic(t *testing.T) {
 
 func TestAccDataSyncTask_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1 datasync.DescribeTaskOutput
+	var task1 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -101,7 +104,7 @@ func TestAccDataSyncTask_disappears(t *testing.T) {
 
 func TestAccDataSyncTask_schedule(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1 datasync.DescribeTaskOutput
+	var task1 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -111,6 +114,10 @@ func TestAccDataSyncTask_schedule(t *testing.T) {
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckTaskDestroy(ctx),
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskConfig_schedule(rName, "rate(30 minutes)"),
+				ExpectError: regexache.MustCompile(`must not run the task more often than once an hour`),
+			},
 			{
 				Config: testAccTaskConfig_schedule(rName, "cron(0 12 ? * SUN,WED *)"),
 				Check: resource.ComposeTestCheckFunc(
@@ -138,7 +145,7 @@ func TestAccDataSyncTask_schedule(t *testing.T) {
 
 func TestAccDataSyncTask_cloudWatchLogGroupARN(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1 datasync.DescribeTaskOutput
+	var task1 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -172,7 +179,7 @@ func TestAccDataSyncTask_cloudWatchLogGroupARN(t *testing.T) {
 
 func TestAccDataSyncTask_excludes(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1 datasync.DescribeTaskOutput
+	var task1 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -211,7 +218,7 @@ func TestAccDataSyncTask_excludes(t *testing.T) {
 
 func TestAccDataSyncTask_includes(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1 datasync.DescribeTaskOutput
+	var task1 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -250,7 +257,7 @@ func TestAccDataSyncTask_includes(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_atimeMtime(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -260,6 +267,10 @@ func TestAccDataSyncTask_DefaultSyncOptions_atimeMtime(t *testing.T) {
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckTaskDestroy(ctx),
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskConfig_defaultSyncOptionsAtimeMtime(rName, "NONE", "PRESERVE"),
+				ExpectError: regexache.MustCompile(`options.0.mtime must be "NONE" when options.0.atime is "NONE"`),
+			},
 			{
 				Config: testAccTaskConfig_defaultSyncOptionsAtimeMtime(rName, "NONE", "NONE"),
 				Check: resource.ComposeTestCheckFunc(
@@ -290,7 +301,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_atimeMtime(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_bytesPerSecond(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -328,7 +339,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_bytesPerSecond(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_gid(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -366,7 +377,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_gid(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_logLevel(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -404,7 +415,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_logLevel(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_objectTags(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -442,7 +453,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_objectTags(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_overwriteMode(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -480,7 +491,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_overwriteMode(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_posixPermissions(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -518,7 +529,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_posixPermissions(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_preserveDeletedFiles(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -528,6 +539,10 @@ func TestAccDataSyncTask_DefaultSyncOptions_preserveDeletedFiles(t *testing.T) {
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckTaskDestroy(ctx),
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskConfig_defaultSyncOptionsPreserveDeletedFilesTransferMode(rName, "REMOVE", "ALL"),
+				ExpectError: regexache.MustCompile(`options.0.preserve_deleted_files "REMOVE" is not supported when options.0.transfer_mode is "ALL"`),
+			},
 			{
 				Config: testAccTaskConfig_defaultSyncOptionsPreserveDeletedFiles(rName, "REMOVE"),
 				Check: resource.ComposeTestCheckFunc(
@@ -556,7 +571,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_preserveDeletedFiles(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_preserveDevices(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -594,7 +609,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_preserveDevices(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_securityDescriptorCopyFlags(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 	domainName := acctest.RandomDomainName()
@@ -639,7 +654,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_securityDescriptorCopyFlags(t *testi
 
 func TestAccDataSyncTask_DefaultSyncOptions_taskQueueing(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -677,7 +692,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_taskQueueing(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_transferMode(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -715,7 +730,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_transferMode(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_uid(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2 datasync.DescribeTaskOutput
+	var task1, task2 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -753,7 +768,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_uid(t *testing.T) {
 
 func TestAccDataSyncTask_DefaultSyncOptions_verifyMode(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2, task3 datasync.DescribeTaskOutput
+	var task1, task2, task3 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -800,7 +815,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_verifyMode(t *testing.T) {
 
 func TestAccDataSyncTask_taskReportConfig(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1 datasync.DescribeTaskOutput
+	var task1 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -838,9 +853,43 @@ func TestAccDataSyncTask_taskReportConfig(t *testing.T) {
 	})
 }
 
+func TestAccDataSyncTask_taskModeEnhanced(t *testing.T) {
+	ctx := acctest.Context(t)
+	var task1 datasync_sdkv2.DescribeTaskOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datasync_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datasync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskConfig_taskModeEnhanced(rName, "POINT_IN_TIME_CONSISTENT"),
+				ExpectError: regexache.MustCompile(`options.0.verify_mode "POINT_IN_TIME_CONSISTENT" is not supported`),
+			},
+			{
+				Config: testAccTaskConfig_taskModeEnhanced(rName, "ONLY_FILES_TRANSFERRED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExists(ctx, resourceName, &task1),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.verify_mode", "ONLY_FILES_TRANSFERRED"),
+					resource.TestCheckResourceAttr(resourceName, "task_mode", "ENHANCED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccDataSyncTask_tags(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1, task2, task3 datasync.DescribeTaskOutput
+	var task1, task2, task3 datasync_sdkv2.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -888,7 +937,7 @@ func TestAccDataSyncTask_tags(t *testing.T) {
 
 func testAccCheckTaskDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_datasync_task" {
@@ -912,14 +961,14 @@ func testAccCheckTaskDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
-func testAccCheckTaskExists(ctx context.Context, resourceName string, task *datasync.DescribeTaskOutput) resource.TestCheckFunc {
+func testAccCheckTaskExists(ctx context.Context, resourceName string, task *datasync_sdkv2.DescribeTaskOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncClient(ctx)
 
 		output, err := tfdatasync.FindTaskByARN(ctx, conn, rs.Primary.ID)
 
@@ -927,9 +976,9 @@ func testAccCheckTaskExists(ctx context.Context, resourceName string, task *data
 			return err
 		}
 
-		if aws.StringValue(output.Status) != datasync.TaskStatusAvailable && aws.StringValue(output.Status) != datasync.TaskStatusRunning {
+		if output.Status != awstypes.TaskStatusAvailable && output.Status != awstypes.TaskStatusRunning {
 			return fmt.Errorf("Task %q not available or running: last status (%s), error code (%s), error detail: %s",
-				rs.Primary.ID, aws.StringValue(output.Status), aws.StringValue(output.ErrorCode), aws.StringValue(output.ErrorDetail))
+				rs.Primary.ID, output.Status, aws.StringValue(output.ErrorCode), aws.StringValue(output.ErrorDetail))
 		}
 
 		*task = *output
@@ -938,7 +987,7 @@ func testAccCheckTaskExists(ctx context.Context, resourceName string, task *data
 	}
 }
 
-func testAccCheckTaskNotRecreated(i, j *datasync.DescribeTaskOutput) resource.TestCheckFunc {
+func testAccCheckTaskNotRecreated(i, j *datasync_sdkv2.DescribeTaskOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if aws.StringValue(i.TaskArn) != aws.StringValue(j.TaskArn) {
 			return errors.New("DataSync Task was recreated")
@@ -1251,6 +1300,24 @@ resource "aws_datasync_task" "test" {
 `, rName, preserveDeletedFiles))
 }
 
+func testAccTaskConfig_defaultSyncOptionsPreserveDeletedFilesTransferMode(rName, preserveDeletedFiles, transferMode string) string {
+	return acctest.ConfigCompose(
+		testAccTaskConfig_baseLocationS3(rName),
+		testAccTaskConfig_baseLocationNFS(rName),
+		fmt.Sprintf(`
+resource "aws_datasync_task" "test" {
+  destination_location_arn = aws_datasync_location_s3.test.arn
+  name                     = %[1]q
+  source_location_arn      = aws_datasync_location_nfs.test.arn
+
+  options {
+    preserve_deleted_files = %[2]q
+    transfer_mode          = %[3]q
+  }
+}
+`, rName, preserveDeletedFiles, transferMode))
+}
+
 func testAccTaskConfig_defaultSyncOptionsPreserveDevices(rName, preserveDevices string) string {
 	return acctest.ConfigCompose(
 		testAccTaskConfig_baseLocationS3(rName),
@@ -1504,6 +1571,32 @@ resource "aws_datasync_task" "test" {
 `, rName, key1, value1, key2, value2))
 }
 
+func testAccTaskConfig_taskModeEnhanced(rName, verifyMode string) string {
+	return acctest.ConfigCompose(testAccTaskConfig_baseLocationS3(rName), fmt.Sprintf(`
+resource "aws_datasync_location_s3" "source" {
+  s3_bucket_arn = aws_s3_bucket.test.arn
+  subdirectory  = "/source"
+
+  s3_config {
+    bucket_access_role_arn = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_datasync_task" "test" {
+  destination_location_arn = aws_datasync_location_s3.test.arn
+  name                     = %[1]q
+  source_location_arn      = aws_datasync_location_s3.source.arn
+  task_mode                = "ENHANCED"
+
+  options {
+    verify_mode = %[2]q
+  }
+}
+`, rName, verifyMode))
+}
+
 func testAccTaskConfig_taskReportConfig(rName string) string {
 	return acctest.ConfigCompose(
 		testAccTaskConfig_baseLocationS3(rName),
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_directory_service_schema_extension", name="Schema Extension")
+func ResourceSchemaExtension() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchemaExtensionCreate,
+		ReadWithoutTimeout:   resourceSchemaExtensionRead,
+		DeleteWithoutTimeout: resourceSchemaExtensionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_snapshot_before_schema_extension": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"directory_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(directoryIDRegex, "must be a valid Directory Service Directory ID"),
+			},
+			"end_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ldif_content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500000),
+			},
+			"schema_extension_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema_extension_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSchemaExtensionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	input := &directoryservice.StartSchemaExtensionInput{
+		CreateSnapshotBeforeSchemaExtension: aws.Bool(d.Get("create_snapshot_before_schema_extension").(bool)),
+		Description:                         aws.String(d.Get("description").(string)),
+		DirectoryId:                         aws.String(directoryID),
+		LdifContent:                         aws.String(d.Get("ldif_content").(string)),
+	}
+
+	output, err := conn.StartSchemaExtensionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Directory Service Schema Extension (%s): %s", directoryID, err)
+	}
+
+	d.SetId(SchemaExtensionCreateResourceID(directoryID, aws.StringValue(output.SchemaExtensionId)))
+
+	if _, err := waitSchemaExtensionCompleted(ctx, conn, directoryID, aws.StringValue(output.SchemaExtensionId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Directory Service Schema Extension (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSchemaExtensionRead(ctx, d, meta)...)
+}
+
+func resourceSchemaExtensionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID, schemaExtensionID, err := SchemaExtensionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	extension, err := FindSchemaExtensionByTwoPartKey(ctx, conn, directoryID, schemaExtensionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Schema Extension (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Schema Extension (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", extension.Description)
+	d.Set("directory_id", extension.DirectoryId)
+	if extension.EndDateTime != nil {
+		d.Set("end_date_time", aws.TimeValue(extension.EndDateTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_date_time", nil)
+	}
+	d.Set("schema_extension_id", extension.SchemaExtensionId)
+	d.Set("schema_extension_status", extension.SchemaExtensionStatus)
+	if extension.StartDateTime != nil {
+		d.Set("start_date_time", aws.TimeValue(extension.StartDateTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_date_time", nil)
+	}
+
+	return diags
+}
+
+func resourceSchemaExtensionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID, schemaExtensionID, err := SchemaExtensionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	extension, err := FindSchemaExtensionByTwoPartKey(ctx, conn, directoryID, schemaExtensionID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Schema Extension (%s): %s", d.Id(), err)
+	}
+
+	// Only schema extensions that are still being applied can be cancelled.
+	// A completed schema extension cannot be reverted and is only removed from state.
+	switch aws.StringValue(extension.SchemaExtensionStatus) {
+	case directoryservice.SchemaExtensionStatusInitializing, directoryservice.SchemaExtensionStatusCreatingSnapshot, directoryservice.SchemaExtensionStatusUpdatingSchema:
+	default:
+		log.Printf("[WARN] Directory Service Schema Extension (%s) cannot be removed from the directory, removing from state", d.Id())
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling Directory Service Schema Extension: %s", d.Id())
+	_, err = conn.CancelSchemaExtensionWithContext(ctx, &directoryservice.CancelSchemaExtensionInput{
+		DirectoryId:       aws.String(directoryID),
+		SchemaExtensionId: aws.String(schemaExtensionID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Directory Service Schema Extension (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSchemaExtensionCancelled(ctx, conn, directoryID, schemaExtensionID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Directory Service Schema Extension (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const schemaExtensionIDSeparator = "," // nosemgrep:ci.ds-in-const-name,ci.ds-in-var-name
+
+func SchemaExtensionCreateResourceID(directoryID, schemaExtensionID string) string {
+	parts := []string{directoryID, schemaExtensionID}
+	id := strings.Join(parts, schemaExtensionIDSeparator)
+
+	return id
+}
+
+func SchemaExtensionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, schemaExtensionIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DirectoryID%[2]sSchemaExtensionID", id, schemaExtensionIDSeparator)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSSchemaExtension_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v directoryservice.SchemaExtensionInfo
+	resourceName := "aws_directory_service_schema_extension.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaExtensionConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaExtensionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "create_snapshot_before_schema_extension", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "end_date_time"),
+					resource.TestMatchResourceAttr(resourceName, "schema_extension_id", regexache.MustCompile(`^e-`)),
+					resource.TestCheckResourceAttr(resourceName, "schema_extension_status", directoryservice.SchemaExtensionStatusCompleted),
+					acctest.CheckResourceAttrRFC3339(resourceName, "start_date_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"create_snapshot_before_schema_extension",
+					"ldif_content",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaExtensionExists(ctx context.Context, n string, v *directoryservice.SchemaExtensionInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service Schema Extension ID is set")
+		}
+
+		directoryID, schemaExtensionID, err := tfds.SchemaExtensionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn(ctx)
+
+		output, err := tfds.FindSchemaExtensionByTwoPartKey(ctx, conn, directoryID, schemaExtensionID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSchemaExtensionConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_directory_service_directory" "test" {
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+resource "aws_directory_service_schema_extension" "test" {
+  directory_id = aws_directory_service_directory.test.id
+  description  = "test"
+
+  ldif_content = <<-EOT
+dn: CN=tfAccTestAttribute,CN=Schema,CN=Configuration,DC=domain,DC=com
+changetype: add
+objectClass: top
+objectClass: attributeSchema
+attributeID: 1.2.840.113556.1.8000.2554.12345.1
+cn: tfAccTestAttribute
+lDAPDisplayName: tfAccTestAttribute
+attributeSyntax: 2.5.5.12
+oMSyntax: 64
+isSingleValued: TRUE
+searchFlags: 0
+
+dn:
+changetype: modify
+add: schemaUpdateNow
+schemaUpdateNow: 1
+-
+EOT
+}
+`, domain))
+}
@@ -35,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDirectory,
 			TypeName: "aws_directory_service_directory",
 		},
+		{
+			Factory:  DataSourceTrusts,
+			TypeName: "aws_directory_service_trusts",
+			Name:     "Trusts",
+		},
 	}
 }
 
@@ -66,6 +71,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Region",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceSchemaExtension,
+			TypeName: "aws_directory_service_schema_extension",
+			Name:     "Schema Extension",
+		},
+		{
+			Factory:  ResourceSettings,
+			TypeName: "aws_directory_service_settings",
+			Name:     "Settings",
+		},
 		{
 			Factory:  ResourceSharedDirectory,
 			TypeName: "aws_directory_service_shared_directory",
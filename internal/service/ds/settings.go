@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_directory_service_settings", name="Settings")
+func ResourceSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSettingsCreate,
+		ReadWithoutTimeout:   resourceSettingsRead,
+		UpdateWithoutTimeout: resourceSettingsUpdate,
+		DeleteWithoutTimeout: resourceSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(directoryIDRegex, "must be a valid Directory Service Directory ID"),
+			},
+			"setting": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	settings := expandSettings(d.Get("setting").(*schema.Set).List())
+
+	if err := updateSettings(ctx, conn, directoryID, settings, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Directory Service Settings (%s): %s", directoryID, err)
+	}
+
+	d.SetId(directoryID)
+
+	return append(diags, resourceSettingsRead(ctx, d, meta)...)
+}
+
+func resourceSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	entries, err := FindSettingsByDirectoryID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Settings (%s): %s", d.Id(), err)
+	}
+
+	// Only the configured settings are tracked, unless importing, in which case
+	// every setting that has been changed from its default value is tracked.
+	names := make(map[string]struct{})
+	for _, tfMapRaw := range d.Get("setting").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			names[tfMap["name"].(string)] = struct{}{}
+		}
+	}
+
+	var tfList []interface{}
+	for _, entry := range entries {
+		name := aws.StringValue(entry.Name)
+
+		if len(names) > 0 {
+			if _, ok := names[name]; !ok {
+				continue
+			}
+		} else if aws.StringValue(entry.RequestStatus) == directoryservice.DirectoryConfigurationStatusDefault {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  name,
+			"value": aws.StringValue(entry.AppliedValue),
+		})
+	}
+
+	d.Set("directory_id", d.Id())
+	if err := d.Set("setting", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	if d.HasChange("setting") {
+		o, n := d.GetChange("setting")
+		settings := expandSettings(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		if len(settings) > 0 {
+			if err := updateSettings(ctx, conn, d.Id(), settings, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Directory Service Settings (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceSettingsRead(ctx, d, meta)...)
+}
+
+func resourceSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Directory settings cannot be reset to their defaults, so they are only removed from state.
+	log.Printf("[WARN] Directory Service Settings (%s) are not reverted on destroy, removing from state", d.Id())
+
+	return diags
+}
+
+func updateSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, settings []*directoryservice.Setting, timeout time.Duration) error {
+	input := &directoryservice.UpdateSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Settings:    settings,
+	}
+
+	_, err := conn.UpdateSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(settings))
+	for _, v := range settings {
+		names = append(names, aws.StringValue(v.Name))
+	}
+
+	if _, err := waitSettingsUpdated(ctx, conn, directoryID, names, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
+
+func expandSettings(tfList []interface{}) []*directoryservice.Setting {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*directoryservice.Setting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &directoryservice.Setting{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
 )
 
 func statusDirectoryStage(ctx context.Context, conn *directoryservice.DirectoryService, id string) retry.StateRefreshFunc {
@@ -107,3 +108,50 @@ func statusSharedDirectory(ctx context.Context, conn *directoryservice.Directory
 		return output, aws.StringValue(output.ShareStatus), nil
 	}
 }
+
+func statusSchemaExtension(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSchemaExtensionByTwoPartKey(ctx, conn, directoryID, schemaExtensionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.SchemaExtensionStatus), nil
+	}
+}
+
+// statusSettings returns the aggregate request status of the named directory settings.
+func statusSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, names []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSettingsByDirectoryID(ctx, conn, directoryID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := directoryservice.DirectoryConfigurationStatusUpdated
+		for _, entry := range output {
+			if !slices.Contains(names, aws.StringValue(entry.Name)) {
+				continue
+			}
+
+			switch v := aws.StringValue(entry.RequestStatus); v {
+			case directoryservice.DirectoryConfigurationStatusFailed:
+				return entry, v, nil
+			case directoryservice.DirectoryConfigurationStatusRequested, directoryservice.DirectoryConfigurationStatusUpdating:
+				status = directoryservice.DirectoryConfigurationStatusUpdating
+			}
+		}
+
+		return output, status, nil
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_directory_service_trusts", name="Trusts")
+func DataSourceTrusts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTrustsRead,
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(directoryIDRegex, "must be a valid Directory Service Directory ID"),
+			},
+			"trusts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_date_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_date_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"selective_auth": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_last_updated_date_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trust_direction": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trust_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trust_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trust_state_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trust_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTrustsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	trusts, err := findTrustsByDirectoryID(ctx, conn, directoryID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Trusts (%s): %s", directoryID, err)
+	}
+
+	d.SetId(directoryID)
+	if err := d.Set("trusts", flattenTrusts(trusts)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting trusts: %s", err)
+	}
+
+	return diags
+}
+
+func flattenTrusts(apiObjects []*directoryservice.Trust) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"remote_domain_name": aws.StringValue(apiObject.RemoteDomainName),
+			"selective_auth":     aws.StringValue(apiObject.SelectiveAuth),
+			"trust_direction":    aws.StringValue(apiObject.TrustDirection),
+			"trust_id":           aws.StringValue(apiObject.TrustId),
+			"trust_state":        aws.StringValue(apiObject.TrustState),
+			"trust_state_reason": aws.StringValue(apiObject.TrustStateReason),
+			"trust_type":         aws.StringValue(apiObject.TrustType),
+		}
+
+		if v := apiObject.CreatedDateTime; v != nil {
+			tfMap["created_date_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastUpdatedDateTime; v != nil {
+			tfMap["last_updated_date_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StateLastUpdatedDateTime; v != nil {
+			tfMap["state_last_updated_date_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSTrustsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_directory_service_trusts.test"
+	resourceName := "aws_directory_service_trust.test"
+	domainName := acctest.RandomDomainName()
+	domainNameOther := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustsDataSourceConfig_basic(rName, domainName, domainNameOther),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "directory_id", resourceName, "directory_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "trusts.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "trusts.0.trust_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "trusts.0.remote_domain_name", resourceName, "remote_domain_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "trusts.0.trust_direction", resourceName, "trust_direction"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "trusts.0.trust_type", resourceName, "trust_type"),
+					acctest.CheckResourceAttrRFC3339(dataSourceName, "trusts.0.created_date_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrustsDataSourceConfig_basic(rName, domain, domainOther string) string {
+	return acctest.ConfigCompose(testAccTrustConfig_basic(rName, domain, domainOther), `
+data "aws_directory_service_trusts" "test" {
+  directory_id = aws_directory_service_trust.test.directory_id
+}
+`)
+}
@@ -146,3 +146,80 @@ func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectorySe
 
 	return nil, err
 }
+
+func waitSchemaExtensionCompleted(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string, timeout time.Duration) (*directoryservice.SchemaExtensionInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			directoryservice.SchemaExtensionStatusInitializing,
+			directoryservice.SchemaExtensionStatusCreatingSnapshot,
+			directoryservice.SchemaExtensionStatusUpdatingSchema,
+			directoryservice.SchemaExtensionStatusReplicating,
+			directoryservice.SchemaExtensionStatusCancelInProgress,
+			directoryservice.SchemaExtensionStatusRollbackInProgress,
+		},
+		Target:  []string{directoryservice.SchemaExtensionStatusCompleted},
+		Refresh: statusSchemaExtension(ctx, conn, directoryID, schemaExtensionID),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SchemaExtensionInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SchemaExtensionStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSchemaExtensionCancelled(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string, timeout time.Duration) (*directoryservice.SchemaExtensionInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			directoryservice.SchemaExtensionStatusInitializing,
+			directoryservice.SchemaExtensionStatusCreatingSnapshot,
+			directoryservice.SchemaExtensionStatusUpdatingSchema,
+			directoryservice.SchemaExtensionStatusCancelInProgress,
+			directoryservice.SchemaExtensionStatusRollbackInProgress,
+		},
+		// A cancelled extension is reported as not found. The directory can also settle on a rolled back state.
+		Target: []string{
+			directoryservice.SchemaExtensionStatusRollbackFailed,
+			directoryservice.SchemaExtensionStatusRolledBack,
+		},
+		Refresh: statusSchemaExtension(ctx, conn, directoryID, schemaExtensionID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SchemaExtensionInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SchemaExtensionStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSettingsUpdated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, names []string, timeout time.Duration) ([]*directoryservice.SettingEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{directoryservice.DirectoryConfigurationStatusUpdating},
+		Target:  []string{directoryservice.DirectoryConfigurationStatusUpdated},
+		Refresh: statusSettings(ctx, conn, directoryID, names),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	switch output := outputRaw.(type) {
+	case []*directoryservice.SettingEntry:
+		return output, err
+	case *directoryservice.SettingEntry:
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.RequestStatusMessage)))
+	}
+
+	return nil, err
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ebs_snapshot_lock", name="EBS Snapshot Lock")
+func ResourceEBSSnapshotLock() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEBSSnapshotLockPut,
+		ReadWithoutTimeout:   resourceEBSSnapshotLockRead,
+		UpdateWithoutTimeout: resourceEBSSnapshotLockPut,
+		DeleteWithoutTimeout: resourceEBSSnapshotLockDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceEBSSnapshotLockCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"cool_off_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 72),
+			},
+			"cool_off_period_expires_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+				ExactlyOneOf:     []string{"expiration_date", "lock_duration"},
+			},
+			"lock_created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lock_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 36500),
+				ExactlyOneOf: []string{"expiration_date", "lock_duration"},
+			},
+			"lock_duration_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lock_expires_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lock_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ec2.LockMode_Values(), false),
+			},
+			"lock_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceEBSSnapshotLockPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	snapshotID := d.Get("snapshot_id").(string)
+	input := &ec2.LockSnapshotInput{
+		LockMode:   aws.String(d.Get("lock_mode").(string)),
+		SnapshotId: aws.String(snapshotID),
+	}
+
+	if v, ok := d.GetOk("cool_off_period"); ok {
+		input.CoolOffPeriod = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExpirationDate = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("lock_duration"); ok {
+		input.LockDuration = aws.Int64(int64(v.(int)))
+	}
+
+	_, err := conn.LockSnapshotWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "locking EBS Snapshot (%s): %s", snapshotID, err)
+	}
+
+	d.SetId(snapshotID)
+
+	return append(diags, resourceEBSSnapshotLockRead(ctx, d, meta)...)
+}
+
+func resourceEBSSnapshotLockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := findLockedSnapshotByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Snapshot Lock (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot Lock (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cool_off_period", output.CoolOffPeriod)
+	d.Set("cool_off_period_expires_on", formatEBSSnapshotLockTime(output.CoolOffPeriodExpiresOn))
+	d.Set("lock_created_on", formatEBSSnapshotLockTime(output.LockCreatedOn))
+	d.Set("lock_duration_start_time", formatEBSSnapshotLockTime(output.LockDurationStartTime))
+	d.Set("lock_expires_on", formatEBSSnapshotLockTime(output.LockExpiresOn))
+	d.Set("lock_state", output.LockState)
+	d.Set("snapshot_id", output.SnapshotId)
+
+	// A lock is created with either a duration or an expiration date.
+	if output.LockDuration != nil {
+		d.Set("expiration_date", nil)
+		d.Set("lock_duration", output.LockDuration)
+	} else {
+		d.Set("expiration_date", formatEBSSnapshotLockTime(output.LockExpiresOn))
+		d.Set("lock_duration", nil)
+	}
+
+	switch state := aws.StringValue(output.LockState); state {
+	case ec2.LockStateCompliance, ec2.LockStateComplianceCooloff:
+		d.Set("lock_mode", ec2.LockModeCompliance)
+	default:
+		d.Set("lock_mode", ec2.LockModeGovernance)
+	}
+
+	return diags
+}
+
+func resourceEBSSnapshotLockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// Snapshots locked in compliance mode can't be unlocked after the cool-off period.
+	if d.Get("lock_state").(string) == ec2.LockStateCompliance {
+		return sdkdiag.AppendWarningf(diags, "EBS Snapshot Lock (%s) is in compliance mode and can't be removed before it expires on %s; removing from state", d.Id(), d.Get("lock_expires_on").(string))
+	}
+
+	log.Printf("[DEBUG] Deleting EBS Snapshot Lock: %s", d.Id())
+	_, err := conn.UnlockSnapshotWithContext(ctx, &ec2.UnlockSnapshotInput{
+		SnapshotId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "unlocking EBS Snapshot (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceEBSSnapshotLockCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	mode := d.Get("lock_mode").(string)
+
+	if _, ok := d.GetOk("cool_off_period"); ok && mode == ec2.LockModeGovernance {
+		return fmt.Errorf(`"cool_off_period" can only be specified when "lock_mode" is %q`, ec2.LockModeCompliance)
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	// Once the cool-off period has ended, a compliance mode lock can't be changed to governance mode
+	// and its duration can only be extended.
+	if d.Get("lock_state").(string) != ec2.LockStateCompliance {
+		return nil
+	}
+
+	if o, n := d.GetChange("lock_mode"); o.(string) == ec2.LockModeCompliance && n.(string) == ec2.LockModeGovernance {
+		return fmt.Errorf("EBS Snapshot Lock (%s) is in compliance mode and its cool-off period has ended; it can't be changed to governance mode", d.Id())
+	}
+
+	if o, n := d.GetChange("lock_duration"); d.HasChange("lock_duration") && n.(int) != 0 && n.(int) < o.(int) {
+		return fmt.Errorf("EBS Snapshot Lock (%s) is in compliance mode and its cool-off period has ended; its lock duration can't be decreased", d.Id())
+	}
+
+	return nil
+}
+
+func formatEBSSnapshotLockTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.TimeValue(t).Format(time.RFC3339)
+}
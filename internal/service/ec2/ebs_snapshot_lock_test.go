@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2EBSSnapshotLock_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LockedSnapshotsInfo
+	resourceName := "aws_ebs_snapshot_lock.test"
+	snapshotResourceName := "aws_ebs_snapshot.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotLockConfig_lockDuration(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotLockExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cool_off_period", "0"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_date", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "lock_created_on"),
+					resource.TestCheckResourceAttr(resourceName, "lock_duration", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "lock_expires_on"),
+					resource.TestCheckResourceAttr(resourceName, "lock_mode", ec2.LockModeGovernance),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", ec2.LockStateGovernance),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", snapshotResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEBSSnapshotLockConfig_lockDuration(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotLockExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lock_duration", "2"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", ec2.LockStateGovernance),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotLock_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LockedSnapshotsInfo
+	resourceName := "aws_ebs_snapshot_lock.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotLockConfig_lockDuration(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotLockExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceEBSSnapshotLock(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotLock_expirationDate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LockedSnapshotsInfo
+	resourceName := "aws_ebs_snapshot_lock.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotLockConfig_expirationDate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotLockExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttr(resourceName, "lock_duration", "0"),
+					resource.TestCheckResourceAttr(resourceName, "lock_mode", ec2.LockModeGovernance),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotLock_coolOffPeriodGovernance(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEBSSnapshotLockConfig_coolOffPeriodGovernance(rName),
+				ExpectError: regexache.MustCompile(`"cool_off_period" can only be specified when "lock_mode" is "compliance"`),
+			},
+		},
+	})
+}
+
+func testAccCheckEBSSnapshotLockDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ebs_snapshot_lock" {
+				continue
+			}
+
+			_, err := tfec2.FindLockedSnapshotByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EBS Snapshot Lock %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEBSSnapshotLockExists(ctx context.Context, n string, v *ec2.LockedSnapshotsInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindLockedSnapshotByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEBSSnapshotLockConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccEBSSnapshotLockConfig_lockDuration(rName string, lockDuration int) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotLockConfig_base(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot_lock" "test" {
+  snapshot_id   = aws_ebs_snapshot.test.id
+  lock_mode     = "governance"
+  lock_duration = %[1]d
+}
+`, lockDuration))
+}
+
+func testAccEBSSnapshotLockConfig_expirationDate(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotLockConfig_base(rName), `
+resource "aws_ebs_snapshot_lock" "test" {
+  snapshot_id     = aws_ebs_snapshot.test.id
+  lock_mode       = "governance"
+  expiration_date = timeadd(plantimestamp(), "48h")
+
+  lifecycle {
+    ignore_changes = [expiration_date]
+  }
+}
+`)
+}
+
+func testAccEBSSnapshotLockConfig_coolOffPeriodGovernance(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotLockConfig_base(rName), `
+resource "aws_ebs_snapshot_lock" "test" {
+  snapshot_id     = aws_ebs_snapshot.test.id
+  lock_mode       = "governance"
+  lock_duration   = 1
+  cool_off_period = 1
+}
+`)
+}
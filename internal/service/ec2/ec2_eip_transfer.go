@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_eip_transfer", name="EIP Transfer")
+func ResourceEIPTransfer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEIPTransferCreate,
+		ReadWithoutTimeout:   resourceEIPTransferRead,
+		DeleteWithoutTimeout: resourceEIPTransferDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_transfer_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allocation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transfer_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"transfer_offer_accepted_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transfer_offer_expiration_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_acceptance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceEIPTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	allocationID := d.Get("allocation_id").(string)
+	input := &ec2.EnableAddressTransferInput{
+		AllocationId:      aws.String(allocationID),
+		TransferAccountId: aws.String(d.Get("transfer_account_id").(string)),
+	}
+
+	_, err := conn.EnableAddressTransferWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "enabling EC2 EIP (%s) transfer: %s", allocationID, err)
+	}
+
+	d.SetId(allocationID)
+
+	if d.Get("wait_for_acceptance").(bool) {
+		if _, err := waitAddressTransferAccepted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 EIP Transfer (%s) accept: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEIPTransferRead(ctx, d, meta)...)
+}
+
+func resourceEIPTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
+		return findAddressTransferByAllocationID(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 EIP Transfer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 EIP Transfer (%s): %s", d.Id(), err)
+	}
+
+	transfer := outputRaw.(*ec2.AddressTransfer)
+	d.Set("address_transfer_status", transfer.AddressTransferStatus)
+	d.Set("allocation_id", transfer.AllocationId)
+	d.Set("public_ip", transfer.PublicIp)
+	d.Set("transfer_account_id", transfer.TransferAccountId)
+	if v := transfer.TransferOfferAcceptedTimestamp; v != nil {
+		d.Set("transfer_offer_accepted_timestamp", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("transfer_offer_accepted_timestamp", nil)
+	}
+	if v := transfer.TransferOfferExpirationTimestamp; v != nil {
+		d.Set("transfer_offer_expiration_timestamp", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("transfer_offer_expiration_timestamp", nil)
+	}
+
+	return diags
+}
+
+func resourceEIPTransferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// An accepted transfer can't be undone: the address now belongs to the transfer account.
+	if d.Get("address_transfer_status").(string) == ec2.AddressTransferStatusAccepted {
+		log.Printf("[DEBUG] EC2 EIP Transfer (%s) has been accepted, removing from state", d.Id())
+		return diags
+	}
+
+	log.Printf("[DEBUG] Disabling EC2 EIP Transfer: %s", d.Id())
+	_, err := conn.DisableAddressTransferWithContext(ctx, &ec2.DisableAddressTransferInput{
+		AllocationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling EC2 EIP Transfer (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func addressTransferExpired(transfer *ec2.AddressTransfer) bool {
+	v := aws.TimeValue(transfer.TransferOfferExpirationTimestamp)
+
+	return !v.IsZero() && time.Now().After(v)
+}
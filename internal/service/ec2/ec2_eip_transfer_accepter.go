@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_eip_transfer_accepter", name="EIP Transfer Accepter")
+func ResourceEIPTransferAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEIPTransferAccepterCreate,
+		ReadWithoutTimeout:   resourceEIPTransferAccepterRead,
+		DeleteWithoutTimeout: resourceEIPTransferAccepterDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"allocation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ipv4_pool": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEIPTransferAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	address := d.Get("address").(string)
+	input := &ec2.AcceptAddressTransferInput{
+		Address: aws.String(address),
+	}
+
+	output, err := conn.AcceptAddressTransferWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "accepting EC2 EIP (%s) transfer: %s", address, err)
+	}
+
+	d.SetId(aws.StringValue(output.AddressTransfer.AllocationId))
+
+	// The address is allocated to this account once the transfer completes.
+	if _, err := tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return FindEIPByAllocationID(ctx, conn, d.Id())
+	}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 EIP Transfer Accepter (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceEIPTransferAccepterRead(ctx, d, meta)...)
+}
+
+func resourceEIPTransferAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	address, err := FindEIPByAllocationID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 EIP Transfer Accepter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 EIP Transfer Accepter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("address", address.PublicIp)
+	d.Set("allocation_id", address.AllocationId)
+	d.Set("domain", address.Domain)
+	d.Set("public_ipv4_pool", address.PublicIpv4Pool)
+
+	return diags
+}
+
+func resourceEIPTransferAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// A completed transfer can't be reversed, so the transferred address is released.
+	log.Printf("[INFO] Releasing EC2 EIP: %s", d.Id())
+	_, err := conn.ReleaseAddressWithContext(ctx, &ec2.ReleaseAddressInput{
+		AllocationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "releasing EC2 EIP (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2EIPTransfer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.AddressTransfer
+	resourceName := "aws_eip_transfer.test"
+	eipResourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPTransferExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address_transfer_status", ec2.AddressTransferStatusPending),
+					resource.TestCheckResourceAttrPair(resourceName, "allocation_id", eipResourceName, "allocation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", eipResourceName, "public_ip"),
+					resource.TestCheckResourceAttrPair(resourceName, "transfer_account_id", "data.aws_caller_identity.accepter", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "transfer_offer_accepted_timestamp", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "transfer_offer_expiration_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_acceptance", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_acceptance"},
+			},
+		},
+	})
+}
+
+func TestAccEC2EIPTransfer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.AddressTransfer
+	resourceName := "aws_eip_transfer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPTransferExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceEIPTransfer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EIPTransfer_accepter(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.AddressTransfer
+	resourceName := "aws_eip_transfer.test"
+	accepterResourceName := "aws_eip_transfer_accepter.test"
+	eipResourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferConfig_accepter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPTransferExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(accepterResourceName, "address", eipResourceName, "public_ip"),
+					resource.TestCheckResourceAttrSet(accepterResourceName, "allocation_id"),
+					resource.TestCheckResourceAttr(accepterResourceName, "domain", "vpc"),
+				),
+				// The source account's aws_eip and aws_eip_transfer see the completed transfer on refresh.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEIPTransferDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eip_transfer" {
+				continue
+			}
+
+			_, err := tfec2.FindAddressTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 EIP Transfer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEIPTransferExists(ctx context.Context, n string, v *ec2.AddressTransfer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindAddressTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEIPTransferConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "accepter" {
+  provider = "awsalternate"
+}
+
+resource "aws_eip" "test" {
+  domain = "vpc"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccEIPTransferConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEIPTransferConfig_base(rName), `
+resource "aws_eip_transfer" "test" {
+  allocation_id       = aws_eip.test.allocation_id
+  transfer_account_id = data.aws_caller_identity.accepter.account_id
+}
+`)
+}
+
+func testAccEIPTransferConfig_accepter(rName string) string {
+	return acctest.ConfigCompose(testAccEIPTransferConfig_base(rName), `
+resource "aws_eip_transfer" "test" {
+  allocation_id       = aws_eip.test.allocation_id
+  transfer_account_id = data.aws_caller_identity.accepter.account_id
+}
+
+resource "aws_eip_transfer_accepter" "test" {
+  provider = "awsalternate"
+
+  address = aws_eip_transfer.test.public_ip
+}
+`)
+}
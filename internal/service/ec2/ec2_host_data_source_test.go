@@ -32,6 +32,7 @@ func TestAccEC2HostDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zone", resourceName, "availability_zone"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "cores"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_maintenance", resourceName, "host_maintenance"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "host_recovery", resourceName, "host_recovery"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "instance_family", resourceName, "instance_family"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
@@ -66,6 +67,7 @@ func TestAccEC2HostDataSource_filter(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zone", resourceName, "availability_zone"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "cores"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_maintenance", resourceName, "host_maintenance"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "host_recovery", resourceName, "host_recovery"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "instance_family", resourceName, "instance_family"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKDataSource("aws_ec2_hosts")
+func DataSourceHosts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceHostsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"filter": CustomFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_family": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.AllocationState_Values(), false),
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.DescribeHostsInput{
+		Filter: BuildAttributeFilterList(
+			map[string]string{
+				"state": d.Get("state").(string),
+			},
+		),
+	}
+
+	input.Filter = append(input.Filter, BuildTagFilterList(
+		Tags(tftags.New(ctx, d.Get("tags").(map[string]interface{}))),
+	)...)
+
+	input.Filter = append(input.Filter, BuildCustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filter) == 0 {
+		input.Filter = nil
+	}
+
+	output, err := FindHosts(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Hosts: %s", err)
+	}
+
+	// DescribeHosts has no instance family filter, so match on the host properties.
+	instanceFamily := d.Get("instance_family").(string)
+	var hostIDs []string
+
+	for _, v := range output {
+		if instanceFamily != "" && (v.HostProperties == nil || aws.StringValue(v.HostProperties.InstanceFamily) != instanceFamily) {
+			continue
+		}
+
+		hostIDs = append(hostIDs, aws.StringValue(v.HostId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", hostIDs)
+
+	return diags
+}
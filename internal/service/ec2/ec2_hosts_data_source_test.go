@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2HostsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_hosts.by_tags", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_ec2_hosts.by_tags", "ids.0", "aws_ec2_host.test", "id"),
+					resource.TestCheckResourceAttr("data.aws_ec2_hosts.by_instance_family", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_ec2_hosts.by_instance_family", "ids.0", "aws_ec2_host.test", "id"),
+					resource.TestCheckResourceAttr("data.aws_ec2_hosts.by_state", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHostsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "c5.large"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_hosts" "by_tags" {
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_host.test]
+}
+
+data "aws_ec2_hosts" "by_instance_family" {
+  instance_family = "c5"
+  state           = "available"
+
+  filter {
+    name   = "tag:Name"
+    values = [%[1]q]
+  }
+
+  depends_on = [aws_ec2_host.test]
+}
+
+data "aws_ec2_hosts" "by_state" {
+  state = "released"
+
+  filter {
+    name   = "tag:Name"
+    values = [%[1]q]
+  }
+
+  depends_on = [aws_ec2_host.test]
+}
+`, rName))
+}
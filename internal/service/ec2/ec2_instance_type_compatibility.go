@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// ValidateInstanceTypeCompatibility returns an error describing each reason that instances of the specified type
+// cannot be launched from the specified AMI with the specified number of network interfaces.
+// It is called from the service/autoscaling package.
+func ValidateInstanceTypeCompatibility(ctx context.Context, conn *ec2.EC2, instanceType, imageID string, networkInterfaceCount int) error {
+	instanceTypeInfo, err := FindInstanceTypeByName(ctx, conn, instanceType)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Instance Type (%s): %w", instanceType, err)
+	}
+
+	var image *ec2.Image
+
+	if imageID != "" {
+		image, err = FindImageByID(ctx, conn, imageID)
+
+		if err != nil {
+			return fmt.Errorf("reading EC2 AMI (%s): %w", imageID, err)
+		}
+	}
+
+	return instanceTypeCompatibilityError(instanceTypeInfo, image, networkInterfaceCount)
+}
+
+// instanceTypeCompatibilityError cross-checks an instance type's capabilities against an AMI (which may be nil)
+// and a number of network interfaces.
+// EC2 does not record whether an AMI includes NVMe drivers, so NVMe requirements are covered only indirectly
+// through the ENA and virtualization type checks.
+func instanceTypeCompatibilityError(instanceType *ec2.InstanceTypeInfo, image *ec2.Image, networkInterfaceCount int) error {
+	var errs []error
+	name := aws.StringValue(instanceType.InstanceType)
+
+	if v := instanceType.NetworkInfo; v != nil {
+		if maxNetworkInterfaces := int(aws.Int64Value(v.MaximumNetworkInterfaces)); maxNetworkInterfaces > 0 && networkInterfaceCount > maxNetworkInterfaces {
+			errs = append(errs, fmt.Errorf("instance type %s supports at most %d network interfaces, %d configured", name, maxNetworkInterfaces, networkInterfaceCount))
+		}
+	}
+
+	if image == nil {
+		return errors.Join(errs...)
+	}
+
+	imageID := aws.StringValue(image.ImageId)
+
+	if v := instanceType.ProcessorInfo; v != nil {
+		if architecture := aws.StringValue(image.Architecture); architecture != "" && !slices.Contains(aws.StringValueSlice(v.SupportedArchitectures), architecture) {
+			errs = append(errs, fmt.Errorf("instance type %s does not support AMI %s architecture (%s)", name, imageID, architecture))
+		}
+	}
+
+	if virtualizationType := aws.StringValue(image.VirtualizationType); virtualizationType != "" && len(instanceType.SupportedVirtualizationTypes) > 0 {
+		if !slices.Contains(aws.StringValueSlice(instanceType.SupportedVirtualizationTypes), virtualizationType) {
+			errs = append(errs, fmt.Errorf("instance type %s does not support AMI %s virtualization type (%s)", name, imageID, virtualizationType))
+		}
+	}
+
+	if bootMode := aws.StringValue(image.BootMode); (bootMode == ec2.BootModeValuesLegacyBios || bootMode == ec2.BootModeValuesUefi) && len(instanceType.SupportedBootModes) > 0 {
+		if !slices.Contains(aws.StringValueSlice(instanceType.SupportedBootModes), bootMode) {
+			errs = append(errs, fmt.Errorf("instance type %s does not support AMI %s boot mode (%s)", name, imageID, bootMode))
+		}
+	}
+
+	if v := instanceType.NetworkInfo; v != nil && aws.StringValue(v.EnaSupport) == ec2.EnaSupportRequired && !aws.BoolValue(image.EnaSupport) {
+		errs = append(errs, fmt.Errorf("instance type %s requires Elastic Network Adapter (ENA) support, which AMI %s does not have", name, imageID))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestInstanceTypeCompatibilityError(t *testing.T) {
+	t.Parallel()
+
+	instanceType := &ec2.InstanceTypeInfo{
+		InstanceType: aws.String("m7g.large"),
+		NetworkInfo: &ec2.NetworkInfo{
+			EnaSupport:               aws.String(ec2.EnaSupportRequired),
+			MaximumNetworkInterfaces: aws.Int64(3),
+		},
+		ProcessorInfo: &ec2.ProcessorInfo{
+			SupportedArchitectures: aws.StringSlice([]string{ec2.ArchitectureTypeArm64}),
+		},
+		SupportedBootModes:           aws.StringSlice([]string{ec2.BootModeTypeUefi}),
+		SupportedVirtualizationTypes: aws.StringSlice([]string{ec2.VirtualizationTypeHvm}),
+	}
+
+	testCases := map[string]struct {
+		image                 *ec2.Image
+		networkInterfaceCount int
+		expectedErrors        []string
+	}{
+		"no image": {},
+		"compatible": {
+			image: &ec2.Image{
+				Architecture:       aws.String(ec2.ArchitectureValuesArm64),
+				BootMode:           aws.String(ec2.BootModeValuesUefi),
+				EnaSupport:         aws.Bool(true),
+				ImageId:            aws.String("ami-12345678"),
+				VirtualizationType: aws.String(ec2.VirtualizationTypeHvm),
+			},
+			networkInterfaceCount: 3,
+		},
+		"too many network interfaces": {
+			networkInterfaceCount: 4,
+			expectedErrors:        []string{"at most 3 network interfaces"},
+		},
+		"incompatible": {
+			image: &ec2.Image{
+				Architecture:       aws.String(ec2.ArchitectureValuesX8664),
+				BootMode:           aws.String(ec2.BootModeValuesLegacyBios),
+				EnaSupport:         aws.Bool(false),
+				ImageId:            aws.String("ami-12345678"),
+				VirtualizationType: aws.String(ec2.VirtualizationTypeParavirtual),
+			},
+			expectedErrors: []string{"architecture (x86_64)", "virtualization type (paravirtual)", "boot mode (legacy-bios)", "Elastic Network Adapter"},
+		},
+		"uefi-preferred": {
+			image: &ec2.Image{
+				Architecture: aws.String(ec2.ArchitectureValuesArm64),
+				BootMode:     aws.String(ec2.BootModeValuesUefiPreferred),
+				EnaSupport:   aws.Bool(true),
+				ImageId:      aws.String("ami-12345678"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := instanceTypeCompatibilityError(instanceType, testCase.image, testCase.networkInterfaceCount)
+
+			if len(testCase.expectedErrors) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, v := range testCase.expectedErrors {
+				if !strings.Contains(err.Error(), v) {
+					t.Errorf("error %q does not contain %q", err, v)
+				}
+			}
+		})
+	}
+}
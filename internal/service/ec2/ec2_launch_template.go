@@ -938,6 +938,10 @@ func ResourceLaunchTemplate() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"validate_instance_type": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"vpc_security_group_ids": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -952,7 +956,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("default_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description":
+					case "name", "name_prefix", "description", "validate_instance_type":
 						continue
 					default:
 						return diff.Get("update_default_version").(bool)
@@ -963,7 +967,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("latest_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description", "default_version", "update_default_version":
+					case "name", "name_prefix", "description", "default_version", "update_default_version", "validate_instance_type":
 						continue
 					default:
 						return true
@@ -972,10 +976,33 @@ func ResourceLaunchTemplate() *schema.Resource {
 				return false
 			}),
 			verify.SetTagsDiff,
+			launchTemplateInstanceTypeCustomizeDiff,
 		),
 	}
 }
 
+// launchTemplateInstanceTypeCustomizeDiff checks, if enabled, that the configured instance type can launch the configured AMI
+// with the configured network interfaces.
+func launchTemplateInstanceTypeCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_instance_type").(bool) {
+		return nil
+	}
+
+	if !diff.NewValueKnown("instance_type") || !diff.NewValueKnown("image_id") || !diff.NewValueKnown("network_interfaces") {
+		return nil
+	}
+
+	instanceType := diff.Get("instance_type").(string)
+
+	if instanceType == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	return ValidateInstanceTypeCompatibility(ctx, conn, instanceType, diff.Get("image_id").(string), len(diff.Get("network_interfaces").([]interface{})))
+}
+
 func resourceLaunchTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
@@ -8,9 +8,13 @@ var (
 	ResourceInstanceConnectEndpoint  = newResourceInstanceConnectEndpoint
 	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
 	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
+	ResourceSecurityGroupRules       = newResourceSecurityGroupRules
 
 	UpdateTags   = updateTags
 	UpdateTagsV2 = updateTagsV2
 
 	StopInstance = stopInstance
+
+	FindAddressTransferByAllocationID = findAddressTransferByAllocationID
+	FindLockedSnapshotByID            = findLockedSnapshotByID
 )
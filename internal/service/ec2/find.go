@@ -6885,6 +6885,153 @@ func FindSnapshotTierStatusBySnapshotID(ctx context.Context, conn *ec2.EC2, id s
 	return output, nil
 }
 
+func findAddressTransfers(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeAddressTransfersInput) ([]*ec2.AddressTransfer, error) {
+	var output []*ec2.AddressTransfer
+
+	err := conn.DescribeAddressTransfersPagesWithContext(ctx, input, func(page *ec2.DescribeAddressTransfersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AddressTransfers {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAddressTransferByAllocationID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.AddressTransfer, error) {
+	input := &ec2.DescribeAddressTransfersInput{
+		AllocationIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := findAddressTransfers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	transfer := output[0]
+
+	if status := aws.StringValue(transfer.AddressTransferStatus); status == ec2.AddressTransferStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	// A transfer offer that was not accepted before it expired is returned to the source account.
+	if status := aws.StringValue(transfer.AddressTransferStatus); status == ec2.AddressTransferStatusPending && addressTransferExpired(transfer) {
+		return nil, &retry.NotFoundError{
+			Message:     "expired",
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(transfer.AllocationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return transfer, nil
+}
+
+func findLockedSnapshots(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLockedSnapshotsInput) ([]*ec2.LockedSnapshotsInfo, error) {
+	var output []*ec2.LockedSnapshotsInfo
+
+	for {
+		page, err := conn.DescribeLockedSnapshotsWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Snapshots {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func findLockedSnapshotByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.LockedSnapshotsInfo, error) {
+	input := &ec2.DescribeLockedSnapshotsInput{
+		SnapshotIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := findLockedSnapshots(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	// An expired lock no longer protects the snapshot.
+	if state := aws.StringValue(output[0].LockState); state == ec2.LockStateExpired {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output[0].SnapshotId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
 func FindNetworkPerformanceMetricSubscriptions(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeAwsNetworkPerformanceMetricSubscriptionsInput) ([]awstypes.Subscription, error) {
 	var output []awstypes.Subscription
 	paginator := ec2_sdkv2.NewDescribeAwsNetworkPerformanceMetricSubscriptionsPaginator(conn, input, func(o *ec2_sdkv2.DescribeAwsNetworkPerformanceMetricSubscriptionsPaginatorOptions) {
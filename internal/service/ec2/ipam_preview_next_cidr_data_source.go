@@ -40,8 +40,9 @@ func DataSourceIPAMPreviewNextCIDR() *schema.Resource {
 					Type: schema.TypeString,
 					ValidateFunc: validation.Any(
 						verify.ValidIPv4CIDRNetworkAddress,
+						verify.ValidIPv6CIDRNetworkAddress,
 						// Follow the numbers used for netmask_length
-						validation.IsCIDRNetwork(0, 32),
+						validation.IsCIDRNetwork(0, 128),
 					),
 				},
 			},
@@ -50,7 +51,8 @@ func DataSourceIPAMPreviewNextCIDR() *schema.Resource {
 				Required: true,
 			},
 			"netmask_length": {
-				// Possible netmask lengths for IPv4 addresses are 0 - 32.
+				// Possible netmask lengths for IPv4 addresses are 0 - 32
+				// and for IPv6 addresses are 0 - 128.
 				// AllocateIpamPoolCidr API
 				//   - If there is no DefaultNetmaskLength allocation rule set on the pool,
 				//   you must specify either the NetmaskLength or the CIDR.
@@ -59,7 +61,7 @@ func DataSourceIPAMPreviewNextCIDR() *schema.Resource {
 				//   DefaultNetmaskLength allocation rule will be ignored.
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ValidateFunc: validation.IntBetween(0, 32),
+				ValidateFunc: validation.IntBetween(0, 128),
 			},
 		},
 	}
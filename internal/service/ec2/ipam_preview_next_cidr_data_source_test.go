@@ -95,6 +95,31 @@ func TestAccIPAMPreviewNextCIDRDataSource_ipv4DisallowedCIDR(t *testing.T) {
 	})
 }
 
+func TestAccIPAMPreviewNextCIDRDataSource_ipv6Basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_vpc_ipam_preview_next_cidr.test"
+	netmaskLength := "56"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMPreviewNextCIDRDataSourceConfig_ipv6Disallowed(netmaskLength, "fd00:fd00::/56"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "cidr", "fd00:fd00:0:100::/56"),
+					resource.TestCheckResourceAttr(datasourceName, "disallowed_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "disallowed_cidrs.0", "fd00:fd00::/56"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "netmask_length", netmaskLength),
+				),
+			},
+		},
+	})
+}
+
 const testAccIPAMPreviewNextCIDRDataSourceConfig_base = `
 data "aws_region" "current" {}
 
@@ -172,3 +197,40 @@ data "aws_vpc_ipam_preview_next_cidr" "test" {
 }
 `, netmaskLength, disallowedCidr)
 }
+
+func testAccIPAMPreviewNextCIDRDataSourceConfig_ipv6Disallowed(netmaskLength, disallowedCidr string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = "test"
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv6"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+  locale         = data.aws_region.current.name
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "fd00:fd00::/52"
+}
+
+data "aws_vpc_ipam_preview_next_cidr" "test" {
+  ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  netmask_length = %[1]q
+
+  disallowed_cidrs = [
+    %[2]q
+  ]
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+`, netmaskLength, disallowedCidr)
+}
@@ -51,6 +51,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory: newResourceSecurityGroupRules,
+			Name:    "Security Group Rules",
+		},
 	}
 }
 
@@ -116,6 +120,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceHost,
 			TypeName: "aws_ec2_host",
 		},
+		{
+			Factory:  DataSourceHosts,
+			TypeName: "aws_ec2_hosts",
+		},
 		{
 			Factory:  DataSourceInstanceType,
 			TypeName: "aws_ec2_instance_type",
@@ -507,6 +515,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceEBSSnapshotLock,
+			TypeName: "aws_ebs_snapshot_lock",
+			Name:     "EBS Snapshot Lock",
+		},
 		{
 			Factory:  ResourceEBSVolume,
 			TypeName: "aws_ebs_volume",
@@ -684,6 +697,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceTransitGatewayDefaultRouteTableAssociation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_association",
+			Name:     "Transit Gateway Default Route Table Association",
+		},
+		{
+			Factory:  ResourceTransitGatewayDefaultRouteTablePropagation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_propagation",
+			Name:     "Transit Gateway Default Route Table Propagation",
+		},
 		{
 			Factory:  ResourceTransitGatewayMulticastDomain,
 			TypeName: "aws_ec2_transit_gateway_multicast_domain",
@@ -792,6 +815,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceEIPAssociation,
 			TypeName: "aws_eip_association",
 		},
+		{
+			Factory:  ResourceEIPTransfer,
+			TypeName: "aws_eip_transfer",
+			Name:     "EIP Transfer",
+		},
+		{
+			Factory:  ResourceEIPTransferAccepter,
+			TypeName: "aws_eip_transfer_accepter",
+			Name:     "EIP Transfer Accepter",
+		},
 		{
 			Factory:  ResourceFlowLog,
 			TypeName: "aws_flow_log",
@@ -1054,6 +1087,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceVPCEndpointServiceAllowedPrincipal,
 			TypeName: "aws_vpc_endpoint_service_allowed_principal",
 		},
+		{
+			Factory:  ResourceVPCEndpointServicePrivateDNSVerification,
+			TypeName: "aws_vpc_endpoint_service_private_dns_verification",
+			Name:     "VPC Endpoint Service Private DNS Verification",
+		},
 		{
 			Factory:  ResourceVPCEndpointSubnetAssociation,
 			TypeName: "aws_vpc_endpoint_subnet_association",
@@ -1238,6 +1238,26 @@ func StatusVPCEndpointServiceStateAvailable(ctx context.Context, conn *ec2.EC2,
 	}
 }
 
+func statusVPCEndpointServicePrivateDNSNameConfiguration(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVPCEndpointServiceConfigurationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.PrivateDnsNameConfiguration == nil {
+			return nil, "", nil
+		}
+
+		return output.PrivateDnsNameConfiguration, aws.StringValue(output.PrivateDnsNameConfiguration.State), nil
+	}
+}
+
 func StatusVPCEndpointServiceStateDeleted(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindVPCEndpointServiceConfigurationByID(ctx, conn, id)
@@ -1499,3 +1519,19 @@ func StatusVerifiedAccessEndpoint(ctx context.Context, conn *ec2_sdkv2.Client, i
 		return output, string(output.Status.Code), nil
 	}
 }
+
+func statusAddressTransfer(ctx context.Context, conn *ec2.EC2, allocationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAddressTransferByAllocationID(ctx, conn, allocationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AddressTransferStatus), nil
+	}
+}
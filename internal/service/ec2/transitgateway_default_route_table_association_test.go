@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccTransitGatewayDefaultRouteTableAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway_default_route_table_association.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDefaultRouteTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayDefaultRouteTableAssociationConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayDefaultRouteTableAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "original_default_route_table_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_default_route_table_id"},
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayDefaultRouteTableAssociation_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway_default_route_table_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDefaultRouteTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayDefaultRouteTableAssociationConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayDefaultRouteTableAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", "aws_ec2_transit_gateway_route_table.test", "id"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayDefaultRouteTableAssociationConfig_basic(rName, "other"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayDefaultRouteTableAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", "aws_ec2_transit_gateway_route_table.other", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayDefaultRouteTableAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway_default_route_table_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDefaultRouteTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayDefaultRouteTableAssociationConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayDefaultRouteTableAssociationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTransitGatewayDefaultRouteTableAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayDefaultRouteTableAssociationExists(ctx context.Context, n string, v *ec2.TransitGateway) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Default Route Table Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindTransitGatewayByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.StringValue(output.Options.AssociationDefaultRouteTableId), rs.Primary.Attributes["transit_gateway_route_table_id"]; got != want {
+			return fmt.Errorf("EC2 Transit Gateway (%s) default association route table is %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayDefaultRouteTableAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_transit_gateway_default_route_table_association" {
+				continue
+			}
+
+			output, err := tfec2.FindTransitGatewayByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.StringValue(output.Options.AssociationDefaultRouteTableId) == rs.Primary.Attributes["transit_gateway_route_table_id"] {
+				return fmt.Errorf("EC2 Transit Gateway Default Route Table Association %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayDefaultRouteTableAssociationConfig_basic(rName, routeTableName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "other" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_default_route_table_association" "test" {
+  transit_gateway_id             = aws_ec2_transit_gateway.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.%[2]s.id
+}
+`, rName, routeTableName)
+}
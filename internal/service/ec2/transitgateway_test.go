@@ -42,6 +42,16 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"InsideCidrBlocks":      testAccTransitGatewayConnectPeer_insideCIDRBlocks,
 			"TransitGatewayAddress": testAccTransitGatewayConnectPeer_TransitGatewayAddress,
 		},
+		"DefaultRouteTableAssociation": {
+			"basic":      testAccTransitGatewayDefaultRouteTableAssociation_basic,
+			"disappears": testAccTransitGatewayDefaultRouteTableAssociation_disappears,
+			"update":     testAccTransitGatewayDefaultRouteTableAssociation_update,
+		},
+		"DefaultRouteTablePropagation": {
+			"basic":      testAccTransitGatewayDefaultRouteTablePropagation_basic,
+			"disappears": testAccTransitGatewayDefaultRouteTablePropagation_disappears,
+			"update":     testAccTransitGatewayDefaultRouteTablePropagation_update,
+		},
 		"Gateway": {
 			"basic":                       testAccTransitGateway_basic,
 			"disappears":                  testAccTransitGateway_disappears,
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -46,7 +48,7 @@ func ResourceVerifiedAccessEndpoint() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"application_domain": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 			"attachment_type": {
@@ -55,6 +57,34 @@ func ResourceVerifiedAccessEndpoint() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(verifiedAccessAttachmentType_Values(), false),
 			},
+			"cidr_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						"port_range": verifiedAccessEndpointPortRangeSchema(),
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(verifiedAccessEndpointProtocol_Values(), false),
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -65,13 +95,13 @@ func ResourceVerifiedAccessEndpoint() *schema.Resource {
 			},
 			"domain_certificate_arn": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			"endpoint_domain_prefix": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 			"endpoint_domain": {
@@ -142,6 +172,53 @@ func ResourceVerifiedAccessEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"rds_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(verifiedAccessEndpointProtocol_Values(), false),
+						},
+						"rds_db_cluster_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"rds_db_instance_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"rds_db_proxy_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"rds_endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"security_group_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -179,8 +256,78 @@ func ResourceVerifiedAccessEndpoint() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			verifiedAccessEndpointCustomizeDiff,
+		),
+	}
+}
+
+func verifiedAccessEndpointPortRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"to_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+			},
+		},
+	}
+}
+
+// verifiedAccessEndpointCustomizeDiff validates that the options block and the
+// HTTP(S)-only arguments that are configured match the endpoint type.
+func verifiedAccessEndpointCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	endpointType := d.Get("endpoint_type").(string)
+
+	if endpointType == "" {
+		return nil
+	}
+
+	optionsBlocks := map[string]string{
+		verifiedAccessEndpointTypeCIDR:             "cidr_options",
+		verifiedAccessEndpointTypeLoadBalancer:     "load_balancer_options",
+		verifiedAccessEndpointTypeNetworkInterface: "network_interface_options",
+		verifiedAccessEndpointTypeRDS:              "rds_options",
+	}
+
+	for t, k := range optionsBlocks {
+		configured := len(d.Get(k).([]interface{})) > 0
+
+		if t == endpointType && !configured {
+			return fmt.Errorf("%q must be configured when endpoint_type is %q", k, endpointType)
+		}
+
+		if t != endpointType && configured {
+			return fmt.Errorf("%q cannot be configured when endpoint_type is %q", k, endpointType)
+		}
+	}
+
+	for _, k := range []string{"application_domain", "domain_certificate_arn", "endpoint_domain_prefix"} {
+		configured := !d.NewValueKnown(k) || d.Get(k).(string) != ""
+
+		switch endpointType {
+		case verifiedAccessEndpointTypeLoadBalancer, verifiedAccessEndpointTypeNetworkInterface:
+			if !configured {
+				return fmt.Errorf("%q must be configured when endpoint_type is %q", k, endpointType)
+			}
+		default:
+			if configured {
+				return fmt.Errorf("%q cannot be configured when endpoint_type is %q", k, endpointType)
+			}
+		}
 	}
+
+	return nil
 }
 
 func resourceVerifiedAccessEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -189,19 +336,32 @@ func resourceVerifiedAccessEndpointCreate(ctx context.Context, d *schema.Resourc
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 	input := &ec2.CreateVerifiedAccessEndpointInput{
-		ApplicationDomain:     aws.String(d.Get("application_domain").(string)),
 		AttachmentType:        types.VerifiedAccessEndpointAttachmentType(d.Get("attachment_type").(string)),
-		DomainCertificateArn:  aws.String(d.Get("domain_certificate_arn").(string)),
-		EndpointDomainPrefix:  aws.String(d.Get("endpoint_domain_prefix").(string)),
 		EndpointType:          types.VerifiedAccessEndpointType(d.Get("endpoint_type").(string)),
 		TagSpecifications:     getTagSpecificationsInV2(ctx, types.ResourceTypeVerifiedAccessEndpoint),
 		VerifiedAccessGroupId: aws.String(d.Get("verified_access_group_id").(string)),
 	}
 
+	if v, ok := d.GetOk("application_domain"); ok {
+		input.ApplicationDomain = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CidrOptions = expandCreateVerifiedAccessEndpointCidrOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("domain_certificate_arn"); ok {
+		input.DomainCertificateArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("endpoint_domain_prefix"); ok {
+		input.EndpointDomainPrefix = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("load_balancer_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.LoadBalancerOptions = expandCreateVerifiedAccessEndpointLoadBalancerOptions(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -214,6 +374,10 @@ func resourceVerifiedAccessEndpointCreate(ctx context.Context, d *schema.Resourc
 		input.PolicyDocument = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("rds_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RdsOptions = expandCreateVerifiedAccessEndpointRdsOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
 		input.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -256,6 +420,9 @@ func resourceVerifiedAccessEndpointRead(ctx context.Context, d *schema.ResourceD
 
 	d.Set("application_domain", ep.ApplicationDomain)
 	d.Set("attachment_type", ep.AttachmentType)
+	if err := d.Set("cidr_options", flattenVerifiedAccessEndpointCidrOptions(ep.CidrOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting cidr_options: %s", err)
+	}
 	d.Set("description", ep.Description)
 	d.Set("device_validation_domain", ep.DeviceValidationDomain)
 	d.Set("domain_certificate_arn", ep.DomainCertificateArn)
@@ -267,6 +434,9 @@ func resourceVerifiedAccessEndpointRead(ctx context.Context, d *schema.ResourceD
 	if err := d.Set("network_interface_options", flattenVerifiedAccessEndpointEniOptions(ep.NetworkInterfaceOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting network_interface_options: %s", err)
 	}
+	if err := d.Set("rds_options", flattenVerifiedAccessEndpointRdsOptions(ep.RdsOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rds_options: %s", err)
+	}
 	d.Set("security_group_ids", aws.StringSlice(ep.SecurityGroupIds))
 	if err := d.Set("sse_specification", flattenVerifiedAccessSseSpecificationRequest(ep.SseSpecification)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting sse_specification: %s", err)
@@ -294,6 +464,12 @@ func resourceVerifiedAccessEndpointUpdate(ctx context.Context, d *schema.Resourc
 			VerifiedAccessEndpointId: aws.String(d.Id()),
 		}
 
+		if d.HasChanges("cidr_options") {
+			if v, ok := d.GetOk("cidr_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CidrOptions = expandModifyVerifiedAccessEndpointCidrOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChanges("description") {
 			input.Description = aws.String(d.Get("description").(string))
 		}
@@ -310,6 +486,12 @@ func resourceVerifiedAccessEndpointUpdate(ctx context.Context, d *schema.Resourc
 			}
 		}
 
+		if d.HasChanges("rds_options") {
+			if v, ok := d.GetOk("rds_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.RdsOptions = expandModifyVerifiedAccessEndpointRdsOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChanges("verified_access_group_id") {
 			input.VerifiedAccessGroupId = aws.String(d.Get("verified_access_group_id").(string))
 		}
@@ -535,3 +717,198 @@ func expandCreateVerifiedAccessEndpointSseSpecification(tfMap map[string]interfa
 	}
 	return apiObject
 }
+
+func flattenVerifiedAccessEndpointCidrOptions(apiObject *types.VerifiedAccessEndpointCidrOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfmap := map[string]interface{}{}
+
+	if v := apiObject.Cidr; v != nil {
+		tfmap["cidr"] = aws.ToString(v)
+	}
+
+	if v := apiObject.PortRanges; v != nil {
+		tfmap["port_range"] = flattenVerifiedAccessEndpointPortRanges(v)
+	}
+
+	if v := apiObject.Protocol; v != "" {
+		tfmap["protocol"] = v
+	}
+
+	if v := apiObject.SubnetIds; v != nil {
+		tfmap["subnet_ids"] = aws.StringSlice(v)
+	}
+
+	return []interface{}{tfmap}
+}
+
+func flattenVerifiedAccessEndpointRdsOptions(apiObject *types.VerifiedAccessEndpointRdsOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfmap := map[string]interface{}{}
+
+	if v := apiObject.Port; v != nil {
+		tfmap["port"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.Protocol; v != "" {
+		tfmap["protocol"] = v
+	}
+
+	if v := apiObject.RdsDbClusterArn; v != nil {
+		tfmap["rds_db_cluster_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.RdsDbInstanceArn; v != nil {
+		tfmap["rds_db_instance_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.RdsDbProxyArn; v != nil {
+		tfmap["rds_db_proxy_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.RdsEndpoint; v != nil {
+		tfmap["rds_endpoint"] = aws.ToString(v)
+	}
+
+	if v := apiObject.SubnetIds; v != nil {
+		tfmap["subnet_ids"] = aws.StringSlice(v)
+	}
+
+	return []interface{}{tfmap}
+}
+
+func flattenVerifiedAccessEndpointPortRanges(apiObjects []types.VerifiedAccessEndpointPortRange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"from_port": aws.ToInt32(apiObject.FromPort),
+			"to_port":   aws.ToInt32(apiObject.ToPort),
+		})
+	}
+
+	return tfList
+}
+
+func expandCreateVerifiedAccessEndpointCidrOptions(tfMap map[string]interface{}) *types.CreateVerifiedAccessEndpointCidrOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CreateVerifiedAccessEndpointCidrOptions{}
+
+	if v, ok := tfMap["cidr"].(string); ok && v != "" {
+		apiObject.Cidr = aws.String(v)
+	}
+
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.PortRanges = append(apiObject.PortRanges, types.CreateVerifiedAccessEndpointPortRange{
+					FromPort: aws.Int32(int32(tfMap["from_port"].(int))),
+					ToPort:   aws.Int32(int32(tfMap["to_port"].(int))),
+				})
+			}
+		}
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = types.VerifiedAccessEndpointProtocol(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCreateVerifiedAccessEndpointRdsOptions(tfMap map[string]interface{}) *types.CreateVerifiedAccessEndpointRdsOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CreateVerifiedAccessEndpointRdsOptions{}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = types.VerifiedAccessEndpointProtocol(v)
+	}
+
+	if v, ok := tfMap["rds_db_cluster_arn"].(string); ok && v != "" {
+		apiObject.RdsDbClusterArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["rds_db_instance_arn"].(string); ok && v != "" {
+		apiObject.RdsDbInstanceArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["rds_db_proxy_arn"].(string); ok && v != "" {
+		apiObject.RdsDbProxyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["rds_endpoint"].(string); ok && v != "" {
+		apiObject.RdsEndpoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func expandModifyVerifiedAccessEndpointCidrOptions(tfMap map[string]interface{}) *types.ModifyVerifiedAccessEndpointCidrOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ModifyVerifiedAccessEndpointCidrOptions{}
+
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.PortRanges = append(apiObject.PortRanges, types.ModifyVerifiedAccessEndpointPortRange{
+					FromPort: aws.Int32(int32(tfMap["from_port"].(int))),
+					ToPort:   aws.Int32(int32(tfMap["to_port"].(int))),
+				})
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandModifyVerifiedAccessEndpointRdsOptions(tfMap map[string]interface{}) *types.ModifyVerifiedAccessEndpointRdsOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ModifyVerifiedAccessEndpointRdsOptions{}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["rds_endpoint"].(string); ok && v != "" {
+		apiObject.RdsEndpoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -240,6 +241,81 @@ func TestAccVerifiedAccessEndpoint_policyDocument(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedAccessEndpoint_cidr(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.VerifiedAccessEndpoint
+	resourceName := "aws_verifiedaccess_endpoint.test"
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckVerifiedAccess(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessEndpointConfig_cidr(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), 22),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "cidr"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cidr_options.0.cidr", "aws_subnet.test.0", "cidr_block"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.0.port_range.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cidr_options.0.port_range.*", map[string]string{
+						"from_port": "22",
+						"to_port":   "22",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.0.subnet_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessEndpointConfig_cidr(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), 3389),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cidr_options.0.port_range.*", map[string]string{
+						"from_port": "3389",
+						"to_port":   "3389",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessEndpoint_optionsMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckVerifiedAccess(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVerifiedAccessEndpointConfig_optionsMismatch(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate)),
+				ExpectError: regexache.MustCompile(`"load_balancer_options" cannot be configured when endpoint_type is "cidr"`),
+			},
+		},
+	})
+}
+
 func testAccCheckVerifiedAccessEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
@@ -535,3 +611,67 @@ resource "aws_verifiedaccess_endpoint" "test" {
 }
 `, rName, key, certificate, policyDocument))
 }
+
+func testAccVerifiedAccessEndpointConfig_cidr(rName, key, certificate string, port int) string {
+	return acctest.ConfigCompose(testAccVerifiedAccessEndpointConfig_base(rName, key, certificate), fmt.Sprintf(`
+resource "aws_verifiedaccess_endpoint" "test" {
+  attachment_type = "vpc"
+  description     = "example"
+  endpoint_type   = "cidr"
+
+  cidr_options {
+    cidr     = aws_subnet.test[0].cidr_block
+    protocol = "tcp"
+
+    port_range {
+      from_port = %[2]d
+      to_port   = %[2]d
+    }
+
+    subnet_ids = [for subnet in aws_subnet.test : subnet.id]
+  }
+
+  security_group_ids       = [aws_security_group.test.id]
+  verified_access_group_id = aws_verifiedaccess_group.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, port))
+}
+
+func testAccVerifiedAccessEndpointConfig_optionsMismatch(rName, key, certificate string) string {
+	return acctest.ConfigCompose(testAccVerifiedAccessEndpointConfig_base(rName, key, certificate), fmt.Sprintf(`
+resource "aws_verifiedaccess_endpoint" "test" {
+  attachment_type = "vpc"
+  endpoint_type   = "cidr"
+
+  cidr_options {
+    cidr     = aws_subnet.test[0].cidr_block
+    protocol = "tcp"
+
+    port_range {
+      from_port = 22
+      to_port   = 22
+    }
+
+    subnet_ids = [for subnet in aws_subnet.test : subnet.id]
+  }
+
+  load_balancer_options {
+    load_balancer_arn = aws_lb.test.arn
+    port              = 443
+    protocol          = "https"
+    subnet_ids        = [for subnet in aws_subnet.test : subnet.id]
+  }
+
+  security_group_ids       = [aws_security_group.test.id]
+  verified_access_group_id = aws_verifiedaccess_group.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
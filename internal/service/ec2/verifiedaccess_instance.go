@@ -36,6 +36,10 @@ func ResourceVerifiedAccessInstance() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"cidr_endpoints_custom_subdomain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"creation_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -53,6 +57,11 @@ func ResourceVerifiedAccessInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"name_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"verified_access_trust_providers": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -98,6 +107,10 @@ func resourceVerifiedAccessInstanceCreate(ctx context.Context, d *schema.Resourc
 		TagSpecifications: getTagSpecificationsInV2(ctx, types.ResourceTypeVerifiedAccessInstance),
 	}
 
+	if v, ok := d.GetOk("cidr_endpoints_custom_subdomain"); ok {
+		input.CidrEndpointsCustomSubDomain = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -133,6 +146,13 @@ func resourceVerifiedAccessInstanceRead(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "reading Verified Access Instance (%s): %s", d.Id(), err)
 	}
 
+	if v := output.CidrEndpointsCustomSubDomain; v != nil {
+		d.Set("cidr_endpoints_custom_subdomain", v.SubDomain)
+		d.Set("name_servers", v.Nameservers)
+	} else {
+		d.Set("cidr_endpoints_custom_subdomain", nil)
+		d.Set("name_servers", nil)
+	}
 	d.Set("creation_time", output.CreationTime)
 	d.Set("description", output.Description)
 	d.Set("fips_enabled", output.FipsEnabled)
@@ -161,6 +181,10 @@ func resourceVerifiedAccessInstanceUpdate(ctx context.Context, d *schema.Resourc
 			VerifiedAccessInstanceId: aws.String(d.Id()),
 		}
 
+		if d.HasChange("cidr_endpoints_custom_subdomain") {
+			input.CidrEndpointsCustomSubDomain = aws.String(d.Get("cidr_endpoints_custom_subdomain").(string))
+		}
+
 		if d.HasChange("description") {
 			input.Description = aws.String(d.Get("description").(string))
 		}
@@ -138,6 +138,47 @@ func TestAccVerifiedAccessInstance_fipsEnabled(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedAccessInstance_cidrEndpointsCustomSubdomain(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 types.VerifiedAccessInstance
+	resourceName := "aws_verifiedaccess_instance.test"
+	originalSubdomain := acctest.RandomSubdomain()
+	updatedSubdomain := acctest.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckVerifiedAccessInstance(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessInstanceConfig_cidrEndpointsCustomSubdomain(originalSubdomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "cidr_endpoints_custom_subdomain", originalSubdomain),
+					resource.TestCheckResourceAttrSet(resourceName, "name_servers.#"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessInstanceConfig_cidrEndpointsCustomSubdomain(updatedSubdomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessInstanceExists(ctx, resourceName, &v2),
+					testAccCheckVerifiedAccessInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "cidr_endpoints_custom_subdomain", updatedSubdomain),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVerifiedAccessInstance_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessInstance
@@ -318,6 +359,14 @@ resource "aws_verifiedaccess_instance" "test" {
 `, fipsEnabled)
 }
 
+func testAccVerifiedAccessInstanceConfig_cidrEndpointsCustomSubdomain(subdomain string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_instance" "test" {
+  cidr_endpoints_custom_subdomain = %[1]q
+}
+`, subdomain)
+}
+
 func testAccVerifiedAccessInstanceConfig_tags1(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_verifiedaccess_instance" "test" {
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -55,6 +57,11 @@ func ResourceVerifiedAccessTrustProvider() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"public_signing_key_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
 						"tenant_id": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -136,8 +143,54 @@ func ResourceVerifiedAccessTrustProvider() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			verifiedAccessTrustProviderCustomizeDiff,
+		),
+	}
+}
+
+// verifiedAccessTrustProviderCustomizeDiff validates the trust provider options against the trust provider type.
+func verifiedAccessTrustProviderCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	trustProviderType := types.TrustProviderType(d.Get("trust_provider_type").(string))
+	deviceTrustProviderType := types.DeviceTrustProviderType(d.Get("device_trust_provider_type").(string))
+
+	switch trustProviderType {
+	case types.TrustProviderTypeDevice:
+		if d.NewValueKnown("device_trust_provider_type") && deviceTrustProviderType == "" {
+			return fmt.Errorf(`"device_trust_provider_type" must be configured when trust_provider_type is %q`, trustProviderType)
+		}
+
+		if v := d.Get("user_trust_provider_type").(string); v != "" {
+			return fmt.Errorf(`"user_trust_provider_type" cannot be configured when trust_provider_type is %q`, trustProviderType)
+		}
+	case types.TrustProviderTypeUser:
+		if d.NewValueKnown("user_trust_provider_type") && d.Get("user_trust_provider_type").(string) == "" {
+			return fmt.Errorf(`"user_trust_provider_type" must be configured when trust_provider_type is %q`, trustProviderType)
+		}
+
+		if deviceTrustProviderType != "" {
+			return fmt.Errorf(`"device_trust_provider_type" cannot be configured when trust_provider_type is %q`, trustProviderType)
+		}
+
+		if len(d.Get("device_options").([]interface{})) > 0 {
+			return fmt.Errorf(`"device_options" cannot be configured when trust_provider_type is %q`, trustProviderType)
+		}
 	}
+
+	var tenantID string
+	if v, ok := d.Get("device_options").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tenantID = v[0].(map[string]interface{})["tenant_id"].(string)
+	}
+
+	switch deviceTrustProviderType {
+	case types.DeviceTrustProviderTypeJamf, types.DeviceTrustProviderTypeJumpcloud:
+		if d.NewValueKnown("device_options") && tenantID == "" {
+			return fmt.Errorf(`"device_options.0.tenant_id" must be configured when device_trust_provider_type is %q`, deviceTrustProviderType)
+		}
+	}
+
+	return nil
 }
 
 func resourceVerifiedAccessTrustProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -278,6 +331,10 @@ func flattenDeviceOptions(apiObject *types.DeviceOptions) []interface{} {
 
 	tfMap := map[string]interface{}{}
 
+	if v := apiObject.PublicSigningKeyUrl; v != nil {
+		tfMap["public_signing_key_url"] = aws.ToString(v)
+	}
+
 	if v := apiObject.TenantId; v != nil {
 		tfMap["tenant_id"] = aws.ToString(v)
 	}
@@ -323,6 +380,10 @@ func expandCreateVerifiedAccessTrustProviderDeviceOptions(tfMap map[string]inter
 
 	apiObject := &types.CreateVerifiedAccessTrustProviderDeviceOptions{}
 
+	if v, ok := tfMap["public_signing_key_url"].(string); ok && v != "" {
+		apiObject.PublicSigningKeyUrl = aws.String(v)
+	}
+
 	if v, ok := tfMap["tenant_id"].(string); ok && v != "" {
 		apiObject.TenantId = aws.String(v)
 	}
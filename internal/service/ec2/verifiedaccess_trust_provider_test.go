@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -99,6 +100,63 @@ func TestAccVerifiedAccessTrustProvider_deviceOptions(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedAccessTrustProvider_deviceOptionsCrowdStrike(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+	policyReferenceName := "test"
+	trustProviderType := "device"
+	deviceTrustProviderType := "crowdstrike"
+	publicSigningKeyURL := "https://example.com/public-signing-key"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckVerifiedAccess(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_deviceOptionsPublicSigningKeyURL(policyReferenceName, trustProviderType, deviceTrustProviderType, publicSigningKeyURL),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "device_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "device_options.0.public_signing_key_url", publicSigningKeyURL),
+					resource.TestCheckResourceAttr(resourceName, "device_trust_provider_type", deviceTrustProviderType),
+					resource.TestCheckResourceAttr(resourceName, "trust_provider_type", trustProviderType),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessTrustProvider_deviceOptionsJamfNoTenantID(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckVerifiedAccess(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVerifiedAccessTrustProviderConfig_deviceOptionsPublicSigningKeyURL("test", "device", "jamf", "https://example.com/public-signing-key"),
+				ExpectError: regexache.MustCompile(`"device_options.0.tenant_id" must be configured`),
+			},
+		},
+	})
+}
+
 func TestAccVerifiedAccessTrustProvider_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessTrustProvider
@@ -321,6 +379,19 @@ resource "aws_verifiedaccess_trust_provider" "test" {
 `, policyReferenceName, trustProviderType, deviceTrustProviderType, tenantId)
 }
 
+func testAccVerifiedAccessTrustProviderConfig_deviceOptionsPublicSigningKeyURL(policyReferenceName, trustProviderType, deviceTrustProviderType, publicSigningKeyURL string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_trust_provider" "test" {
+  device_options {
+    public_signing_key_url = %[4]q
+  }
+  device_trust_provider_type = %[3]q
+  policy_reference_name      = %[1]q
+  trust_provider_type        = %[2]q
+}
+`, policyReferenceName, trustProviderType, deviceTrustProviderType, publicSigningKeyURL)
+}
+
 func testAccVerifiedAccessTrustProviderConfig_oidcOptions(policyReferenceName, trustProviderType, userTrustProviderType, authorizationEndpoint, clientId, clientSecret, issuer, scope, tokenEndpoint, userInfoEndpoint string) string {
 	return fmt.Sprintf(`
 resource "aws_verifiedaccess_trust_provider" "test" {
@@ -6,12 +6,15 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
+	"net/url"
 	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
@@ -156,6 +159,12 @@ func ResourceVPCEndpoint() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"service_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -242,12 +251,17 @@ func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.SubnetIds = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
-	output, err := conn.CreateVpcEndpointWithContext(ctx, input)
+	var opts []request.Option
+	if v, ok := d.GetOk("service_region"); ok {
+		opts = append(opts, withVPCEndpointServiceRegion(v.(string)))
+	}
+
+	output, err := conn.CreateVpcEndpointWithContext(ctx, input, opts...)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
 	if input.TagSpecifications != nil && errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
 		input.TagSpecifications = nil
-		output, err = conn.CreateVpcEndpointWithContext(ctx, input)
+		output, err = conn.CreateVpcEndpointWithContext(ctx, input, opts...)
 	}
 
 	if err != nil {
@@ -337,6 +351,15 @@ func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	d.Set("vpc_id", vpce.VpcId)
 
+	// The service Region is not modeled by AWS SDK for Go v1.
+	vpceV2, err := findVPCEndpointByIDV2(ctx, meta.(*conns.AWSClient).EC2Client(ctx), d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("service_region", vpceV2.ServiceRegion)
+
 	if pl, err := FindPrefixListByName(ctx, conn, serviceName); err != nil {
 		if tfresource.NotFound(err) {
 			d.Set("cidr_blocks", nil)
@@ -493,6 +516,35 @@ func vpcEndpointAccept(ctx context.Context, conn *ec2.EC2, vpceID, serviceName s
 	return nil
 }
 
+// withVPCEndpointServiceRegion returns a request option that sets the ServiceRegion parameter
+// used to create cross-Region VPC endpoints. The parameter is not modeled by AWS SDK for Go v1.
+func withVPCEndpointServiceRegion(region string) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Build.PushBack(func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			body, err := io.ReadAll(r.GetBody())
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			values, err := url.ParseQuery(string(body))
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			values.Set("ServiceRegion", region)
+			r.SetBufferBody([]byte(values.Encode()))
+		})
+	}
+}
+
 func isAmazonS3VPCEndpoint(serviceName string) bool {
 	ok, _ := regexp.MatchString("com\\.amazonaws\\.([a-z]+\\-[a-z]+\\-[0-9])\\.s3", serviceName)
 	return ok
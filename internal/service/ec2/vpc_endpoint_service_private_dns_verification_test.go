@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCEndpointServicePrivateDNSVerification_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_endpoint_service_private_dns_verification.test"
+	serviceResourceName := "aws_vpc_endpoint_service.test"
+	rName := sdkacctest.RandomWithPrefix("tfacctest") // 32 character limit
+	domainName := acctest.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name", domainName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", serviceResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "verification_state"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_verification", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, dnsName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_privateDNSName(rName, dnsName), `
+resource "aws_vpc_endpoint_service_private_dns_verification" "test" {
+  service_id = aws_vpc_endpoint_service.test.id
+}
+`)
+}
@@ -6,6 +6,7 @@ package ec2
 import (
 	"context"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -56,6 +57,11 @@ func ResourceNetworkInsightsAnalysis() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"explanation_codes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"explanations": networkInsightsAnalysisExplanationsSchema,
 			"filter_in_arns": {
 				Type:     schema.TypeSet,
@@ -91,6 +97,12 @@ func ResourceNetworkInsightsAnalysis() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"wait_for_completion": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -1457,6 +1469,7 @@ func resourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "setting alternate_path_hints: %s", err)
 	}
 	d.Set("arn", output.NetworkInsightsAnalysisArn)
+	d.Set("explanation_codes", flattenExplanationCodes(output.Explanations))
 	if err := d.Set("explanations", flattenExplanations(output.Explanations)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting explanations: %s", err)
 	}
@@ -2029,6 +2042,23 @@ func flattenExplanations(apiObjects []*ec2.Explanation) []interface{} {
 	return tfList
 }
 
+// flattenExplanationCodes returns the distinct explanation codes, for simple assertions on why a path was not found.
+func flattenExplanationCodes(apiObjects []*ec2.Explanation) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.ExplanationCode == nil {
+			continue
+		}
+
+		if v := aws.StringValue(apiObject.ExplanationCode); !slices.Contains(tfList, v) {
+			tfList = append(tfList, v)
+		}
+	}
+
+	return tfList
+}
+
 func flattenPathComponent(apiObject *ec2.PathComponent) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -43,6 +43,11 @@ func DataSourceNetworkInsightsAnalysis() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"explanation_codes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"explanations": networkInsightsAnalysisExplanationsSchema,
 			"filter":       CustomFiltersSchema(),
 			"filter_in_arns": {
@@ -119,6 +124,7 @@ func dataSourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "setting alternate_path_hints: %s", err)
 	}
 	d.Set("arn", output.NetworkInsightsAnalysisArn)
+	d.Set("explanation_codes", flattenExplanationCodes(output.Explanations))
 	if err := d.Set("explanations", flattenExplanations(output.Explanations)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting explanations: %s", err)
 	}
@@ -35,6 +35,7 @@ func TestAccVPCNetworkInsightsAnalysis_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckNetworkInsightsAnalysisExists(ctx, resourceName),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexache.MustCompile(`network-insights-analysis/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "explanation_codes.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "filter_in_arns.#", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "network_insights_path_id", "aws_ec2_network_insights_path.test", "id"),
 					resource.TestCheckResourceAttr(resourceName, "path_found", "true"),
@@ -158,6 +159,47 @@ func TestAccVPCNetworkInsightsAnalysis_filterInARNs(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInsightsAnalysis_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var id string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "1"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources[resourceName].Primary.ID; got == id {
+							return fmt.Errorf("expected a new analysis when triggers change, got %s again", got)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCNetworkInsightsAnalysis_waitForCompletion(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ec2_network_insights_analysis.test"
@@ -319,3 +361,19 @@ resource "aws_ec2_network_insights_analysis" "test" {
 }
 `, rName, waitForCompletion))
 }
+
+func testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, run string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+
+  triggers = {
+    run = %[2]q
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, run))
+}
@@ -0,0 +1,599 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Maximum number of rules authorized or revoked in a single API call.
+	securityGroupRulesBatchSize = 100
+)
+
+// @FrameworkResource(name="Security Group Rules")
+func newResourceSecurityGroupRules(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSecurityGroupRules{}, nil
+}
+
+type resourceSecurityGroupRules struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceSecurityGroupRules) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_rules"
+}
+
+func (r *resourceSecurityGroupRules) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	sourceAttributeNames := []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"}
+	sourceValidator := func(name string) validator.String {
+		var expressions []path.Expression
+		for _, v := range sourceAttributeNames {
+			if v != name {
+				expressions = append(expressions, path.MatchRelative().AtParent().AtName(v))
+			}
+		}
+		return stringvalidator.ExactlyOneOf(expressions...)
+	}
+	ruleBlock := schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[securityGroupRulesRuleModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"cidr_ipv4": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fwvalidators.IPv4CIDRNetworkAddress(),
+						sourceValidator("cidr_ipv4"),
+					},
+				},
+				"cidr_ipv6": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fwvalidators.IPv6CIDRNetworkAddress(),
+						sourceValidator("cidr_ipv6"),
+					},
+				},
+				"description": schema.StringAttribute{
+					Optional: true,
+				},
+				"from_port": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(-1, 65535),
+					},
+				},
+				"ip_protocol": schema.StringAttribute{
+					Required: true,
+				},
+				"prefix_list_id": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						sourceValidator("prefix_list_id"),
+					},
+				},
+				"referenced_security_group_id": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						sourceValidator("referenced_security_group_id"),
+					},
+				},
+				"to_port": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(-1, 65535),
+					},
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"egress":  ruleBlock,
+			"ingress": ruleBlock,
+		},
+	}
+}
+
+func (r *resourceSecurityGroupRules) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSecurityGroupRulesData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+
+	if err := r.reconcile(ctx, &data, &response.Diagnostics); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Security Group Rules (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(securityGroupID)
+
+	response.Diagnostics.Append(r.read(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSecurityGroupRules) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSecurityGroupRulesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	_, err := FindSecurityGroupByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		tflog.Warn(ctx, "VPC Security Group not found, removing VPC Security Group Rules from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.SecurityGroupID = data.ID
+
+	response.Diagnostics.Append(r.read(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSecurityGroupRules) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data resourceSecurityGroupRulesData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, &data, &response.Diagnostics); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group Rules (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.read(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSecurityGroupRules) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSecurityGroupRulesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	tflog.Debug(ctx, "deleting VPC Security Group Rules", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	var ruleIDs []string
+	response.Diagnostics.Append(data.SecurityGroupRuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s) rules", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Only revoke the rules that are still present.
+	managed := make(map[string]struct{}, len(ruleIDs))
+	for _, v := range ruleIDs {
+		managed[v] = struct{}{}
+	}
+	var ingress, egress []string
+	for _, v := range rules {
+		id := aws.StringValue(v.SecurityGroupRuleId)
+		if _, ok := managed[id]; !ok {
+			continue
+		}
+
+		if aws.BoolValue(v.IsEgress) {
+			egress = append(egress, id)
+		} else {
+			ingress = append(ingress, id)
+		}
+	}
+
+	err = r.revoke(ctx, data.ID.ValueString(), ingress, egress)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Security Group Rules (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceSecurityGroupRules) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+// reconcile authorizes all planned rules that are not yet present, updates the descriptions
+// of planned rules whose description has changed and then revokes all of the security group's
+// rules that are not in the planned rule set.
+// Rules are authorized before any are revoked so that traffic allowed by both the old and
+// new rule sets is never interrupted.
+func (r *resourceSecurityGroupRules) reconcile(ctx context.Context, data *resourceSecurityGroupRulesData, diags *diag.Diagnostics) error {
+	conn := r.Meta().EC2Conn(ctx)
+	securityGroupID := data.SecurityGroupID.ValueString()
+
+	ingress, d := data.Ingress.ToSlice(ctx)
+	diags.Append(d...)
+	egress, d := data.Egress.ToSlice(ctx)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	existing, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return err
+	}
+
+	wantIngress, wantEgress := make(map[string]*securityGroupRulesRuleModel), make(map[string]*securityGroupRulesRuleModel)
+	for _, v := range ingress {
+		wantIngress[v.key(r.Meta().AccountID)] = v
+	}
+	for _, v := range egress {
+		wantEgress[v.key(r.Meta().AccountID)] = v
+	}
+
+	haveIngress, haveEgress := make(map[string]struct{}), make(map[string]struct{})
+	var revokeIngress, revokeEgress []string
+	var describeIngress, describeEgress []*ec2.IpPermission
+	for _, v := range existing {
+		rule := r.flattenSecurityGroupRule(ctx, v)
+		key := rule.key(r.Meta().AccountID)
+		id := aws.StringValue(v.SecurityGroupRuleId)
+
+		if aws.BoolValue(v.IsEgress) {
+			if want, ok := wantEgress[key]; ok {
+				haveEgress[key] = struct{}{}
+				if want.Description.ValueString() != rule.Description.ValueString() {
+					describeEgress = append(describeEgress, want.expandIPPermission(ctx))
+				}
+			} else {
+				revokeEgress = append(revokeEgress, id)
+			}
+		} else {
+			if want, ok := wantIngress[key]; ok {
+				haveIngress[key] = struct{}{}
+				if want.Description.ValueString() != rule.Description.ValueString() {
+					describeIngress = append(describeIngress, want.expandIPPermission(ctx))
+				}
+			} else {
+				revokeIngress = append(revokeIngress, id)
+			}
+		}
+	}
+
+	var authorizeIngress, authorizeEgress []*ec2.IpPermission
+	for _, v := range ingress {
+		if _, ok := haveIngress[v.key(r.Meta().AccountID)]; !ok {
+			authorizeIngress = append(authorizeIngress, v.expandIPPermission(ctx))
+		}
+	}
+	for _, v := range egress {
+		if _, ok := haveEgress[v.key(r.Meta().AccountID)]; !ok {
+			authorizeEgress = append(authorizeEgress, v.expandIPPermission(ctx))
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(authorizeIngress, securityGroupRulesBatchSize) {
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: chunk,
+		}
+
+		if _, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("authorizing ingress rules: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(authorizeEgress, securityGroupRulesBatchSize) {
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: chunk,
+		}
+
+		if _, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("authorizing egress rules: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(describeIngress, securityGroupRulesBatchSize) {
+		input := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: chunk,
+		}
+
+		if _, err := conn.UpdateSecurityGroupRuleDescriptionsIngressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating ingress rule descriptions: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(describeEgress, securityGroupRulesBatchSize) {
+		input := &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: chunk,
+		}
+
+		if _, err := conn.UpdateSecurityGroupRuleDescriptionsEgressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating egress rule descriptions: %w", err)
+		}
+	}
+
+	return r.revoke(ctx, securityGroupID, revokeIngress, revokeEgress)
+}
+
+func (r *resourceSecurityGroupRules) revoke(ctx context.Context, securityGroupID string, ingress, egress []string) error {
+	conn := r.Meta().EC2Conn(ctx)
+
+	for _, chunk := range tfslices.Chunks(ingress, securityGroupRulesBatchSize) {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(chunk),
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("revoking ingress rules: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(egress, securityGroupRulesBatchSize) {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(chunk),
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("revoking egress rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// read sets the security group's complete rule set, preserving the configured representation
+// of rules that are semantically equivalent to those returned by the API.
+func (r *resourceSecurityGroupRules) read(ctx context.Context, data *resourceSecurityGroupRulesData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().EC2Conn(ctx)
+
+	output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading VPC Security Group (%s) rules", data.ID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	priorIngress, d := data.Ingress.ToSlice(ctx)
+	diags.Append(d...)
+	priorEgress, d := data.Egress.ToSlice(ctx)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	prior := func(rules []*securityGroupRulesRuleModel) map[string]*securityGroupRulesRuleModel {
+		m := make(map[string]*securityGroupRulesRuleModel, len(rules))
+		for _, v := range rules {
+			m[v.key(r.Meta().AccountID)] = v
+		}
+		return m
+	}
+	ingressByKey, egressByKey := prior(priorIngress), prior(priorEgress)
+
+	var ingress, egress []securityGroupRulesRuleModel
+	var ruleIDs []string
+	for _, v := range output {
+		rule := r.flattenSecurityGroupRule(ctx, v)
+		key := rule.key(r.Meta().AccountID)
+
+		if aws.BoolValue(v.IsEgress) {
+			egress = append(egress, rule.preserve(egressByKey[key]))
+		} else {
+			ingress = append(ingress, rule.preserve(ingressByKey[key]))
+		}
+
+		ruleIDs = append(ruleIDs, aws.StringValue(v.SecurityGroupRuleId))
+	}
+
+	data.Egress = fwtypes.NewSetNestedObjectValueOfValueSlice(ctx, egress)
+	data.Ingress = fwtypes.NewSetNestedObjectValueOfValueSlice(ctx, ingress)
+	data.SecurityGroupRuleIDs = flex.FlattenFrameworkStringValueSet(ctx, ruleIDs)
+
+	return diags
+}
+
+func (r *resourceSecurityGroupRules) flattenSecurityGroupRule(ctx context.Context, apiObject *ec2.SecurityGroupRule) securityGroupRulesRuleModel {
+	rule := securityGroupRulesRuleModel{
+		CIDRIPv4:                  flex.StringToFramework(ctx, apiObject.CidrIpv4),
+		CIDRIPv6:                  flex.StringToFramework(ctx, apiObject.CidrIpv6),
+		Description:               flex.StringToFramework(ctx, apiObject.Description),
+		FromPort:                  flex.Int64ToFramework(ctx, apiObject.FromPort),
+		IPProtocol:                flex.StringToFramework(ctx, apiObject.IpProtocol),
+		PrefixListID:              flex.StringToFramework(ctx, apiObject.PrefixListId),
+		ReferencedSecurityGroupID: types.StringNull(),
+		ToPort:                    flex.Int64ToFramework(ctx, apiObject.ToPort),
+	}
+
+	if v := apiObject.ReferencedGroupInfo; v != nil {
+		if v.UserId == nil || aws.StringValue(v.UserId) == r.Meta().AccountID {
+			rule.ReferencedSecurityGroupID = flex.StringToFramework(ctx, v.GroupId)
+		} else {
+			// [UserID/]GroupID.
+			rule.ReferencedSecurityGroupID = types.StringValue(strings.Join([]string{aws.StringValue(v.UserId), aws.StringValue(v.GroupId)}, "/"))
+		}
+	}
+
+	return rule
+}
+
+type resourceSecurityGroupRulesData struct {
+	Egress               fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel] `tfsdk:"egress"`
+	ID                   types.String                                                `tfsdk:"id"`
+	Ingress              fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel] `tfsdk:"ingress"`
+	SecurityGroupID      types.String                                                `tfsdk:"security_group_id"`
+	SecurityGroupRuleIDs types.Set                                                   `tfsdk:"security_group_rule_ids"`
+}
+
+type securityGroupRulesRuleModel struct {
+	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
+	Description               types.String `tfsdk:"description"`
+	FromPort                  types.Int64  `tfsdk:"from_port"`
+	IPProtocol                types.String `tfsdk:"ip_protocol"`
+	PrefixListID              types.String `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
+	ToPort                    types.Int64  `tfsdk:"to_port"`
+}
+
+// key returns a string that uniquely identifies the rule's semantics.
+// Protocol names and numbers, unset and -1 ports and the owning account ID prefix
+// of same-account referenced security groups are all normalized.
+// The description is not part of the key as it can be updated in place.
+func (m securityGroupRulesRuleModel) key(accountID string) string {
+	port := func(v types.Int64) int64 {
+		if v.IsNull() || v.IsUnknown() {
+			return -1
+		}
+		return v.ValueInt64()
+	}
+
+	var source string
+	switch {
+	case !m.CIDRIPv4.IsNull():
+		source = "cidr_ipv4=" + m.CIDRIPv4.ValueString()
+	case !m.CIDRIPv6.IsNull():
+		source = "cidr_ipv6=" + m.CIDRIPv6.ValueString()
+	case !m.PrefixListID.IsNull():
+		source = "prefix_list_id=" + m.PrefixListID.ValueString()
+	case !m.ReferencedSecurityGroupID.IsNull():
+		source = "referenced_security_group_id=" + strings.TrimPrefix(m.ReferencedSecurityGroupID.ValueString(), accountID+"/")
+	}
+
+	return fmt.Sprintf("%s|%d|%d|%s", ProtocolForValue(m.IPProtocol.ValueString()), port(m.FromPort), port(m.ToPort), source)
+}
+
+// preserve returns the prior representation of a semantically equivalent rule, if any,
+// with the description reported by the API.
+func (m securityGroupRulesRuleModel) preserve(prior *securityGroupRulesRuleModel) securityGroupRulesRuleModel {
+	if prior == nil {
+		return m
+	}
+
+	rule := *prior
+	if rule.Description.ValueString() != m.Description.ValueString() {
+		rule.Description = m.Description
+	}
+
+	return rule
+}
+
+func (m *securityGroupRulesRuleModel) expandIPPermission(ctx context.Context) *ec2.IpPermission {
+	data := &resourceSecurityGroupRuleData{
+		CIDRIPv4:                  m.CIDRIPv4,
+		CIDRIPv6:                  m.CIDRIPv6,
+		Description:               m.Description,
+		FromPort:                  m.FromPort,
+		IPProtocol:                m.IPProtocol,
+		PrefixListID:              m.PrefixListID,
+		ReferencedSecurityGroupID: m.ReferencedSecurityGroupID,
+		ToPort:                    m.ToPort,
+	}
+
+	return (&resourceSecurityGroupRule{}).expandIPPermission(ctx, data)
+}
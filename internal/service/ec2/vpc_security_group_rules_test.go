@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccVPCSecurityGroupRules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v []*ec2.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "egress.*", map[string]string{
+						"cidr_ipv4":   "0.0.0.0/0",
+						"ip_protocol": "-1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"from_port":   "80",
+						"ip_protocol": "tcp",
+						"to_port":     "80",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv6":   "::/0",
+						"description": "https",
+						"from_port":   "443",
+						"ip_protocol": "tcp",
+						"to_port":     "443",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule_ids.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v []*ec2.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceSecurityGroupRules, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v []*ec2.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule_ids.#", "3"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"from_port":   "80",
+						"ip_protocol": "tcp",
+						"to_port":     "80",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"from_port":   "22",
+						"ip_protocol": "6",
+						"to_port":     "22",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "ingress.*.referenced_security_group_id", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 []*ec2.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_description(rName, "description1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.description", "description1"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_description(rName, "description2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExists(ctx, resourceName, &v2),
+					testAccCheckSecurityGroupRulesNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_security_group_rules" {
+				continue
+			}
+
+			output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("VPC Security Group Rules %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesExists(ctx context.Context, n string, v *[]*ec2.SecurityGroupRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Security Group Rules ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesNotRecreated(before, after *[]*ec2.SecurityGroupRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(*before) != len(*after) {
+			return fmt.Errorf("VPC Security Group Rules count changed: %d != %d", len(*before), len(*after))
+		}
+
+		ids := make(map[string]bool, len(*before))
+		for _, v := range *before {
+			ids[aws.StringValue(v.SecurityGroupRuleId)] = true
+		}
+
+		for _, v := range *after {
+			if id := aws.StringValue(v.SecurityGroupRuleId); !ids[id] {
+				return fmt.Errorf("VPC Security Group Rule %s was recreated", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCSecurityGroupRulesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  egress {
+    cidr_ipv4   = "0.0.0.0/0"
+    ip_protocol = "-1"
+  }
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 80
+    ip_protocol = "tcp"
+    to_port     = 80
+  }
+
+  ingress {
+    cidr_ipv6   = "::/0"
+    description = "https"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 80
+    ip_protocol = "tcp"
+    to_port     = 80
+  }
+
+  ingress {
+    from_port                    = 22
+    ip_protocol                  = "6"
+    referenced_security_group_id = aws_security_group.test.id
+    to_port                      = 22
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesConfig_description(rName, description string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    description = %[1]q
+    from_port   = 80
+    ip_protocol = "tcp"
+    to_port     = 80
+  }
+}
+`, description))
+}
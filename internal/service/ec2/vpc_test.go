@@ -66,10 +66,28 @@ func TestAccVPC_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccVPCImportStateIDConsoleURLFunc(resourceName),
+				ImportStateVerify: true,
+				SkipFunc:          func() (bool, error) { return acctest.Partition() != names.StandardPartitionID, nil },
+			},
 		},
 	})
 }
 
+func testAccVPCImportStateIDConsoleURLFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("https://%[1]s.console.aws.amazon.com/vpc/home?region=%[1]s#VpcDetails:VpcId=%[2]s", acctest.Region(), rs.Primary.ID), nil
+	}
+}
+
 func TestAccVPC_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var vpc awstypes.Vpc
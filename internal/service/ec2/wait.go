@@ -2607,6 +2607,25 @@ func WaitVPCEndpointServiceAvailable(ctx context.Context, conn *ec2.EC2, id stri
 	return nil, err
 }
 
+func waitVPCEndpointServicePrivateDNSNameVerified(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.PrivateDnsNameConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ec2.DnsNameStatePendingVerification},
+		Target:     []string{ec2.DnsNameStateVerified},
+		Refresh:    statusVPCEndpointServicePrivateDNSNameConfiguration(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.PrivateDnsNameConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.ServiceConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{ec2.ServiceStateAvailable, ec2.ServiceStateDeleting},
@@ -3160,3 +3179,34 @@ func WaitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Clie
 
 	return nil, err
 }
+
+// waitAddressTransferAccepted waits for a transfer offer to be accepted.
+// The wait ends early with an error when the offer expires.
+func waitAddressTransferAccepted(ctx context.Context, conn *ec2.EC2, allocationID string, timeout time.Duration) (*ec2.AddressTransfer, error) {
+	transfer, err := findAddressTransferByAllocationID(ctx, conn, allocationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if v := aws.TimeValue(transfer.TransferOfferExpirationTimestamp); !v.IsZero() {
+		if untilExpiry := time.Until(v); untilExpiry < timeout {
+			timeout = untilExpiry
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.AddressTransferStatusPending},
+		Target:  []string{ec2.AddressTransferStatusAccepted},
+		Refresh: statusAddressTransfer(ctx, conn, allocationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.AddressTransfer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
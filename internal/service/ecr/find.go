@@ -7,18 +7,19 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func FindPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.ECR, repositoryPrefix string) (*ecr.PullThroughCacheRule, error) {
+func FindPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.ECR, repositoryPrefix string, optFns ...request.Option) (*ecr.PullThroughCacheRule, error) {
 	input := ecr.DescribePullThroughCacheRulesInput{
 		EcrRepositoryPrefixes: aws.StringSlice([]string{repositoryPrefix}),
 	}
 
-	output, err := conn.DescribePullThroughCacheRulesWithContext(ctx, &input)
+	output, err := conn.DescribePullThroughCacheRulesWithContext(ctx, &input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, ecr.ErrCodePullThroughCacheRuleNotFoundException) {
 		return nil, &retry.NotFoundError{
@@ -41,3 +42,32 @@ func FindPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.E
 
 	return output.PullThroughCacheRules[0], nil
 }
+
+func FindRepositoryCreationTemplateByPrefix(ctx context.Context, conn *ecr.ECR, prefix string) (*ecr.RepositoryCreationTemplate, string, error) {
+	input := &ecr.DescribeRepositoryCreationTemplatesInput{
+		Prefixes: aws.StringSlice([]string{prefix}),
+	}
+
+	output, err := conn.DescribeRepositoryCreationTemplatesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeTemplateNotFoundException) {
+		return nil, "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if output == nil || len(output.RepositoryCreationTemplates) == 0 || output.RepositoryCreationTemplates[0] == nil {
+		return nil, "", tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.RepositoryCreationTemplates); count > 1 {
+		return nil, "", tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.RepositoryCreationTemplates[0], aws.StringValue(output.RegistryId), nil
+}
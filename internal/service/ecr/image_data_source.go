@@ -6,7 +6,9 @@ package ecr
 import (
 	"context"
 	"fmt"
+	"regexp"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -28,8 +30,8 @@ func DataSourceImage() *schema.Resource {
 				Type:          schema.TypeString,
 				Computed:      true,
 				Optional:      true,
-				AtLeastOneOf:  []string{"image_digest", "image_tag", "most_recent"},
-				ConflictsWith: []string{"most_recent"},
+				AtLeastOneOf:  []string{"image_digest", "image_tag", "image_tag_regex", "most_recent"},
+				ConflictsWith: []string{"image_tag_regex", "most_recent"},
 			},
 			"image_pushed_at": {
 				Type:     schema.TypeInt,
@@ -42,8 +44,15 @@ func DataSourceImage() *schema.Resource {
 			"image_tag": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				AtLeastOneOf:  []string{"image_digest", "image_tag", "most_recent"},
-				ConflictsWith: []string{"most_recent"},
+				AtLeastOneOf:  []string{"image_digest", "image_tag", "image_tag_regex", "most_recent"},
+				ConflictsWith: []string{"image_tag_regex", "most_recent"},
+			},
+			"image_tag_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"image_digest", "image_tag", "image_tag_regex", "most_recent"},
+				ConflictsWith: []string{"image_digest", "image_tag"},
+				ValidateFunc:  validation.StringIsValidRegExp,
 			},
 			"image_tags": {
 				Type:     schema.TypeList,
@@ -57,7 +66,7 @@ func DataSourceImage() *schema.Resource {
 			"most_recent": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				AtLeastOneOf:  []string{"image_digest", "image_tag", "most_recent"},
+				AtLeastOneOf:  []string{"image_digest", "image_tag", "image_tag_regex", "most_recent"},
 				ConflictsWith: []string{"image_digest", "image_tag"},
 			},
 			"registry_id": {
@@ -102,7 +111,14 @@ func dataSourceImageRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	if v, ok := d.Get("most_recent").(bool); ok && v {
+	var tagRegex *regexp.Regexp
+
+	if v, ok := d.GetOk("image_tag_regex"); ok {
+		tagRegex = regexache.MustCompile(v.(string))
+		input.Filter = &ecr.DescribeImagesFilter{
+			TagStatus: aws.String(ecr.TagStatusTagged),
+		}
+	} else if v, ok := d.Get("most_recent").(bool); ok && v {
 		if len(input.ImageIds) == 0 {
 			input.ImageIds = []*ecr.ImageIdentifier{
 				{
@@ -124,6 +140,12 @@ func dataSourceImageRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading ECR Images: %s", err)
 	}
 
+	if tagRegex != nil {
+		imageDetails = slices.DeleteFunc(imageDetails, func(v *ecr.ImageDetail) bool {
+			return !slices.ContainsFunc(aws.StringValueSlice(v.ImageTags), tagRegex.MatchString)
+		})
+	}
+
 	if len(imageDetails) == 0 {
 		return sdkdiag.AppendErrorf(diags, "Your query returned no results. Please change your search criteria and try again.")
 	}
@@ -18,6 +18,7 @@ func TestAccECRImageDataSource_basic(t *testing.T) {
 	resourceByTag := "data.aws_ecr_image.by_tag"
 	resourceByDigest := "data.aws_ecr_image.by_digest"
 	resourceByMostRecent := "data.aws_ecr_image.by_most_recent"
+	resourceByTagRegex := "data.aws_ecr_image.by_tag_regex"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
@@ -39,6 +40,9 @@ func TestAccECRImageDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceByMostRecent, "image_pushed_at"),
 					resource.TestCheckResourceAttrSet(resourceByMostRecent, "image_size_in_bytes"),
 					resource.TestCheckTypeSetElemAttr(resourceByMostRecent, "image_tags.*", tag),
+					resource.TestCheckResourceAttrPair(resourceByTagRegex, "image_digest", resourceByTag, "image_digest"),
+					resource.TestCheckResourceAttrPair(resourceByTagRegex, "image_uri", resourceByTag, "image_uri"),
+					resource.TestCheckTypeSetElemAttr(resourceByTagRegex, "image_tags.*", tag),
 				),
 			},
 		},
@@ -64,5 +68,12 @@ data "aws_ecr_image" "by_most_recent" {
   repository_name = data.aws_ecr_image.by_tag.repository_name
   most_recent     = true
 }
+
+data "aws_ecr_image" "by_tag_regex" {
+  registry_id     = data.aws_ecr_image.by_tag.registry_id
+  repository_name = data.aws_ecr_image.by_tag.repository_name
+  image_tag_regex = "^%[3]s$"
+  most_recent     = true
+}
 `, reg, repo, tag)
 }
@@ -4,11 +4,15 @@
 package ecr
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"log"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_ecr_pull_through_cache_rule")
@@ -31,6 +36,12 @@ func ResourcePullThroughCacheRule() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"custom_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"ecr_repository_prefix": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -66,8 +77,14 @@ func resourcePullThroughCacheRuleCreate(ctx context.Context, d *schema.ResourceD
 		UpstreamRegistryUrl: aws.String(d.Get("upstream_registry_url").(string)),
 	}
 
+	var optFns []request.Option
+
+	if v, ok := d.GetOk("custom_role_arn"); ok {
+		optFns = append(optFns, withPullThroughCacheRuleCustomRoleARN(v.(string)))
+	}
+
 	log.Printf("[DEBUG] Creating ECR Pull Through Cache Rule: %s", input)
-	_, err := conn.CreatePullThroughCacheRuleWithContext(ctx, input)
+	_, err := conn.CreatePullThroughCacheRuleWithContext(ctx, input, optFns...)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating ECR Pull Through Cache Rule (%s): %s", repositoryPrefix, err)
@@ -83,7 +100,8 @@ func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceDat
 
 	conn := meta.(*conns.AWSClient).ECRConn(ctx)
 
-	rule, err := FindPullThroughCacheRuleByRepositoryPrefix(ctx, conn, d.Id())
+	var customRoleARN string
+	rule, err := FindPullThroughCacheRuleByRepositoryPrefix(ctx, conn, d.Id(), withPullThroughCacheRuleCustomRoleARNResponse(&customRoleARN))
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] ECR Pull Through Cache Rule (%s) not found, removing from state", d.Id())
@@ -95,6 +113,7 @@ func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "reading ECR Pull Through Cache Rule (%s): %s", d.Id(), err)
 	}
 
+	d.Set("custom_role_arn", customRoleARN)
 	d.Set("ecr_repository_prefix", rule.EcrRepositoryPrefix)
 	d.Set("registry_id", rule.RegistryId)
 	d.Set("upstream_registry_url", rule.UpstreamRegistryUrl)
@@ -123,3 +142,70 @@ func resourcePullThroughCacheRuleDelete(ctx context.Context, d *schema.ResourceD
 
 	return diags
 }
+
+// The custom role ARN used to authenticate to upstream ECR registries is not modeled by
+// AWS SDK for Go v1, so it is added to CreatePullThroughCacheRule request bodies by a request option.
+func withPullThroughCacheRuleCustomRoleARN(arn string) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Build.PushBack(func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			b, err := io.ReadAll(r.GetBody())
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			body := map[string]json.RawMessage{}
+
+			if len(b) > 0 {
+				if err := json.Unmarshal(b, &body); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			if body["customRoleArn"], err = json.Marshal(arn); err != nil {
+				r.Error = err
+				return
+			}
+
+			if b, err = json.Marshal(body); err != nil {
+				r.Error = err
+				return
+			}
+
+			r.SetBufferBody(b)
+		})
+	}
+}
+
+// withPullThroughCacheRuleCustomRoleARNResponse reads the custom role ARN of the first rule
+// in a DescribePullThroughCacheRules response.
+func withPullThroughCacheRuleCustomRoleARNResponse(arn *string) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Unmarshal.PushFront(func(r *request.Request) {
+			b, err := io.ReadAll(r.HTTPResponse.Body)
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			r.HTTPResponse.Body = io.NopCloser(bytes.NewReader(b))
+
+			var output struct {
+				PullThroughCacheRules []struct {
+					CustomRoleArn string `json:"customRoleArn"`
+				} `json:"pullThroughCacheRules"`
+			}
+
+			if err := json.Unmarshal(b, &output); err == nil && len(output.PullThroughCacheRules) > 0 {
+				*arn = output.PullThroughCacheRules[0].CustomRoleArn
+			}
+		})
+	}
+}
@@ -20,6 +20,10 @@ func DataSourcePullThroughCacheRule() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePullThroughCacheRuleRead,
 		Schema: map[string]*schema.Schema{
+			"custom_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"ecr_repository_prefix": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -50,13 +54,15 @@ func dataSourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceD
 
 	repositoryPrefix := d.Get("ecr_repository_prefix").(string)
 
-	rule, err := FindPullThroughCacheRuleByRepositoryPrefix(ctx, conn, repositoryPrefix)
+	var customRoleARN string
+	rule, err := FindPullThroughCacheRuleByRepositoryPrefix(ctx, conn, repositoryPrefix, withPullThroughCacheRuleCustomRoleARNResponse(&customRoleARN))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECR Pull Through Cache Rule (%s): %s", repositoryPrefix, err)
 	}
 
 	d.SetId(aws.StringValue(rule.EcrRepositoryPrefix))
+	d.Set("custom_role_arn", customRoleARN)
 	d.Set("ecr_repository_prefix", rule.EcrRepositoryPrefix)
 	d.Set("registry_id", rule.RegistryId)
 	d.Set("upstream_registry_url", rule.UpstreamRegistryUrl)
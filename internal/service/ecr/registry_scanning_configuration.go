@@ -5,6 +5,7 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_ecr_registry_scanning_configuration")
@@ -28,6 +30,8 @@ func ResourceRegistryScanningConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceRegistryScanningConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -133,6 +137,45 @@ func resourceRegistryScanningConfigurationDelete(ctx context.Context, d *schema.
 	return diags
 }
 
+// registryScanningFrequencies lists the scan frequencies supported by each scan type.
+var registryScanningFrequencies = map[string][]string{
+	ecr.ScanTypeBasic:    {ecr.ScanFrequencyManual, ecr.ScanFrequencyScanOnPush},
+	ecr.ScanTypeEnhanced: {ecr.ScanFrequencyContinuousScan, ecr.ScanFrequencyScanOnPush},
+}
+
+func resourceRegistryScanningConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("scan_type") || !diff.NewValueKnown("rule") {
+		return nil
+	}
+
+	scanType := diff.Get("scan_type").(string)
+	frequencies, ok := registryScanningFrequencies[scanType]
+
+	if !ok {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("rule").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		scanFrequency := tfMap["scan_frequency"].(string)
+
+		if scanFrequency == "" {
+			continue
+		}
+
+		if !slices.Contains(frequencies, scanFrequency) {
+			return fmt.Errorf("rule scan_frequency %q is not supported for scan_type %q, must be one of %q", scanFrequency, scanType, frequencies)
+		}
+	}
+
+	return nil
+}
+
 // Helper functions
 
 func expandScanningRegistryRules(l []interface{}) []*ecr.RegistryScanningRule {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -19,8 +20,9 @@ func TestAccECRScanningConfiguration_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		"basic":  testAccRegistryScanningConfiguration_basic,
-		"update": testAccRegistryScanningConfiguration_update,
+		"basic":                testAccRegistryScanningConfiguration_basic,
+		"update":               testAccRegistryScanningConfiguration_update,
+		"invalidScanFrequency": testAccRegistryScanningConfiguration_invalidScanFrequency,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -55,6 +57,23 @@ func testAccRegistryScanningConfiguration_basic(t *testing.T) {
 	})
 }
 
+func testAccRegistryScanningConfiguration_invalidScanFrequency(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRegistryScanningConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_basicContinuousScan(),
+				ExpectError: regexache.MustCompile(`rule scan_frequency "CONTINUOUS_SCAN" is not supported for scan_type "BASIC"`),
+			},
+		},
+	})
+}
+
 func testAccRegistryScanningConfiguration_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ecr.GetRegistryScanningConfigurationOutput
@@ -200,6 +219,21 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
 `
 }
 
+func testAccRegistryScanningConfigurationConfig_basicContinuousScan() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+    repository_filter {
+      filter      = "example"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
+
 func testAccRegistryScanningConfigurationConfig_twoRules() string {
 	return `
 resource "aws_ecr_registry_scanning_configuration" "test" {
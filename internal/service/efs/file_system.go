@@ -140,6 +140,10 @@ func ResourceFileSystem() *schema.Resource {
 								efs.ReplicationOverwriteProtectionEnabled,
 								efs.ReplicationOverwriteProtectionDisabled,
 							}, false),
+							// Protection can't be changed while the file system is a replication destination.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return old == efs.ReplicationOverwriteProtectionReplicating
+							},
 						},
 					},
 				},
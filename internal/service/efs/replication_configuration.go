@@ -55,13 +55,19 @@ func ResourceReplicationConfiguration() *schema.Resource {
 						},
 						"file_system_id": {
 							Type:     schema.TypeString,
+							Optional: true,
 							Computed: true,
+							ForceNew: true,
 						},
 						"kms_key_id": {
 							Type:     schema.TypeString,
 							Optional: true,
 							ForceNew: true,
 						},
+						"last_replicated_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"region": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -194,7 +200,7 @@ func resourceReplicationConfigurationDelete(ctx context.Context, d *schema.Resou
 		return sdkdiag.AppendErrorf(diags, "deleting EFS Replication Configuration (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitReplicationConfigurationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitReplicationConfigurationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EFS Replication Configuration (%s) delete: %s", d.Id(), err)
 	}
 
@@ -212,6 +218,10 @@ func expandDestinationToCreate(tfMap map[string]interface{}) *efs.DestinationToC
 		apiObject.AvailabilityZoneName = aws.String(v)
 	}
 
+	if v, ok := tfMap["file_system_id"].(string); ok && v != "" {
+		apiObject.FileSystemId = aws.String(v)
+	}
+
 	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
 		apiObject.KmsKeyId = aws.String(v)
 	}
@@ -260,6 +270,10 @@ func flattenDestination(apiObject *efs.Destination) map[string]interface{} {
 		tfMap["file_system_id"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.LastReplicatedTimestamp; v != nil {
+		tfMap["last_replicated_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
 	if v := apiObject.Region; v != nil {
 		tfMap["region"] = aws.StringValue(v)
 	}
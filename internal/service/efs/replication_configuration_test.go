@@ -129,6 +129,44 @@ func TestAccEFSReplicationConfiguration_allAttributes(t *testing.T) {
 	})
 }
 
+func TestAccEFSReplicationConfiguration_existingDestination(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_efs_replication_configuration.test"
+	destinationFsResourceName := "aws_efs_file_system.destination"
+	fsResourceName := "aws_efs_file_system.test"
+	region := acctest.Region()
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, efs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationConfig_existingDestination(region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.file_system_id", destinationFsResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.region", region),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.status", efs.ReplicationStatusEnabled),
+					resource.TestCheckResourceAttrPair(resourceName, "source_file_system_id", fsResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckReplicationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -218,3 +256,24 @@ resource "aws_efs_replication_configuration" "test" {
 }
 `, region))
 }
+
+func testAccReplicationConfigurationConfig_existingDestination(region string) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {}
+
+resource "aws_efs_file_system" "destination" {
+  protection {
+    replication_overwrite = "DISABLED"
+  }
+}
+
+resource "aws_efs_replication_configuration" "test" {
+  source_file_system_id = aws_efs_file_system.test.id
+
+  destination {
+    file_system_id = aws_efs_file_system.destination.id
+    region         = %[1]q
+  }
+}
+`, region)
+}
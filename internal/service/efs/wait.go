@@ -109,7 +109,7 @@ func waitReplicationConfigurationCreated(ctx context.Context, conn *efs.EFS, id
 
 func waitReplicationConfigurationDeleted(ctx context.Context, conn *efs.EFS, id string, timeout time.Duration) (*efs.ReplicationConfigurationDescription, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:                   []string{efs.ReplicationStatusDeleting},
+		Pending:                   []string{efs.ReplicationStatusDeleting, efs.ReplicationStatusPaused, efs.ReplicationStatusPausing},
 		Target:                    []string{},
 		Refresh:                   statusReplicationConfiguration(ctx, conn, id),
 		Timeout:                   timeout,
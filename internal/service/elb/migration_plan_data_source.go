@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	migrationPlanLoadBalancerTypeApplication = "application"
+	migrationPlanLoadBalancerTypeNetwork     = "network"
+
+	migrationPlanHealthCheckPortTrafficPort = "traffic-port"
+)
+
+// @SDKDataSource("aws_elb_migration_plan", name="Migration Plan")
+func DataSourceMigrationPlan() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMigrationPlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"cross_zone_load_balancing": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"idle_timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"internal": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"listener": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_group_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"load_balancer_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"security_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"target_group": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"deregistration_delay": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"health_check": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"healthy_threshold": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"interval": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"port": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"timeout": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"unhealthy_threshold": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMigrationPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn(ctx)
+
+	lbName := d.Get("name").(string)
+	lb, err := FindLoadBalancerByName(ctx, conn, lbName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELB Classic Load Balancer (%s): %s", lbName, err)
+	}
+
+	lbAttrs, err := findLoadBalancerAttributesByName(ctx, conn, lbName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELB Classic Load Balancer (%s) attributes: %s", lbName, err)
+	}
+
+	plan := newMigrationPlan(lb, lbAttrs)
+
+	d.SetId(aws.StringValue(lb.LoadBalancerName))
+	if v := lbAttrs.CrossZoneLoadBalancing; v != nil {
+		d.Set("cross_zone_load_balancing", v.Enabled)
+	}
+	if v := lbAttrs.ConnectionSettings; v != nil && plan.loadBalancerType == migrationPlanLoadBalancerTypeApplication {
+		d.Set("idle_timeout", v.IdleTimeout)
+	}
+	d.Set("internal", aws.StringValue(lb.Scheme) == "internal")
+	if err := d.Set("listener", plan.listeners); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting listener: %s", err)
+	}
+	d.Set("load_balancer_type", plan.loadBalancerType)
+	d.Set("name", lb.LoadBalancerName)
+	d.Set("security_groups", flex.FlattenStringList(lb.SecurityGroups))
+	d.Set("subnets", flex.FlattenStringList(lb.Subnets))
+	if err := d.Set("target_group", plan.targetGroups); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_group: %s", err)
+	}
+	d.Set("vpc_id", lb.VPCId)
+	d.Set("warnings", plan.warnings)
+
+	return diags
+}
+
+type migrationPlan struct {
+	listeners        []interface{}
+	loadBalancerType string
+	targetGroups     []interface{}
+	warnings         []string
+}
+
+// newMigrationPlan maps the listeners, health check and instances of a Classic Load Balancer
+// to the equivalent listeners and target groups of an Application or Network Load Balancer.
+// An Application Load Balancer is only planned if every listener is HTTP or HTTPS.
+// Settings that can't be carried over are reported as warnings.
+func newMigrationPlan(lb *elb.LoadBalancerDescription, lbAttrs *elb.LoadBalancerAttributes) *migrationPlan {
+	plan := &migrationPlan{
+		listeners:        []interface{}{},
+		loadBalancerType: migrationPlanLoadBalancerTypeApplication,
+		targetGroups:     []interface{}{},
+		warnings:         []string{},
+	}
+
+	var listeners []*elb.Listener
+
+	for _, v := range lb.ListenerDescriptions {
+		if v == nil || v.Listener == nil {
+			continue
+		}
+
+		listeners = append(listeners, v.Listener)
+
+		if len(v.PolicyNames) > 0 {
+			plan.warnings = append(plan.warnings, fmt.Sprintf("listener on port %d: policies (%s) are not migrated", aws.Int64Value(v.Listener.LoadBalancerPort), strings.Join(aws.StringValueSlice(v.PolicyNames), ", ")))
+		}
+
+		if !isLayer7Protocol(aws.StringValue(v.Listener.Protocol)) {
+			plan.loadBalancerType = migrationPlanLoadBalancerTypeNetwork
+		}
+	}
+
+	if plan.loadBalancerType == migrationPlanLoadBalancerTypeNetwork && slices.ContainsFunc(listeners, func(v *elb.Listener) bool {
+		return isLayer7Protocol(aws.StringValue(v.Protocol))
+	}) {
+		plan.warnings = append(plan.warnings, "HTTP and HTTPS listeners are mapped to TCP and TLS listeners because the load balancer also has TCP or SSL listeners")
+	}
+
+	for _, v := range lb.BackendServerDescriptions {
+		if v != nil && len(v.PolicyNames) > 0 {
+			plan.warnings = append(plan.warnings, fmt.Sprintf("instance port %d: backend server policies (%s) are not migrated", aws.Int64Value(v.InstancePort), strings.Join(aws.StringValueSlice(v.PolicyNames), ", ")))
+		}
+	}
+
+	if aws.StringValue(lb.VPCId) == "" {
+		plan.warnings = append(plan.warnings, "load balancer is not in a VPC, subnets must be chosen for the new load balancer")
+	}
+
+	var deregistrationDelay int64
+	if v := lbAttrs.ConnectionDraining; v != nil && aws.BoolValue(v.Enabled) {
+		deregistrationDelay = aws.Int64Value(v.Timeout)
+	}
+
+	targetIDs := []interface{}{}
+	for _, v := range lb.Instances {
+		if v != nil {
+			targetIDs = append(targetIDs, aws.StringValue(v.InstanceId))
+		}
+	}
+
+	var targetGroupKeys []string
+
+	for _, v := range listeners {
+		protocol := migrationPlanProtocol(plan.loadBalancerType, aws.StringValue(v.Protocol))
+		targetPort := aws.Int64Value(v.InstancePort)
+		targetProtocol := migrationPlanProtocol(plan.loadBalancerType, aws.StringValue(v.InstanceProtocol))
+		targetGroupKey := fmt.Sprintf("%s-%d", targetProtocol, targetPort)
+
+		listener := map[string]interface{}{
+			"certificate_arn":  aws.StringValue(v.SSLCertificateId),
+			"port":             aws.Int64Value(v.LoadBalancerPort),
+			"protocol":         protocol,
+			"target_group_key": targetGroupKey,
+		}
+		plan.listeners = append(plan.listeners, listener)
+
+		if slices.Contains(targetGroupKeys, targetGroupKey) {
+			continue
+		}
+		targetGroupKeys = append(targetGroupKeys, targetGroupKey)
+
+		targetGroup := map[string]interface{}{
+			"deregistration_delay": deregistrationDelay,
+			"key":                  targetGroupKey,
+			"port":                 targetPort,
+			"protocol":             targetProtocol,
+			"target_ids":           targetIDs,
+		}
+
+		if v := lb.HealthCheck; v != nil {
+			healthCheck, warning := migrationPlanHealthCheck(plan.loadBalancerType, targetPort, v)
+			targetGroup["health_check"] = []interface{}{healthCheck}
+
+			if warning != "" {
+				plan.warnings = append(plan.warnings, fmt.Sprintf("target group %s: %s", targetGroupKey, warning))
+			}
+		}
+
+		plan.targetGroups = append(plan.targetGroups, targetGroup)
+	}
+
+	return plan
+}
+
+func isLayer7Protocol(protocol string) bool {
+	switch strings.ToUpper(protocol) {
+	case "HTTP", "HTTPS":
+		return true
+	default:
+		return false
+	}
+}
+
+// migrationPlanProtocol returns the ALB or NLB protocol equivalent to a Classic Load Balancer protocol.
+func migrationPlanProtocol(loadBalancerType, protocol string) string {
+	protocol = strings.ToUpper(protocol)
+
+	if loadBalancerType == migrationPlanLoadBalancerTypeApplication {
+		return protocol
+	}
+
+	switch protocol {
+	case "HTTPS", "SSL":
+		return "TLS"
+	default:
+		return "TCP"
+	}
+}
+
+// migrationPlanHealthCheck returns the target group health check equivalent to a Classic Load Balancer health check.
+// The health check target has the form PROTOCOL:PORT[/PATH].
+func migrationPlanHealthCheck(loadBalancerType string, targetPort int64, apiObject *elb.HealthCheck) (map[string]interface{}, string) {
+	var warning string
+
+	protocol, portAndPath, _ := strings.Cut(aws.StringValue(apiObject.Target), ":")
+	protocol = strings.ToUpper(protocol)
+	port, path, _ := strings.Cut(portAndPath, "/")
+
+	if port == strconv.FormatInt(targetPort, 10) {
+		port = migrationPlanHealthCheckPortTrafficPort
+	}
+
+	switch protocol {
+	case "HTTP", "HTTPS":
+		path = "/" + path
+	case "TCP", "SSL":
+		path = ""
+
+		if loadBalancerType == migrationPlanLoadBalancerTypeApplication {
+			warning = fmt.Sprintf("%s health check is replaced by an HTTP health check of path /", protocol)
+			protocol = "HTTP"
+			path = "/"
+		} else if protocol == "SSL" {
+			warning = "SSL health check is replaced by a TCP health check"
+			protocol = "TCP"
+		}
+	}
+
+	tfMap := map[string]interface{}{
+		"healthy_threshold":   aws.Int64Value(apiObject.HealthyThreshold),
+		"interval":            aws.Int64Value(apiObject.Interval),
+		"path":                path,
+		"port":                port,
+		"protocol":            protocol,
+		"timeout":             aws.Int64Value(apiObject.Timeout),
+		"unhealthy_threshold": aws.Int64Value(apiObject.UnhealthyThreshold),
+	}
+
+	return tfMap, warning
+}
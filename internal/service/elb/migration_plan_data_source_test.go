@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccELBMigrationPlanDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_elb_migration_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMigrationPlanDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cross_zone_load_balancing", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "idle_timeout", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "internal", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.0.port", "80"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.0.target_group_key", "HTTP-8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "load_balancer_type", "application"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "security_groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_group.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_group.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_group.0.health_check.0.path", "/health"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_group.0.health_check.0.port", "traffic-port"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_group.0.health_check.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_group.0.key", "HTTP-8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_group.0.port", "8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_group.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_group.0.target_ids.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "warnings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMigrationPlanDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_elb" "test" {
+  name            = %[1]q
+  internal        = true
+  security_groups = [aws_security_group.test.id]
+  subnets         = aws_subnet.test[*].id
+
+  idle_timeout = 30
+
+  listener {
+    instance_port     = 8080
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+
+  health_check {
+    healthy_threshold   = 2
+    unhealthy_threshold = 2
+    target              = "HTTP:8080/health"
+    interval            = 30
+    timeout             = 5
+  }
+}
+
+data "aws_elb_migration_plan" "test" {
+  name = aws_elb.test.name
+}
+`, rName))
+}
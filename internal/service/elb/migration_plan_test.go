@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+)
+
+func TestNewMigrationPlan(t *testing.T) {
+	t.Parallel()
+
+	healthCheck := &elb.HealthCheck{
+		HealthyThreshold:   aws.Int64(2),
+		Interval:           aws.Int64(30),
+		Target:             aws.String("TCP:8080"),
+		Timeout:            aws.Int64(5),
+		UnhealthyThreshold: aws.Int64(3),
+	}
+	lbAttrs := &elb.LoadBalancerAttributes{
+		ConnectionDraining: &elb.ConnectionDraining{
+			Enabled: aws.Bool(true),
+			Timeout: aws.Int64(60),
+		},
+	}
+
+	testCases := map[string]struct {
+		listeners            []*elb.ListenerDescription
+		wantLoadBalancerType string
+		wantListeners        []interface{}
+		wantHealthCheck      map[string]interface{}
+		wantWarnings         []string
+	}{
+		"application": {
+			listeners: []*elb.ListenerDescription{
+				{Listener: &elb.Listener{InstancePort: aws.Int64(8080), InstanceProtocol: aws.String("HTTP"), LoadBalancerPort: aws.Int64(80), Protocol: aws.String("HTTP")}},
+				{Listener: &elb.Listener{InstancePort: aws.Int64(8080), InstanceProtocol: aws.String("HTTP"), LoadBalancerPort: aws.Int64(443), Protocol: aws.String("HTTPS"), SSLCertificateId: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/example")}}, //lintignore:AWSAT003,AWSAT005
+			},
+			wantLoadBalancerType: migrationPlanLoadBalancerTypeApplication,
+			wantListeners: []interface{}{
+				map[string]interface{}{"certificate_arn": "", "port": int64(80), "protocol": "HTTP", "target_group_key": "HTTP-8080"},
+				map[string]interface{}{"certificate_arn": "arn:aws:acm:us-west-2:123456789012:certificate/example", "port": int64(443), "protocol": "HTTPS", "target_group_key": "HTTP-8080"}, //lintignore:AWSAT003,AWSAT005
+			},
+			wantHealthCheck: map[string]interface{}{"healthy_threshold": int64(2), "interval": int64(30), "path": "/", "port": "traffic-port", "protocol": "HTTP", "timeout": int64(5), "unhealthy_threshold": int64(3)},
+			wantWarnings:    []string{"target group HTTP-8080: TCP health check is replaced by an HTTP health check of path /"},
+		},
+		"network": {
+			listeners: []*elb.ListenerDescription{
+				{Listener: &elb.Listener{InstancePort: aws.Int64(8080), InstanceProtocol: aws.String("TCP"), LoadBalancerPort: aws.Int64(80), Protocol: aws.String("TCP")}, PolicyNames: aws.StringSlice([]string{"example"})},
+				{Listener: &elb.Listener{InstancePort: aws.Int64(8080), InstanceProtocol: aws.String("HTTP"), LoadBalancerPort: aws.Int64(8080), Protocol: aws.String("HTTP")}},
+			},
+			wantLoadBalancerType: migrationPlanLoadBalancerTypeNetwork,
+			wantListeners: []interface{}{
+				map[string]interface{}{"certificate_arn": "", "port": int64(80), "protocol": "TCP", "target_group_key": "TCP-8080"},
+				map[string]interface{}{"certificate_arn": "", "port": int64(8080), "protocol": "TCP", "target_group_key": "TCP-8080"},
+			},
+			wantHealthCheck: map[string]interface{}{"healthy_threshold": int64(2), "interval": int64(30), "path": "", "port": "traffic-port", "protocol": "TCP", "timeout": int64(5), "unhealthy_threshold": int64(3)},
+			wantWarnings: []string{
+				"listener on port 80: policies (example) are not migrated",
+				"HTTP and HTTPS listeners are mapped to TCP and TLS listeners because the load balancer also has TCP or SSL listeners",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lb := &elb.LoadBalancerDescription{
+				HealthCheck:          healthCheck,
+				Instances:            []*elb.Instance{{InstanceId: aws.String("i-12345678")}},
+				ListenerDescriptions: testCase.listeners,
+				VPCId:                aws.String("vpc-12345678"),
+			}
+
+			plan := newMigrationPlan(lb, lbAttrs)
+
+			if got, want := plan.loadBalancerType, testCase.wantLoadBalancerType; got != want {
+				t.Errorf("load balancer type = %s, want %s", got, want)
+			}
+
+			if got, want := plan.listeners, testCase.wantListeners; !reflect.DeepEqual(got, want) {
+				t.Errorf("listeners = %v, want %v", got, want)
+			}
+
+			if got := len(plan.targetGroups); got != 1 {
+				t.Fatalf("got %d target groups, want 1", got)
+			}
+
+			targetGroup := plan.targetGroups[0].(map[string]interface{})
+
+			if got, want := targetGroup["deregistration_delay"], int64(60); got != want {
+				t.Errorf("deregistration delay = %v, want %v", got, want)
+			}
+
+			if got, want := targetGroup["target_ids"], []interface{}{"i-12345678"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("target IDs = %v, want %v", got, want)
+			}
+
+			if got, want := targetGroup["health_check"], []interface{}{testCase.wantHealthCheck}; !reflect.DeepEqual(got, want) {
+				t.Errorf("health check = %v, want %v", got, want)
+			}
+
+			if got, want := plan.warnings, testCase.wantWarnings; !reflect.DeepEqual(got, want) {
+				t.Errorf("warnings = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -33,6 +33,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceHostedZoneID,
 			TypeName: "aws_elb_hosted_zone_id",
 		},
+		{
+			Factory:  DataSourceMigrationPlan,
+			TypeName: "aws_elb_migration_plan",
+			Name:     "Migration Plan",
+		},
 		{
 			Factory:  DataSourceServiceAccount,
 			TypeName: "aws_elb_service_account",
@@ -918,8 +918,9 @@ func expandMutualAuthenticationAttributes(l []interface{}) *elbv2.MutualAuthenti
 		return nil
 	}
 
+	// Neither trust store nor certificate expiry settings apply when client certificates aren't verified.
 	mode := tfMap["mode"].(string)
-	if mode == mutualAuthenticationOff {
+	if mode == mutualAuthenticationOff || mode == mutualAuthenticationPassthrough {
 		return &elbv2.MutualAuthenticationAttributes{
 			Mode: aws.String(mode),
 		}
@@ -1025,7 +1026,7 @@ func flattenMutualAuthenticationAttributes(description *elbv2.MutualAuthenticati
 	}
 
 	mode := aws.StringValue(description.Mode)
-	if mode == mutualAuthenticationOff {
+	if mode == mutualAuthenticationOff || mode == mutualAuthenticationPassthrough {
 		return []interface{}{
 			map[string]interface{}{
 				"mode": mode,
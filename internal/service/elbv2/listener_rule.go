@@ -60,6 +60,15 @@ func ResourceListenerRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_priority": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"effective_priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"listener_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -596,15 +605,18 @@ func resourceListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("listener_arn", ListenerARNFromRuleARN(aws.StringValue(rule.RuleArn)))
 
 	// Rules are evaluated in priority order, from the lowest value to the highest value. The default rule has the lowest priority.
-	if aws.StringValue(rule.Priority) == "default" {
-		d.Set("priority", listenerRulePriorityDefault)
-	} else {
-		if priority, err := strconv.Atoi(aws.StringValue(rule.Priority)); err != nil {
+	priority := listenerRulePriorityDefault
+	if aws.StringValue(rule.Priority) != "default" {
+		if priority, err = strconv.Atoi(aws.StringValue(rule.Priority)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "Cannot convert rule priority %q to int: %s", aws.StringValue(rule.Priority), err)
-		} else {
-			d.Set("priority", priority)
 		}
 	}
+	d.Set("effective_priority", priority)
+	// With automatic priority management the configured priority is only the preferred priority.
+	// The rule may have been assigned a higher priority because the preferred one was in use.
+	if !d.Get("auto_priority").(bool) || d.Get("priority").(int) == 0 {
+		d.Set("priority", priority)
+	}
 
 	sort.Slice(rule.Actions, func(i, j int) bool {
 		return aws.Int64Value(rule.Actions[i].Order) < aws.Int64Value(rule.Actions[j].Order)
@@ -789,8 +801,15 @@ func resourceListenerRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 				},
 			}
 
-			_, err := conn.SetRulePrioritiesWithContext(ctx, params)
-			if err != nil {
+			if d.Get("auto_priority").(bool) {
+				priority, err := nextFreeListenerRulePriority(ctx, conn, d.Get("listener_arn").(string), d.Id(), aws.Int64Value(params.RulePriorities[0].Priority))
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating ELB v2 Listener Rule (%s): %s", d.Id(), err)
+				}
+				params.RulePriorities[0].Priority = aws.Int64(priority)
+			}
+
+			if _, err := conn.SetRulePrioritiesWithContext(ctx, params); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating ELB v2 Listener Rule (%s): setting priority: %s", d.Id(), err)
 			}
 		}
@@ -848,7 +867,19 @@ func resourceListenerRuleDelete(ctx context.Context, d *schema.ResourceData, met
 
 func retryListenerRuleCreate(ctx context.Context, conn *elbv2.ELBV2, d *schema.ResourceData, params *elbv2.CreateRuleInput, listenerARN string) (*elbv2.CreateRuleOutput, error) {
 	var resp *elbv2.CreateRuleOutput
-	if v, ok := d.GetOk("priority"); ok {
+	if v, ok := d.GetOk("priority"); ok && d.Get("auto_priority").(bool) {
+		priority, err := nextFreeListenerRulePriority(ctx, conn, listenerARN, "", int64(v.(int)))
+		if err != nil {
+			return nil, err
+		}
+
+		params.Priority = aws.Int64(priority)
+		resp, err = conn.CreateRuleWithContext(ctx, params)
+
+		if err != nil {
+			return nil, err
+		}
+	} else if ok {
 		var err error
 		params.Priority = aws.Int64(int64(v.(int)))
 		resp, err = conn.CreateRuleWithContext(ctx, params)
@@ -954,6 +985,63 @@ func highestListenerRulePriority(ctx context.Context, conn *elbv2.ELBV2, arn str
 	return int64(priorities[len(priorities)-1]), nil
 }
 
+// nextFreeListenerRulePriority returns the first priority, starting at the specified priority, that isn't used by
+// another rule on the listener. The rule identified by ruleARN is ignored. Other rules are never moved.
+func nextFreeListenerRulePriority(ctx context.Context, conn *elbv2.ELBV2, listenerARN, ruleARN string, priority int64) (int64, error) {
+	rules, err := findListenerRules(ctx, conn, listenerARN)
+
+	if err != nil {
+		return 0, fmt.Errorf("reading ELBv2 Listener (%s) rules: %w", listenerARN, err)
+	}
+
+	occupied := make(map[int64]bool)
+	for _, rule := range rules {
+		if aws.StringValue(rule.Priority) == "default" || aws.StringValue(rule.RuleArn) == ruleARN {
+			continue
+		}
+
+		p, err := strconv.ParseInt(aws.StringValue(rule.Priority), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		occupied[p] = true
+	}
+
+	for p := priority; p <= listenerRulePriorityMax; p++ {
+		if !occupied[p] {
+			return p, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no free priority from %d on ELBv2 Listener (%s)", priority, listenerARN)
+}
+
+func findListenerRules(ctx context.Context, conn *elbv2.ELBV2, listenerARN string) ([]*elbv2.Rule, error) {
+	input := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerARN),
+	}
+	var output []*elbv2.Rule
+
+	for {
+		page, err := conn.DescribeRulesWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Rules...)
+
+		if page.NextMarker == nil {
+			break
+		}
+
+		input.Marker = page.NextMarker
+	}
+
+	return output, nil
+}
+
 // lbListenerRuleConditions converts data source generated by Terraform into
 // an elbv2.RuleCondition object suitable for submitting to AWS API.
 func lbListenerRuleConditions(conditions []interface{}) ([]*elbv2.RuleCondition, error) {
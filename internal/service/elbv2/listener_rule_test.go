@@ -615,6 +615,45 @@ func TestAccELBV2ListenerRule_priority(t *testing.T) {
 	})
 }
 
+func TestAccELBV2ListenerRule_autoPriority(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule elbv2.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleConfig_autoPriority(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(ctx, "aws_lb_listener_rule.existing", &rule),
+					testAccCheckListenerRuleExists(ctx, "aws_lb_listener_rule.test", &rule),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "auto_priority", "true"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "priority", "10"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "effective_priority", "11"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.existing", "priority", "10"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.existing", "effective_priority", "10"),
+				),
+			},
+			{
+				Config:   testAccListenerRuleConfig_autoPriority(rName, 10),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccListenerRuleConfig_autoPriority(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(ctx, "aws_lb_listener_rule.test", &rule),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "priority", "20"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.test", "effective_priority", "20"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2ListenerRule_cognito(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf elbv2.Rule
@@ -3402,3 +3441,51 @@ resource "aws_lb_listener_rule" "test" {
 }
 `, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccListenerRuleConfig_autoPriority(rName string, priority int) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_baseWithListener(rName), fmt.Sprintf(`
+resource "aws_lb_listener_rule" "existing" {
+  listener_arn = aws_lb_listener.test.arn
+  priority     = 10
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/existing/*"]
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+# Requests the priority already used by the existing rule and is assigned the next free priority.
+resource "aws_lb_listener_rule" "test" {
+  listener_arn  = aws_lb_listener.test.arn
+  priority      = %[2]d
+  auto_priority = true
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/test/*"]
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_lb_listener_rule.existing]
+}
+`, rName, priority))
+}
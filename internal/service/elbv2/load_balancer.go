@@ -12,11 +12,15 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"time"
 
 	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -24,6 +28,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -194,6 +199,12 @@ func ResourceLoadBalancer() *schema.Resource {
 				Default:          false,
 				DiffSuppressFunc: suppressIfLBTypeNot(elbv2.LoadBalancerTypeEnumApplication),
 			},
+			"enable_zonal_shift": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          false,
+				DiffSuppressFunc: suppressIfLBTypeNot(elbv2.LoadBalancerTypeEnumApplication, elbv2.LoadBalancerTypeEnumNetwork),
+			},
 			"enforce_security_group_inbound_rules_on_private_link_traffic": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -226,6 +237,20 @@ func ResourceLoadBalancer() *schema.Resource {
 				Default:      elbv2.LoadBalancerTypeEnumApplication,
 				ValidateFunc: validation.StringInSlice(elbv2.LoadBalancerTypeEnum_Values(), false),
 			},
+			"minimum_load_balancer_capacity": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressIfLBTypeNot(elbv2.LoadBalancerTypeEnumApplication, elbv2.LoadBalancerTypeEnumNetwork),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"capacity_units": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -473,6 +498,17 @@ func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if v, ok := d.GetOk("minimum_load_balancer_capacity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil && lbType != elbv2.LoadBalancerTypeEnumGateway {
+		input := &elasticloadbalancingv2.ModifyCapacityReservationInput{
+			LoadBalancerArn:             aws_sdkv2.String(d.Id()),
+			MinimumLoadBalancerCapacity: expandMinimumLoadBalancerCapacity(v.([]interface{})[0].(map[string]interface{})),
+		}
+
+		if err := modifyCapacityReservation(ctx, meta.(*conns.AWSClient).ELBV2Client(ctx), input, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceLoadBalancerRead(ctx, d, meta)...)
 }
 
@@ -531,9 +567,34 @@ func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta
 
 	loadBalancerAttributes.flatten(d, attributes)
 
+	if lbType == elbv2.LoadBalancerTypeEnumApplication || lbType == elbv2.LoadBalancerTypeEnumNetwork {
+		_, configured := d.GetOk("minimum_load_balancer_capacity")
+		capacityReservation, err := findCapacityReservationByARN(ctx, meta.(*conns.AWSClient).ELBV2Client(ctx), d.Id())
+
+		switch {
+		case err == nil:
+			if err := d.Set("minimum_load_balancer_capacity", flattenMinimumLoadBalancerCapacity(capacityReservation.MinimumLoadBalancerCapacity)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting minimum_load_balancer_capacity: %s", err)
+			}
+		case !configured && capacityReservationUnavailable(meta.(*conns.AWSClient).Partition, err):
+			log.Printf("[WARN] Unable to read ELBv2 Load Balancer (%s) capacity reservation: %s", d.Id(), err)
+		default:
+			return sdkdiag.AppendErrorf(diags, "reading ELBv2 Load Balancer (%s) capacity reservation: %s", d.Id(), err)
+		}
+	} else {
+		d.Set("minimum_load_balancer_capacity", nil)
+	}
+
 	return diags
 }
 
+// capacityReservationUnavailable returns whether the specified error suggests that load balancer capacity reservations
+// can't be read, either because the API isn't available in the partition or because the caller isn't allowed to use it.
+// Such errors are only ignored for load balancers that don't configure a minimum capacity.
+func capacityReservationUnavailable(partition string, err error) bool {
+	return errs.IsUnsupportedOperationInPartitionError(partition, err) || tfawserr_sdkv2.ErrCodeEquals(err, errCodeAccessDenied)
+}
+
 func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
@@ -630,6 +691,22 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Load Balancer (%s) update: %s", d.Id(), err)
 	}
 
+	if d.HasChange("minimum_load_balancer_capacity") && d.Get("load_balancer_type").(string) != elbv2.LoadBalancerTypeEnumGateway {
+		input := &elasticloadbalancingv2.ModifyCapacityReservationInput{
+			LoadBalancerArn: aws_sdkv2.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("minimum_load_balancer_capacity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MinimumLoadBalancerCapacity = expandMinimumLoadBalancerCapacity(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.ResetCapacityReservation = aws_sdkv2.Bool(true)
+		}
+
+		if err := modifyCapacityReservation(ctx, meta.(*conns.AWSClient).ELBV2Client(ctx), input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceLoadBalancerRead(ctx, d, meta)...)
 }
 
@@ -744,6 +821,11 @@ var loadBalancerAttributes = loadBalancerAttributeMap(map[string]loadBalancerAtt
 		tfType:                     schema.TypeBool,
 		loadBalancerTypesSupported: []string{elbv2.LoadBalancerTypeEnumApplication},
 	},
+	"enable_zonal_shift": {
+		apiAttributeKey:            loadBalancerAttributeZonalShiftConfigEnabled,
+		tfType:                     schema.TypeBool,
+		loadBalancerTypesSupported: []string{elbv2.LoadBalancerTypeEnumApplication, elbv2.LoadBalancerTypeEnumNetwork},
+	},
 	"idle_timeout": {
 		apiAttributeKey:            loadBalancerAttributeIdleTimeoutTimeoutSeconds,
 		tfType:                     schema.TypeInt,
@@ -946,6 +1028,108 @@ func waitLoadBalancerActive(ctx context.Context, conn *elbv2.ELBV2, arn string,
 	return nil, err
 }
 
+func modifyCapacityReservation(ctx context.Context, conn *elasticloadbalancingv2.Client, input *elasticloadbalancingv2.ModifyCapacityReservationInput, timeout time.Duration) error {
+	arn := aws_sdkv2.ToString(input.LoadBalancerArn)
+
+	_, err := conn.ModifyCapacityReservation(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("modifying ELBv2 Load Balancer (%s) capacity reservation: %w", arn, err)
+	}
+
+	if _, err := waitCapacityReservationProvisioned(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for ELBv2 Load Balancer (%s) capacity reservation provision: %w", arn, err)
+	}
+
+	return nil
+}
+
+func findCapacityReservationByARN(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) (*elasticloadbalancingv2.DescribeCapacityReservationOutput, error) {
+	input := &elasticloadbalancingv2.DescribeCapacityReservationInput{
+		LoadBalancerArn: aws_sdkv2.String(arn),
+	}
+
+	output, err := conn.DescribeCapacityReservation(ctx, input)
+
+	if errs.IsA[*awstypes.LoadBalancerNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// statusCapacityReservation returns the aggregate state of all the zonal capacity reservations.
+// A load balancer without any zonal capacity reservations is considered provisioned.
+func statusCapacityReservation(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCapacityReservationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		state := awstypes.CapacityReservationStateEnumProvisioned
+		for _, v := range output.CapacityReservationState {
+			if v.State == nil {
+				continue
+			}
+
+			switch code := v.State.Code; code {
+			case awstypes.CapacityReservationStateEnumFailed:
+				return output, string(code), nil
+			case awstypes.CapacityReservationStateEnumPending, awstypes.CapacityReservationStateEnumRebalancing:
+				state = code
+			}
+		}
+
+		return output, string(state), nil
+	}
+}
+
+func waitCapacityReservationProvisioned(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string, timeout time.Duration) (*elasticloadbalancingv2.DescribeCapacityReservationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.CapacityReservationStateEnumPending, awstypes.CapacityReservationStateEnumRebalancing),
+		Target:     enum.Slice(awstypes.CapacityReservationStateEnumProvisioned),
+		Refresh:    statusCapacityReservation(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elasticloadbalancingv2.DescribeCapacityReservationOutput); ok {
+		var reasons []error
+
+		for _, v := range output.CapacityReservationState {
+			if v.State != nil && v.State.Code == awstypes.CapacityReservationStateEnumFailed {
+				reasons = append(reasons, fmt.Errorf("%s: %s", aws_sdkv2.ToString(v.AvailabilityZone), aws_sdkv2.ToString(v.State.Reason)))
+			}
+		}
+
+		tfresource.SetLastError(err, errors.Join(reasons...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 // ALB automatically creates ENI(s) on creation
 // but the cleanup is asynchronous and may take time
 // which then blocks IGW, SG or VPC on deletion
@@ -1328,6 +1512,32 @@ func flattenLoadBalancerConnectionLogsAttributes(apiObjects []*elbv2.LoadBalance
 	return tfMap
 }
 
+func expandMinimumLoadBalancerCapacity(tfMap map[string]interface{}) *awstypes.MinimumLoadBalancerCapacity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.MinimumLoadBalancerCapacity{}
+
+	if v, ok := tfMap["capacity_units"].(int); ok {
+		apiObject.CapacityUnits = aws_sdkv2.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenMinimumLoadBalancerCapacity(apiObject *awstypes.MinimumLoadBalancerCapacity) []interface{} {
+	if apiObject == nil || apiObject.CapacityUnits == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"capacity_units": aws_sdkv2.ToInt32(apiObject.CapacityUnits),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandSubnetMapping(tfMap map[string]interface{}) *elbv2.SubnetMapping {
 	if tfMap == nil {
 		return nil
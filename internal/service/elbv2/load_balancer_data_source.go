@@ -125,6 +125,10 @@ func DataSourceLoadBalancer() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"enable_zonal_shift": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"enforce_security_group_inbound_rules_on_private_link_traffic": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -145,6 +149,18 @@ func DataSourceLoadBalancer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"minimum_load_balancer_capacity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"capacity_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -296,6 +312,22 @@ func dataSourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, met
 
 	loadBalancerAttributes.flatten(d, attributes)
 
+	d.Set("minimum_load_balancer_capacity", nil)
+	if lbType := aws.StringValue(lb.Type); lbType == elbv2.LoadBalancerTypeEnumApplication || lbType == elbv2.LoadBalancerTypeEnumNetwork {
+		capacityReservation, err := findCapacityReservationByARN(ctx, meta.(*conns.AWSClient).ELBV2Client(ctx), d.Id())
+
+		switch {
+		case err == nil:
+			if err := d.Set("minimum_load_balancer_capacity", flattenMinimumLoadBalancerCapacity(capacityReservation.MinimumLoadBalancerCapacity)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting minimum_load_balancer_capacity: %s", err)
+			}
+		case capacityReservationUnavailable(meta.(*conns.AWSClient).Partition, err):
+			log.Printf("[WARN] Unable to read ELBv2 Load Balancer (%s) capacity reservation: %s", d.Id(), err)
+		default:
+			return sdkdiag.AppendErrorf(diags, "reading ELBv2 Load Balancer (%s) capacity reservation: %s", d.Id(), err)
+		}
+	}
+
 	tags, err := listTags(ctx, conn, d.Id())
 
 	if errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
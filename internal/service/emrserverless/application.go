@@ -6,6 +6,7 @@ package emrserverless
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -155,6 +156,26 @@ func resourceApplication() *schema.Resource {
 					},
 				},
 			},
+			"interactive_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"livy_endpoint_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"studio_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"maximum_capacity": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -179,6 +200,29 @@ func resourceApplication() *schema.Resource {
 					},
 				},
 			},
+			"monitoring_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prometheus_monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"remote_write_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 10280),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -211,6 +255,32 @@ func resourceApplication() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"scheduler_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_concurrent_runs": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"queue_timeout_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(15, 720),
+						},
+					},
+				},
+			},
+			"started": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"type": {
@@ -266,7 +336,7 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
-	output, err := conn.CreateApplication(ctx, input)
+	output, err := conn.CreateApplication(ctx, input, withApplicationExtensionsRequest(expandApplicationExtensions(d)))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating EMR Serveless Application (%s): %s", name, err)
@@ -278,6 +348,12 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "waiting for EMR Serveless Application (%s) create: %s", d.Id(), err)
 	}
 
+	if d.Get("started").(bool) {
+		if err := startApplication(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceApplicationRead(ctx, d, meta)...)
 }
 
@@ -285,7 +361,7 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
 
-	application, err := findApplicationByID(ctx, conn, d.Id())
+	output, err := findApplicationOutputByID(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EMR Serverless Application (%s) not found, removing from state", d.Id())
@@ -297,10 +373,14 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "reading EMR Serverless Application (%s): %s", d.Id(), err)
 	}
 
+	application := output.Application
+	extensions := applicationExtensionsFromMetadata(output.ResultMetadata)
+
 	d.Set("architecture", application.Architecture)
 	d.Set("arn", application.Arn)
 	d.Set("name", application.Name)
 	d.Set("release_label", application.ReleaseLabel)
+	d.Set("started", application.State == types.ApplicationStateStarted || application.State == types.ApplicationStateStarting)
 	d.Set("type", strings.ToLower(aws.ToString(application.Type)))
 
 	if err := d.Set("auto_start_configuration", []interface{}{flattenAutoStartConfig(application.AutoStartConfiguration)}); err != nil {
@@ -319,14 +399,26 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting initial_capacity: %s", err)
 	}
 
+	if err := d.Set("interactive_configuration", flattenInteractiveConfiguration(extensions.InteractiveConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting interactive_configuration: %s", err)
+	}
+
 	if err := d.Set("maximum_capacity", []interface{}{flattenMaximumCapacity(application.MaximumCapacity)}); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting maximum_capacity: %s", err)
 	}
 
+	if err := d.Set("monitoring_configuration", flattenMonitoringConfiguration(extensions.MonitoringConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting monitoring_configuration: %s", err)
+	}
+
 	if err := d.Set("network_configuration", []interface{}{flattenNetworkConfiguration(application.NetworkConfiguration)}); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
 	}
 
+	if err := d.Set("scheduler_configuration", flattenSchedulerConfiguration(extensions.SchedulerConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scheduler_configuration: %s", err)
+	}
+
 	setTagsOut(ctx, application.Tags)
 
 	return diags
@@ -336,7 +428,29 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("started", "tags", "tags_all") {
+		// An application can only be updated while it is in the CREATED or STOPPED state.
+		application, err := findApplicationByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EMR Serverless Application (%s): %s", d.Id(), err)
+		}
+
+		restart := false
+
+		switch application.State {
+		case types.ApplicationStateStarting, types.ApplicationStateStarted:
+			if err := stopApplication(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			restart = d.Get("started").(bool)
+		case types.ApplicationStateStopping:
+			if _, err := waitApplicationStopped(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EMR Serveless Application (%s) stop: %s", d.Id(), err)
+			}
+		}
+
 		input := &emrserverless.UpdateApplicationInput{
 			ApplicationId: aws.String(d.Id()),
 			ClientToken:   aws.String(id.UniqueId()),
@@ -374,11 +488,29 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.ReleaseLabel = aws.String(v.(string))
 		}
 
-		_, err := conn.UpdateApplication(ctx, input)
+		_, err = conn.UpdateApplication(ctx, input, withApplicationExtensionsRequest(expandApplicationExtensions(d)))
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EMR Serveless Application (%s): %s", d.Id(), err)
 		}
+
+		if restart {
+			if err := startApplication(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	if d.HasChange("started") {
+		if d.Get("started").(bool) {
+			if err := startApplication(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		} else {
+			if err := stopApplication(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 	}
 
 	return append(diags, resourceApplicationRead(ctx, d, meta)...)
@@ -388,8 +520,30 @@ func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
 
+	// An application can only be deleted while it is in the CREATED or STOPPED state.
+	application, err := findApplicationByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Serverless Application (%s): %s", d.Id(), err)
+	}
+
+	switch application.State {
+	case types.ApplicationStateStarting, types.ApplicationStateStarted:
+		if err := stopApplication(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	case types.ApplicationStateStopping:
+		if _, err := waitApplicationStopped(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EMR Serveless Application (%s) stop: %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting EMR Serverless Application: %s", d.Id())
-	_, err := conn.DeleteApplication(ctx, &emrserverless.DeleteApplicationInput{
+	_, err = conn.DeleteApplication(ctx, &emrserverless.DeleteApplicationInput{
 		ApplicationId: aws.String(d.Id()),
 	})
 
@@ -409,11 +563,21 @@ func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func findApplicationByID(ctx context.Context, conn *emrserverless.Client, id string) (*types.Application, error) {
+	output, err := findApplicationOutputByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Application, nil
+}
+
+func findApplicationOutputByID(ctx context.Context, conn *emrserverless.Client, id string) (*emrserverless.GetApplicationOutput, error) {
 	input := &emrserverless.GetApplicationInput{
 		ApplicationId: aws.String(id),
 	}
 
-	output, err := conn.GetApplication(ctx, input)
+	output, err := conn.GetApplication(ctx, input, withApplicationExtensionsResponse)
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
@@ -434,7 +598,39 @@ func findApplicationByID(ctx context.Context, conn *emrserverless.Client, id str
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.Application, nil
+	return output, nil
+}
+
+func startApplication(ctx context.Context, conn *emrserverless.Client, id string) error {
+	_, err := conn.StartApplication(ctx, &emrserverless.StartApplicationInput{
+		ApplicationId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting EMR Serverless Application (%s): %w", id, err)
+	}
+
+	if _, err := waitApplicationStarted(ctx, conn, id); err != nil {
+		return fmt.Errorf("waiting for EMR Serverless Application (%s) start: %w", id, err)
+	}
+
+	return nil
+}
+
+func stopApplication(ctx context.Context, conn *emrserverless.Client, id string) error {
+	_, err := conn.StopApplication(ctx, &emrserverless.StopApplicationInput{
+		ApplicationId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping EMR Serverless Application (%s): %w", id, err)
+	}
+
+	if _, err := waitApplicationStopped(ctx, conn, id); err != nil {
+		return fmt.Errorf("waiting for EMR Serverless Application (%s) stop: %w", id, err)
+	}
+
+	return nil
 }
 
 func statusApplication(ctx context.Context, conn *emrserverless.Client, id string) retry.StateRefreshFunc {
@@ -481,6 +677,62 @@ func waitApplicationCreated(ctx context.Context, conn *emrserverless.Client, id
 	return nil, err
 }
 
+func waitApplicationStarted(ctx context.Context, conn *emrserverless.Client, id string) (*types.Application, error) {
+	const (
+		timeout    = 30 * time.Minute
+		minTimeout = 10 * time.Second
+		delay      = 30 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.ApplicationStateCreated, types.ApplicationStateStarting, types.ApplicationStateStopped),
+		Target:     enum.Slice(types.ApplicationStateStarted),
+		Refresh:    statusApplication(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Application); ok {
+		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(stateChangeReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationStopped(ctx context.Context, conn *emrserverless.Client, id string) (*types.Application, error) {
+	const (
+		timeout    = 30 * time.Minute
+		minTimeout = 10 * time.Second
+		delay      = 30 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.ApplicationStateStarting, types.ApplicationStateStarted, types.ApplicationStateStopping),
+		Target:     enum.Slice(types.ApplicationStateStopped),
+		Refresh:    statusApplication(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Application); ok {
+		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(stateChangeReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitApplicationTerminated(ctx context.Context, conn *emrserverless.Client, id string) (*types.Application, error) {
 	const (
 		timeout    = 20 * time.Minute
@@ -797,3 +1049,147 @@ func flattenWorkerResourceConfig(apiObject *types.WorkerResourceConfig) map[stri
 
 	return tfMap
 }
+
+func expandApplicationExtensions(d *schema.ResourceData) *applicationExtensions {
+	apiObject := &applicationExtensions{}
+
+	if v, ok := d.GetOk("interactive_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.InteractiveConfiguration = expandInteractiveConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("monitoring_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.MonitoringConfiguration = expandMonitoringConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("scheduler_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.SchedulerConfiguration = expandSchedulerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandInteractiveConfiguration(tfMap map[string]interface{}) *interactiveConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &interactiveConfiguration{}
+
+	if v, ok := tfMap["livy_endpoint_enabled"].(bool); ok {
+		apiObject.LivyEndpointEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["studio_enabled"].(bool); ok {
+		apiObject.StudioEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenInteractiveConfiguration(apiObject *interactiveConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LivyEndpointEnabled; v != nil {
+		tfMap["livy_endpoint_enabled"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.StudioEnabled; v != nil {
+		tfMap["studio_enabled"] = aws.ToBool(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandMonitoringConfiguration(tfMap map[string]interface{}) *monitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &monitoringConfiguration{}
+
+	if v, ok := tfMap["prometheus_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PrometheusMonitoringConfiguration = expandPrometheusMonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenMonitoringConfiguration(apiObject *monitoringConfiguration) []interface{} {
+	if apiObject == nil || apiObject.PrometheusMonitoringConfiguration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"prometheus_monitoring_configuration": flattenPrometheusMonitoringConfiguration(apiObject.PrometheusMonitoringConfiguration),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandPrometheusMonitoringConfiguration(tfMap map[string]interface{}) *prometheusMonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &prometheusMonitoringConfiguration{}
+
+	if v, ok := tfMap["remote_write_url"].(string); ok && v != "" {
+		apiObject.RemoteWriteURL = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPrometheusMonitoringConfiguration(apiObject *prometheusMonitoringConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.RemoteWriteURL; v != nil {
+		tfMap["remote_write_url"] = aws.ToString(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSchedulerConfiguration(tfMap map[string]interface{}) *schedulerConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &schedulerConfiguration{}
+
+	if v, ok := tfMap["max_concurrent_runs"].(int); ok && v != 0 {
+		apiObject.MaxConcurrentRuns = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["queue_timeout_minutes"].(int); ok && v != 0 {
+		apiObject.QueueTimeoutMinutes = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenSchedulerConfiguration(apiObject *schedulerConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaxConcurrentRuns; v != nil {
+		tfMap["max_concurrent_runs"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.QueueTimeoutMinutes; v != nil {
+		tfMap["queue_timeout_minutes"] = aws.ToInt32(v)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// The application members below (interactive endpoints, Prometheus monitoring and job run scheduling)
+// are not modeled by the version of AWS SDK for Go v2 in use, so they are added to request bodies
+// and extracted from response bodies by middleware.
+
+type applicationExtensions struct {
+	InteractiveConfiguration *interactiveConfiguration `json:"interactiveConfiguration,omitempty"`
+	MonitoringConfiguration  *monitoringConfiguration  `json:"monitoringConfiguration,omitempty"`
+	SchedulerConfiguration   *schedulerConfiguration   `json:"schedulerConfiguration,omitempty"`
+}
+
+type interactiveConfiguration struct {
+	LivyEndpointEnabled *bool `json:"livyEndpointEnabled,omitempty"`
+	StudioEnabled       *bool `json:"studioEnabled,omitempty"`
+}
+
+type monitoringConfiguration struct {
+	PrometheusMonitoringConfiguration *prometheusMonitoringConfiguration `json:"prometheusMonitoringConfiguration,omitempty"`
+}
+
+type prometheusMonitoringConfiguration struct {
+	RemoteWriteURL *string `json:"remoteWriteUrl,omitempty"`
+}
+
+type schedulerConfiguration struct {
+	MaxConcurrentRuns   *int32 `json:"maxConcurrentRuns,omitempty"`
+	QueueTimeoutMinutes *int32 `json:"queueTimeoutMinutes,omitempty"`
+}
+
+type applicationExtensionsKey struct{}
+
+// withApplicationExtensionsRequest returns an API option that merges the specified members into
+// the serialized CreateApplication or UpdateApplication request body.
+func withApplicationExtensionsRequest(apiObject *applicationExtensions) func(*emrserverless.Options) {
+	return func(o *emrserverless.Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Build.Add(middleware.BuildMiddlewareFunc("ApplicationExtensionsRequest", func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (middleware.BuildOutput, middleware.Metadata, error) {
+				request, ok := in.Request.(*smithyhttp.Request)
+
+				if !ok {
+					return middleware.BuildOutput{}, middleware.Metadata{}, fmt.Errorf("unexpected transport type (%T)", in.Request)
+				}
+
+				body := map[string]json.RawMessage{}
+
+				if stream := request.GetStream(); stream != nil {
+					b, err := io.ReadAll(stream)
+
+					if err != nil {
+						return middleware.BuildOutput{}, middleware.Metadata{}, err
+					}
+
+					if len(b) > 0 {
+						if err := json.Unmarshal(b, &body); err != nil {
+							return middleware.BuildOutput{}, middleware.Metadata{}, err
+						}
+					}
+				}
+
+				if v := apiObject.InteractiveConfiguration; v != nil {
+					b, err := json.Marshal(v)
+
+					if err != nil {
+						return middleware.BuildOutput{}, middleware.Metadata{}, err
+					}
+
+					body["interactiveConfiguration"] = b
+				}
+
+				if v := apiObject.MonitoringConfiguration; v != nil && v.PrometheusMonitoringConfiguration != nil {
+					// Merge into any monitoring configuration serialized by the SDK.
+					monitoring := map[string]json.RawMessage{}
+
+					if v, ok := body["monitoringConfiguration"]; ok {
+						if err := json.Unmarshal(v, &monitoring); err != nil {
+							return middleware.BuildOutput{}, middleware.Metadata{}, err
+						}
+					}
+
+					b, err := json.Marshal(v.PrometheusMonitoringConfiguration)
+
+					if err != nil {
+						return middleware.BuildOutput{}, middleware.Metadata{}, err
+					}
+
+					monitoring["prometheusMonitoringConfiguration"] = b
+
+					if body["monitoringConfiguration"], err = json.Marshal(monitoring); err != nil {
+						return middleware.BuildOutput{}, middleware.Metadata{}, err
+					}
+				}
+
+				if v := apiObject.SchedulerConfiguration; v != nil {
+					b, err := json.Marshal(v)
+
+					if err != nil {
+						return middleware.BuildOutput{}, middleware.Metadata{}, err
+					}
+
+					body["schedulerConfiguration"] = b
+				}
+
+				b, err := json.Marshal(body)
+
+				if err != nil {
+					return middleware.BuildOutput{}, middleware.Metadata{}, err
+				}
+
+				request, err = request.SetStream(bytes.NewReader(b))
+
+				if err != nil {
+					return middleware.BuildOutput{}, middleware.Metadata{}, err
+				}
+
+				if request.Header.Get("Content-Type") == "" {
+					request.Header.Set("Content-Type", "application/json")
+				}
+
+				in.Request = request
+
+				return next.HandleBuild(ctx, in)
+			}), middleware.Before)
+		})
+	}
+}
+
+// withApplicationExtensionsResponse returns an API option that extracts the members from the raw
+// GetApplication response body into the operation's result metadata.
+func withApplicationExtensionsResponse(o *emrserverless.Options) {
+	o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+		return stack.Deserialize.Insert(middleware.DeserializeMiddlewareFunc("ApplicationExtensionsResponse", func(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleDeserialize(ctx, in)
+
+			if err != nil {
+				return out, metadata, err
+			}
+
+			response, ok := out.RawResponse.(*smithyhttp.Response)
+
+			if !ok || response.StatusCode < 200 || response.StatusCode >= 300 {
+				return out, metadata, err
+			}
+
+			b, err := io.ReadAll(response.Body)
+
+			if err != nil {
+				return out, metadata, err
+			}
+
+			response.Body = io.NopCloser(bytes.NewReader(b))
+
+			var body struct {
+				Application *applicationExtensions `json:"application"`
+			}
+
+			if err := json.Unmarshal(b, &body); err == nil && body.Application != nil {
+				metadata.Set(applicationExtensionsKey{}, body.Application)
+			}
+
+			return out, metadata, nil
+		}), "OperationDeserializer", middleware.After)
+	})
+}
+
+func applicationExtensionsFromMetadata(metadata middleware.Metadata) *applicationExtensions {
+	if v, ok := metadata.Get(applicationExtensionsKey{}).(*applicationExtensions); ok {
+		return v
+	}
+
+	return &applicationExtensions{}
+}
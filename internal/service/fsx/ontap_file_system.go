@@ -5,6 +5,7 @@ package fsx
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -155,8 +157,7 @@ func ResourceONTAPFileSystem() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IntBetween(1, 6),
+				ValidateFunc: validation.IntBetween(1, 12),
 			},
 			"kms_key_id": {
 				Type:         schema.TypeString,
@@ -226,8 +227,7 @@ func ResourceONTAPFileSystem() *schema.Resource {
 			"throughput_capacity_per_ha_pair": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IntInSlice([]int{3072, 6144}),
+				ValidateFunc: validation.IntInSlice([]int{128, 256, 512, 1024, 1536, 2048, 3072, 4096, 6144}),
 				ExactlyOneOf: []string{"throughput_capacity", "throughput_capacity_per_ha_pair"},
 			},
 			"vpc_id": {
@@ -245,7 +245,13 @@ func ResourceONTAPFileSystem() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			// HA pairs can be added to a scale-out file system but not removed.
+			customdiff.ForceNewIfChange("ha_pairs", func(_ context.Context, old, new, meta interface{}) bool {
+				return new.(int) < old.(int)
+			}),
+		),
 	}
 }
 
@@ -284,14 +290,7 @@ func resourceONTAPFileSystemCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if v, ok := d.GetOk("ha_pairs"); ok {
-		v := int64(v.(int))
-		input.OntapConfiguration.HAPairs = aws.Int64(v)
-
-		if v > 1 {
-			if v, ok := d.GetOk("throughput_capacity_per_ha_pair"); ok {
-				input.OntapConfiguration.ThroughputCapacityPerHAPair = aws.Int64(int64(v.(int)))
-			}
-		}
+		input.OntapConfiguration.HAPairs = aws.Int64(int64(v.(int)))
 	}
 
 	if v, ok := d.GetOk("kms_key_id"); ok {
@@ -310,6 +309,10 @@ func resourceONTAPFileSystemCreate(ctx context.Context, d *schema.ResourceData,
 		input.OntapConfiguration.ThroughputCapacity = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("throughput_capacity_per_ha_pair"); ok {
+		input.OntapConfiguration.ThroughputCapacityPerHAPair = aws.Int64(int64(v.(int)))
+	}
+
 	if v, ok := d.GetOk("weekly_maintenance_start_time"); ok {
 		input.OntapConfiguration.WeeklyMaintenanceStartTime = aws.String(v.(string))
 	}
@@ -370,7 +373,7 @@ func resourceONTAPFileSystemRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("storage_capacity", filesystem.StorageCapacity)
 	d.Set("storage_type", filesystem.StorageType)
 	d.Set("subnet_ids", aws.StringValueSlice(filesystem.SubnetIds))
-	if haPairs > 1 {
+	if haPairs > 1 || aws.StringValue(ontapConfig.DeploymentType) == fsx.OntapDeploymentTypeSingleAz2 {
 		d.Set("throughput_capacity", nil)
 		d.Set("throughput_capacity_per_ha_pair", ontapConfig.ThroughputCapacityPerHAPair)
 	} else {
@@ -389,7 +392,26 @@ func resourceONTAPFileSystemUpdate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FSxConn(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	// HA pairs must be added on their own, and the new pairs are available only once the update completes.
+	if d.HasChange("ha_pairs") {
+		input := &fsx.UpdateFileSystemInput{
+			ClientRequestToken: aws.String(id.UniqueId()),
+			FileSystemId:       aws.String(d.Id()),
+			OntapConfiguration: &fsx.UpdateFileSystemOntapConfiguration{
+				HAPairs: aws.Int64(int64(d.Get("ha_pairs").(int))),
+			},
+		}
+
+		if d.HasChange("throughput_capacity_per_ha_pair") {
+			input.OntapConfiguration.ThroughputCapacityPerHAPair = aws.Int64(int64(d.Get("throughput_capacity_per_ha_pair").(int)))
+		}
+
+		if err := updateONTAPFileSystem(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "adding FSx for NetApp ONTAP File System (%s) HA pairs: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChangesExcept("tags", "tags_all", "ha_pairs", "throughput_capacity_per_ha_pair") || (d.HasChange("throughput_capacity_per_ha_pair") && !d.HasChange("ha_pairs")) {
 		input := &fsx.UpdateFileSystemInput{
 			ClientRequestToken: aws.String(id.UniqueId()),
 			FileSystemId:       aws.String(d.Id()),
@@ -434,27 +456,40 @@ func resourceONTAPFileSystemUpdate(ctx context.Context, d *schema.ResourceData,
 			input.OntapConfiguration.ThroughputCapacity = aws.Int64(int64(d.Get("throughput_capacity").(int)))
 		}
 
+		if d.HasChange("throughput_capacity_per_ha_pair") && !d.HasChange("ha_pairs") {
+			input.OntapConfiguration.ThroughputCapacityPerHAPair = aws.Int64(int64(d.Get("throughput_capacity_per_ha_pair").(int)))
+		}
+
 		if d.HasChange("weekly_maintenance_start_time") {
 			input.OntapConfiguration.WeeklyMaintenanceStartTime = aws.String(d.Get("weekly_maintenance_start_time").(string))
 		}
 
-		startTime := time.Now()
-		_, err := conn.UpdateFileSystemWithContext(ctx, input)
-
-		if err != nil {
+		if err := updateONTAPFileSystem(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating FSx for NetApp ONTAP File System (%s): %s", d.Id(), err)
 		}
+	}
 
-		if _, err := waitFileSystemUpdated(ctx, conn, d.Id(), startTime, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for FSx for NetApp ONTAP File System (%s) update: %s", d.Id(), err)
-		}
+	return append(diags, resourceONTAPFileSystemRead(ctx, d, meta)...)
+}
 
-		if _, err := waitFileSystemAdministrativeActionCompleted(ctx, conn, d.Id(), fsx.AdministrativeActionTypeFileSystemUpdate, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for FSx for NetApp ONTAP File System (%s) administrative action (%s) complete: %s", d.Id(), fsx.AdministrativeActionTypeFileSystemUpdate, err)
-		}
+func updateONTAPFileSystem(ctx context.Context, conn *fsx.FSx, input *fsx.UpdateFileSystemInput, timeout time.Duration) error {
+	fileSystemID := aws.StringValue(input.FileSystemId)
+	startTime := time.Now()
+	_, err := conn.UpdateFileSystemWithContext(ctx, input)
+
+	if err != nil {
+		return err
 	}
 
-	return append(diags, resourceONTAPFileSystemRead(ctx, d, meta)...)
+	if _, err := waitFileSystemUpdated(ctx, conn, fileSystemID, startTime, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	if _, err := waitFileSystemAdministrativeActionCompleted(ctx, conn, fileSystemID, fsx.AdministrativeActionTypeFileSystemUpdate, timeout); err != nil {
+		return fmt.Errorf("waiting for administrative action (%s) complete: %w", fsx.AdministrativeActionTypeFileSystemUpdate, err)
+	}
+
+	return nil
 }
 
 func resourceONTAPFileSystemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
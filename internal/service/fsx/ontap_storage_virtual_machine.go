@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -232,7 +233,10 @@ func ResourceONTAPStorageVirtualMachine() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			storageVirtualMachineActiveDirectoryCustomizeDiff,
+		),
 	}
 }
 
@@ -319,7 +323,12 @@ func resourceONTAPStorageVirtualMachineUpdate(ctx context.Context, d *schema.Res
 		}
 
 		if d.HasChange("active_directory_configuration") {
-			input.ActiveDirectoryConfiguration = expandUpdateSvmActiveDirectoryConfiguration(d.Get("active_directory_configuration").([]interface{}))
+			if o, _ := d.GetChange("active_directory_configuration.0.self_managed_active_directory_configuration"); len(o.([]interface{})) == 0 {
+				// Joining the SVM to an Active Directory for the first time.
+				input.ActiveDirectoryConfiguration = expandUpdateSvmActiveDirectoryConfiguration(d.Get("active_directory_configuration").([]interface{}))
+			} else {
+				input.ActiveDirectoryConfiguration = expandUpdateSvmActiveDirectoryConfigurationModifications(d)
+			}
 		}
 
 		if d.HasChange("svm_admin_password") {
@@ -476,6 +485,36 @@ func expandSelfManagedActiveDirectoryConfigurationUpdates(cfg []interface{}) *fs
 	return &out
 }
 
+// Only the NetBIOS name, DNS IP addresses and service account credentials of a joined SVM can be modified in place.
+func expandUpdateSvmActiveDirectoryConfigurationModifications(d *schema.ResourceData) *fsx.UpdateSvmActiveDirectoryConfiguration {
+	const prefix = "active_directory_configuration.0.self_managed_active_directory_configuration.0."
+	out := &fsx.UpdateSvmActiveDirectoryConfiguration{}
+
+	if d.HasChange("active_directory_configuration.0.netbios_name") {
+		if v, ok := d.GetOk("active_directory_configuration.0.netbios_name"); ok {
+			out.NetBiosName = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChanges(prefix+"dns_ips", prefix+"password", prefix+"username") {
+		updates := &fsx.SelfManagedActiveDirectoryConfigurationUpdates{}
+
+		if d.HasChange(prefix + "dns_ips") {
+			updates.DnsIps = flex.ExpandStringSet(d.Get(prefix + "dns_ips").(*schema.Set))
+		}
+
+		// The service account credentials are always updated together.
+		if d.HasChanges(prefix+"password", prefix+"username") {
+			updates.Password = aws.String(d.Get(prefix + "password").(string))
+			updates.UserName = aws.String(d.Get(prefix + "username").(string))
+		}
+
+		out.SelfManagedActiveDirectoryConfiguration = updates
+	}
+
+	return out
+}
+
 func flattenSvmActiveDirectoryConfiguration(d *schema.ResourceData, rs *fsx.SvmActiveDirectoryConfiguration) []interface{} {
 	if rs == nil {
 		return []interface{}{}
@@ -569,6 +608,43 @@ func flattenSvmEndpoint(rs *fsx.SvmEndpoint) []interface{} {
 	return []interface{}{m}
 }
 
+func storageVirtualMachineActiveDirectoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const key = "active_directory_configuration.0.self_managed_active_directory_configuration"
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	o, n := d.GetChange(key)
+
+	// Joining the SVM to an Active Directory for the first time is done in place.
+	if len(o.([]interface{})) == 0 {
+		return nil
+	}
+
+	// An SVM can't be removed from its Active Directory.
+	if len(n.([]interface{})) == 0 {
+		return d.ForceNew("active_directory_configuration")
+	}
+
+	if d.HasChange(key + ".0.domain_name") {
+		if err := d.ForceNew(key + ".0.domain_name"); err != nil {
+			return err
+		}
+	}
+
+	// These values aren't returned by the API, so only force replacement when a previously configured value changes.
+	for _, k := range []string{"file_system_administrators_group", "organizational_unit_distinguished_name"} {
+		if o, _ := d.GetChange(key + ".0." + k); o.(string) != "" && d.HasChange(key+".0."+k) {
+			if err := d.ForceNew(key + ".0." + k); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func FindStorageVirtualMachineByID(ctx context.Context, conn *fsx.FSx, id string) (*fsx.StorageVirtualMachine, error) {
 	input := &fsx.DescribeStorageVirtualMachinesInput{
 		StorageVirtualMachineIds: []*string{aws.String(id)},
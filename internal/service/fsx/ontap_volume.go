@@ -10,6 +10,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -52,6 +54,39 @@ func ResourceONTAPVolume() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"aggregate_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregates": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 12,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexache.MustCompile(`^aggr[0-9]{1,2}$`), "must be in the format aggrX"),
+							},
+						},
+						"constituents_per_aggregate": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 200),
+						},
+						"total_constituents": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -278,6 +313,13 @@ func ResourceONTAPVolume() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"volume_style": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(fsx.VolumeStyle_Values(), false),
+			},
 			"volume_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -300,6 +342,10 @@ func resourceONTAPVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 		StorageVirtualMachineId: aws.String(d.Get("storage_virtual_machine_id").(string)),
 	}
 
+	if v, ok := d.GetOk("aggregate_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		ontapConfig.AggregateConfiguration = expandCreateAggregateConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("copy_tags_to_backups"); ok {
 		ontapConfig.CopyTagsToBackups = aws.Bool(v.(bool))
 	}
@@ -332,6 +378,10 @@ func resourceONTAPVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 		ontapConfig.TieringPolicy = expandTieringPolicy(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("volume_style"); ok {
+		ontapConfig.VolumeStyle = aws.String(v.(string))
+	}
+
 	name := d.Get("name").(string)
 	input := &fsx.CreateVolumeInput{
 		Name:               aws.String(name),
@@ -373,6 +423,13 @@ func resourceONTAPVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	ontapConfig := volume.OntapConfiguration
 
+	if ontapConfig.AggregateConfiguration != nil {
+		if err := d.Set("aggregate_configuration", []interface{}{flattenAggregateConfiguration(ontapConfig.AggregateConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting aggregate_configuration: %s", err)
+		}
+	} else {
+		d.Set("aggregate_configuration", nil)
+	}
 	d.Set("arn", volume.ResourceARN)
 	d.Set("copy_tags_to_backups", ontapConfig.CopyTagsToBackups)
 	d.Set("file_system_id", volume.FileSystemId)
@@ -399,6 +456,7 @@ func resourceONTAPVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("tiering_policy", nil)
 	}
 	d.Set("uuid", ontapConfig.UUID)
+	d.Set("volume_style", ontapConfig.VolumeStyle)
 	d.Set("volume_type", volume.VolumeType)
 
 	return diags
@@ -500,6 +558,47 @@ func resourceONTAPVolumeDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func expandCreateAggregateConfiguration(tfMap map[string]interface{}) *fsx.CreateAggregateConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &fsx.CreateAggregateConfiguration{}
+
+	if v, ok := tfMap["aggregates"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Aggregates = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["constituents_per_aggregate"].(int); ok && v != 0 {
+		apiObject.ConstituentsPerAggregate = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenAggregateConfiguration(apiObject *fsx.AggregateConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Aggregates; v != nil {
+		tfMap["aggregates"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.TotalConstituents; v != nil {
+		tfMap["total_constituents"] = aws.Int64Value(v)
+
+		// Constituents are evenly distributed across aggregates.
+		if n := len(apiObject.Aggregates); n > 0 {
+			tfMap["constituents_per_aggregate"] = aws.Int64Value(v) / int64(n)
+		}
+	}
+
+	return tfMap
+}
+
 const minTieringPolicyCoolingPeriod = 2
 
 func expandTieringPolicy(tfMap map[string]interface{}) *fsx.TieringPolicy {
@@ -53,6 +53,7 @@ func TestAccFSxONTAPVolume_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "tiering_policy.#", "1"),
 					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+					resource.TestCheckResourceAttr(resourceName, "volume_style", "FLEXVOL"),
 					resource.TestCheckResourceAttr(resourceName, "volume_type", "ONTAP"),
 				),
 			},
@@ -126,6 +127,40 @@ func TestAccFSxONTAPVolume_copyTagsToBackups(t *testing.T) {
 	})
 }
 
+func TestAccFSxONTAPVolume_aggregateConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var volume fsx.Volume
+	resourceName := "aws_fsx_ontap_volume.test"
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, fsx.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, fsx.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckONTAPVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccONTAPVolumeConfig_aggregateConfiguration(rName, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckONTAPVolumeExists(ctx, resourceName, &volume),
+					resource.TestCheckResourceAttr(resourceName, "aggregate_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregate_configuration.0.aggregates.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "aggregate_configuration.0.aggregates.0", "aggr1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregate_configuration.0.aggregates.1", "aggr2"),
+					resource.TestCheckResourceAttr(resourceName, "aggregate_configuration.0.constituents_per_aggregate", "4"),
+					resource.TestCheckResourceAttr(resourceName, "aggregate_configuration.0.total_constituents", "8"),
+					resource.TestCheckResourceAttr(resourceName, "volume_style", "FLEXGROUP"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccFSxONTAPVolume_junctionPath(t *testing.T) {
 	ctx := acctest.Context(t)
 	var volume1, volume2 fsx.Volume
@@ -688,6 +723,42 @@ resource "aws_fsx_ontap_volume" "test" {
 `, rName))
 }
 
+func testAccONTAPVolumeConfig_aggregateConfiguration(rName string, constituentsPerAggregate int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_fsx_ontap_file_system" "test" {
+  storage_capacity                = 2048
+  subnet_ids                      = [aws_subnet.test[0].id]
+  deployment_type                 = "SINGLE_AZ_2"
+  ha_pairs                        = 2
+  throughput_capacity_per_ha_pair = 3072
+  preferred_subnet_id             = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_fsx_ontap_storage_virtual_machine" "test" {
+  file_system_id = aws_fsx_ontap_file_system.test.id
+  name           = %[1]q
+}
+
+resource "aws_fsx_ontap_volume" "test" {
+  name                       = %[1]q
+  junction_path              = "/%[1]s"
+  size_in_megabytes          = 819200
+  storage_efficiency_enabled = true
+  storage_virtual_machine_id = aws_fsx_ontap_storage_virtual_machine.test.id
+  volume_style               = "FLEXGROUP"
+
+  aggregate_configuration {
+    aggregates                 = ["aggr1", "aggr2"]
+    constituents_per_aggregate = %[2]d
+  }
+}
+`, rName, constituentsPerAggregate))
+}
+
 func testAccONTAPVolumeConfig_copyTagsToBackups(rName string, copyTagsToBackups bool) string {
 	return acctest.ConfigCompose(testAccONTAPVolumeConfig_base(rName), fmt.Sprintf(`
 resource "aws_fsx_ontap_volume" "test" {
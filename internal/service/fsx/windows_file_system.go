@@ -88,6 +88,11 @@ func ResourceWindowsFileSystem() *schema.Resource {
 							ValidateFunc: verify.ValidARN,
 							StateFunc:    windowsAuditLogStateFunc,
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								// The destination isn't returned when audit logging is disabled.
+								if d.Get("audit_log_configuration.0.file_access_audit_log_level").(string) == fsx.WindowsAccessAuditLogLevelDisabled && d.Get("audit_log_configuration.0.file_share_access_audit_log_level").(string) == fsx.WindowsAccessAuditLogLevelDisabled {
+									return true
+								}
+
 								return strings.HasPrefix(old, fmt.Sprintf("%s:", new))
 							},
 						},
@@ -721,6 +726,11 @@ func expandWindowsAuditLogCreateConfiguration(l []interface{}) *fsx.WindowsAudit
 		FileShareAccessAuditLogLevel: aws.String(data["file_share_access_audit_log_level"].(string)),
 	}
 
+	// The destination can't be specified when both audit log levels are disabled.
+	if aws.StringValue(req.FileAccessAuditLogLevel) == fsx.WindowsAccessAuditLogLevelDisabled && aws.StringValue(req.FileShareAccessAuditLogLevel) == fsx.WindowsAccessAuditLogLevelDisabled {
+		return req
+	}
+
 	if v, ok := data["audit_log_destination"].(string); ok && v != "" {
 		req.AuditLogDestination = aws.String(windowsAuditLogStateFunc(v))
 	}
@@ -0,0 +1,527 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	catalogTableOptimizerResourceIDPartCount = 4
+)
+
+// @SDKResource("aws_glue_catalog_table_optimizer", name="Catalog Table Optimizer")
+func ResourceCatalogTableOptimizer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCatalogTableOptimizerCreate,
+		ReadWithoutTimeout:   resourceCatalogTableOptimizerRead,
+		UpdateWithoutTimeout: resourceCatalogTableOptimizerUpdate,
+		DeleteWithoutTimeout: resourceCatalogTableOptimizerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"orphan_file_deletion_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iceberg_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"location": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"orphan_file_retention_period_in_days": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"retention_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iceberg_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"clean_expired_files": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"number_of_snapshots_to_retain": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"snapshot_retention_period_in_days": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validRoleARN,
+						},
+					},
+				},
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(tableOptimizerType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: customizeCatalogTableOptimizerDiff,
+	}
+}
+
+func resourceCatalogTableOptimizerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID := d.Get("catalog_id").(string)
+	dbName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	optimizerType := d.Get("type").(string)
+	configuration, extensions := expandTableOptimizerConfiguration(d.Get("configuration").([]interface{}))
+	input := &glue.CreateTableOptimizerInput{
+		CatalogId:                   aws.String(catalogID),
+		DatabaseName:                aws.String(dbName),
+		TableName:                   aws.String(tableName),
+		TableOptimizerConfiguration: configuration,
+		Type:                        aws.String(optimizerType),
+	}
+
+	// Newly created IAM roles may not yet be assumable by AWS Glue.
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateTableOptimizerWithContext(ctx, input, withTableOptimizerConfigurationExtensionsRequest(extensions))
+	}, glue.ErrCodeAccessDeniedException, "does not have the correct trust policies")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Glue Catalog Table Optimizer: %s", err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{catalogID, dbName, tableName, optimizerType}, catalogTableOptimizerResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceCatalogTableOptimizerRead(ctx, d, meta)...)
+}
+
+func resourceCatalogTableOptimizerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), catalogTableOptimizerResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	catalogID, dbName, tableName, optimizerType := parts[0], parts[1], parts[2], parts[3]
+	extensions := &tableOptimizerConfigurationExtensions{}
+	optimizer, err := FindTableOptimizerByFourPartKey(ctx, conn, catalogID, dbName, tableName, optimizerType, withTableOptimizerConfigurationExtensionsResponse(extensions))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Catalog Table Optimizer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", catalogID)
+	if err := d.Set("configuration", flattenTableOptimizerConfiguration(optimizer.Configuration, extensions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set("database_name", dbName)
+	d.Set("table_name", tableName)
+	d.Set("type", optimizer.Type)
+
+	return diags
+}
+
+func resourceCatalogTableOptimizerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	configuration, extensions := expandTableOptimizerConfiguration(d.Get("configuration").([]interface{}))
+	input := &glue.UpdateTableOptimizerInput{
+		CatalogId:                   aws.String(d.Get("catalog_id").(string)),
+		DatabaseName:                aws.String(d.Get("database_name").(string)),
+		TableName:                   aws.String(d.Get("table_name").(string)),
+		TableOptimizerConfiguration: configuration,
+		Type:                        aws.String(d.Get("type").(string)),
+	}
+
+	_, err := conn.UpdateTableOptimizerWithContext(ctx, input, withTableOptimizerConfigurationExtensionsRequest(extensions))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCatalogTableOptimizerRead(ctx, d, meta)...)
+}
+
+func resourceCatalogTableOptimizerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Glue Catalog Table Optimizer: %s", d.Id())
+	_, err := conn.DeleteTableOptimizerWithContext(ctx, &glue.DeleteTableOptimizerInput{
+		CatalogId:    aws.String(d.Get("catalog_id").(string)),
+		DatabaseName: aws.String(d.Get("database_name").(string)),
+		TableName:    aws.String(d.Get("table_name").(string)),
+		Type:         aws.String(d.Get("type").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// customizeCatalogTableOptimizerDiff ensures that only the configuration block matching the optimizer type is set.
+func customizeCatalogTableOptimizerDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	optimizerType := diff.Get("type").(string)
+
+	for k, t := range map[string]string{
+		"configuration.0.orphan_file_deletion_configuration": tableOptimizerTypeOrphanFileDeletion,
+		"configuration.0.retention_configuration":            tableOptimizerTypeRetention,
+	} {
+		if v, ok := diff.GetOk(k); ok && len(v.([]interface{})) > 0 && optimizerType != t {
+			return fmt.Errorf("%s can only be set when type is %q", k, t)
+		}
+	}
+
+	return nil
+}
+
+// The retention and orphan file deletion optimizer configurations are not modeled by
+// AWS SDK for Go v1, so they are added to request bodies and read from response bodies
+// by request options.
+
+type tableOptimizerConfigurationExtensions struct {
+	OrphanFileDeletionConfiguration *orphanFileDeletionConfiguration `json:"orphanFileDeletionConfiguration,omitempty"`
+	RetentionConfiguration          *retentionConfiguration          `json:"retentionConfiguration,omitempty"`
+}
+
+type orphanFileDeletionConfiguration struct {
+	IcebergConfiguration *icebergOrphanFileDeletionConfiguration `json:"icebergConfiguration,omitempty"`
+}
+
+type icebergOrphanFileDeletionConfiguration struct {
+	Location                        *string `json:"location,omitempty"`
+	OrphanFileRetentionPeriodInDays *int64  `json:"orphanFileRetentionPeriodInDays,omitempty"`
+}
+
+type retentionConfiguration struct {
+	IcebergConfiguration *icebergRetentionConfiguration `json:"icebergConfiguration,omitempty"`
+}
+
+type icebergRetentionConfiguration struct {
+	CleanExpiredFiles             *bool  `json:"cleanExpiredFiles,omitempty"`
+	NumberOfSnapshotsToRetain     *int64 `json:"numberOfSnapshotsToRetain,omitempty"`
+	SnapshotRetentionPeriodInDays *int64 `json:"snapshotRetentionPeriodInDays,omitempty"`
+}
+
+// withTableOptimizerConfigurationExtensionsRequest returns a request option that merges the
+// specified members into the TableOptimizerConfiguration of a CreateTableOptimizer or
+// UpdateTableOptimizer request body.
+func withTableOptimizerConfigurationExtensionsRequest(apiObject *tableOptimizerConfigurationExtensions) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Build.PushBack(func(r *request.Request) {
+			if r.Error != nil || apiObject == nil || (apiObject.OrphanFileDeletionConfiguration == nil && apiObject.RetentionConfiguration == nil) {
+				return
+			}
+
+			b, err := io.ReadAll(r.GetBody())
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			body := map[string]json.RawMessage{}
+
+			if len(b) > 0 {
+				if err := json.Unmarshal(b, &body); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			configuration := map[string]json.RawMessage{}
+
+			if v, ok := body["TableOptimizerConfiguration"]; ok {
+				if err := json.Unmarshal(v, &configuration); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			if v := apiObject.OrphanFileDeletionConfiguration; v != nil {
+				if configuration["orphanFileDeletionConfiguration"], err = json.Marshal(v); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			if v := apiObject.RetentionConfiguration; v != nil {
+				if configuration["retentionConfiguration"], err = json.Marshal(v); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			if body["TableOptimizerConfiguration"], err = json.Marshal(configuration); err != nil {
+				r.Error = err
+				return
+			}
+
+			if b, err = json.Marshal(body); err != nil {
+				r.Error = err
+				return
+			}
+
+			r.SetBufferBody(b)
+		})
+	}
+}
+
+// withTableOptimizerConfigurationExtensionsResponse returns a request option that reads the
+// members from the TableOptimizer configuration of a GetTableOptimizer response body into apiObject.
+func withTableOptimizerConfigurationExtensionsResponse(apiObject *tableOptimizerConfigurationExtensions) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Unmarshal.PushFront(func(r *request.Request) {
+			b, err := io.ReadAll(r.HTTPResponse.Body)
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			r.HTTPResponse.Body = io.NopCloser(bytes.NewReader(b))
+
+			var body struct {
+				TableOptimizer *struct {
+					Configuration *tableOptimizerConfigurationExtensions `json:"configuration"`
+				} `json:"TableOptimizer"`
+			}
+
+			if err := json.Unmarshal(b, &body); err == nil && body.TableOptimizer != nil && body.TableOptimizer.Configuration != nil {
+				*apiObject = *body.TableOptimizer.Configuration
+			}
+		})
+	}
+}
+
+// validRoleARN validates that a value is the ARN of an IAM role.
+func validRoleARN(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return ws, errors
+	}
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return ws, errors
+	}
+
+	if parsedARN.Service != "iam" || !strings.HasPrefix(parsedARN.Resource, "role/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is not an IAM role ARN", k, value))
+	}
+
+	return ws, errors
+}
+
+func expandTableOptimizerConfiguration(tfList []interface{}) (*glue.TableOptimizerConfiguration, *tableOptimizerConfigurationExtensions) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &glue.TableOptimizerConfiguration{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+	extensions := &tableOptimizerConfigurationExtensions{}
+
+	if v, ok := tfMap["orphan_file_deletion_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		extensions.OrphanFileDeletionConfiguration = &orphanFileDeletionConfiguration{}
+
+		if v, ok := v[0].(map[string]interface{})["iceberg_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			iceberg := &icebergOrphanFileDeletionConfiguration{}
+
+			if v, ok := tfMap["location"].(string); ok && v != "" {
+				iceberg.Location = aws.String(v)
+			}
+
+			if v, ok := tfMap["orphan_file_retention_period_in_days"].(int); ok && v != 0 {
+				iceberg.OrphanFileRetentionPeriodInDays = aws.Int64(int64(v))
+			}
+
+			extensions.OrphanFileDeletionConfiguration.IcebergConfiguration = iceberg
+		}
+	}
+
+	if v, ok := tfMap["retention_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		extensions.RetentionConfiguration = &retentionConfiguration{}
+
+		if v, ok := v[0].(map[string]interface{})["iceberg_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			iceberg := &icebergRetentionConfiguration{}
+
+			if v, ok := tfMap["clean_expired_files"].(bool); ok {
+				iceberg.CleanExpiredFiles = aws.Bool(v)
+			}
+
+			if v, ok := tfMap["number_of_snapshots_to_retain"].(int); ok && v != 0 {
+				iceberg.NumberOfSnapshotsToRetain = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["snapshot_retention_period_in_days"].(int); ok && v != 0 {
+				iceberg.SnapshotRetentionPeriodInDays = aws.Int64(int64(v))
+			}
+
+			extensions.RetentionConfiguration.IcebergConfiguration = iceberg
+		}
+	}
+
+	return apiObject, extensions
+}
+
+func flattenTableOptimizerConfiguration(apiObject *glue.TableOptimizerConfiguration, extensions *tableOptimizerConfigurationExtensions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":  aws.BoolValue(apiObject.Enabled),
+		"role_arn": aws.StringValue(apiObject.RoleArn),
+	}
+
+	if v := extensions.OrphanFileDeletionConfiguration; v != nil {
+		tfMapOrphan := map[string]interface{}{}
+
+		if v := v.IcebergConfiguration; v != nil {
+			tfMapOrphan["iceberg_configuration"] = []interface{}{map[string]interface{}{
+				"location":                             aws.StringValue(v.Location),
+				"orphan_file_retention_period_in_days": aws.Int64Value(v.OrphanFileRetentionPeriodInDays),
+			}}
+		}
+
+		tfMap["orphan_file_deletion_configuration"] = []interface{}{tfMapOrphan}
+	}
+
+	if v := extensions.RetentionConfiguration; v != nil {
+		tfMapRetention := map[string]interface{}{}
+
+		if v := v.IcebergConfiguration; v != nil {
+			tfMapRetention["iceberg_configuration"] = []interface{}{map[string]interface{}{
+				"clean_expired_files":               aws.BoolValue(v.CleanExpiredFiles),
+				"number_of_snapshots_to_retain":     aws.Int64Value(v.NumberOfSnapshotsToRetain),
+				"snapshot_retention_period_in_days": aws.Int64Value(v.SnapshotRetentionPeriodInDays),
+			}}
+		}
+
+		tfMap["retention_configuration"] = []interface{}{tfMapRetention}
+	}
+
+	return []interface{}{tfMap}
+}
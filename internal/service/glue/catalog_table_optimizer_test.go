@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlueCatalogTableOptimizer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_catalog_table_optimizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCatalogTableOptimizerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTableOptimizerConfig_basic(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCatalogTableOptimizerExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "type", "compaction"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCatalogTableOptimizerConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCatalogTableOptimizerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueCatalogTableOptimizer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_catalog_table_optimizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCatalogTableOptimizerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTableOptimizerConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCatalogTableOptimizerExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfglue.ResourceCatalogTableOptimizer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueCatalogTableOptimizer_retentionConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_catalog_table_optimizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCatalogTableOptimizerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTableOptimizerConfig_retentionConfiguration(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCatalogTableOptimizerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_configuration.0.iceberg_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_configuration.0.iceberg_configuration.0.clean_expired_files", "true"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_configuration.0.iceberg_configuration.0.number_of_snapshots_to_retain", "3"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_configuration.0.iceberg_configuration.0.snapshot_retention_period_in_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "type", "retention"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCatalogTableOptimizerConfig_retentionConfiguration(rName, 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCatalogTableOptimizerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.retention_configuration.0.iceberg_configuration.0.snapshot_retention_period_in_days", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueCatalogTableOptimizer_orphanFileDeletionConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_catalog_table_optimizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCatalogTableOptimizerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTableOptimizerConfig_orphanFileDeletionConfiguration(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCatalogTableOptimizerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.orphan_file_deletion_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.orphan_file_deletion_configuration.0.iceberg_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.orphan_file_deletion_configuration.0.iceberg_configuration.0.orphan_file_retention_period_in_days", "3"),
+					resource.TestCheckResourceAttr(resourceName, "type", "orphan_file_deletion"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCatalogTableOptimizerConfig_orphanFileDeletionConfiguration(rName, 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCatalogTableOptimizerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.orphan_file_deletion_configuration.0.iceberg_configuration.0.orphan_file_retention_period_in_days", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueCatalogTableOptimizer_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCatalogTableOptimizerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCatalogTableOptimizerConfig_mismatchedType(rName),
+				ExpectError: regexache.MustCompile(`can only be set when type is "retention"`),
+			},
+			{
+				Config:      testAccCatalogTableOptimizerConfig_invalidRoleARN(rName),
+				ExpectError: regexache.MustCompile(`is not an IAM role ARN`),
+			},
+		},
+	})
+}
+
+func testAccCheckCatalogTableOptimizerExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 4, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn(ctx)
+
+		_, err = tfglue.FindTableOptimizerByFourPartKey(ctx, conn, parts[0], parts[1], parts[2], parts[3])
+
+		return err
+	}
+}
+
+func testAccCheckCatalogTableOptimizerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_glue_catalog_table_optimizer" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 4, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfglue.FindTableOptimizerByFourPartKey(ctx, conn, parts[0], parts[1], parts[2], parts[3])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Glue Catalog Table Optimizer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCatalogTableOptimizerConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccCatalogTableConfig_openTableFormat(rName, "test"), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = [
+          "s3:PutObject",
+          "s3:GetObject",
+          "s3:DeleteObject",
+          "s3:ListBucket",
+        ]
+        Resource = [
+          aws_s3_bucket.bucket.arn,
+          "${aws_s3_bucket.bucket.arn}/*",
+        ]
+      },
+      {
+        Effect = "Allow"
+        Action = [
+          "glue:UpdateTable",
+          "glue:GetTable",
+        ]
+        Resource = [
+          "arn:${data.aws_partition.current.partition}:glue:*:${data.aws_caller_identity.current.account_id}:catalog",
+          "arn:${data.aws_partition.current.partition}:glue:*:${data.aws_caller_identity.current.account_id}:database/${aws_glue_catalog_database.test.name}",
+          "arn:${data.aws_partition.current.partition}:glue:*:${data.aws_caller_identity.current.account_id}:table/${aws_glue_catalog_database.test.name}/${aws_glue_catalog_table.test.name}",
+        ]
+      },
+      {
+        Effect = "Allow"
+        Action = [
+          "logs:CreateLogGroup",
+          "logs:CreateLogStream",
+          "logs:PutLogEvents",
+        ]
+        Resource = "arn:${data.aws_partition.current.partition}:logs:*:${data.aws_caller_identity.current.account_id}:log-group:/aws-glue/iceberg-compaction/logs:*"
+      },
+    ]
+  })
+}
+`, rName))
+}
+
+func testAccCatalogTableOptimizerConfig_basic(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccCatalogTableOptimizerConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table_optimizer" "test" {
+  catalog_id    = data.aws_caller_identity.current.account_id
+  database_name = aws_glue_catalog_database.test.name
+  table_name    = aws_glue_catalog_table.test.name
+  type          = "compaction"
+
+  configuration {
+    role_arn = aws_iam_role.test.arn
+    enabled  = %[1]t
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, enabled))
+}
+
+func testAccCatalogTableOptimizerConfig_retentionConfiguration(rName string, retentionPeriod int) string {
+	return acctest.ConfigCompose(testAccCatalogTableOptimizerConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table_optimizer" "test" {
+  catalog_id    = data.aws_caller_identity.current.account_id
+  database_name = aws_glue_catalog_database.test.name
+  table_name    = aws_glue_catalog_table.test.name
+  type          = "retention"
+
+  configuration {
+    role_arn = aws_iam_role.test.arn
+    enabled  = true
+
+    retention_configuration {
+      iceberg_configuration {
+        snapshot_retention_period_in_days = %[1]d
+        number_of_snapshots_to_retain     = 3
+        clean_expired_files               = true
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, retentionPeriod))
+}
+
+func testAccCatalogTableOptimizerConfig_orphanFileDeletionConfiguration(rName string, retentionPeriod int) string {
+	return acctest.ConfigCompose(testAccCatalogTableOptimizerConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table_optimizer" "test" {
+  catalog_id    = data.aws_caller_identity.current.account_id
+  database_name = aws_glue_catalog_database.test.name
+  table_name    = aws_glue_catalog_table.test.name
+  type          = "orphan_file_deletion"
+
+  configuration {
+    role_arn = aws_iam_role.test.arn
+    enabled  = true
+
+    orphan_file_deletion_configuration {
+      iceberg_configuration {
+        orphan_file_retention_period_in_days = %[1]d
+        location                             = "s3://${aws_s3_bucket.bucket.bucket}/files/"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, retentionPeriod))
+}
+
+func testAccCatalogTableOptimizerConfig_mismatchedType(rName string) string {
+	return acctest.ConfigCompose(testAccCatalogTableOptimizerConfig_base(rName), `
+resource "aws_glue_catalog_table_optimizer" "test" {
+  catalog_id    = data.aws_caller_identity.current.account_id
+  database_name = aws_glue_catalog_database.test.name
+  table_name    = aws_glue_catalog_table.test.name
+  type          = "compaction"
+
+  configuration {
+    role_arn = aws_iam_role.test.arn
+    enabled  = true
+
+    retention_configuration {
+      iceberg_configuration {
+        snapshot_retention_period_in_days = 7
+      }
+    }
+  }
+}
+`)
+}
+
+func testAccCatalogTableOptimizerConfig_invalidRoleARN(rName string) string {
+	return acctest.ConfigCompose(testAccCatalogTableOptimizerConfig_base(rName), `
+resource "aws_glue_catalog_table_optimizer" "test" {
+  catalog_id    = data.aws_caller_identity.current.account_id
+  database_name = aws_glue_catalog_database.test.name
+  table_name    = aws_glue_catalog_table.test.name
+  type          = "compaction"
+
+  configuration {
+    role_arn = "arn:aws:iam::123456789012:user/example"
+    enabled  = true
+  }
+}
+`)
+}
@@ -17,3 +17,21 @@ const (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	tableOptimizerTypeCompaction         = "compaction"
+	tableOptimizerTypeOrphanFileDeletion = "orphan_file_deletion"
+	tableOptimizerTypeRetention          = "retention"
+)
+
+func tableOptimizerType_Values() []string {
+	return []string{
+		tableOptimizerTypeCompaction,
+		tableOptimizerTypeOrphanFileDeletion,
+		tableOptimizerTypeRetention,
+	}
+}
+
+const (
+	jobCommandNameRay = "glueray"
+)
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -38,12 +39,12 @@ func FindDevEndpointByName(ctx context.Context, conn *glue.Glue, name string) (*
 	return output.DevEndpoint, nil
 }
 
-func FindJobByName(ctx context.Context, conn *glue.Glue, name string) (*glue.Job, error) {
+func FindJobByName(ctx context.Context, conn *glue.Glue, name string, optFns ...request.Option) (*glue.Job, error) {
 	input := &glue.GetJobInput{
 		JobName: aws.String(name),
 	}
 
-	output, err := conn.GetJobWithContext(ctx, input)
+	output, err := conn.GetJobWithContext(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
 		return nil, &retry.NotFoundError{
@@ -323,3 +324,56 @@ func FindCrawlerByName(ctx context.Context, conn *glue.Glue, name string) (*glue
 
 	return output.Crawler, nil
 }
+
+func FindTableOptimizerByFourPartKey(ctx context.Context, conn *glue.Glue, catalogID, dbName, tableName, optimizerType string, optFns ...request.Option) (*glue.TableOptimizer, error) {
+	input := &glue.GetTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	}
+
+	output, err := conn.GetTableOptimizerWithContext(ctx, input, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TableOptimizer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TableOptimizer, nil
+}
+
+func FindUsageProfileByName(ctx context.Context, conn *glue.Glue, name string) (*glue.GetUsageProfileOutput, error) {
+	input := &glue.GetUsageProfileInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetUsageProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -4,15 +4,20 @@
 package glue
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -36,7 +41,10 @@ func ResourceJob() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeJobDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -113,6 +121,11 @@ func ResourceJob() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"job_run_queuing_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"max_capacity": {
 				Type:          schema.TypeFloat,
 				Optional:      true,
@@ -156,6 +169,10 @@ func ResourceJob() *schema.Resource {
 				ConflictsWith: []string{"max_capacity"},
 				ValidateFunc:  validation.IntAtLeast(2),
 			},
+			"profile_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -253,7 +270,11 @@ func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.WorkerType = aws.String(v.(string))
 	}
 
-	output, err := conn.CreateJobWithContext(ctx, input)
+	extensions := &jobExtensions{
+		JobRunQueuingEnabled: aws.Bool(d.Get("job_run_queuing_enabled").(bool)),
+	}
+
+	output, err := conn.CreateJobWithContext(ctx, input, withJobExtensionsRequest("", extensions))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Glue Job (%s): %s", name, err)
@@ -268,7 +289,8 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GlueConn(ctx)
 
-	job, err := FindJobByName(ctx, conn, d.Id())
+	extensions := &jobExtensions{}
+	job, err := FindJobByName(ctx, conn, d.Id(), withJobExtensionsResponse(extensions))
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Glue Job (%s) not found, removing from state", d.Id())
@@ -301,6 +323,7 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "setting execution_property: %s", err)
 	}
 	d.Set("glue_version", job.GlueVersion)
+	d.Set("job_run_queuing_enabled", aws.BoolValue(extensions.JobRunQueuingEnabled))
 	d.Set("max_capacity", job.MaxCapacity)
 	d.Set("max_retries", job.MaxRetries)
 	d.Set("name", job.Name)
@@ -309,6 +332,7 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "setting notification_property: %s", err)
 	}
 	d.Set("number_of_workers", job.NumberOfWorkers)
+	d.Set("profile_name", job.ProfileName)
 	d.Set("role_arn", job.Role)
 	d.Set("security_configuration", job.SecurityConfiguration)
 	d.Set("timeout", job.Timeout)
@@ -390,7 +414,11 @@ func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 			JobUpdate: jobUpdate,
 		}
 
-		_, err := conn.UpdateJobWithContext(ctx, input)
+		extensions := &jobExtensions{
+			JobRunQueuingEnabled: aws.Bool(d.Get("job_run_queuing_enabled").(bool)),
+		}
+
+		_, err := conn.UpdateJobWithContext(ctx, input, withJobExtensionsRequest("JobUpdate", extensions))
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Glue Job (%s): %s", d.Id(), err)
@@ -420,6 +448,126 @@ func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	return diags
 }
 
+// customizeJobDiff validates the worker type and runtime of Ray jobs.
+func customizeJobDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("command") || !diff.NewValueKnown("worker_type") {
+		return nil
+	}
+
+	commandName := diff.Get("command.0.name").(string)
+	workerType := diff.Get("worker_type").(string)
+
+	if commandName == jobCommandNameRay {
+		if workerType != glue.WorkerTypeZ2x {
+			return fmt.Errorf("worker_type must be %q when command.0.name is %q", glue.WorkerTypeZ2x, jobCommandNameRay)
+		}
+
+		if v, ok := diff.GetOk("command.0.runtime"); !ok || v.(string) == "" {
+			return fmt.Errorf("command.0.runtime must be set when command.0.name is %q", jobCommandNameRay)
+		}
+
+		return nil
+	}
+
+	if workerType == glue.WorkerTypeZ2x {
+		return fmt.Errorf("worker_type %q can only be used when command.0.name is %q", glue.WorkerTypeZ2x, jobCommandNameRay)
+	}
+
+	return nil
+}
+
+// Job run queuing is not modeled by AWS SDK for Go v1, so it is added to request bodies
+// and read from response bodies by request options.
+
+type jobExtensions struct {
+	JobRunQueuingEnabled *bool `json:"JobRunQueuingEnabled,omitempty"`
+}
+
+// withJobExtensionsRequest returns a request option that merges the specified members into
+// a CreateJob request body or, if key is set, into the named member of the request body.
+func withJobExtensionsRequest(key string, apiObject *jobExtensions) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Build.PushBack(func(r *request.Request) {
+			if r.Error != nil || apiObject == nil || apiObject.JobRunQueuingEnabled == nil {
+				return
+			}
+
+			b, err := io.ReadAll(r.GetBody())
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			body := map[string]json.RawMessage{}
+
+			if len(b) > 0 {
+				if err := json.Unmarshal(b, &body); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			target := body
+
+			if key != "" {
+				target = map[string]json.RawMessage{}
+
+				if v, ok := body[key]; ok {
+					if err := json.Unmarshal(v, &target); err != nil {
+						r.Error = err
+						return
+					}
+				}
+			}
+
+			if target["JobRunQueuingEnabled"], err = json.Marshal(apiObject.JobRunQueuingEnabled); err != nil {
+				r.Error = err
+				return
+			}
+
+			if key != "" {
+				if body[key], err = json.Marshal(target); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			if b, err = json.Marshal(body); err != nil {
+				r.Error = err
+				return
+			}
+
+			r.SetBufferBody(b)
+		})
+	}
+}
+
+// withJobExtensionsResponse returns a request option that reads the members from the Job
+// of a GetJob response body into apiObject.
+func withJobExtensionsResponse(apiObject *jobExtensions) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Unmarshal.PushFront(func(r *request.Request) {
+			b, err := io.ReadAll(r.HTTPResponse.Body)
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			r.HTTPResponse.Body = io.NopCloser(bytes.NewReader(b))
+
+			var body struct {
+				Job *jobExtensions `json:"Job"`
+			}
+
+			if err := json.Unmarshal(b, &body); err == nil && body.Job != nil {
+				*apiObject = *body.Job
+			}
+		})
+	}
+}
+
 func expandExecutionProperty(l []interface{}) *glue.ExecutionProperty {
 	m := l[0].(map[string]interface{})
 
@@ -41,6 +41,7 @@ func TestAccGlueJob_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "command.0.script_location", "testscriptlocation"),
 					resource.TestCheckResourceAttr(resourceName, "default_arguments.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "execution_class", ""),
+					resource.TestCheckResourceAttr(resourceName, "job_run_queuing_enabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "non_overridable_arguments.%", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
@@ -754,6 +755,67 @@ func TestAccGlueJob_rayJob(t *testing.T) {
 	})
 }
 
+func TestAccGlueJob_rayJobValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_rayJobWorkerType(rName, "glueray", "G.1X"),
+				ExpectError: regexache.MustCompile(`worker_type must be "Z.2X"`),
+			},
+			{
+				Config:      testAccJobConfig_rayJobWorkerType(rName, "glueetl", "Z.2X"),
+				ExpectError: regexache.MustCompile(`worker_type "Z.2X" can only be used`),
+			},
+			{
+				Config:      testAccJobConfig_rayJobNoRuntime(rName),
+				ExpectError: regexache.MustCompile(`command.0.runtime must be set`),
+			},
+		},
+	})
+}
+
+func TestAccGlueJob_jobRunQueuingEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job glue.Job
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_jobRunQueuingEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "job_run_queuing_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobConfig_jobRunQueuingEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "job_run_queuing_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueJob_maxCapacity(t *testing.T) {
 	ctx := acctest.Context(t)
 	var job glue.Job
@@ -1247,6 +1309,63 @@ resource "aws_glue_job" "test" {
 `, rName))
 }
 
+func testAccJobConfig_rayJobWorkerType(rName, commandName, workerType string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  glue_version      = "4.0"
+  name              = %[1]q
+  role_arn          = aws_iam_role.test.arn
+  worker_type       = %[3]q
+  number_of_workers = 10
+
+  command {
+    name            = %[2]q
+    python_version  = "3.9"
+    runtime         = "Ray2.4"
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, commandName, workerType))
+}
+
+func testAccJobConfig_rayJobNoRuntime(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  glue_version      = "4.0"
+  name              = %[1]q
+  role_arn          = aws_iam_role.test.arn
+  worker_type       = "Z.2X"
+  number_of_workers = 10
+
+  command {
+    name            = "glueray"
+    python_version  = "3.9"
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccJobConfig_jobRunQueuingEnabled(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  name                    = %[1]q
+  role_arn                = aws_iam_role.test.arn
+  job_run_queuing_enabled = %[2]t
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, enabled))
+}
+
 func testAccJobConfig_maxCapacity(rName string, maxCapacity float64) string {
 	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
 resource "aws_glue_job" "test" {
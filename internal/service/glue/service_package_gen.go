@@ -58,6 +58,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCatalogTable,
 			TypeName: "aws_glue_catalog_table",
 		},
+		{
+			Factory:  ResourceCatalogTableOptimizer,
+			TypeName: "aws_glue_catalog_table_optimizer",
+			Name:     "Catalog Table Optimizer",
+		},
 		{
 			Factory:  ResourceClassifier,
 			TypeName: "aws_glue_classifier",
@@ -154,6 +159,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceUsageProfile,
+			TypeName: "aws_glue_usage_profile",
+			Name:     "Usage Profile",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceUserDefinedFunction,
 			TypeName: "aws_glue_user_defined_function",
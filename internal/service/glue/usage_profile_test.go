@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlueUsageProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var profile glue.GetUsageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_usage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageProfileConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &profile),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "glue", fmt.Sprintf("usageProfile/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.job_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.job_configuration.*", map[string]string{
+						"key":           "numberOfWorkers",
+						"default_value": "10",
+						"max_value":     "20",
+						"min_value":     "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.session_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueUsageProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var profile glue.GetUsageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_usage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageProfileConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &profile),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfglue.ResourceUsageProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueUsageProfile_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var profile glue.GetUsageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_usage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageProfileConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.job_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.session_configuration.#", "0"),
+				),
+			},
+			{
+				Config: testAccUsageProfileConfig_updated(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.job_configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.job_configuration.*", map[string]string{
+						"key":              "workerType",
+						"default_value":    "G.1X",
+						"allowed_values.#": "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.session_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.session_configuration.*", map[string]string{
+						"key":           "timeout",
+						"default_value": "60",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueUsageProfile_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var profile glue.GetUsageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_usage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageProfileConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUsageProfileConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccUsageProfileConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUsageProfileExists(ctx context.Context, n string, v *glue.GetUsageProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Usage Profile ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn(ctx)
+
+		output, err := tfglue.FindUsageProfileByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckUsageProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_glue_usage_profile" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn(ctx)
+
+			_, err := tfglue.FindUsageProfileByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Glue Usage Profile %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccUsageProfileConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_usage_profile" "test" {
+  name = %[1]q
+
+  configuration {
+    job_configuration {
+      key           = "numberOfWorkers"
+      default_value = "10"
+      max_value     = "20"
+      min_value     = "2"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccUsageProfileConfig_updated(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_usage_profile" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  configuration {
+    job_configuration {
+      key           = "numberOfWorkers"
+      default_value = "10"
+      max_value     = "40"
+      min_value     = "2"
+    }
+
+    job_configuration {
+      key            = "workerType"
+      allowed_values = ["G.1X", "G.2X"]
+      default_value  = "G.1X"
+    }
+
+    session_configuration {
+      key           = "timeout"
+      default_value = "60"
+    }
+  }
+}
+`, rName, description)
+}
+
+func testAccUsageProfileConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_usage_profile" "test" {
+  name = %[1]q
+
+  configuration {
+    job_configuration {
+      key           = "numberOfWorkers"
+      default_value = "10"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccUsageProfileConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_usage_profile" "test" {
+  name = %[1]q
+
+  configuration {
+    job_configuration {
+      key           = "numberOfWorkers"
+      default_value = "10"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -75,8 +75,9 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"invitationMessage":  testAccMember_invitationMessage,
 		},
 		"PublishingDestination": {
-			"basic":      testAccPublishingDestination_basic,
-			"disappears": testAccPublishingDestination_disappears,
+			"basic":            testAccPublishingDestination_basic,
+			"disappears":       testAccPublishingDestination_disappears,
+			"datasource_basic": testAccPublishingDestinationDataSource_basic,
 		},
 	}
 
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_guardduty_publishing_destination")
+func DataSourcePublishingDestination() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePublishingDestinationRead,
+
+		Schema: map[string]*schema.Schema{
+			"destination_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePublishingDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID := d.Get("detector_id").(string)
+	destinationID := d.Get("destination_id").(string)
+	input := &guardduty.DescribePublishingDestinationInput{
+		DestinationId: aws.String(destinationID),
+		DetectorId:    aws.String(detectorID),
+	}
+
+	output, err := conn.DescribePublishingDestinationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Publishing Destination (%s): %s", destinationID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorID, aws.StringValue(output.DestinationId)))
+	if v := output.DestinationProperties; v != nil {
+		d.Set("destination_arn", v.DestinationArn)
+		d.Set("kms_key_arn", v.KmsKeyArn)
+	}
+	d.Set("destination_type", output.DestinationType)
+	d.Set("status", output.Status)
+
+	return diags
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccPublishingDestinationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_guardduty_publishing_destination.test"
+	resourceName := "aws_guardduty_publishing_destination.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDetectorNotExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPublishingDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublishingDestinationDataSourceConfig_basic(bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination_arn", resourceName, "destination_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination_type", resourceName, "destination_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "detector_id", resourceName, "detector_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_arn", resourceName, "kms_key_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", guardduty.PublishingStatusPublishing),
+				),
+			},
+		},
+	})
+}
+
+func testAccPublishingDestinationDataSourceConfig_basic(bucketName string) string {
+	return acctest.ConfigCompose(testAccPublishingDestinationConfig_basic(bucketName), `
+data "aws_guardduty_publishing_destination" "test" {
+  detector_id    = aws_guardduty_publishing_destination.test.detector_id
+  destination_id = split(":", aws_guardduty_publishing_destination.test.id)[1]
+}
+`)
+}
@@ -34,6 +34,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDetector,
 			TypeName: "aws_guardduty_detector",
 		},
+		{
+			Factory:  DataSourcePublishingDestination,
+			TypeName: "aws_guardduty_publishing_destination",
+		},
 	}
 }
 
@@ -72,6 +76,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceMalwareProtectionPlan,
+			TypeName: "aws_guardduty_malware_protection_plan",
+			Name:     "Malware Protection Plan",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceMember,
 			TypeName: "aws_guardduty_member",
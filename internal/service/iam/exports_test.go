@@ -17,5 +17,9 @@ var (
 	FindAttachedUserPolicies            = findAttachedUserPolicies
 	FindAttachedUserPolicyByTwoPartKey  = findAttachedUserPolicyByTwoPartKey
 	FindEntitiesForPolicyByARN          = findEntitiesForPolicyByARN
+	FindGroupAttachedPolicyARNs         = findGroupAttachedPolicyARNs
 	FindPolicyByARN                     = findPolicyByARN
+	FindRoleAttachedPolicies            = findRoleAttachedPolicies
+	FindRolePolicyNames                 = findRolePolicyNames
+	FindUserAttachedPolicyARNs          = findUserAttachedPolicyARNs
 )
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_group_policy_attachments_exclusive", name="Group Policy Attachments Exclusive")
+func resourceGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceGroupPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	groupName := d.Get("group_name").(string)
+	want := flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set))
+
+	have, err := findGroupAttachedPolicyARNs(ctx, conn, groupName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group (%s) attached policies: %s", groupName, err)
+	}
+
+	attach, detach := stringsDifference(want, have)
+	var errs []error
+
+	for _, policyARN := range attach {
+		if err := attachPolicyToGroup(ctx, conn, groupName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, policyARN := range detach {
+		if err := detachPolicyFromGroup(ctx, conn, groupName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(groupName)
+
+	return append(diags, resourceGroupPolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceGroupPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group (%s): %s", d.Id(), err)
+	}
+
+	policyARNs, err := findGroupAttachedPolicyARNs(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group (%s) attached policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("group_name", d.Id())
+
+	return diags
+}
+
+func resourceGroupPolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Managed policies are left attached when exclusive management ends.
+	log.Printf("[DEBUG] Removing IAM Group Policy Attachments Exclusive (%s) from state", d.Id())
+
+	return diags
+}
+
+func findGroupAttachedPolicyARNs(ctx context.Context, conn *iam.IAM, groupName string) ([]string, error) {
+	input := &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	output, err := findAttachedGroupPolicies(ctx, conn, input, tfslices.PredicateTrue[*iam.AttachedPolicy]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v *iam.AttachedPolicy) string {
+		return aws.StringValue(v.PolicyArn)
+	}), nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(groupName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentCount(ctx, groupName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_iam_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(groupName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentCount(ctx, groupName, 1),
+					testAccCheckGroupPolicyAttachmentsExclusiveAttachReadOnlyAccess(ctx, groupName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(groupName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentCount(ctx, groupName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupPolicyAttachmentsExclusiveAttachReadOnlyAccess(ctx context.Context, groupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+
+		_, err := conn.AttachGroupPolicyWithContext(ctx, &iam.AttachGroupPolicyInput{
+			PolicyArn: aws.String(fmt.Sprintf("arn:%s:iam::aws:policy/ReadOnlyAccess", acctest.Partition())),
+			GroupName: aws.String(groupName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		policyARNs, err := tfiam.FindGroupAttachedPolicyARNs(ctx, conn, groupName)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(policyARNs), 2; got != want {
+			return fmt.Errorf("IAM Group (%s) attached policies = %v, want %d", groupName, policyARNs, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccGroupPolicyAttachmentsExclusiveConfig_basic(groupName, policyName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_policy" "test" {
+  name = %[2]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:DescribeRegions"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_group_policy_attachments_exclusive" "test" {
+  group_name  = aws_iam_group.test.name
+  policy_arns = [aws_iam_policy.test.arn]
+}
+`, groupName, policyName)
+}
@@ -4,12 +4,14 @@
 package iam
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,6 +23,12 @@ import (
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length.
+const (
+	policyDocumentManagedPolicySizeLimit = 6144
+	policyDocumentTrustPolicySizeLimit   = 2048
+)
+
 // @SDKDataSource("aws_iam_policy_document")
 func DataSourcePolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
@@ -35,10 +43,19 @@ func DataSourcePolicyDocument() *schema.Resource {
 		ReadWithoutTimeout: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
+			"estimated_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"minify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"override_policy_documents": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -261,19 +278,62 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
+	var jsonDoc []byte
+	var err error
+	if d.Get("minify").(bool) {
+		jsonDoc, err = json.Marshal(mergedDoc)
+	} else {
+		jsonDoc, err = json.MarshalIndent(mergedDoc, "", "  ")
+	}
 	if err != nil {
 		// should never happen if the above code is correct
 		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
 	}
 	jsonString := string(jsonDoc)
 
+	size, err := policyDocumentEstimatedSize(jsonString)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: estimating size: %s", err)
+	}
+
+	// Statements with principals are only valid in resource-based policies such as role trust policies.
+	if policyDocumentHasPrincipals(mergedDoc) {
+		if size > policyDocumentTrustPolicySizeLimit {
+			diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document estimated size (%d characters) exceeds the default role trust policy size limit (%d characters)", size, policyDocumentTrustPolicySizeLimit)
+		}
+	} else if size > policyDocumentManagedPolicySizeLimit {
+		diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document estimated size (%d characters) exceeds the managed policy size limit (%d characters)", size, policyDocumentManagedPolicySizeLimit)
+	}
+
+	d.Set("estimated_size", size)
 	d.Set("json", jsonString)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
 }
 
+// policyDocumentEstimatedSize returns the number of characters in the policy document
+// excluding insignificant whitespace, which is how IAM counts against its size limits.
+func policyDocumentEstimatedSize(document string) (int, error) {
+	var buf bytes.Buffer
+
+	if err := json.Compact(&buf, []byte(document)); err != nil {
+		return 0, err
+	}
+
+	return utf8.RuneCount(buf.Bytes()), nil
+}
+
+func policyDocumentHasPrincipals(doc *IAMPolicyDoc) bool {
+	for _, stmt := range doc.Statements {
+		if len(stmt.Principals) > 0 || len(stmt.NotPrincipals) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
@@ -436,6 +436,33 @@ func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_minify(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_minify(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "estimated_size", "89"),
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccPolicyDocumentMinifyExpectedJSON),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_minify(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "estimated_size", "89"),
+					resource.TestCheckResourceAttr(dataSourceName, "json", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
 var testAccPolicyDocumentDataSourceConfig_basic = `
 data "aws_partition" "current" {}
 
@@ -1614,3 +1641,27 @@ data "aws_iam_policy_document" "test" {
   override_policy_documents = ["{"]
 }
 `
+
+func testAccPolicyDocumentDataSourceConfig_minify(minify bool) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  minify = %[1]t
+
+  statement {
+    actions   = ["ec2:*"]
+    resources = ["*"]
+  }
+}
+`, minify)
+}
+
+const testAccPolicyDocumentMinifyExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "ec2:*",
+      "Resource": "*"
+    }
+  ]
+}`
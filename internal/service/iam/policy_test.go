@@ -355,6 +355,39 @@ func TestAccIAMPolicy_policyDuplicateKeys(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicy_validatePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var out iam.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_validatePolicy(rName, "iam:PassRole"),
+				ExpectError: regexache.MustCompile(`SECURITY_WARNING \(PASS_ROLE_WITH_STAR_IN_RESOURCE\)`),
+			},
+			{
+				Config: testAccPolicyConfig_validatePolicy(rName, "ec2:DescribeInstances"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &out),
+					resource.TestCheckResourceAttr(resourceName, "validate_policy", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_policy"},
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyExists(ctx context.Context, n string, v *iam.Policy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -509,6 +542,24 @@ resource "aws_iam_policy" "test" {
 `, rName, policy)
 }
 
+func testAccPolicyConfig_validatePolicy(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name            = %[1]q
+  validate_policy = true
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = %[2]q
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName, action)
+}
+
 func testAccPolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_policy" "test" {
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// validatePolicySchema returns the schema for the opt-in `validate_policy` argument.
+func validatePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+}
+
+// validatePolicyCustomizeDiff validates the planned `policy` value with IAM Access Analyzer when
+// `validate_policy` is set. ERROR and SECURITY_WARNING findings fail the plan; other findings are logged.
+func validatePolicyCustomizeDiff(policyType awstypes.PolicyType) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.Get("validate_policy").(bool) {
+			return nil
+		}
+
+		if !d.NewValueKnown("policy") || (d.Id() != "" && !d.HasChange("policy")) {
+			return nil
+		}
+
+		conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+		findings, err := findPolicyValidationFindings(ctx, conn, d.Get("policy").(string), policyType)
+
+		if err != nil {
+			return fmt.Errorf("validating policy: %w", err)
+		}
+
+		var errs []error
+		for _, v := range findings {
+			msg := fmt.Sprintf("%s (%s): %s", v.FindingType, aws.ToString(v.IssueCode), aws.ToString(v.FindingDetails))
+
+			switch v.FindingType {
+			case awstypes.ValidatePolicyFindingTypeError, awstypes.ValidatePolicyFindingTypeSecurityWarning:
+				errs = append(errs, errors.New(msg))
+			default:
+				log.Printf("[WARN] IAM Access Analyzer policy validation: %s", msg)
+			}
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return fmt.Errorf("policy validation findings: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func findPolicyValidationFindings(ctx context.Context, conn *accessanalyzer.Client, policy string, policyType awstypes.PolicyType) ([]awstypes.ValidatePolicyFinding, error) {
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policy),
+		PolicyType:     policyType,
+	}
+	var output []awstypes.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_iam_role_policies_exclusive", name="Role Policies Exclusive")
+func resourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceRolePoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceRolePoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	roleName := d.Get("role_name").(string)
+	want := flex.ExpandStringValueSet(d.Get("policy_names").(*schema.Set))
+
+	have, err := findRolePolicyNames(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) inline policies: %s", roleName, err)
+	}
+
+	// Inline policies cannot be created without a policy document, so only remove unwanted policies.
+	_, remove := stringsDifference(want, have)
+
+	if err := deleteRoleInlinePolicies(ctx, conn, roleName, remove); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(roleName)
+
+	return append(diags, resourceRolePoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindRoleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s): %s", d.Id(), err)
+	}
+
+	policyNames, err := findRolePolicyNames(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", policyNames)
+	d.Set("role_name", d.Id())
+
+	return diags
+}
+
+func resourceRolePoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Inline policies are left in place when exclusive management ends.
+	log.Printf("[DEBUG] Removing IAM Role Policies Exclusive (%s) from state", d.Id())
+
+	return diags
+}
+
+// stringsDifference returns the values in want that are not in have (add)
+// and the values in have that are not in want (remove).
+func stringsDifference(want, have []string) (add, remove []string) {
+	wantSet := make(map[string]struct{}, len(want))
+	for _, v := range want {
+		wantSet[v] = struct{}{}
+	}
+	haveSet := make(map[string]struct{}, len(have))
+	for _, v := range have {
+		haveSet[v] = struct{}{}
+	}
+
+	for _, v := range want {
+		if _, ok := haveSet[v]; !ok {
+			add = append(add, v)
+		}
+	}
+	for _, v := range have {
+		if _, ok := wantSet[v]; !ok {
+			remove = append(remove, v)
+		}
+	}
+
+	return add, remove
+}
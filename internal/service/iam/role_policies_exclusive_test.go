@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestAccIAMRolePoliciesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePoliciesExclusiveConfig_basic(roleName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyNames(ctx, roleName, []string{policyName}),
+					resource.TestCheckResourceAttrPair(resourceName, "role_name", "aws_iam_role.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "policy_names.*", policyName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePoliciesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	outOfBandPolicyName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePoliciesExclusiveConfig_basic(roleName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyNames(ctx, roleName, []string{policyName}),
+					testAccCheckRolePoliciesExclusivePutInlinePolicy(ctx, roleName, outOfBandPolicyName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRolePoliciesExclusiveConfig_basic(roleName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyNames(ctx, roleName, []string{policyName}),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "policy_names.*", policyName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRolePolicyNames(ctx context.Context, roleName string, want []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+
+		got, err := tfiam.FindRolePolicyNames(ctx, conn, roleName)
+
+		if err != nil {
+			return err
+		}
+
+		if len(got) != len(want) {
+			return fmt.Errorf("IAM Role (%s) inline policies = %v, want %v", roleName, got, want)
+		}
+
+		for _, v := range want {
+			found := false
+			for _, g := range got {
+				if g == v {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("IAM Role (%s) inline policies = %v, want %v", roleName, got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRolePoliciesExclusivePutInlinePolicy(ctx context.Context, roleName, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+
+		_, err := conn.PutRolePolicyWithContext(ctx, &iam.PutRolePolicyInput{
+			PolicyDocument: aws.String(testAccRolePolicyExtraInlineConfig()),
+			PolicyName:     aws.String(policyName),
+			RoleName:       aws.String(roleName),
+		})
+
+		return err
+	}
+}
+
+func testAccRolePoliciesExclusiveConfig_basic(roleName, policyName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[2]q
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:DescribeRegions"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_role_policies_exclusive" "test" {
+  role_name    = aws_iam_role.test.name
+  policy_names = [aws_iam_role_policy.test.name]
+}
+`, roleName, policyName)
+}
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strings"
 
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -75,7 +76,10 @@ func ResourceRolePolicy() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validRolePolicyRole,
 			},
+			"validate_policy": validatePolicySchema(),
 		},
+
+		CustomizeDiff: validatePolicyCustomizeDiff(awstypes.PolicyTypeIdentityPolicy),
 	}
 }
 
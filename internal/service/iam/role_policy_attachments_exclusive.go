@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_role_policy_attachments_exclusive", name="Role Policy Attachments Exclusive")
+func resourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceRolePolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceRolePolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	roleName := d.Get("role_name").(string)
+	want := flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set))
+
+	have, err := findRoleAttachedPolicies(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) attached policies: %s", roleName, err)
+	}
+
+	attach, detach := stringsDifference(want, have)
+	var errs []error
+
+	for _, policyARN := range attach {
+		if err := attachPolicyToRole(ctx, conn, roleName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, policyARN := range detach {
+		if err := detachPolicyFromRole(ctx, conn, roleName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(roleName)
+
+	return append(diags, resourceRolePolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindRoleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s): %s", d.Id(), err)
+	}
+
+	policyARNs, err := findRoleAttachedPolicies(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) attached policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("role_name", d.Id())
+
+	return diags
+}
+
+func resourceRolePolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Managed policies are left attached when exclusive management ends.
+	log.Printf("[DEBUG] Removing IAM Role Policy Attachments Exclusive (%s) from state", d.Id())
+
+	return diags
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_user_policy_attachments_exclusive", name="User Policy Attachments Exclusive")
+func resourceUserPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceUserPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceUserPolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceUserPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	userName := d.Get("user_name").(string)
+	want := flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set))
+
+	have, err := findUserAttachedPolicyARNs(ctx, conn, userName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User (%s) attached policies: %s", userName, err)
+	}
+
+	attach, detach := stringsDifference(want, have)
+	var errs []error
+
+	for _, policyARN := range attach {
+		if err := attachPolicyToUser(ctx, conn, userName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, policyARN := range detach {
+		if err := detachPolicyFromUser(ctx, conn, userName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(userName)
+
+	return append(diags, resourceUserPolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceUserPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindUserByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User (%s): %s", d.Id(), err)
+	}
+
+	policyARNs, err := findUserAttachedPolicyARNs(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User (%s) attached policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("user_name", d.Id())
+
+	return diags
+}
+
+func resourceUserPolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Managed policies are left attached when exclusive management ends.
+	log.Printf("[DEBUG] Removing IAM User Policy Attachments Exclusive (%s) from state", d.Id())
+
+	return diags
+}
+
+func findUserAttachedPolicyARNs(ctx context.Context, conn *iam.IAM, userName string) ([]string, error) {
+	input := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+
+	output, err := findAttachedUserPolicies(ctx, conn, input, tfslices.PredicateTrue[*iam.AttachedPolicy]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v *iam.AttachedPolicy) string {
+		return aws.StringValue(v.PolicyArn)
+	}), nil
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameGroupMemberships = "GroupMemberships"
+
+	// groupMembershipsConcurrency is the number of membership changes made in parallel.
+	groupMembershipsConcurrency = 10
+)
+
+// @SDKResource("aws_identitystore_group_memberships", name="Group Memberships")
+func ResourceGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMembershipsPut,
+		ReadWithoutTimeout:   resourceGroupMembershipsRead,
+		UpdateWithoutTimeout: resourceGroupMembershipsPut,
+		DeleteWithoutTimeout: resourceGroupMembershipsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+			"member_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 47),
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID, groupID := d.Get("identity_store_id").(string), d.Get("group_id").(string)
+	id := fmt.Sprintf("%s/%s", identityStoreID, groupID)
+
+	memberships, err := FindGroupMembershipsByTwoPartKey(ctx, conn, identityStoreID, groupID)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, id, err)
+	}
+
+	want := flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set))
+
+	var add, remove []string
+	for _, memberID := range want {
+		if _, ok := memberships[memberID]; !ok {
+			add = append(add, memberID)
+		}
+	}
+	for memberID, membershipID := range memberships {
+		if !d.Get("member_ids").(*schema.Set).Contains(memberID) {
+			remove = append(remove, membershipID)
+		}
+	}
+
+	if err := deleteGroupMemberships(ctx, conn, identityStoreID, remove); err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, id, err)
+	}
+
+	if err := createGroupMemberships(ctx, conn, identityStoreID, groupID, add); err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceGroupMembershipsRead(ctx, d, meta)...)
+}
+
+func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID, groupID, err := resourceGroupParseID(d.Id())
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	memberships, err := FindGroupMembershipsByTwoPartKey(ctx, conn, identityStoreID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IdentityStore GroupMemberships (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	memberIDs := make([]string, 0, len(memberships))
+	for memberID := range memberships {
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("identity_store_id", identityStoreID)
+	d.Set("member_ids", flex.FlattenStringValueSet(memberIDs))
+
+	return diags
+}
+
+func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID, groupID := d.Get("identity_store_id").(string), d.Get("group_id").(string)
+
+	memberships, err := FindGroupMembershipsByTwoPartKey(ctx, conn, identityStoreID, groupID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	var remove []string
+	for _, memberID := range flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set)) {
+		if membershipID, ok := memberships[memberID]; ok {
+			remove = append(remove, membershipID)
+		}
+	}
+
+	log.Printf("[INFO] Deleting IdentityStore GroupMemberships %s", d.Id())
+	if err := deleteGroupMemberships(ctx, conn, identityStoreID, remove); err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	return diags
+}
+
+func createGroupMemberships(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string, memberIDs []string) error {
+	return forEachGroupMembership(memberIDs, func(memberID string) error {
+		_, err := conn.CreateGroupMembership(ctx, &identitystore.CreateGroupMembershipInput{
+			GroupId:         aws.String(groupID),
+			IdentityStoreId: aws.String(identityStoreID),
+			MemberId:        &types.MemberIdMemberUserId{Value: memberID},
+		})
+
+		if errs.IsA[*types.ConflictException](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("adding member (%s): %w", memberID, err)
+		}
+
+		return nil
+	})
+}
+
+func deleteGroupMemberships(ctx context.Context, conn *identitystore.Client, identityStoreID string, membershipIDs []string) error {
+	return forEachGroupMembership(membershipIDs, func(membershipID string) error {
+		_, err := conn.DeleteGroupMembership(ctx, &identitystore.DeleteGroupMembershipInput{
+			IdentityStoreId: aws.String(identityStoreID),
+			MembershipId:    aws.String(membershipID),
+		})
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("removing membership (%s): %w", membershipID, err)
+		}
+
+		return nil
+	})
+}
+
+// forEachGroupMembership calls f for each ID, running at most groupMembershipsConcurrency calls at a time.
+// The Identity Store API has no batch operations for group memberships.
+func forEachGroupMembership(ids []string, f func(string) error) error {
+	var (
+		failures []error
+		mu       sync.Mutex
+		sem      = make(chan struct{}, groupMembershipsConcurrency)
+		wg       sync.WaitGroup
+	)
+
+	for _, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(id); err != nil {
+				mu.Lock()
+				failures = append(failures, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(failures...)
+}
+
+// FindGroupMembershipsByTwoPartKey returns the group's user memberships as a map of member ID to membership ID.
+func FindGroupMembershipsByTwoPartKey(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string) (map[string]string, error) {
+	in := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+		MaxResults:      aws.Int32(100),
+	}
+	out := make(map[string]string)
+
+	pages := identitystore.NewListGroupMembershipsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.GroupMemberships {
+			if memberID, ok := v.MemberId.(*types.MemberIdMemberUserId); ok {
+				out[memberID.Value] = aws.ToString(v.MembershipId)
+			}
+		}
+	}
+
+	return out, nil
+}
@@ -45,6 +45,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGroupMembership,
 			TypeName: "aws_identitystore_group_membership",
 		},
+		{
+			Factory:  ResourceGroupMemberships,
+			TypeName: "aws_identitystore_group_memberships",
+			Name:     "Group Memberships",
+		},
 		{
 			Factory:  ResourceUser,
 			TypeName: "aws_identitystore_user",
@@ -698,6 +698,54 @@ func testAccCheckImagePipelineExists(ctx context.Context, resourceName string) r
 	}
 }
 
+func TestAccImageBuilderImagePipeline_workflow(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_image_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImagePipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagePipelineConfig_workflow(rName, imagebuilder.OnWorkflowFailureAbort, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role", "aws_iam_role.workflow", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.on_failure", imagebuilder.OnWorkflowFailureAbort),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.parallel_group", "test"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "workflow.0.parameter.*", map[string]string{
+						"name":  "waitForActionAtEnd",
+						"value": "true",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow.0.workflow_arn", "aws_imagebuilder_workflow.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImagePipelineConfig_workflow(rName, imagebuilder.OnWorkflowFailureContinue, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workflow.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.on_failure", imagebuilder.OnWorkflowFailureContinue),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "workflow.0.parameter.*", map[string]string{
+						"name":  "waitForActionAtEnd",
+						"value": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccImagePipelineConfig_base(rName string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
@@ -1076,6 +1124,49 @@ resource "aws_imagebuilder_image_pipeline" "test" {
 `, rName, status))
 }
 
+func testAccImagePipelineConfig_workflow(rName, onFailure, waitForActionAtEnd string) string {
+	return acctest.ConfigCompose(testAccImagePipelineConfig_base(rName), testAccWorkflowConfig_name(rName), fmt.Sprintf(`
+resource "aws_iam_role" "workflow" {
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "imagebuilder.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+  name = "%[1]s-workflow"
+}
+
+resource "aws_iam_role_policy_attachment" "workflow" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/aws-service-role/AWSServiceRoleForImageBuilder"
+  role       = aws_iam_role.workflow.name
+}
+
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  name                             = %[1]q
+  execution_role                   = aws_iam_role.workflow.arn
+
+  workflow {
+    on_failure     = %[2]q
+    parallel_group = "test"
+    workflow_arn   = aws_imagebuilder_workflow.test.arn
+
+    parameter {
+      name  = "waitForActionAtEnd"
+      value = %[3]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.workflow]
+}
+`, rName, onFailure, waitForActionAtEnd))
+}
+
 func testAccImagePipelineConfig_tags1(rName string, tagKey1 string, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccImagePipelineConfig_base(rName), fmt.Sprintf(`
 resource "aws_imagebuilder_image_pipeline" "test" {
@@ -0,0 +1,439 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfimagebuilder "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
+)
+
+func TestAccImageBuilderLifecyclePolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "imagebuilder", fmt.Sprintf("lifecycle-policy/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.action.0.type", imagebuilder.LifecyclePolicyDetailActionTypeDelete),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.exclusion_rules.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.type", imagebuilder.LifecyclePolicyDetailFilterTypeAge),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.unit", imagebuilder.LifecyclePolicyTimeUnitDays),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.value", "6"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.retain_at_least", "10"),
+					resource.TestCheckResourceAttr(resourceName, "resource_selection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_selection.0.tag_map.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_selection.0.tag_map.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", imagebuilder.LifecyclePolicyResourceTypeAmiImage),
+					resource.TestCheckResourceAttr(resourceName, "status", imagebuilder.LifecyclePolicyStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderLifecyclePolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfimagebuilder.ResourceLifecyclePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderLifecyclePolicy_policyDetails(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_policyDetails(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Deprecate and delete AMIs"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.action.0.type", imagebuilder.LifecyclePolicyDetailActionTypeDeprecate),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.type", imagebuilder.LifecyclePolicyDetailFilterTypeCount),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.value", "5"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.action.0.type", imagebuilder.LifecyclePolicyDetailActionTypeDelete),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.action.0.include_resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.action.0.include_resources.0.amis", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.action.0.include_resources.0.snapshots", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.exclusion_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.exclusion_rules.0.amis.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.exclusion_rules.0.amis.0.is_public", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.exclusion_rules.0.amis.0.last_launched.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.exclusion_rules.0.amis.0.last_launched.0.unit", imagebuilder.LifecyclePolicyTimeUnitWeeks),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.exclusion_rules.0.amis.0.last_launched.0.value", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.exclusion_rules.0.amis.0.regions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.exclusion_rules.0.tag_map.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.exclusion_rules.0.tag_map.keep", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.1.filter.0.type", imagebuilder.LifecyclePolicyDetailFilterTypeAge),
+					resource.TestCheckResourceAttr(resourceName, "resource_selection.0.recipe.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_selection.0.recipe.*", map[string]string{
+						"name":             rName,
+						"semantic_version": "1.0.0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "status", imagebuilder.LifecyclePolicyStatusDisabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_selection.0.recipe.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", imagebuilder.LifecyclePolicyStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderLifecyclePolicy_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLifecyclePolicyConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLifecyclePolicyConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLifecyclePolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_imagebuilder_lifecycle_policy" {
+				continue
+			}
+
+			input := &imagebuilder.GetLifecyclePolicyInput{
+				LifecyclePolicyArn: aws.String(rs.Primary.ID),
+			}
+
+			output, err := conn.GetLifecyclePolicyWithContext(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error getting Image Builder Lifecycle Policy (%s): %w", rs.Primary.ID, err)
+			}
+
+			if output != nil {
+				return fmt.Errorf("Image Builder Lifecycle Policy (%s) still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLifecyclePolicyExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderConn(ctx)
+
+		input := &imagebuilder.GetLifecyclePolicyInput{
+			LifecyclePolicyArn: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.GetLifecyclePolicyWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("error getting Image Builder Lifecycle Policy (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccLifecyclePolicyConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "imagebuilder.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+  name = %[1]q
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/EC2ImageBuilderLifecycleExecutionPolicy"
+  role       = aws_iam_role.test.name
+}
+`, rName)
+}
+
+func testAccLifecyclePolicyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLifecyclePolicyConfig_base(rName), fmt.Sprintf(`
+resource "aws_imagebuilder_lifecycle_policy" "test" {
+  name           = %[1]q
+  execution_role = aws_iam_role.test.arn
+  resource_type  = "AMI_IMAGE"
+
+  policy_detail {
+    action {
+      type = "DELETE"
+    }
+
+    filter {
+      type            = "AGE"
+      value           = 6
+      retain_at_least = 10
+      unit            = "DAYS"
+    }
+  }
+
+  resource_selection {
+    tag_map = {
+      key1 = "value1"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccLifecyclePolicyConfig_policyDetails(rName string) string {
+	return acctest.ConfigCompose(testAccLifecyclePolicyConfig_base(rName), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_imagebuilder_lifecycle_policy" "test" {
+  name           = %[1]q
+  description    = "Deprecate and delete AMIs"
+  execution_role = aws_iam_role.test.arn
+  resource_type  = "AMI_IMAGE"
+  status         = "DISABLED"
+
+  policy_detail {
+    action {
+      type = "DEPRECATE"
+    }
+
+    filter {
+      type  = "COUNT"
+      value = 5
+    }
+  }
+
+  policy_detail {
+    action {
+      type = "DELETE"
+
+      include_resources {
+        amis      = true
+        snapshots = true
+      }
+    }
+
+    exclusion_rules {
+      amis {
+        is_public = true
+        regions   = [data.aws_region.current.name]
+
+        last_launched {
+          unit  = "WEEKS"
+          value = 2
+        }
+      }
+
+      tag_map = {
+        keep = "true"
+      }
+    }
+
+    filter {
+      type            = "AGE"
+      value           = 6
+      retain_at_least = 10
+      unit            = "MONTHS"
+    }
+  }
+
+  resource_selection {
+    recipe {
+      name             = %[1]q
+      semantic_version = "1.0.0"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccLifecyclePolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccLifecyclePolicyConfig_base(rName), fmt.Sprintf(`
+resource "aws_imagebuilder_lifecycle_policy" "test" {
+  name           = %[1]q
+  execution_role = aws_iam_role.test.arn
+  resource_type  = "AMI_IMAGE"
+
+  policy_detail {
+    action {
+      type = "DELETE"
+    }
+
+    filter {
+      type  = "COUNT"
+      value = 10
+    }
+  }
+
+  resource_selection {
+    tag_map = {
+      key1 = "value1"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccLifecyclePolicyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccLifecyclePolicyConfig_base(rName), fmt.Sprintf(`
+resource "aws_imagebuilder_lifecycle_policy" "test" {
+  name           = %[1]q
+  execution_role = aws_iam_role.test.arn
+  resource_type  = "AMI_IMAGE"
+
+  policy_detail {
+    action {
+      type = "DELETE"
+    }
+
+    filter {
+      type  = "COUNT"
+      value = 10
+    }
+  }
+
+  resource_selection {
+    tag_map = {
+      key1 = "value1"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
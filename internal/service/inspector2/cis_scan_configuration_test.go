@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccInspector2CISScanConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "inspector2", regexache.MustCompile(`owner/.+/cis-configuration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.one_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_level", "LEVEL_1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.account_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.target_resource_tags.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccInspector2CISScanConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfinspector2.ResourceCISScanConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccInspector2CISScanConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_daily(rName, "LEVEL_1", "01:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.0.start_time.0.time_of_day", "01:00"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.0.start_time.0.timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "security_level", "LEVEL_1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_weekly(rName, "LEVEL_2", "13:30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.0.days.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "schedule.0.weekly.0.days.*", "MON"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "schedule.0.weekly.0.days.*", "THU"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.0.start_time.0.time_of_day", "13:30"),
+					resource.TestCheckResourceAttr(resourceName, "security_level", "LEVEL_2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInspector2CISScanConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCISScanConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector2 CIS Scan Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		_, err := tfinspector2.FindCISScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCISScanConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_inspector2_cis_scan_configuration" {
+				continue
+			}
+
+			_, err := tfinspector2.FindCISScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Inspector2 CIS Scan Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCISScanConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  name           = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time {}
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_daily(rName, securityLevel, timeOfDay string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  name           = %[1]q
+  security_level = %[2]q
+
+  schedule {
+    daily {
+      start_time {
+        time_of_day = %[3]q
+        timezone    = "UTC"
+      }
+    }
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+}
+`, rName, securityLevel, timeOfDay)
+}
+
+func testAccCISScanConfigurationConfig_weekly(rName, securityLevel, timeOfDay string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  name           = %[1]q
+  security_level = %[2]q
+
+  schedule {
+    weekly {
+      days = ["MON", "THU"]
+
+      start_time {
+        time_of_day = %[3]q
+        timezone    = "UTC"
+      }
+    }
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+}
+`, rName, securityLevel, timeOfDay)
+}
+
+func testAccCISScanConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  name           = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time {}
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCISScanConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  name           = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time {}
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
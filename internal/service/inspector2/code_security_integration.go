@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_inspector2_code_security_integration", name="Code Security Integration")
+// @Tags(identifierAttribute="arn")
+func ResourceCodeSecurityIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCodeSecurityIntegrationCreate,
+		ReadWithoutTimeout:   resourceCodeSecurityIntegrationRead,
+		UpdateWithoutTimeout: resourceCodeSecurityIntegrationUpdate,
+		DeleteWithoutTimeout: resourceCodeSecurityIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gitlab_self_managed": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_token": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"instance_url": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"last_update_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.IntegrationType](),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCodeSecurityIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	name := d.Get("name").(string)
+	input := &inspector2.CreateCodeSecurityIntegrationInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+		Type: types.IntegrationType(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("gitlab_self_managed"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		input.Details = &types.CreateIntegrationDetailMemberGitlabSelfManaged{
+			Value: types.CreateGitLabSelfManagedIntegrationDetail{
+				AccessToken: aws.String(tfMap["access_token"].(string)),
+				InstanceUrl: aws.String(tfMap["instance_url"].(string)),
+			},
+		}
+	}
+
+	output, err := conn.CreateCodeSecurityIntegration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Inspector Code Security Integration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.IntegrationArn))
+
+	if _, err := waitCodeSecurityIntegrationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Amazon Inspector Code Security Integration (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCodeSecurityIntegrationRead(ctx, d, meta)...)
+}
+
+func resourceCodeSecurityIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	output, err := FindCodeSecurityIntegrationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Amazon Inspector Code Security Integration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amazon Inspector Code Security Integration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.IntegrationArn)
+	d.Set("authorization_url", output.AuthorizationUrl)
+	if output.CreatedOn != nil {
+		d.Set("created_on", aws.ToTime(output.CreatedOn).Format(time.RFC3339))
+	} else {
+		d.Set("created_on", nil)
+	}
+	if output.LastUpdateOn != nil {
+		d.Set("last_update_on", aws.ToTime(output.LastUpdateOn).Format(time.RFC3339))
+	} else {
+		d.Set("last_update_on", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("status_reason", output.StatusReason)
+	d.Set("type", output.Type)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceCodeSecurityIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceCodeSecurityIntegrationRead(ctx, d, meta)
+}
+
+func resourceCodeSecurityIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting Amazon Inspector Code Security Integration: %s", d.Id())
+	_, err := conn.DeleteCodeSecurityIntegration(ctx, &inspector2.DeleteCodeSecurityIntegrationInput{
+		IntegrationArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Amazon Inspector Code Security Integration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCodeSecurityIntegrationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Amazon Inspector Code Security Integration (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCodeSecurityIntegrationByARN(ctx context.Context, conn *inspector2.Client, arn string) (*inspector2.GetCodeSecurityIntegrationOutput, error) {
+	input := &inspector2.GetCodeSecurityIntegrationInput{
+		IntegrationArn: aws.String(arn),
+	}
+
+	output, err := conn.GetCodeSecurityIntegration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusCodeSecurityIntegration(ctx context.Context, conn *inspector2.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCodeSecurityIntegrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCodeSecurityIntegrationCreated(ctx context.Context, conn *inspector2.Client, arn string, timeout time.Duration) (*inspector2.GetCodeSecurityIntegrationOutput, error) {
+	// A newly created integration stays PENDING until the OAuth authorization is completed out-of-band.
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.IntegrationStatusInProgress),
+		Target:  enum.Slice(types.IntegrationStatusPending, types.IntegrationStatusActive),
+		Refresh: statusCodeSecurityIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*inspector2.GetCodeSecurityIntegrationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCodeSecurityIntegrationDeleted(ctx context.Context, conn *inspector2.Client, arn string, timeout time.Duration) (*inspector2.GetCodeSecurityIntegrationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.IntegrationStatusActive, types.IntegrationStatusDisabling, types.IntegrationStatusInactive, types.IntegrationStatusInProgress, types.IntegrationStatusPending),
+		Target:  []string{},
+		Refresh: statusCodeSecurityIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*inspector2.GetCodeSecurityIntegrationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -35,11 +35,12 @@ func TestAccInspector2_serial(t *testing.T) {
 			"disappears": testAccMemberAssociation_disappears,
 		},
 		"OrganizationConfiguration": {
-			"basic":      testAccOrganizationConfiguration_basic,
-			"disappears": testAccOrganizationConfiguration_disappears,
-			"ec2ECR":     testAccOrganizationConfiguration_ec2ECR,
-			"lambda":     testAccOrganizationConfiguration_lambda,
-			"lambdaCode": testAccOrganizationConfiguration_lambdaCode,
+			"basic":          testAccOrganizationConfiguration_basic,
+			"disappears":     testAccOrganizationConfiguration_disappears,
+			"ec2ECR":         testAccOrganizationConfiguration_ec2ECR,
+			"lambda":         testAccOrganizationConfiguration_lambda,
+			"lambdaCode":     testAccOrganizationConfiguration_lambdaCode,
+			"codeRepository": testAccOrganizationConfiguration_codeRepository,
 		},
 	}
 
@@ -43,6 +43,11 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 				MinItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"code_repository": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"ec2": {
 							Type:     schema.TypeBool,
 							Required: true,
@@ -135,7 +140,7 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		return create.AppendDiagError(diags, names.Inspector2, create.ErrActionUpdating, ResNameOrganizationConfiguration, d.Id(), err)
 	}
 
-	if err := waitOrganizationConfigurationUpdated(ctx, conn, d.Get("auto_enable.0.ec2").(bool), d.Get("auto_enable.0.ecr").(bool), d.Get("auto_enable.0.lambda").(bool), d.Get("auto_enable.0.lambda_code").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if err := waitOrganizationConfigurationUpdated(ctx, conn, d.Get("auto_enable.0.ec2").(bool), d.Get("auto_enable.0.ecr").(bool), d.Get("auto_enable.0.lambda").(bool), d.Get("auto_enable.0.lambda_code").(bool), d.Get("auto_enable.0.code_repository").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return create.AppendDiagError(diags, names.Inspector2, create.ErrActionWaitingForUpdate, ResNameOrganizationConfiguration, d.Id(), err)
 	}
 
@@ -152,10 +157,11 @@ func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.Reso
 
 	in := &inspector2.UpdateOrganizationConfigurationInput{
 		AutoEnable: &types.AutoEnable{
-			Ec2:        aws.Bool(false),
-			Ecr:        aws.Bool(false),
-			Lambda:     aws.Bool(false),
-			LambdaCode: aws.Bool(false),
+			CodeRepository: aws.Bool(false),
+			Ec2:            aws.Bool(false),
+			Ecr:            aws.Bool(false),
+			Lambda:         aws.Bool(false),
+			LambdaCode:     aws.Bool(false),
 		},
 	}
 
@@ -165,39 +171,21 @@ func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.Reso
 		return create.AppendDiagError(diags, names.Inspector2, create.ErrActionUpdating, ResNameOrganizationConfiguration, d.Id(), err)
 	}
 
-	if err := waitOrganizationConfigurationUpdated(ctx, conn, false, false, false, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if err := waitOrganizationConfigurationUpdated(ctx, conn, false, false, false, false, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return create.AppendDiagError(diags, names.Inspector2, create.ErrActionWaitingForUpdate, ResNameOrganizationConfiguration, d.Id(), err)
 	}
 
 	return diags
 }
 
-func waitOrganizationConfigurationUpdated(ctx context.Context, conn *inspector2.Client, ec2, ecr, lambda, lambda_code bool, timeout time.Duration) error {
-	needle := fmt.Sprintf("%t:%t:%t:%t", ec2, ecr, lambda, lambda_code)
-
-	all := []string{
-		fmt.Sprintf("%t:%t:%t:%t", false, false, false, false),
-		fmt.Sprintf("%t:%t:%t:%t", false, false, false, true),
-		fmt.Sprintf("%t:%t:%t:%t", false, true, false, false),
-		fmt.Sprintf("%t:%t:%t:%t", false, true, false, true),
-		fmt.Sprintf("%t:%t:%t:%t", false, false, true, false),
-		fmt.Sprintf("%t:%t:%t:%t", false, false, true, true),
-		fmt.Sprintf("%t:%t:%t:%t", false, true, true, false),
-		fmt.Sprintf("%t:%t:%t:%t", false, true, true, true),
-		fmt.Sprintf("%t:%t:%t:%t", true, false, false, false),
-		fmt.Sprintf("%t:%t:%t:%t", true, false, false, true),
-		fmt.Sprintf("%t:%t:%t:%t", true, false, true, false),
-		fmt.Sprintf("%t:%t:%t:%t", true, false, true, true),
-		fmt.Sprintf("%t:%t:%t:%t", true, true, false, false),
-		fmt.Sprintf("%t:%t:%t:%t", true, true, false, true),
-		fmt.Sprintf("%t:%t:%t:%t", true, true, true, false),
-		fmt.Sprintf("%t:%t:%t:%t", true, true, true, true),
-	}
+func waitOrganizationConfigurationUpdated(ctx context.Context, conn *inspector2.Client, ec2, ecr, lambda, lambda_code, code_repository bool, timeout time.Duration) error {
+	needle := organizationConfigurationStatus(ec2, ecr, lambda, lambda_code, code_repository)
 
-	for i, v := range all {
-		if v == needle {
-			all = append(all[:i], all[i+1:]...)
-			break
+	var all []string
+	for i := 0; i < 1<<5; i++ {
+		v := organizationConfigurationStatus(i&1 != 0, i&2 != 0, i&4 != 0, i&8 != 0, i&16 != 0)
+		if v != needle {
+			all = append(all, v)
 		}
 	}
 
@@ -227,10 +215,14 @@ func statusOrganizationConfiguration(ctx context.Context, conn *inspector2.Clien
 			return nil, "", err
 		}
 
-		return out, fmt.Sprintf("%t:%t:%t:%t", aws.ToBool(out.AutoEnable.Ec2), aws.ToBool(out.AutoEnable.Ecr), aws.ToBool(out.AutoEnable.Lambda), aws.ToBool(out.AutoEnable.LambdaCode)), nil
+		return out, organizationConfigurationStatus(aws.ToBool(out.AutoEnable.Ec2), aws.ToBool(out.AutoEnable.Ecr), aws.ToBool(out.AutoEnable.Lambda), aws.ToBool(out.AutoEnable.LambdaCode), aws.ToBool(out.AutoEnable.CodeRepository)), nil
 	}
 }
 
+func organizationConfigurationStatus(ec2, ecr, lambda, lambda_code, code_repository bool) string {
+	return fmt.Sprintf("%t:%t:%t:%t:%t", ec2, ecr, lambda, lambda_code, code_repository)
+}
+
 func flattenAutoEnable(apiObject *types.AutoEnable) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -238,6 +230,10 @@ func flattenAutoEnable(apiObject *types.AutoEnable) map[string]interface{} {
 
 	m := map[string]interface{}{}
 
+	if v := apiObject.CodeRepository; v != nil {
+		m["code_repository"] = aws.ToBool(v)
+	}
+
 	if v := apiObject.Ec2; v != nil {
 		m["ec2"] = aws.ToBool(v)
 	}
@@ -264,6 +260,10 @@ func expandAutoEnable(tfMap map[string]interface{}) *types.AutoEnable {
 
 	a := &types.AutoEnable{}
 
+	if v, ok := tfMap["code_repository"].(bool); ok {
+		a.CodeRepository = aws.Bool(v)
+	}
+
 	if v, ok := tfMap["ec2"].(bool); ok {
 		a.Ec2 = aws.Bool(v)
 	}
@@ -28,6 +28,22 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceCISScanConfiguration,
+			TypeName: "aws_inspector2_cis_scan_configuration",
+			Name:     "CIS Scan Configuration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceCodeSecurityIntegration,
+			TypeName: "aws_inspector2_code_security_integration",
+			Name:     "Code Security Integration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceDelegatedAdminAccount,
 			TypeName: "aws_inspector2_delegated_admin_account",
@@ -148,6 +148,16 @@ func FindKeyPolicyByKeyIDAndPolicyName(ctx context.Context, conn *kms.KMS, keyID
 }
 
 func FindKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.KMS, keyID string) (*bool, error) {
+	output, err := FindKeyRotationStatusByKeyID(ctx, conn, keyID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.KeyRotationEnabled, nil
+}
+
+func FindKeyRotationStatusByKeyID(ctx context.Context, conn *kms.KMS, keyID string) (*kms.GetKeyRotationStatusOutput, error) {
 	input := &kms.GetKeyRotationStatusInput{
 		KeyId: aws.String(keyID),
 	}
@@ -169,5 +179,5 @@ func FindKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.KMS, keyID str
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.KeyRotationEnabled, nil
+	return output, nil
 }
@@ -85,6 +85,16 @@ func ResourceKey() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"rotation_period_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(90, 2560),
+			},
+			"rotate_on_demand_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"is_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -187,7 +197,7 @@ func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
 
 	if enableKeyRotation := d.Get("enable_key_rotation").(bool); enableKeyRotation {
-		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), enableKeyRotation); err != nil {
+		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), enableKeyRotation, d.Get("rotation_period_in_days").(int)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating KMS Key (%s): %s", d.Id(), err)
 		}
 	}
@@ -231,7 +241,8 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", d.Id(), err)
 	}
 
-	if aws.BoolValue(key.metadata.MultiRegion) &&
+	// A multi-Region key already in state may have been demoted to a replica by promoting one of its replicas.
+	if aws.BoolValue(key.metadata.MultiRegion) && !d.Get("multi_region").(bool) &&
 		aws.StringValue(key.metadata.MultiRegionConfiguration.MultiRegionKeyType) != kms.MultiRegionKeyTypePrimary {
 		return sdkdiag.AppendErrorf(diags, "KMS Key (%s) is not a multi-Region primary key", d.Id())
 	}
@@ -245,6 +256,7 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("key_id", key.metadata.KeyId)
 	d.Set("key_usage", key.metadata.KeyUsage)
 	d.Set("multi_region", key.metadata.MultiRegion)
+	d.Set("rotation_period_in_days", key.rotationPeriodInDays)
 
 	if key.metadata.XksKeyConfiguration != nil {
 		d.Set("xks_key_id", key.metadata.XksKeyConfiguration.Id)
@@ -277,8 +289,14 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if hasChange, enableKeyRotation := d.HasChange("enable_key_rotation"), d.Get("enable_key_rotation").(bool); hasChange {
-		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), enableKeyRotation); err != nil {
+	if hasChange, enableKeyRotation := d.HasChanges("enable_key_rotation", "rotation_period_in_days"), d.Get("enable_key_rotation").(bool); hasChange {
+		if err := updateKeyRotationEnabled(ctx, conn, d.Id(), enableKeyRotation, d.Get("rotation_period_in_days").(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Key (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("rotate_on_demand_trigger") && d.Get("rotate_on_demand_trigger").(string) != "" {
+		if err := rotateKeyOnDemand(ctx, conn, d.Id()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating KMS Key (%s): %s", d.Id(), err)
 		}
 	}
@@ -342,10 +360,11 @@ func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 type kmsKey struct {
-	metadata *kms.KeyMetadata
-	policy   string
-	rotation *bool
-	tags     []*kms.Tag
+	metadata             *kms.KeyMetadata
+	policy               string
+	rotation             *bool
+	rotationPeriodInDays *int64
+	tags                 []*kms.Tag
 }
 
 func findKey(ctx context.Context, conn *kms.KMS, keyID string, isNewResource bool) (*kmsKey, error) {
@@ -373,11 +392,14 @@ func findKey(ctx context.Context, conn *kms.KMS, keyID string, isNewResource boo
 		}
 
 		if aws.StringValue(key.metadata.Origin) == kms.OriginTypeAwsKms {
-			key.rotation, err = FindKeyRotationEnabledByKeyID(ctx, conn, keyID)
+			rotationStatus, err := FindKeyRotationStatusByKeyID(ctx, conn, keyID)
 
 			if err != nil {
 				return nil, fmt.Errorf("reading KMS Key (%s) rotation enabled: %w", keyID, err)
 			}
+
+			key.rotation = rotationStatus.KeyRotationEnabled
+			key.rotationPeriodInDays = rotationStatus.RotationPeriodInDays
 		}
 
 		tags, err := listTags(ctx, conn, keyID)
@@ -495,7 +517,7 @@ func updateKeyPolicy(ctx context.Context, conn *kms.KMS, keyID string, policy st
 	return nil
 }
 
-func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string, enabled bool) error {
+func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string, enabled bool, rotationPeriodInDays int) error {
 	var action string
 
 	updateFunc := func() (interface{}, error) {
@@ -503,9 +525,15 @@ func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string,
 
 		if enabled {
 			log.Printf("[DEBUG] Enabling KMS Key (%s) key rotation", keyID)
-			_, err = conn.EnableKeyRotationWithContext(ctx, &kms.EnableKeyRotationInput{
+			input := &kms.EnableKeyRotationInput{
 				KeyId: aws.String(keyID),
-			})
+			}
+
+			if rotationPeriodInDays > 0 {
+				input.RotationPeriodInDays = aws.Int64(int64(rotationPeriodInDays))
+			}
+
+			_, err = conn.EnableKeyRotationWithContext(ctx, input)
 		} else {
 			log.Printf("[DEBUG] Disabling KMS Key (%s) key rotation", keyID)
 			_, err = conn.DisableKeyRotationWithContext(ctx, &kms.DisableKeyRotationInput{
@@ -522,7 +550,7 @@ func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string,
 	}
 
 	// Wait for propagation since KMS is eventually consistent.
-	err = WaitKeyRotationEnabledPropagated(ctx, conn, keyID, enabled)
+	err = WaitKeyRotationEnabledPropagated(ctx, conn, keyID, enabled, rotationPeriodInDays)
 
 	if err != nil {
 		return fmt.Errorf("%s key rotation: waiting for completion: %w", action, err)
@@ -530,3 +558,40 @@ func updateKeyRotationEnabled(ctx context.Context, conn *kms.KMS, keyID string,
 
 	return nil
 }
+
+func rotateKeyOnDemand(ctx context.Context, conn *kms.KMS, keyID string) error {
+	input := &kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, PropagationTimeout, func() (interface{}, error) {
+		return conn.RotateKeyOnDemandWithContext(ctx, input)
+	}, kms.ErrCodeNotFoundException)
+
+	if err != nil {
+		return fmt.Errorf("rotating key material on demand: %w", err)
+	}
+
+	return nil
+}
+
+// updatePrimaryRegion makes the multi-Region key in the specified Region the primary key.
+// The request is sent to the Region of the current primary key.
+func updatePrimaryRegion(ctx context.Context, conn *kms.KMS, keyID, currentPrimaryRegion, primaryRegion string, terraformVersion string) error {
+	session, err := conns.NewSessionForRegion(&conn.Config, currentPrimaryRegion, terraformVersion)
+
+	if err != nil {
+		return fmt.Errorf("creating AWS session: %w", err)
+	}
+
+	input := &kms.UpdatePrimaryRegionInput{
+		KeyId:         aws.String(keyID),
+		PrimaryRegion: aws.String(primaryRegion),
+	}
+
+	if _, err := kms.New(session).UpdatePrimaryRegionWithContext(ctx, input); err != nil {
+		return fmt.Errorf("updating primary Region to %s: %w", primaryRegion, err)
+	}
+
+	return nil
+}
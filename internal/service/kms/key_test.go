@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -456,6 +457,75 @@ func TestAccKMSKey_isEnabled(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_rotationPeriod(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_enabledRotation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "enable_key_rotation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period_in_days", "365"),
+				),
+			},
+			{
+				Config: testAccKeyConfig_rotationPeriod(rName, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "enable_key_rotation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period_in_days", "90"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+			},
+		},
+	})
+}
+
+func TestAccKMSKey_rotateOnDemand(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_rotateOnDemand(rName, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "rotate_on_demand_trigger", "initial"),
+				),
+			},
+			{
+				Config: testAccKeyConfig_rotateOnDemand(rName, "rotate-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "rotate_on_demand_trigger", "rotate-1"),
+					testAccCheckKeyRotatedOnDemand(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKMSKey_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key kms.KeyMetadata
@@ -682,6 +752,35 @@ func testAccCheckKeyExists(ctx context.Context, name string, key *kms.KeyMetadat
 	}
 }
 
+func testAccCheckKeyRotatedOnDemand(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn(ctx)
+
+		return tfresource.WaitUntil(ctx, 5*time.Minute, func() (bool, error) {
+			output, err := conn.ListKeyRotationsWithContext(ctx, &kms.ListKeyRotationsInput{
+				KeyId: aws.String(rs.Primary.ID),
+			})
+
+			if err != nil {
+				return false, err
+			}
+
+			for _, v := range output.Rotations {
+				if aws.StringValue(v.RotationType) == kms.RotationTypeOnDemand {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		}, tfresource.WaitOpts{MinTimeout: 10 * time.Second})
+	}
+}
+
 func testAccCheckKeyAddTag(ctx context.Context, key *kms.KeyMetadata, tagKey, tagValue string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn(ctx)
@@ -1129,6 +1228,27 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKeyConfig_rotationPeriod(rName string, rotationPeriodInDays int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+  rotation_period_in_days = %[2]d
+}
+`, rName, rotationPeriodInDays)
+}
+
+func testAccKeyConfig_rotateOnDemand(rName, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description              = %[1]q
+  deletion_window_in_days  = 7
+  rotate_on_demand_trigger = %[2]q
+}
+`, rName, trigger)
+}
+
 func testAccKeyConfig_disabled(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -5,6 +5,7 @@ package kms
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
@@ -95,6 +96,11 @@ func ResourceReplicaKey() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"promote_to_primary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -163,6 +169,12 @@ func resourceReplicaKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.Get("promote_to_primary").(bool) {
+		if err := updateReplicaKeyPrimary(ctx, conn, d.Id(), primaryKeyARN.Region, meta.(*conns.AWSClient).Region, meta.(*conns.AWSClient).TerraformVersion); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating KMS Replica Key (%s): %s", d.Id(), err)
+		}
+	}
+
 	// Wait for propagation since KMS is eventually consistent.
 	if v, ok := d.GetOk("policy"); ok {
 		if err := WaitKeyPolicyPropagated(ctx, conn, d.Id(), v.(string)); err != nil {
@@ -204,8 +216,13 @@ func resourceReplicaKeyRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "KMS Replica Key (%s) has invalid Origin: %s", d.Id(), origin)
 	}
 
-	if !aws.BoolValue(key.metadata.MultiRegion) ||
-		aws.StringValue(key.metadata.MultiRegionConfiguration.MultiRegionKeyType) != kms.MultiRegionKeyTypeReplica {
+	if !aws.BoolValue(key.metadata.MultiRegion) {
+		return sdkdiag.AppendErrorf(diags, "KMS Replica Key (%s) is not a multi-Region replica key", d.Id())
+	}
+
+	// A replica key already in state may have been promoted to the primary key.
+	isPrimary := aws.StringValue(key.metadata.MultiRegionConfiguration.MultiRegionKeyType) == kms.MultiRegionKeyTypePrimary
+	if isPrimary && d.Get("primary_key_arn").(string) == "" {
 		return sdkdiag.AppendErrorf(diags, "KMS Replica Key (%s) is not a multi-Region replica key", d.Id())
 	}
 
@@ -224,7 +241,10 @@ func resourceReplicaKeyRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.Set("policy", policyToSet)
-	d.Set("primary_key_arn", key.metadata.MultiRegionConfiguration.PrimaryKey.Arn)
+	if !isPrimary {
+		d.Set("primary_key_arn", key.metadata.MultiRegionConfiguration.PrimaryKey.Arn)
+	}
+	d.Set("promote_to_primary", isPrimary)
 
 	setTagsOut(ctx, key.tags)
 
@@ -244,6 +264,26 @@ func resourceReplicaKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("promote_to_primary") {
+		primaryKeyARN, err := arn.Parse(d.Get("primary_key_arn").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing primary key ARN: %s", err)
+		}
+
+		region := meta.(*conns.AWSClient).Region
+		if d.Get("promote_to_primary").(bool) {
+			err = updateReplicaKeyPrimary(ctx, conn, d.Id(), primaryKeyARN.Region, region, meta.(*conns.AWSClient).TerraformVersion)
+		} else {
+			// Hand the primary role back to the original primary key.
+			err = updateReplicaKeyPrimary(ctx, conn, d.Id(), region, primaryKeyARN.Region, meta.(*conns.AWSClient).TerraformVersion)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Replica Key (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("description") {
 		if err := updateKeyDescription(ctx, conn, d.Id(), d.Get("description").(string)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating KMS Replica Key (%s): %s", d.Id(), err)
@@ -301,3 +341,20 @@ func resourceReplicaKeyDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return diags
 }
+
+func updateReplicaKeyPrimary(ctx context.Context, conn *kms.KMS, keyID, currentPrimaryRegion, primaryRegion, terraformVersion string) error {
+	if err := updatePrimaryRegion(ctx, conn, keyID, currentPrimaryRegion, primaryRegion, terraformVersion); err != nil {
+		return err
+	}
+
+	keyType := kms.MultiRegionKeyTypeReplica
+	if primaryRegion == aws.StringValue(conn.Config.Region) {
+		keyType = kms.MultiRegionKeyTypePrimary
+	}
+
+	if err := WaitKeyPrimaryRegionUpdated(ctx, conn, keyID, keyType); err != nil {
+		return fmt.Errorf("updating primary Region to %s: waiting for completion: %w", primaryRegion, err)
+	}
+
+	return nil
+}
@@ -8,9 +8,11 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 )
@@ -273,6 +275,58 @@ func TestAccKMSReplicaKey_twoReplicas(t *testing.T) {
 	})
 }
 
+func TestAccKMSReplicaKey_promoteToPrimary(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key1, key2 kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryKeyResourceName := "aws_kms_key.test"
+	resourceName := "aws_kms_replica_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicaKeyConfig_promoteToPrimary(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key1),
+					resource.TestCheckResourceAttr(resourceName, "promote_to_primary", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+					testAccCheckKeyMultiRegionKeyType(&key1, kms.MultiRegionKeyTypePrimary),
+				),
+			},
+			{
+				Config: testAccReplicaKeyConfig_promoteToPrimary(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key2),
+					resource.TestCheckResourceAttr(resourceName, "promote_to_primary", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+					testAccCheckKeyMultiRegionKeyType(&key2, kms.MultiRegionKeyTypeReplica),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyMultiRegionKeyType(key *kms.KeyMetadata, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if key.MultiRegionConfiguration == nil {
+			return fmt.Errorf("KMS Key (%s) is not a multi-Region key", aws.StringValue(key.KeyId))
+		}
+
+		if actual := aws.StringValue(key.MultiRegionConfiguration.MultiRegionKeyType); actual != expected {
+			return fmt.Errorf("KMS Key (%s) multi-Region key type: expected %s, got %s", aws.StringValue(key.KeyId), expected, actual)
+		}
+
+		return nil
+	}
+}
+
 func testAccReplicaKeyConfig_basic(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -438,3 +492,19 @@ resource "aws_kms_replica_key" "test2" {
 }
 `, rName))
 }
+
+func testAccReplicaKeyConfig_promoteToPrimary(rName string, promote bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  provider = awsalternate
+
+  description  = %[1]q
+  multi_region = true
+}
+
+resource "aws_kms_replica_key" "test" {
+  primary_key_arn    = aws_kms_key.test.arn
+  promote_to_primary = %[2]t
+}
+`, rName, promote))
+}
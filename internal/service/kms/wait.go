@@ -22,6 +22,7 @@ const (
 	KeyDescriptionPropagationTimeout = 10 * time.Minute
 	KeyMaterialImportedTimeout       = 10 * time.Minute
 	KeyPolicyPropagationTimeout      = 10 * time.Minute
+	KeyPrimaryRegionUpdatedTimeout   = 10 * time.Minute
 	KeyRotationUpdatedTimeout        = 10 * time.Minute
 	KeyStatePropagationTimeout       = 20 * time.Minute
 	KeyTagsPropagationTimeout        = 10 * time.Minute
@@ -133,9 +134,9 @@ func WaitKeyPolicyPropagated(ctx context.Context, conn *kms.KMS, id, policy stri
 	return tfresource.WaitUntil(ctx, KeyPolicyPropagationTimeout, checkFunc, opts)
 }
 
-func WaitKeyRotationEnabledPropagated(ctx context.Context, conn *kms.KMS, id string, enabled bool) error {
+func WaitKeyPrimaryRegionUpdated(ctx context.Context, conn *kms.KMS, id string, keyType string) error {
 	checkFunc := func() (bool, error) {
-		output, err := FindKeyRotationEnabledByKeyID(ctx, conn, id)
+		output, err := FindKeyByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return false, nil
@@ -145,7 +146,37 @@ func WaitKeyRotationEnabledPropagated(ctx context.Context, conn *kms.KMS, id str
 			return false, err
 		}
 
-		return aws.BoolValue(output) == enabled, nil
+		if aws.StringValue(output.KeyState) == kms.KeyStateUpdating || output.MultiRegionConfiguration == nil {
+			return false, nil
+		}
+
+		return aws.StringValue(output.MultiRegionConfiguration.MultiRegionKeyType) == keyType, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 5,
+		MinTimeout:                2 * time.Second,
+	}
+
+	return tfresource.WaitUntil(ctx, KeyPrimaryRegionUpdatedTimeout, checkFunc, opts)
+}
+
+func WaitKeyRotationEnabledPropagated(ctx context.Context, conn *kms.KMS, id string, enabled bool, rotationPeriodInDays int) error {
+	checkFunc := func() (bool, error) {
+		output, err := FindKeyRotationStatusByKeyID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		if enabled && rotationPeriodInDays > 0 && aws.Int64Value(output.RotationPeriodInDays) != int64(rotationPeriodInDays) {
+			return false, nil
+		}
+
+		return aws.BoolValue(output.KeyRotationEnabled) == enabled, nil
 	}
 	opts := tfresource.WaitOpts{
 		ContinuousTargetOccurence: 5,
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	dataCellsFilterIDPartCount = 4
+)
+
+// @SDKDataSource("aws_lakeformation_data_cells_filter")
+func DataSourceDataCellsFilter() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDataCellsFilterRead,
+
+		Schema: map[string]*schema.Schema{
+			"column_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"column_wildcard": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"row_filter": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all_rows_wildcard": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"filter_expression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"table_catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDataCellsFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	tableCatalogID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("table_catalog_id"); ok {
+		tableCatalogID = v.(string)
+	}
+	databaseName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	name := d.Get("name").(string)
+	id, err := flex.FlattenResourceId([]string{tableCatalogID, databaseName, tableName, name}, dataCellsFilterIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	filter, err := FindDataCellsFilterByFourPartKey(ctx, conn, tableCatalogID, databaseName, tableName, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Data Cells Filter (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("column_names", aws.StringValueSlice(filter.ColumnNames))
+	if err := d.Set("column_wildcard", flattenColumnWildcard(filter.ColumnWildcard)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting column_wildcard: %s", err)
+	}
+	d.Set("database_name", filter.DatabaseName)
+	d.Set("name", filter.Name)
+	if err := d.Set("row_filter", flattenRowFilter(filter.RowFilter)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting row_filter: %s", err)
+	}
+	d.Set("table_catalog_id", filter.TableCatalogId)
+	d.Set("table_name", filter.TableName)
+	d.Set("version_id", filter.VersionId)
+
+	return diags
+}
+
+func FindDataCellsFilterByFourPartKey(ctx context.Context, conn *lakeformation.LakeFormation, tableCatalogID, databaseName, tableName, name string) (*lakeformation.DataCellsFilter, error) {
+	input := &lakeformation.GetDataCellsFilterInput{
+		DatabaseName:   aws.String(databaseName),
+		Name:           aws.String(name),
+		TableCatalogId: aws.String(tableCatalogID),
+		TableName:      aws.String(tableName),
+	}
+
+	output, err := conn.GetDataCellsFilterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataCellsFilter == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataCellsFilter, nil
+}
+
+func flattenColumnWildcard(apiObject *lakeformation.ColumnWildcard) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"excluded_column_names": aws.StringValueSlice(apiObject.ExcludedColumnNames),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRowFilter(apiObject *lakeformation.RowFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"all_rows_wildcard": apiObject.AllRowsWildcard != nil,
+		"filter_expression": aws.StringValue(apiObject.FilterExpression),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -13,6 +13,9 @@ func TestAccLakeFormation_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DataCellsFilterDataSource": {
+			"notFound": testAccDataCellsFilterDataSource_notFound,
+		},
 		"DataLakeSettings": {
 			"basic":            testAccDataLakeSettings_basic,
 			"disappears":       testAccDataLakeSettings_disappears,
@@ -68,6 +71,15 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"values":          testAccLFTag_Values,
 			"valuesOverFifty": testAccLFTag_Values_overFifty,
 		},
+		"LFTagExpression": {
+			"basic":      testAccLFTagExpression_basic,
+			"disappears": testAccLFTagExpression_disappears,
+		},
+		"OptIn": {
+			"database":   testAccOptIn_database,
+			"disappears": testAccOptIn_disappears,
+			"table":      testAccOptIn_table,
+		},
 		"ResourceLFTags": {
 			"basic":                testAccResourceLFTags_basic,
 			"database":             testAccResourceLFTags_database,
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lakeformation_lf_tag_expression", name="LF-Tag Expression")
+func ResourceLFTagExpression() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLFTagExpressionCreate,
+		ReadWithoutTimeout:   resourceLFTagExpressionRead,
+		UpdateWithoutTimeout: resourceLFTagExpressionUpdate,
+		DeleteWithoutTimeout: resourceLFTagExpressionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"expression": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 50,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateLFTagValues(),
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexache.MustCompile(`^[\p{L}\p{Z}\p{N}_.:\/=+\-@%]*$`), ""),
+				),
+			},
+		},
+	}
+}
+
+func resourceLFTagExpressionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	catalogID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+	name := d.Get("name").(string)
+	input := &createLFTagExpressionInput{
+		CatalogId:  aws.String(catalogID),
+		Expression: ExpandLFTagExpression(d.Get("expression").(*schema.Set).List()),
+		Name:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if err := sendLFTagExpressionRequest(ctx, conn, "CreateLFTagExpression", input, &lfTagExpressionEmptyOutput{}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation LF-Tag Expression (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", catalogID, name))
+
+	return append(diags, resourceLFTagExpressionRead(ctx, d, meta)...)
+}
+
+func resourceLFTagExpressionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	catalogID, name, err := ReadLFTagExpressionID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation LF-Tag Expression (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindLFTagExpressionByTwoPartKey(ctx, conn, catalogID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation LF-Tag Expression (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation LF-Tag Expression (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", output.CatalogId)
+	d.Set("description", output.Description)
+	if err := d.Set("expression", flattenLFTagExpression(output.Expression)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting expression: %s", err)
+	}
+	d.Set("name", output.Name)
+
+	return diags
+}
+
+func resourceLFTagExpressionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	catalogID, name, err := ReadLFTagExpressionID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lake Formation LF-Tag Expression (%s): %s", d.Id(), err)
+	}
+
+	input := &updateLFTagExpressionInput{
+		CatalogId:   aws.String(catalogID),
+		Description: aws.String(d.Get("description").(string)),
+		Expression:  ExpandLFTagExpression(d.Get("expression").(*schema.Set).List()),
+		Name:        aws.String(name),
+	}
+
+	if err := sendLFTagExpressionRequest(ctx, conn, "UpdateLFTagExpression", input, &lfTagExpressionEmptyOutput{}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lake Formation LF-Tag Expression (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceLFTagExpressionRead(ctx, d, meta)...)
+}
+
+func resourceLFTagExpressionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	catalogID, name, err := ReadLFTagExpressionID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation LF-Tag Expression (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lake Formation LF-Tag Expression: %s", d.Id())
+	err = sendLFTagExpressionRequest(ctx, conn, "DeleteLFTagExpression", &deleteLFTagExpressionInput{
+		CatalogId: aws.String(catalogID),
+		Name:      aws.String(name),
+	}, &lfTagExpressionEmptyOutput{})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation LF-Tag Expression (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindLFTagExpressionByTwoPartKey(ctx context.Context, conn *lakeformation.LakeFormation, catalogID, name string) (*getLFTagExpressionOutput, error) {
+	input := &getLFTagExpressionInput{
+		CatalogId: aws.String(catalogID),
+		Name:      aws.String(name),
+	}
+	output := &getLFTagExpressionOutput{}
+
+	err := sendLFTagExpressionRequest(ctx, conn, "GetLFTagExpression", input, output)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Name == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func ReadLFTagExpressionID(id string) (string, string, error) {
+	catalogID, name, found := strings.Cut(id, ":")
+
+	if !found {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected CATALOG-ID:NAME", id)
+	}
+
+	return catalogID, name, nil
+}
+
+// The LF-Tag expression operations are not modeled by AWS SDK for Go v1,
+// so requests are made using the client's REST-JSON protocol handlers and the shapes below.
+
+type createLFTagExpressionInput struct {
+	CatalogId   *string                `type:"string"`
+	Description *string                `type:"string"`
+	Expression  []*lakeformation.LFTag `type:"list"`
+	Name        *string                `type:"string"`
+}
+
+type getLFTagExpressionInput struct {
+	CatalogId *string `type:"string"`
+	Name      *string `type:"string"`
+}
+
+type getLFTagExpressionOutput struct {
+	CatalogId   *string                `type:"string"`
+	Description *string                `type:"string"`
+	Expression  []*lakeformation.LFTag `type:"list"`
+	Name        *string                `type:"string"`
+}
+
+type updateLFTagExpressionInput struct {
+	CatalogId   *string                `type:"string"`
+	Description *string                `type:"string"`
+	Expression  []*lakeformation.LFTag `type:"list"`
+	Name        *string                `type:"string"`
+}
+
+type deleteLFTagExpressionInput struct {
+	CatalogId *string `type:"string"`
+	Name      *string `type:"string"`
+}
+
+type lfTagExpressionEmptyOutput struct{}
+
+func sendLFTagExpressionRequest(ctx context.Context, conn *lakeformation.LakeFormation, operation string, input, output interface{}) error {
+	req := conn.NewRequest(&request.Operation{
+		Name:       operation,
+		HTTPMethod: http.MethodPost,
+		HTTPPath:   "/" + operation,
+	}, input, output)
+	req.SetContext(ctx)
+
+	return req.Send()
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lakeformation_opt_in", name="Opt In")
+func ResourceOptIn() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOptInCreate,
+		ReadWithoutTimeout:   resourceOptInRead,
+		DeleteWithoutTimeout: resourceOptInDelete,
+
+		Schema: map[string]*schema.Schema{
+			"data_location": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"data_location", "database", "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"data_location", "database", "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"table": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"data_location", "database", "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"table.0.name", "table.0.wildcard"},
+						},
+						"wildcard": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"table.0.name", "table.0.wildcard"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceOptInCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	principal, resource := expandOptInPrincipal(d), expandOptInResource(d)
+	input := &lakeformation.CreateLakeFormationOptInInput{
+		Principal: principal,
+		Resource:  resource,
+	}
+
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, IAMPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateLakeFormationOptInWithContext(ctx, input)
+	}, lakeformation.ErrCodeInvalidInputException, "Invalid principal")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Opt In: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(input.String())))
+
+	return append(diags, resourceOptInRead(ctx, d, meta)...)
+}
+
+func resourceOptInRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	principal, resource := expandOptInPrincipal(d), expandOptInResource(d)
+	output, err := FindOptIn(ctx, conn, principal, resource)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Opt In (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Opt In (%s): %s", d.Id(), err)
+	}
+
+	if output.LastModified != nil {
+		d.Set("last_modified", aws.TimeValue(output.LastModified).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified", nil)
+	}
+	d.Set("last_updated_by", output.LastUpdatedBy)
+
+	return diags
+}
+
+func resourceOptInDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Lake Formation Opt In: %s", d.Id())
+	_, err := conn.DeleteLakeFormationOptInWithContext(ctx, &lakeformation.DeleteLakeFormationOptInInput{
+		Principal: expandOptInPrincipal(d),
+		Resource:  expandOptInResource(d),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Opt In (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindOptIn(ctx context.Context, conn *lakeformation.LakeFormation, principal *lakeformation.DataLakePrincipal, resource *lakeformation.Resource) (*lakeformation.LakeFormationOptInsInfo, error) {
+	input := &lakeformation.ListLakeFormationOptInsInput{
+		Principal: principal,
+		Resource:  resource,
+	}
+	var output []*lakeformation.LakeFormationOptInsInfo
+
+	err := conn.ListLakeFormationOptInsPagesWithContext(ctx, input, func(page *lakeformation.ListLakeFormationOptInsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LakeFormationOptInsInfoList {
+			if v != nil && v.Principal != nil && aws.StringValue(v.Principal.DataLakePrincipalIdentifier) == aws.StringValue(principal.DataLakePrincipalIdentifier) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func expandOptInPrincipal(d *schema.ResourceData) *lakeformation.DataLakePrincipal {
+	return &lakeformation.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+	}
+}
+
+func expandOptInResource(d *schema.ResourceData) *lakeformation.Resource {
+	apiObject := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccOptIn_database(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lakeformation_opt_in.test"
+	roleName := "aws_iam_role.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_database(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "database.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "database.0.name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_by"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOptIn_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lakeformation_opt_in.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_database(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceOptIn(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccOptIn_table(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lakeformation_opt_in.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, lakeformation.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_table(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table.0.database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table.0.name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOptInDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_opt_in" {
+				continue
+			}
+
+			_, err := tflakeformation.FindOptIn(ctx, conn, testAccOptInPrincipal(rs), testAccOptInResource(rs))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lake Formation Opt In %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOptInExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn(ctx)
+
+		_, err := tflakeformation.FindOptIn(ctx, conn, testAccOptInPrincipal(rs), testAccOptInResource(rs))
+
+		return err
+	}
+}
+
+func testAccOptInPrincipal(rs *terraform.ResourceState) *lakeformation.DataLakePrincipal {
+	return &lakeformation.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(rs.Primary.Attributes["principal"]),
+	}
+}
+
+func testAccOptInResource(rs *terraform.ResourceState) *lakeformation.Resource {
+	apiObject := &lakeformation.Resource{}
+
+	if v, ok := rs.Primary.Attributes["data_location.#"]; ok && v == "1" {
+		apiObject.DataLocation = &lakeformation.DataLocationResource{
+			CatalogId:   aws.String(rs.Primary.Attributes["data_location.0.catalog_id"]),
+			ResourceArn: aws.String(rs.Primary.Attributes["data_location.0.arn"]),
+		}
+	}
+
+	if v, ok := rs.Primary.Attributes["database.#"]; ok && v == "1" {
+		apiObject.Database = &lakeformation.DatabaseResource{
+			CatalogId: aws.String(rs.Primary.Attributes["database.0.catalog_id"]),
+			Name:      aws.String(rs.Primary.Attributes["database.0.name"]),
+		}
+	}
+
+	if v, ok := rs.Primary.Attributes["table.#"]; ok && v == "1" {
+		apiObject.Table = &lakeformation.TableResource{
+			CatalogId:    aws.String(rs.Primary.Attributes["table.0.catalog_id"]),
+			DatabaseName: aws.String(rs.Primary.Attributes["table.0.database_name"]),
+		}
+
+		if v := rs.Primary.Attributes["table.0.name"]; v != "" {
+			apiObject.Table.Name = aws.String(v)
+		}
+
+		if v := rs.Primary.Attributes["table.0.wildcard"]; v == "true" {
+			apiObject.Table.TableWildcard = &lakeformation.TableWildcard{}
+		}
+	}
+
+	return apiObject
+}
+
+func testAccOptInConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+`, rName)
+}
+
+func testAccOptInConfig_database(rName string) string {
+	return acctest.ConfigCompose(testAccOptInConfig_base(rName), `
+resource "aws_lakeformation_opt_in" "test" {
+  principal = aws_iam_role.test.arn
+
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccOptInConfig_table(rName string) string {
+	return acctest.ConfigCompose(testAccOptInConfig_base(rName), `
+resource "aws_lakeformation_opt_in" "test" {
+  principal = aws_iam_role.test.arn
+
+  table {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceDataCellsFilter,
+			TypeName: "aws_lakeformation_data_cells_filter",
+		},
 		{
 			Factory:  DataSourceDataLakeSettings,
 			TypeName: "aws_lakeformation_data_lake_settings",
@@ -50,6 +54,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLFTag,
 			TypeName: "aws_lakeformation_lf_tag",
 		},
+		{
+			Factory:  ResourceLFTagExpression,
+			TypeName: "aws_lakeformation_lf_tag_expression",
+			Name:     "LF-Tag Expression",
+		},
+		{
+			Factory:  ResourceOptIn,
+			TypeName: "aws_lakeformation_opt_in",
+			Name:     "Opt In",
+		},
 		{
 			Factory:  ResourcePermissions,
 			TypeName: "aws_lakeformation_permissions",
@@ -3,15 +3,19 @@
 
 package lambda
 
-import (
+import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"reflect"
 	"sort"
 	"strings"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	lambda_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/lambda"
@@ -21,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -38,6 +43,8 @@ func ResourceEventSourceMapping() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: checkEventSourceMappingSourceType,
+
 		Schema: map[string]*schema.Schema{
 			"amazon_managed_kafka_event_source_config": {
 				Type:          schema.TypeList,
@@ -228,12 +235,50 @@ func ResourceEventSourceMapping() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.IntBetween(-1, 10_000),
 			},
+			"metrics_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metrics": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.EventSourceMappingMetric](),
+							},
+						},
+					},
+				},
+			},
 			"parallelization_factor": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(1, 10),
 				Computed:     true,
 			},
+			"provisioned_poller_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_pollers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 2000),
+						},
+						"minimum_pollers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 200),
+						},
+					},
+				},
+			},
 			"queues": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -490,6 +535,27 @@ func resourceEventSourceMappingCreate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "waiting for Lambda Event Source Mapping (%s) create: %s", d.Id(), err)
 	}
 
+	// Provisioned Mode and metrics configuration aren't available in the AWS SDK for Go v1.
+	_, metricsConfigOk := d.GetOk("metrics_config")
+	_, provisionedPollerConfigOk := d.GetOk("provisioned_poller_config")
+	if metricsConfigOk || provisionedPollerConfigOk {
+		input := &lambda_sdkv2.UpdateEventSourceMappingInput{
+			UUID: aws_sdkv2.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("metrics_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MetricsConfig = expandEventSourceMappingMetricsConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("provisioned_poller_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ProvisionedPollerConfig = expandProvisionedPollerConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if err := updateEventSourceMappingV2(ctx, meta.(*conns.AWSClient).LambdaClient(ctx), conn, input); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceEventSourceMappingRead(ctx, d, meta)...)
 }
 
@@ -590,6 +656,29 @@ func resourceEventSourceMappingRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("tumbling_window_in_seconds", eventSourceMappingConfiguration.TumblingWindowInSeconds)
 	d.Set("uuid", eventSourceMappingConfiguration.UUID)
 
+	output, err := meta.(*conns.AWSClient).LambdaClient(ctx).GetEventSourceMapping(ctx, &lambda_sdkv2.GetEventSourceMappingInput{
+		UUID: aws_sdkv2.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Event Source Mapping (%s): %s", d.Id(), err)
+	}
+
+	if v := output.MetricsConfig; v != nil && len(v.Metrics) > 0 {
+		if err := d.Set("metrics_config", []interface{}{flattenEventSourceMappingMetricsConfig(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting metrics_config: %s", err)
+		}
+	} else {
+		d.Set("metrics_config", nil)
+	}
+	if v := output.ProvisionedPollerConfig; v != nil {
+		if err := d.Set("provisioned_poller_config", []interface{}{flattenProvisionedPollerConfig(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting provisioned_poller_config: %s", err)
+		}
+	} else {
+		d.Set("provisioned_poller_config", nil)
+	}
+
 	switch state := d.Get("state").(string); state {
 	case eventSourceMappingStateEnabled, eventSourceMappingStateEnabling:
 		d.Set("enabled", true)
@@ -699,9 +788,96 @@ func resourceEventSourceMappingUpdate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "waiting for Lambda Event Source Mapping (%s) update: %s", d.Id(), err)
 	}
 
+	if d.HasChanges("metrics_config", "provisioned_poller_config") {
+		input := &lambda_sdkv2.UpdateEventSourceMappingInput{
+			UUID: aws_sdkv2.String(d.Id()),
+		}
+
+		if d.HasChange("metrics_config") {
+			if v, ok := d.GetOk("metrics_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.MetricsConfig = expandEventSourceMappingMetricsConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// AWS ignores the removal if this is left as nil.
+				input.MetricsConfig = &awstypes.EventSourceMappingMetricsConfig{
+					Metrics: []awstypes.EventSourceMappingMetric{},
+				}
+			}
+		}
+
+		if d.HasChange("provisioned_poller_config") {
+			if v, ok := d.GetOk("provisioned_poller_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ProvisionedPollerConfig = expandProvisionedPollerConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// AWS ignores the removal if this is left as nil.
+				input.ProvisionedPollerConfig = &awstypes.ProvisionedPollerConfig{}
+			}
+		}
+
+		if err := updateEventSourceMappingV2(ctx, meta.(*conns.AWSClient).LambdaClient(ctx), conn, input); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceEventSourceMappingRead(ctx, d, meta)...)
 }
 
+func updateEventSourceMappingV2(ctx context.Context, client *lambda_sdkv2.Client, conn *lambda.Lambda, input *lambda_sdkv2.UpdateEventSourceMappingInput) error {
+	id := aws_sdkv2.ToString(input.UUID)
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ResourceInUseException](ctx, propagationTimeout, func() (interface{}, error) {
+		return client.UpdateEventSourceMapping(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating Lambda Event Source Mapping (%s): %w", id, err)
+	}
+
+	if _, err := waitEventSourceMappingUpdate(ctx, conn, id); err != nil {
+		return fmt.Errorf("waiting for Lambda Event Source Mapping (%s) update: %w", id, err)
+	}
+
+	return nil
+}
+
+// checkEventSourceMappingSourceType validates that the configured features are supported by the type of event source.
+func checkEventSourceMappingSourceType(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var serviceName string
+	if v, ok := d.GetOk("self_managed_event_source"); ok && len(v.([]interface{})) > 0 {
+		serviceName = "kafka"
+	} else if v, ok := d.GetOk("event_source_arn"); ok {
+		eventSourceARN, err := arn.Parse(v.(string))
+		if err != nil {
+			return nil
+		}
+		serviceName = eventSourceARN.Service
+	} else {
+		// The event source isn't known yet.
+		return nil
+	}
+
+	if v, ok := d.GetOk("provisioned_poller_config"); ok && len(v.([]interface{})) > 0 {
+		if serviceName != "kafka" {
+			return errors.New("provisioned_poller_config is only supported for Amazon MSK and self-managed Apache Kafka event sources")
+		}
+
+		if tfMap, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			if minPollers, maxPollers := tfMap["minimum_pollers"].(int), tfMap["maximum_pollers"].(int); minPollers != 0 && maxPollers != 0 && minPollers > maxPollers {
+				return fmt.Errorf("provisioned_poller_config minimum_pollers (%d) must not be greater than maximum_pollers (%d)", minPollers, maxPollers)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("metrics_config"); ok && len(v.([]interface{})) > 0 {
+		switch serviceName {
+		case "dynamodb", "kinesis", "sqs":
+		default:
+			return errors.New("metrics_config is only supported for Amazon DynamoDB, Amazon Kinesis and Amazon SQS event sources")
+		}
+	}
+
+	return nil
+}
+
 func resourceEventSourceMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
@@ -1133,6 +1309,68 @@ func flattenScalingConfig(apiObject *lambda.ScalingConfig) map[string]interface{
 	return tfMap
 }
 
+func expandEventSourceMappingMetricsConfig(tfMap map[string]interface{}) *awstypes.EventSourceMappingMetricsConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.EventSourceMappingMetricsConfig{}
+
+	if v, ok := tfMap["metrics"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Metrics = flex.ExpandStringyValueSet[awstypes.EventSourceMappingMetric](v)
+	}
+
+	return apiObject
+}
+
+func flattenEventSourceMappingMetricsConfig(apiObject *awstypes.EventSourceMappingMetricsConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"metrics": enum.Slice(apiObject.Metrics...),
+	}
+
+	return tfMap
+}
+
+func expandProvisionedPollerConfig(tfMap map[string]interface{}) *awstypes.ProvisionedPollerConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ProvisionedPollerConfig{}
+
+	if v, ok := tfMap["maximum_pollers"].(int); ok && v != 0 {
+		apiObject.MaximumPollers = aws_sdkv2.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["minimum_pollers"].(int); ok && v != 0 {
+		apiObject.MinimumPollers = aws_sdkv2.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenProvisionedPollerConfig(apiObject *awstypes.ProvisionedPollerConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaximumPollers; v != nil {
+		tfMap["maximum_pollers"] = int(aws_sdkv2.ToInt32(v))
+	}
+
+	if v := apiObject.MinimumPollers; v != nil {
+		tfMap["minimum_pollers"] = int(aws_sdkv2.ToInt32(v))
+	}
+
+	return tfMap
+}
+
 func findEventSourceMappingConfiguration(ctx context.Context, conn *lambda.Lambda, input *lambda.GetEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
 	output, err := conn.GetEventSourceMappingWithContext(ctx, input)
 
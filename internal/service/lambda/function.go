@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	homedir "github.com/mitchellh/go-homedir"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -291,6 +292,12 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"recursive_loop": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.RecursiveLoop](),
+			},
 			"replace_security_groups_on_destroy": {
 				Deprecated: "AWS no longer supports this operation. This attribute now has " +
 					"no effect and will be removed in a future major version.",
@@ -456,6 +463,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkSnapStartConfiguration,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -616,6 +624,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("recursive_loop"); ok {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: types.RecursiveLoop(v.(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -737,6 +756,23 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("version", latest.Version)
 
 		setTagsOut(ctx, output.Tags)
+
+		// Recursion configuration is only read when managed, so that callers without lambda:GetFunctionRecursionConfig
+		// and partitions without the API are unaffected.
+		if _, ok := d.GetOk("recursive_loop"); ok {
+			recursionConfig, err := conn.GetFunctionRecursionConfig(ctx, &lambda.GetFunctionRecursionConfigInput{
+				FunctionName: aws.String(d.Id()),
+			})
+
+			switch {
+			case err == nil:
+				d.Set("recursive_loop", recursionConfig.RecursiveLoop)
+			case errs.IsUnsupportedOperationInPartitionError(meta.(*conns.AWSClient).Partition, err):
+				log.Printf("[WARN] Unable to read Lambda Function (%s) recursion config: %s", d.Id(), err)
+			default:
+				return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) recursion config: %s", d.Id(), err)
+			}
+		}
 	}
 
 	// Currently, this functionality is only enabled in AWS Commercial partition
@@ -1011,6 +1047,17 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("recursive_loop") {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: types.RecursiveLoop(d.Get("recursive_loop").(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+	}
+
 	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish")) {
 		input := &lambda.PublishVersionInput{
 			FunctionName: aws.String(d.Id()),
@@ -1264,6 +1311,46 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// snapStartRuntimes are the runtimes that support SnapStart.
+var snapStartRuntimes = []types.Runtime{
+	types.RuntimeDotnet8,
+	types.RuntimeJava11,
+	types.RuntimeJava17,
+	types.RuntimeJava21,
+	types.RuntimePython312,
+	types.RuntimePython313,
+}
+
+// checkSnapStartConfiguration validates at plan time the constraints Lambda places on functions using SnapStart.
+func checkSnapStartConfiguration(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	snapStart := expandSnapStart(d.Get("snap_start").([]interface{}))
+	if snapStart.ApplyOn != types.SnapStartApplyOnPublishedVersions {
+		return nil
+	}
+
+	if packageType := types.PackageType(d.Get("package_type").(string)); packageType != types.PackageTypeZip {
+		return fmt.Errorf("snap_start is not supported for package_type %s", packageType)
+	}
+
+	if d.NewValueKnown("runtime") {
+		if runtime := types.Runtime(d.Get("runtime").(string)); !slices.Contains(snapStartRuntimes, runtime) {
+			return fmt.Errorf("snap_start is not supported for runtime %s, supported runtimes are: %s", runtime, strings.Join(enum.Slice(snapStartRuntimes...), ", "))
+		}
+	}
+
+	if v, ok := d.GetOk("file_system_config"); ok && len(v.([]interface{})) > 0 {
+		return errors.New("snap_start is not supported with file_system_config")
+	}
+
+	if v, ok := d.GetOk("ephemeral_storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if size := v.([]interface{})[0].(map[string]interface{})["size"].(int); size > 512 {
+			return fmt.Errorf("snap_start is not supported with ephemeral_storage size greater than 512 MB (%d)", size)
+		}
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_macie2_automated_discovery_configuration")
+func ResourceAutomatedDiscoveryConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomatedDiscoveryConfigurationPut,
+		ReadWithoutTimeout:   resourceAutomatedDiscoveryConfigurationRead,
+		UpdateWithoutTimeout: resourceAutomatedDiscoveryConfigurationPut,
+		DeleteWithoutTimeout: resourceAutomatedDiscoveryConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"classification_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disabled_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_enabled_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sensitivity_inspection_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(macie2.AutomatedDiscoveryStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAutomatedDiscoveryConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	input := &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+		Status: aws.String(d.Get("status").(string)),
+	}
+
+	_, err := conn.UpdateAutomatedDiscoveryConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Macie Automated Discovery Configuration: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return append(diags, resourceAutomatedDiscoveryConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceAutomatedDiscoveryConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := FindAutomatedDiscoveryConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Automated Discovery Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Automated Discovery Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("classification_scope_id", output.ClassificationScopeId)
+	d.Set("disabled_at", formatOptionalTime(output.DisabledAt))
+	d.Set("first_enabled_at", formatOptionalTime(output.FirstEnabledAt))
+	d.Set("last_updated_at", formatOptionalTime(output.LastUpdatedAt))
+	d.Set("sensitivity_inspection_template_id", output.SensitivityInspectionTemplateId)
+	d.Set("status", output.Status)
+
+	return diags
+}
+
+func resourceAutomatedDiscoveryConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	input := &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+		Status: aws.String(macie2.AutomatedDiscoveryStatusDisabled),
+	}
+
+	log.Printf("[DEBUG] Disabling Macie Automated Discovery Configuration: %s", d.Id())
+	_, err := conn.UpdateAutomatedDiscoveryConfigurationWithContext(ctx, input)
+
+	if isResourceNotFoundOrMacieNotEnabledError(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling Macie Automated Discovery Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.TimeValue(t).Format(time.RFC3339)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAutomatedDiscoveryConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v macie2.GetAutomatedDiscoveryConfigurationOutput
+	resourceName := "aws_macie2_automated_discovery_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomatedDiscoveryConfigurationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_basic(macie2.AutomatedDiscoveryStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "classification_scope_id"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "first_enabled_at"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "last_updated_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "sensitivity_inspection_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.AutomatedDiscoveryStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_basic(macie2.AutomatedDiscoveryStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRFC3339(resourceName, "disabled_at"),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.AutomatedDiscoveryStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomatedDiscoveryConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_automated_discovery_configuration" {
+				continue
+			}
+
+			output, err := tfmacie2.FindAutomatedDiscoveryConfiguration(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.StringValue(output.Status) == macie2.AutomatedDiscoveryStatusEnabled {
+				return fmt.Errorf("macie automated discovery configuration %q still enabled", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutomatedDiscoveryConfigurationExists(ctx context.Context, resourceName string, v *macie2.GetAutomatedDiscoveryConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		output, err := tfmacie2.FindAutomatedDiscoveryConfiguration(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAutomatedDiscoveryConfigurationConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_automated_discovery_configuration" "test" {
+  status = %[1]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, status)
+}
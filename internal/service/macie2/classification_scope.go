@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_macie2_classification_scope")
+func ResourceClassificationScope() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClassificationScopeCreate,
+		ReadWithoutTimeout:   resourceClassificationScopeRead,
+		UpdateWithoutTimeout: resourceClassificationScopeUpdate,
+		DeleteWithoutTimeout: resourceClassificationScopeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"excluded_bucket_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceClassificationScopeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	// The classification scope is created by Macie when automated sensitive data discovery is first enabled.
+	id, err := findClassificationScopeID(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Classification Scope: %s", err)
+	}
+
+	if err := updateClassificationScopeExcludedBucketNames(ctx, conn, id, flex.ExpandStringSet(d.Get("excluded_bucket_names").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Macie Classification Scope (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceClassificationScopeRead(ctx, d, meta)...)
+}
+
+func resourceClassificationScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := FindClassificationScopeByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Classification Scope (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Classification Scope (%s): %s", d.Id(), err)
+	}
+
+	d.Set("excluded_bucket_names", aws.StringValueSlice(output.S3.Excludes.BucketNames))
+	d.Set("name", output.Name)
+
+	return diags
+}
+
+func resourceClassificationScopeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	if d.HasChange("excluded_bucket_names") {
+		if err := updateClassificationScopeExcludedBucketNames(ctx, conn, d.Id(), flex.ExpandStringSet(d.Get("excluded_bucket_names").(*schema.Set))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie Classification Scope (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceClassificationScopeRead(ctx, d, meta)...)
+}
+
+func resourceClassificationScopeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	// The classification scope cannot be deleted, so remove all bucket exclusions instead.
+	log.Printf("[DEBUG] Deleting Macie Classification Scope: %s", d.Id())
+	err := updateClassificationScopeExcludedBucketNames(ctx, conn, d.Id(), []*string{})
+
+	if isResourceNotFoundOrMacieNotEnabledError(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Macie Classification Scope (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateClassificationScopeExcludedBucketNames(ctx context.Context, conn *macie2.Macie2, id string, bucketNames []*string) error {
+	if bucketNames == nil {
+		bucketNames = []*string{}
+	}
+
+	input := &macie2.UpdateClassificationScopeInput{
+		Id: aws.String(id),
+		S3: &macie2.S3ClassificationScopeUpdate{
+			Excludes: &macie2.S3ClassificationScopeExclusionUpdate{
+				BucketNames: bucketNames,
+				Operation:   aws.String(macie2.ClassificationScopeUpdateOperationReplace),
+			},
+		},
+	}
+
+	_, err := conn.UpdateClassificationScopeWithContext(ctx, input)
+
+	return err
+}
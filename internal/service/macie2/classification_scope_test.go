@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccClassificationScope_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v macie2.GetClassificationScopeOutput
+	resourceName := "aws_macie2_classification_scope.test"
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationScopeDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationScopeConfig_excludedBucketNames(fmt.Sprintf("%q", rName1)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationScopeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "id", "aws_macie2_automated_discovery_configuration.test", "classification_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "excluded_bucket_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "excluded_bucket_names.*", rName1),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClassificationScopeConfig_excludedBucketNames(fmt.Sprintf("%q, %q", rName1, rName2)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationScopeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "excluded_bucket_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "excluded_bucket_names.*", rName1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "excluded_bucket_names.*", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClassificationScopeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_classification_scope" {
+				continue
+			}
+
+			output, err := tfmacie2.FindClassificationScopeByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output.S3.Excludes.BucketNames) > 0 {
+				return fmt.Errorf("macie classification scope %q still has bucket exclusions", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckClassificationScopeExists(ctx context.Context, resourceName string, v *macie2.GetClassificationScopeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		output, err := tfmacie2.FindClassificationScopeByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccClassificationScopeConfig_excludedBucketNames(bucketNames string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_automated_discovery_configuration" "test" {
+  status = "ENABLED"
+
+  depends_on = [aws_macie2_account.test]
+}
+
+resource "aws_macie2_classification_scope" "test" {
+  excluded_bucket_names = [%[1]s]
+
+  depends_on = [aws_macie2_automated_discovery_configuration.test]
+}
+`, bucketNames)
+}
@@ -8,6 +8,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // findMemberNotAssociated Return a list of members not associated and compare with account ID
@@ -38,3 +41,156 @@ func findMemberNotAssociated(ctx context.Context, conn *macie2.Macie2, accountID
 
 	return result, err
 }
+
+// isResourceNotFoundOrMacieNotEnabledError returns whether the error indicates that
+// the requested resource does not exist or that Macie is not enabled for the account.
+func isResourceNotFoundOrMacieNotEnabledError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")
+}
+
+func FindAutomatedDiscoveryConfiguration(ctx context.Context, conn *macie2.Macie2) (*macie2.GetAutomatedDiscoveryConfigurationOutput, error) {
+	input := &macie2.GetAutomatedDiscoveryConfigurationInput{}
+
+	output, err := conn.GetAutomatedDiscoveryConfigurationWithContext(ctx, input)
+
+	if isResourceNotFoundOrMacieNotEnabledError(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findClassificationScopeID returns the ID of the account's classification scope.
+// Macie creates exactly one classification scope per account.
+func findClassificationScopeID(ctx context.Context, conn *macie2.Macie2) (string, error) {
+	input := &macie2.ListClassificationScopesInput{}
+	var result string
+
+	err := conn.ListClassificationScopesPagesWithContext(ctx, input, func(page *macie2.ListClassificationScopesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ClassificationScopes {
+			if v != nil && v.Id != nil {
+				result = aws.StringValue(v.Id)
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if isResourceNotFoundOrMacieNotEnabledError(err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if result == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+func FindClassificationScopeByID(ctx context.Context, conn *macie2.Macie2, id string) (*macie2.GetClassificationScopeOutput, error) {
+	input := &macie2.GetClassificationScopeInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetClassificationScopeWithContext(ctx, input)
+
+	if isResourceNotFoundOrMacieNotEnabledError(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.S3 == nil || output.S3.Excludes == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindResourceProfileByARN(ctx context.Context, conn *macie2.Macie2, arn string) (*macie2.GetResourceProfileOutput, error) {
+	input := &macie2.GetResourceProfileInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.GetResourceProfileWithContext(ctx, input)
+
+	if isResourceNotFoundOrMacieNotEnabledError(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findSuppressedResourceProfileDetectionsByARN(ctx context.Context, conn *macie2.Macie2, arn string) ([]*macie2.Detection, error) {
+	input := &macie2.ListResourceProfileDetectionsInput{
+		ResourceArn: aws.String(arn),
+	}
+	var output []*macie2.Detection
+
+	err := conn.ListResourceProfileDetectionsPagesWithContext(ctx, input, func(page *macie2.ListResourceProfileDetectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Detections {
+			if v != nil && aws.BoolValue(v.Suppressed) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -20,6 +20,9 @@ func TestAccMacie2_serial(t *testing.T) {
 			"finding_and_status":           testAccAccount_WithFindingAndStatus,
 			"disappears":                   testAccAccount_disappears,
 		},
+		"AutomatedDiscoveryConfiguration": {
+			"basic": testAccAutomatedDiscoveryConfiguration_basic,
+		},
 		"ClassificationExportConfiguration": {
 			"basic": testAccClassificationExportConfiguration_basic,
 		},
@@ -33,6 +36,9 @@ func TestAccMacie2_serial(t *testing.T) {
 			"tags":            testAccClassificationJob_WithTags,
 			"bucket_criteria": testAccClassificationJob_BucketCriteria,
 		},
+		"ClassificationScope": {
+			"basic": testAccClassificationScope_basic,
+		},
 		"CustomDataIdentifier": {
 			"basic":              testAccCustomDataIdentifier_basic,
 			"name_generated":     testAccCustomDataIdentifier_Name_Generated,
@@ -64,6 +70,9 @@ func TestAccMacie2_serial(t *testing.T) {
 			"invite_removed":                        testAccMember_inviteRemoved,
 			"status":                                testAccMember_status,
 		},
+		"ResourceProfile": {
+			"basic": testAccResourceProfile_basic,
+		},
 		"InvitationAccepter": {
 			"basic": testAccInvitationAccepter_basic,
 		},
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_macie2_resource_profile")
+func ResourceResourceProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceProfileCreate,
+		ReadWithoutTimeout:   resourceResourceProfileRead,
+		UpdateWithoutTimeout: resourceResourceProfileUpdate,
+		DeleteWithoutTimeout: resourceResourceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sensitivity_score": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sensitivity_score_override": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"suppressed_data_identifier": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(macie2.DataIdentifierType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceResourceProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	arn := d.Get("resource_arn").(string)
+
+	if v, ok := d.GetOk("sensitivity_score_override"); ok {
+		if err := updateResourceProfileSensitivityScoreOverride(ctx, conn, arn, aws.Int64(int64(v.(int)))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Macie Resource Profile (%s): %s", arn, err)
+		}
+	}
+
+	if v, ok := d.GetOk("suppressed_data_identifier"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateResourceProfileSuppressedDataIdentifiers(ctx, conn, arn, expandSuppressDataIdentifiers(v.(*schema.Set).List())); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Macie Resource Profile (%s): %s", arn, err)
+		}
+	}
+
+	d.SetId(arn)
+
+	return append(diags, resourceResourceProfileRead(ctx, d, meta)...)
+}
+
+func resourceResourceProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := FindResourceProfileByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Resource Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Resource Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set("resource_arn", d.Id())
+	d.Set("sensitivity_score", output.SensitivityScore)
+	if aws.BoolValue(output.SensitivityScoreOverridden) {
+		d.Set("sensitivity_score_override", output.SensitivityScore)
+	} else {
+		d.Set("sensitivity_score_override", nil)
+	}
+
+	detections, err := findSuppressedResourceProfileDetectionsByARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Resource Profile (%s) detections: %s", d.Id(), err)
+	}
+
+	if err := d.Set("suppressed_data_identifier", flattenSuppressedDetections(detections)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting suppressed_data_identifier: %s", err)
+	}
+
+	return diags
+}
+
+func resourceResourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	if d.HasChange("sensitivity_score_override") {
+		var score *int64
+		if v, ok := d.GetOk("sensitivity_score_override"); ok {
+			score = aws.Int64(int64(v.(int)))
+		}
+
+		if err := updateResourceProfileSensitivityScoreOverride(ctx, conn, d.Id(), score); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie Resource Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("suppressed_data_identifier") {
+		if err := updateResourceProfileSuppressedDataIdentifiers(ctx, conn, d.Id(), expandSuppressDataIdentifiers(d.Get("suppressed_data_identifier").(*schema.Set).List())); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie Resource Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceProfileRead(ctx, d, meta)...)
+}
+
+func resourceResourceProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	// Resource profiles are managed by Macie, so revert the sensitivity scoring adjustments instead.
+	log.Printf("[DEBUG] Deleting Macie Resource Profile: %s", d.Id())
+	err := updateResourceProfileSensitivityScoreOverride(ctx, conn, d.Id(), nil)
+
+	if err == nil {
+		err = updateResourceProfileSuppressedDataIdentifiers(ctx, conn, d.Id(), []*macie2.SuppressDataIdentifier{})
+	}
+
+	if isResourceNotFoundOrMacieNotEnabledError(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Macie Resource Profile (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updateResourceProfileSensitivityScoreOverride sets the sensitivity score for the resource.
+// A nil score reverts the resource to its calculated sensitivity score.
+func updateResourceProfileSensitivityScoreOverride(ctx context.Context, conn *macie2.Macie2, arn string, score *int64) error {
+	input := &macie2.UpdateResourceProfileInput{
+		ResourceArn:              aws.String(arn),
+		SensitivityScoreOverride: score,
+	}
+
+	_, err := conn.UpdateResourceProfileWithContext(ctx, input)
+
+	return err
+}
+
+func updateResourceProfileSuppressedDataIdentifiers(ctx context.Context, conn *macie2.Macie2, arn string, identifiers []*macie2.SuppressDataIdentifier) error {
+	input := &macie2.UpdateResourceProfileDetectionsInput{
+		ResourceArn:             aws.String(arn),
+		SuppressDataIdentifiers: identifiers,
+	}
+
+	_, err := conn.UpdateResourceProfileDetectionsWithContext(ctx, input)
+
+	return err
+}
+
+func expandSuppressDataIdentifiers(tfList []interface{}) []*macie2.SuppressDataIdentifier {
+	apiObjects := []*macie2.SuppressDataIdentifier{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &macie2.SuppressDataIdentifier{
+			Id:   aws.String(tfMap["id"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSuppressedDetections(apiObjects []*macie2.Detection) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"id":   aws.StringValue(apiObject.Id),
+			"type": aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
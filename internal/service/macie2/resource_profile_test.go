@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccResourceProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v macie2.GetResourceProfileOutput
+	resourceName := "aws_macie2_resource_profile.test"
+	// Macie only creates a resource profile after automated discovery has analyzed the bucket.
+	bucketARN := acctest.SkipIfEnvVarNotSet(t, "MACIE2_RESOURCE_PROFILE_BUCKET_ARN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceProfileDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProfileConfig_sensitivityScoreOverride(bucketARN, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "resource_arn", bucketARN),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_score", "100"),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_score_override", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceProfileConfig_sensitivityScoreOverride(bucketARN, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_score", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_score_override", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_resource_profile" {
+				continue
+			}
+
+			output, err := tfmacie2.FindResourceProfileByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.BoolValue(output.SensitivityScoreOverridden) {
+				return fmt.Errorf("macie resource profile %q still has a sensitivity score override", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceProfileExists(ctx context.Context, resourceName string, v *macie2.GetResourceProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		output, err := tfmacie2.FindResourceProfileByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccResourceProfileConfig_sensitivityScoreOverride(bucketARN string, score int) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_resource_profile" "test" {
+  resource_arn               = %[1]q
+  sensitivity_score_override = %[2]d
+}
+`, bucketARN, score)
+}
@@ -33,6 +33,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAccount,
 			TypeName: "aws_macie2_account",
 		},
+		{
+			Factory:  ResourceAutomatedDiscoveryConfiguration,
+			TypeName: "aws_macie2_automated_discovery_configuration",
+		},
 		{
 			Factory:  ResourceClassificationExportConfiguration,
 			TypeName: "aws_macie2_classification_export_configuration",
@@ -43,6 +47,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Classification Job",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceClassificationScope,
+			TypeName: "aws_macie2_classification_scope",
+		},
 		{
 			Factory:  ResourceCustomDataIdentifier,
 			TypeName: "aws_macie2_custom_data_identifier",
@@ -69,6 +77,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceOrganizationAdminAccount,
 			TypeName: "aws_macie2_organization_admin_account",
 		},
+		{
+			Factory:  ResourceResourceProfile,
+			TypeName: "aws_macie2_resource_profile",
+		},
 	}
 }
 
@@ -0,0 +1,486 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+)
+
+// Conversion of Elastic Transcoder presets and pipelines to MediaConvert settings.
+// Elastic Transcoder settings without a MediaConvert equivalent are reported rather than
+// silently dropped, so that migrated presets can be reviewed before use.
+
+const elasticTranscoderAuto = "auto"
+
+var elasticTranscoderContainers = map[string]string{
+	"fmp4": mediaconvert.ContainerTypeCmfc,
+	"flac": mediaconvert.ContainerTypeRaw,
+	"mp3":  mediaconvert.ContainerTypeRaw,
+	"mp4":  mediaconvert.ContainerTypeMp4,
+	"mxf":  mediaconvert.ContainerTypeMxf,
+	"ts":   mediaconvert.ContainerTypeM2ts,
+	"wav":  mediaconvert.ContainerTypeRaw,
+	"webm": mediaconvert.ContainerTypeWebm,
+}
+
+// Elastic Transcoder frame rates as MediaConvert numerator/denominator pairs.
+var elasticTranscoderFrameRates = map[string][2]int64{
+	"10":    {10, 1},
+	"15":    {15, 1},
+	"23.97": {24000, 1001},
+	"24":    {24, 1},
+	"25":    {25, 1},
+	"29.97": {30000, 1001},
+	"30":    {30, 1},
+	"50":    {50, 1},
+	"60":    {60, 1},
+}
+
+var elasticTranscoderH264Profiles = map[string]string{
+	"baseline": mediaconvert.H264CodecProfileBaseline,
+	"high":     mediaconvert.H264CodecProfileHigh,
+	"high10":   mediaconvert.H264CodecProfileHigh10bit,
+	"high422":  mediaconvert.H264CodecProfileHigh422,
+	"main":     mediaconvert.H264CodecProfileMain,
+}
+
+var elasticTranscoderAACProfiles = map[string]string{
+	"":         mediaconvert.AacCodecProfileLc,
+	"AAC-LC":   mediaconvert.AacCodecProfileLc,
+	"HE-AAC":   mediaconvert.AacCodecProfileHev1,
+	"HE-AACv2": mediaconvert.AacCodecProfileHev2,
+	"auto":     mediaconvert.AacCodecProfileLc,
+}
+
+var elasticTranscoderSizingPolicies = map[string]string{
+	"Fill":        mediaconvert.ScalingBehaviorFill,
+	"Fit":         mediaconvert.ScalingBehaviorFit,
+	"ShrinkToFit": mediaconvert.ScalingBehaviorFitNoUpscale,
+	"Stretch":     mediaconvert.ScalingBehaviorStretchToOutput,
+}
+
+// presetSettingsFromElasticTranscoder returns MediaConvert preset settings equivalent to the
+// specified Elastic Transcoder preset, along with a list of settings that could not be converted.
+func presetSettingsFromElasticTranscoder(preset *elastictranscoder.Preset) (*mediaconvert.PresetSettings, []string, error) {
+	var unsupported []string
+
+	container := aws.StringValue(preset.Container)
+	containerType, ok := elasticTranscoderContainers[container]
+
+	if !ok {
+		return nil, nil, fmt.Errorf("container %q has no MediaConvert equivalent", container)
+	}
+
+	settings := &mediaconvert.PresetSettings{
+		ContainerSettings: &mediaconvert.ContainerSettings{
+			Container: aws.String(containerType),
+		},
+	}
+
+	if preset.Video != nil && preset.Video.Codec != nil {
+		videoDescription, u, err := videoDescriptionFromElasticTranscoder(preset.Video)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("video: %w", err)
+		}
+
+		settings.VideoDescription = videoDescription
+		unsupported = append(unsupported, u...)
+	}
+
+	if preset.Audio != nil && preset.Audio.Codec != nil {
+		audioDescription, u, err := audioDescriptionFromElasticTranscoder(preset.Audio)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("audio: %w", err)
+		}
+
+		if audioDescription != nil {
+			settings.AudioDescriptions = []*mediaconvert.AudioDescription{audioDescription}
+		}
+		unsupported = append(unsupported, u...)
+	}
+
+	if preset.Thumbnails != nil && preset.Thumbnails.Format != nil {
+		unsupported = append(unsupported, "thumbnails")
+	}
+
+	return settings, unsupported, nil
+}
+
+func videoDescriptionFromElasticTranscoder(video *elastictranscoder.VideoParameters) (*mediaconvert.VideoDescription, []string, error) {
+	var unsupported []string
+
+	videoDescription := &mediaconvert.VideoDescription{
+		CodecSettings: &mediaconvert.VideoCodecSettings{},
+	}
+
+	bitrate, err := elasticTranscoderKbps(aws.StringValue(video.BitRate))
+	if err != nil {
+		return nil, nil, fmt.Errorf("bit rate: %w", err)
+	}
+	if bitrate == nil {
+		unsupported = append(unsupported, "video.bit_rate=auto")
+	}
+
+	framerateControl, framerate, err := elasticTranscoderFrameRate(aws.StringValue(video.FrameRate))
+	if err != nil {
+		return nil, nil, fmt.Errorf("frame rate: %w", err)
+	}
+
+	var gopSize *float64
+	if v := aws.StringValue(video.KeyframesMaxDist); v != "" {
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("keyframes max dist: %w", err)
+		}
+		gopSize = aws.Float64(n)
+	}
+
+	switch codec := aws.StringValue(video.Codec); codec {
+	case "H.264":
+		h264Settings := &mediaconvert.H264Settings{
+			FramerateControl: aws.String(framerateControl),
+			GopSize:          gopSize,
+			GopSizeUnits:     aws.String(mediaconvert.H264GopSizeUnitsFrames),
+		}
+
+		if framerate != nil {
+			h264Settings.FramerateNumerator = aws.Int64(framerate[0])
+			h264Settings.FramerateDenominator = aws.Int64(framerate[1])
+		}
+
+		if bitrate != nil {
+			h264Settings.Bitrate = bitrate
+			h264Settings.RateControlMode = aws.String(mediaconvert.H264RateControlModeVbr)
+		} else {
+			h264Settings.RateControlMode = aws.String(mediaconvert.H264RateControlModeQvbr)
+		}
+
+		// Sort codec options so that the unsupported settings are reported in a stable order.
+		keys := make([]string, 0, len(video.CodecOptions))
+		for k := range video.CodecOptions {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			switch value := aws.StringValue(video.CodecOptions[k]); k {
+			case "Profile":
+				profile, ok := elasticTranscoderH264Profiles[value]
+				if !ok {
+					return nil, nil, fmt.Errorf("H.264 profile %q has no MediaConvert equivalent", value)
+				}
+				h264Settings.CodecProfile = aws.String(profile)
+			case "Level":
+				h264Settings.CodecLevel = aws.String(elasticTranscoderH264Level(value))
+			case "MaxBitRate":
+				maxBitrate, err := elasticTranscoderKbps(value)
+				if err != nil {
+					return nil, nil, fmt.Errorf("max bit rate: %w", err)
+				}
+				h264Settings.MaxBitrate = maxBitrate
+			case "MaxReferenceFrames":
+				n, err := strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					return nil, nil, fmt.Errorf("max reference frames: %w", err)
+				}
+				h264Settings.NumberReferenceFrames = aws.Int64(n)
+			default:
+				unsupported = append(unsupported, "video.codec_options."+k)
+			}
+		}
+
+		videoDescription.CodecSettings.Codec = aws.String(mediaconvert.VideoCodecH264)
+		videoDescription.CodecSettings.H264Settings = h264Settings
+	case "mpeg2":
+		mpeg2Settings := &mediaconvert.Mpeg2Settings{
+			Bitrate:          bitrate,
+			FramerateControl: aws.String(framerateControl),
+			GopSize:          gopSize,
+			GopSizeUnits:     aws.String(mediaconvert.Mpeg2GopSizeUnitsFrames),
+			RateControlMode:  aws.String(mediaconvert.Mpeg2RateControlModeVbr),
+		}
+
+		if framerate != nil {
+			mpeg2Settings.FramerateNumerator = aws.Int64(framerate[0])
+			mpeg2Settings.FramerateDenominator = aws.Int64(framerate[1])
+		}
+
+		videoDescription.CodecSettings.Codec = aws.String(mediaconvert.VideoCodecMpeg2)
+		videoDescription.CodecSettings.Mpeg2Settings = mpeg2Settings
+	case "vp8":
+		vp8Settings := &mediaconvert.Vp8Settings{
+			Bitrate:          bitrate,
+			FramerateControl: aws.String(framerateControl),
+			GopSize:          gopSize,
+			RateControlMode:  aws.String(mediaconvert.Vp8RateControlModeVbr),
+		}
+
+		if framerate != nil {
+			vp8Settings.FramerateNumerator = aws.Int64(framerate[0])
+			vp8Settings.FramerateDenominator = aws.Int64(framerate[1])
+		}
+
+		videoDescription.CodecSettings.Codec = aws.String(mediaconvert.VideoCodecVp8)
+		videoDescription.CodecSettings.Vp8Settings = vp8Settings
+	case "vp9":
+		vp9Settings := &mediaconvert.Vp9Settings{
+			Bitrate:          bitrate,
+			FramerateControl: aws.String(framerateControl),
+			GopSize:          gopSize,
+			RateControlMode:  aws.String(mediaconvert.Vp9RateControlModeVbr),
+		}
+
+		if framerate != nil {
+			vp9Settings.FramerateNumerator = aws.Int64(framerate[0])
+			vp9Settings.FramerateDenominator = aws.Int64(framerate[1])
+		}
+
+		videoDescription.CodecSettings.Codec = aws.String(mediaconvert.VideoCodecVp9)
+		videoDescription.CodecSettings.Vp9Settings = vp9Settings
+	default:
+		return nil, nil, fmt.Errorf("codec %q has no MediaConvert equivalent", codec)
+	}
+
+	if v := aws.StringValue(video.MaxWidth); v != "" && v != elasticTranscoderAuto {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("max width: %w", err)
+		}
+		videoDescription.Width = aws.Int64(n)
+	}
+
+	if v := aws.StringValue(video.MaxHeight); v != "" && v != elasticTranscoderAuto {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("max height: %w", err)
+		}
+		videoDescription.Height = aws.Int64(n)
+	}
+
+	if v := aws.StringValue(video.SizingPolicy); v != "" {
+		if scalingBehavior, ok := elasticTranscoderSizingPolicies[v]; ok {
+			videoDescription.ScalingBehavior = aws.String(scalingBehavior)
+		} else {
+			unsupported = append(unsupported, "video.sizing_policy="+v)
+		}
+	}
+
+	if v := aws.StringValue(video.PaddingPolicy); v == "Pad" {
+		unsupported = append(unsupported, "video.padding_policy=Pad")
+	}
+
+	if len(video.Watermarks) > 0 {
+		unsupported = append(unsupported, "video.watermarks")
+	}
+
+	return videoDescription, unsupported, nil
+}
+
+// audioDescriptionFromElasticTranscoder returns nil if the preset has no audio channels.
+func audioDescriptionFromElasticTranscoder(audio *elastictranscoder.AudioParameters) (*mediaconvert.AudioDescription, []string, error) {
+	var unsupported []string
+
+	bitrate, err := elasticTranscoderKbps(aws.StringValue(audio.BitRate))
+	if err != nil {
+		return nil, nil, fmt.Errorf("bit rate: %w", err)
+	}
+
+	sampleRate := int64(48000)
+	if v := aws.StringValue(audio.SampleRate); v != "" && v != elasticTranscoderAuto {
+		sampleRate, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sample rate: %w", err)
+		}
+	} else {
+		unsupported = append(unsupported, "audio.sample_rate=auto")
+	}
+
+	channels := int64(2)
+	if v := aws.StringValue(audio.Channels); v != "" && v != elasticTranscoderAuto {
+		channels, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("channels: %w", err)
+		}
+	} else {
+		unsupported = append(unsupported, "audio.channels=auto")
+	}
+
+	if channels == 0 {
+		return nil, unsupported, nil
+	}
+
+	if v := aws.StringValue(audio.AudioPackingMode); v != "" && v != "SingleTrack" {
+		unsupported = append(unsupported, "audio.audio_packing_mode="+v)
+	}
+
+	var bitDepth *int64
+	var profile string
+	if audio.CodecOptions != nil {
+		if v := aws.StringValue(audio.CodecOptions.BitDepth); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("bit depth: %w", err)
+			}
+			bitDepth = aws.Int64(n)
+		}
+		profile = aws.StringValue(audio.CodecOptions.Profile)
+	}
+
+	codecSettings := &mediaconvert.AudioCodecSettings{}
+
+	switch codec := aws.StringValue(audio.Codec); codec {
+	case "AAC":
+		codecProfile, ok := elasticTranscoderAACProfiles[profile]
+		if !ok {
+			return nil, nil, fmt.Errorf("AAC profile %q has no MediaConvert equivalent", profile)
+		}
+
+		var codingMode string
+		switch channels {
+		case 1:
+			codingMode = mediaconvert.AacCodingModeCodingMode10
+		case 2:
+			codingMode = mediaconvert.AacCodingModeCodingMode20
+		default:
+			return nil, nil, fmt.Errorf("AAC with %d channels has no MediaConvert equivalent", channels)
+		}
+
+		codecSettings.Codec = aws.String(mediaconvert.AudioCodecAac)
+		codecSettings.AacSettings = &mediaconvert.AacSettings{
+			Bitrate:      bitrate,
+			CodecProfile: aws.String(codecProfile),
+			CodingMode:   aws.String(codingMode),
+			SampleRate:   aws.Int64(sampleRate),
+		}
+	case "flac":
+		codecSettings.Codec = aws.String(mediaconvert.AudioCodecFlac)
+		codecSettings.FlacSettings = &mediaconvert.FlacSettings{
+			BitDepth:   bitDepth,
+			Channels:   aws.Int64(channels),
+			SampleRate: aws.Int64(sampleRate),
+		}
+	case "mp2":
+		codecSettings.Codec = aws.String(mediaconvert.AudioCodecMp2)
+		codecSettings.Mp2Settings = &mediaconvert.Mp2Settings{
+			Bitrate:    bitrate,
+			Channels:   aws.Int64(channels),
+			SampleRate: aws.Int64(sampleRate),
+		}
+	case "mp3":
+		codecSettings.Codec = aws.String(mediaconvert.AudioCodecMp3)
+		codecSettings.Mp3Settings = &mediaconvert.Mp3Settings{
+			Bitrate:         bitrate,
+			Channels:        aws.Int64(channels),
+			RateControlMode: aws.String(mediaconvert.Mp3RateControlModeCbr),
+			SampleRate:      aws.Int64(sampleRate),
+		}
+	case "pcm":
+		codecSettings.Codec = aws.String(mediaconvert.AudioCodecWav)
+		codecSettings.WavSettings = &mediaconvert.WavSettings{
+			BitDepth:   bitDepth,
+			Channels:   aws.Int64(channels),
+			Format:     aws.String(mediaconvert.WavFormatRiff),
+			SampleRate: aws.Int64(sampleRate),
+		}
+	case "vorbis":
+		codecSettings.Codec = aws.String(mediaconvert.AudioCodecVorbis)
+		codecSettings.VorbisSettings = &mediaconvert.VorbisSettings{
+			Channels:   aws.Int64(channels),
+			SampleRate: aws.Int64(sampleRate),
+		}
+	default:
+		return nil, nil, fmt.Errorf("codec %q has no MediaConvert equivalent", codec)
+	}
+
+	return &mediaconvert.AudioDescription{CodecSettings: codecSettings}, unsupported, nil
+}
+
+// jobTemplateSettingsFromElasticTranscoder returns MediaConvert job template settings that write
+// one output per MediaConvert preset to the Elastic Transcoder pipeline's output bucket.
+func jobTemplateSettingsFromElasticTranscoder(pipeline *elastictranscoder.Pipeline, presetNames []string) (*mediaconvert.JobTemplateSettings, error) {
+	bucket := aws.StringValue(pipeline.OutputBucket)
+
+	if bucket == "" && pipeline.ContentConfig != nil {
+		bucket = aws.StringValue(pipeline.ContentConfig.Bucket)
+	}
+
+	if bucket == "" {
+		return nil, fmt.Errorf("pipeline (%s) has no output bucket", aws.StringValue(pipeline.Id))
+	}
+
+	var outputs []*mediaconvert.Output
+
+	for _, presetName := range presetNames {
+		outputs = append(outputs, &mediaconvert.Output{
+			NameModifier: aws.String("_" + presetName),
+			Preset:       aws.String(presetName),
+		})
+	}
+
+	return &mediaconvert.JobTemplateSettings{
+		OutputGroups: []*mediaconvert.OutputGroup{{
+			Name: aws.String("File Group"),
+			OutputGroupSettings: &mediaconvert.OutputGroupSettings{
+				FileGroupSettings: &mediaconvert.FileGroupSettings{
+					Destination: aws.String(fmt.Sprintf("s3://%s/", bucket)),
+				},
+				Type: aws.String(mediaconvert.OutputGroupTypeFileGroupSettings),
+			},
+			Outputs: outputs,
+		}},
+	}, nil
+}
+
+// elasticTranscoderKbps converts an Elastic Transcoder kilobits per second value to bits per second.
+// It returns nil for "auto".
+func elasticTranscoderKbps(v string) (*int64, error) {
+	if v == "" || v == elasticTranscoderAuto {
+		return nil, nil
+	}
+
+	n, err := strconv.ParseInt(v, 10, 64)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return aws.Int64(n * 1000), nil
+}
+
+func elasticTranscoderFrameRate(v string) (string, *[2]int64, error) {
+	if v == "" || v == elasticTranscoderAuto {
+		return mediaconvert.H264FramerateControlInitializeFromSource, nil, nil
+	}
+
+	framerate, ok := elasticTranscoderFrameRates[v]
+
+	if !ok {
+		return "", nil, fmt.Errorf("%q has no MediaConvert equivalent", v)
+	}
+
+	return mediaconvert.H264FramerateControlSpecified, &framerate, nil
+}
+
+// elasticTranscoderH264Level converts an Elastic Transcoder H.264 level such as "3.1" to a
+// MediaConvert level such as "LEVEL_3_1". Levels without an equivalent are set to "AUTO".
+func elasticTranscoderH264Level(v string) string {
+	level := "LEVEL_" + strings.ReplaceAll(v, ".", "_")
+
+	for _, l := range mediaconvert.H264CodecLevel_Values() {
+		if l == level {
+			return level
+		}
+	}
+
+	return mediaconvert.H264CodecLevelAuto
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_media_convert_elastic_transcoder_pipeline", name="Elastic Transcoder Pipeline")
+func DataSourceElasticTranscoderPipeline() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceElasticTranscoderPipelineRead,
+
+		Schema: map[string]*schema.Schema{
+			"input_bucket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_bucket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pipeline_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"preset_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"settings": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceElasticTranscoderPipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticTranscoderConn(ctx)
+
+	id := d.Get("pipeline_id").(string)
+
+	output, err := conn.ReadPipelineWithContext(ctx, &elastictranscoder.ReadPipelineInput{
+		Id: aws.String(id),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Transcoder Pipeline (%s): %s", id, err)
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Transcoder Pipeline (%s): empty response", id)
+	}
+
+	pipeline := output.Pipeline
+	settings, err := jobTemplateSettingsFromElasticTranscoder(pipeline, flex.ExpandStringValueList(d.Get("preset_names").([]interface{})))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "converting Elastic Transcoder Pipeline (%s): %s", id, err)
+	}
+
+	v, err := flattenSettings(settings)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "converting Elastic Transcoder Pipeline (%s): %s", id, err)
+	}
+
+	d.SetId(aws.StringValue(pipeline.Id))
+	d.Set("input_bucket", pipeline.InputBucket)
+	d.Set("name", pipeline.Name)
+	d.Set("output_bucket", pipeline.OutputBucket)
+	d.Set("role", pipeline.Role)
+	d.Set("settings", v)
+
+	return diags
+}
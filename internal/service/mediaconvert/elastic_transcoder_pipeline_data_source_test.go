@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccMediaConvertElasticTranscoderPipelineDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastictranscoder_pipeline.test"
+	dataSourceName := "data.aws_media_convert_elastic_transcoder_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, elastictranscoder.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, elastictranscoder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccElasticTranscoderPipelineDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "input_bucket", resourceName, "input_bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "output_bucket", resourceName, "output_bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role", resourceName, "role"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "settings"),
+				),
+			},
+		},
+	})
+}
+
+func testAccElasticTranscoderPipelineDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elastictranscoder_pipeline" "test" {
+  input_bucket  = aws_s3_bucket.test.bucket
+  output_bucket = aws_s3_bucket.test.bucket
+  name          = %[1]q
+  role          = aws_iam_role.test.arn
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "elastictranscoder.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_media_convert_elastic_transcoder_pipeline" "test" {
+  pipeline_id  = aws_elastictranscoder_pipeline.test.id
+  preset_names = ["hd", "sd"]
+}
+`, rName)
+}
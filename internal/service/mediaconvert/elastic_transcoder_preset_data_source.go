@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_media_convert_elastic_transcoder_preset", name="Elastic Transcoder Preset")
+func DataSourceElasticTranscoderPreset() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceElasticTranscoderPresetRead,
+
+		Schema: map[string]*schema.Schema{
+			"container": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"settings": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unsupported_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceElasticTranscoderPresetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticTranscoderConn(ctx)
+
+	id := d.Get("preset_id").(string)
+
+	output, err := conn.ReadPresetWithContext(ctx, &elastictranscoder.ReadPresetInput{
+		Id: aws.String(id),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Transcoder Preset (%s): %s", id, err)
+	}
+
+	if output == nil || output.Preset == nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Transcoder Preset (%s): empty response", id)
+	}
+
+	preset := output.Preset
+	settings, unsupported, err := presetSettingsFromElasticTranscoder(preset)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "converting Elastic Transcoder Preset (%s): %s", id, err)
+	}
+
+	v, err := flattenSettings(settings)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "converting Elastic Transcoder Preset (%s): %s", id, err)
+	}
+
+	d.SetId(aws.StringValue(preset.Id))
+	d.Set("container", preset.Container)
+	d.Set("description", preset.Description)
+	d.Set("name", preset.Name)
+	d.Set("settings", v)
+	d.Set("unsupported_settings", unsupported)
+
+	return diags
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccMediaConvertElasticTranscoderPresetDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_media_convert_elastic_transcoder_preset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, elastictranscoder.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, elastictranscoder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// System preset: Generic 720p.
+				Config: testAccElasticTranscoderPresetDataSourceConfig_basic("1351620000001-000010"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "container", "mp4"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "settings"),
+				),
+			},
+		},
+	})
+}
+
+func testAccElasticTranscoderPresetDataSourceConfig_basic(presetID string) string {
+	return fmt.Sprintf(`
+data "aws_media_convert_elastic_transcoder_preset" "test" {
+  preset_id = %[1]q
+}
+`, presetID)
+}
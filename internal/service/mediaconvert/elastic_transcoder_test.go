@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/google/go-cmp/cmp"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+)
+
+func TestPresetSettingsFromElasticTranscoder(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		preset              *elastictranscoder.Preset
+		expectedSettings    *mediaconvert.PresetSettings
+		expectedUnsupported []string
+		expectError         bool
+	}{
+		"h264 aac": {
+			preset: &elastictranscoder.Preset{
+				Audio: &elastictranscoder.AudioParameters{
+					BitRate:    aws.String("160"),
+					Channels:   aws.String("2"),
+					Codec:      aws.String("AAC"),
+					SampleRate: aws.String("44100"),
+					CodecOptions: &elastictranscoder.AudioCodecOptions{
+						Profile: aws.String("AAC-LC"),
+					},
+				},
+				Container: aws.String("mp4"),
+				Video: &elastictranscoder.VideoParameters{
+					BitRate: aws.String("2200"),
+					Codec:   aws.String("H.264"),
+					CodecOptions: map[string]*string{
+						"ColorSpaceConversionMode": aws.String("None"),
+						"Level":                    aws.String("3.1"),
+						"MaxReferenceFrames":       aws.String("3"),
+						"Profile":                  aws.String("main"),
+					},
+					FrameRate:        aws.String("29.97"),
+					KeyframesMaxDist: aws.String("90"),
+					MaxHeight:        aws.String("720"),
+					MaxWidth:         aws.String("1280"),
+					PaddingPolicy:    aws.String("Pad"),
+					SizingPolicy:     aws.String("ShrinkToFit"),
+				},
+			},
+			expectedSettings: &mediaconvert.PresetSettings{
+				AudioDescriptions: []*mediaconvert.AudioDescription{{
+					CodecSettings: &mediaconvert.AudioCodecSettings{
+						AacSettings: &mediaconvert.AacSettings{
+							Bitrate:      aws.Int64(160000),
+							CodecProfile: aws.String(mediaconvert.AacCodecProfileLc),
+							CodingMode:   aws.String(mediaconvert.AacCodingModeCodingMode20),
+							SampleRate:   aws.Int64(44100),
+						},
+						Codec: aws.String(mediaconvert.AudioCodecAac),
+					},
+				}},
+				ContainerSettings: &mediaconvert.ContainerSettings{
+					Container: aws.String(mediaconvert.ContainerTypeMp4),
+				},
+				VideoDescription: &mediaconvert.VideoDescription{
+					CodecSettings: &mediaconvert.VideoCodecSettings{
+						Codec: aws.String(mediaconvert.VideoCodecH264),
+						H264Settings: &mediaconvert.H264Settings{
+							Bitrate:               aws.Int64(2200000),
+							CodecLevel:            aws.String(mediaconvert.H264CodecLevelLevel31),
+							CodecProfile:          aws.String(mediaconvert.H264CodecProfileMain),
+							FramerateControl:      aws.String(mediaconvert.H264FramerateControlSpecified),
+							FramerateDenominator:  aws.Int64(1001),
+							FramerateNumerator:    aws.Int64(30000),
+							GopSize:               aws.Float64(90),
+							GopSizeUnits:          aws.String(mediaconvert.H264GopSizeUnitsFrames),
+							NumberReferenceFrames: aws.Int64(3),
+							RateControlMode:       aws.String(mediaconvert.H264RateControlModeVbr),
+						},
+					},
+					Height:          aws.Int64(720),
+					ScalingBehavior: aws.String(mediaconvert.ScalingBehaviorFitNoUpscale),
+					Width:           aws.Int64(1280),
+				},
+			},
+			expectedUnsupported: []string{
+				"video.codec_options.ColorSpaceConversionMode",
+				"video.padding_policy=Pad",
+			},
+		},
+		"audio only auto": {
+			preset: &elastictranscoder.Preset{
+				Audio: &elastictranscoder.AudioParameters{
+					BitRate:    aws.String("192"),
+					Channels:   aws.String("auto"),
+					Codec:      aws.String("mp3"),
+					SampleRate: aws.String("auto"),
+				},
+				Container: aws.String("mp3"),
+			},
+			expectedSettings: &mediaconvert.PresetSettings{
+				AudioDescriptions: []*mediaconvert.AudioDescription{{
+					CodecSettings: &mediaconvert.AudioCodecSettings{
+						Codec: aws.String(mediaconvert.AudioCodecMp3),
+						Mp3Settings: &mediaconvert.Mp3Settings{
+							Bitrate:         aws.Int64(192000),
+							Channels:        aws.Int64(2),
+							RateControlMode: aws.String(mediaconvert.Mp3RateControlModeCbr),
+							SampleRate:      aws.Int64(48000),
+						},
+					},
+				}},
+				ContainerSettings: &mediaconvert.ContainerSettings{
+					Container: aws.String(mediaconvert.ContainerTypeRaw),
+				},
+			},
+			expectedUnsupported: []string{
+				"audio.sample_rate=auto",
+				"audio.channels=auto",
+			},
+		},
+		"unsupported container": {
+			preset: &elastictranscoder.Preset{
+				Container: aws.String("gif"),
+			},
+			expectError: true,
+		},
+		"unsupported video codec": {
+			preset: &elastictranscoder.Preset{
+				Container: aws.String("mp4"),
+				Video: &elastictranscoder.VideoParameters{
+					Codec: aws.String("gif"),
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			settings, unsupported, err := tfmediaconvert.PresetSettingsFromElasticTranscoder(testCase.preset)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("PresetSettingsFromElasticTranscoder() err %t, want %t: %s", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(settings, testCase.expectedSettings); diff != "" {
+				t.Errorf("unexpected settings diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(unsupported, testCase.expectedUnsupported); diff != "" {
+				t.Errorf("unexpected unsupported settings diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJobTemplateSettingsFromElasticTranscoder(t *testing.T) {
+	t.Parallel()
+
+	pipeline := &elastictranscoder.Pipeline{
+		Id:           aws.String("1111111111111-abcde1"),
+		OutputBucket: aws.String("example-output"),
+	}
+
+	settings, err := tfmediaconvert.JobTemplateSettingsFromElasticTranscoder(pipeline, []string{"hd", "sd"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &mediaconvert.JobTemplateSettings{
+		OutputGroups: []*mediaconvert.OutputGroup{{
+			Name: aws.String("File Group"),
+			OutputGroupSettings: &mediaconvert.OutputGroupSettings{
+				FileGroupSettings: &mediaconvert.FileGroupSettings{
+					Destination: aws.String("s3://example-output/"),
+				},
+				Type: aws.String(mediaconvert.OutputGroupTypeFileGroupSettings),
+			},
+			Outputs: []*mediaconvert.Output{
+				{
+					NameModifier: aws.String("_hd"),
+					Preset:       aws.String("hd"),
+				},
+				{
+					NameModifier: aws.String("_sd"),
+					Preset:       aws.String("sd"),
+				},
+			},
+		}},
+	}
+
+	if diff := cmp.Diff(settings, expected); diff != "" {
+		t.Errorf("unexpected settings diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := tfmediaconvert.JobTemplateSettingsFromElasticTranscoder(&elastictranscoder.Pipeline{Id: aws.String("1111111111111-abcde1")}, []string{"hd"}); err == nil {
+		t.Error("expected error for pipeline without output bucket")
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_media_convert_job_template", name="Job Template")
+// @Tags
+func ResourceJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobTemplateCreate,
+		ReadWithoutTimeout:   resourceJobTemplateRead,
+		UpdateWithoutTimeout: resourceJobTemplateUpdate,
+		DeleteWithoutTimeout: resourceJobTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acceleration_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mediaconvert.AccelerationMode_Values(), false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(-50, 50),
+			},
+			"queue": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"settings": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentSettingsDiffs,
+			},
+			"status_update_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mediaconvert.StatusUpdateInterval_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceJobTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(ctx, meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	settings, err := expandJobTemplateSettings(d.Get("settings").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Job Template (%s): settings: %s", name, err)
+	}
+
+	input := &mediaconvert.CreateJobTemplateInput{
+		Name:     aws.String(name),
+		Priority: aws.Int64(int64(d.Get("priority").(int))),
+		Settings: settings,
+		Tags:     getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("acceleration_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccelerationSettings = expandAccelerationSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("queue"); ok {
+		input.Queue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status_update_interval"); ok {
+		input.StatusUpdateInterval = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateJobTemplateWithContext(ctx, input)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Job Template (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.JobTemplate.Name))
+
+	return append(diags, resourceJobTemplateRead(ctx, d, meta)...)
+}
+
+func resourceJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(ctx, meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	jobTemplate, err := FindJobTemplateByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Job Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("acceleration_settings", flattenAccelerationSettings(jobTemplate.AccelerationSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting acceleration_settings: %s", err)
+	}
+	d.Set("arn", jobTemplate.Arn)
+	d.Set("category", jobTemplate.Category)
+	d.Set("description", jobTemplate.Description)
+	d.Set("name", jobTemplate.Name)
+	d.Set("priority", jobTemplate.Priority)
+	d.Set("queue", jobTemplate.Queue)
+	d.Set("status_update_interval", jobTemplate.StatusUpdateInterval)
+
+	settings, err := flattenSettings(jobTemplate.Settings)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Job Template (%s): settings: %s", d.Id(), err)
+	}
+	d.Set("settings", settings)
+
+	tags, err := listTags(ctx, conn, aws.StringValue(jobTemplate.Arn))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	return diags
+}
+
+func resourceJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(ctx, meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		settings, err := expandJobTemplateSettings(d.Get("settings").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Job Template (%s): settings: %s", d.Id(), err)
+		}
+
+		input := &mediaconvert.UpdateJobTemplateInput{
+			Category:    aws.String(d.Get("category").(string)),
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+			Priority:    aws.Int64(int64(d.Get("priority").(int))),
+			Settings:    settings,
+		}
+
+		if v, ok := d.GetOk("acceleration_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AccelerationSettings = expandAccelerationSettings(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.AccelerationSettings = &mediaconvert.AccelerationSettings{
+				Mode: aws.String(mediaconvert.AccelerationModeDisabled),
+			}
+		}
+
+		if v, ok := d.GetOk("queue"); ok {
+			input.Queue = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("status_update_interval"); ok {
+			input.StatusUpdateInterval = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateJobTemplateWithContext(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Job Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := updateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating tags: %s", err)
+		}
+	}
+
+	return append(diags, resourceJobTemplateRead(ctx, d, meta)...)
+}
+
+func resourceJobTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(ctx, meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Media Convert Job Template: %s", d.Id())
+	_, err = conn.DeleteJobTemplateWithContext(ctx, &mediaconvert.DeleteJobTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindJobTemplateByName(ctx context.Context, conn *mediaconvert.MediaConvert, name string) (*mediaconvert.JobTemplate, error) {
+	input := &mediaconvert.GetJobTemplateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetJobTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JobTemplate, nil
+}
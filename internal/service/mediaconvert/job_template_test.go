@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConvertJobTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.#", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", regexache.MustCompile(`jobTemplates/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "queue"),
+					resource.TestCheckResourceAttrSet(resourceName, "settings"),
+					resource.TestCheckResourceAttrSet(resourceName, "status_update_interval"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconvert.ResourceJobTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_full(rName, mediaconvert.AccelerationModePreferred, 10, mediaconvert.StatusUpdateIntervalSeconds30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.0.mode", mediaconvert.AccelerationModePreferred),
+					resource.TestCheckResourceAttr(resourceName, "category", "test"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue", "aws_media_convert_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status_update_interval", mediaconvert.StatusUpdateIntervalSeconds30),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobTemplateConfig_full(rName, mediaconvert.AccelerationModeEnabled, -10, mediaconvert.StatusUpdateIntervalSeconds60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.0.mode", mediaconvert.AccelerationModeEnabled),
+					resource.TestCheckResourceAttr(resourceName, "priority", "-10"),
+					resource.TestCheckResourceAttr(resourceName, "status_update_interval", mediaconvert.StatusUpdateIntervalSeconds60),
+				),
+			},
+			{
+				Config: testAccJobTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccJobTemplateConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := tfmediaconvert.GetAccountClient(ctx, acctest.Provider.Meta().(*conns.AWSClient))
+		if err != nil {
+			return err
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_convert_job_template" {
+				continue
+			}
+
+			_, err := tfmediaconvert.FindJobTemplateByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Media Convert Job Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobTemplateExists(ctx context.Context, n string, v *mediaconvert.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := tfmediaconvert.GetAccountClient(ctx, acctest.Provider.Meta().(*conns.AWSClient))
+		if err != nil {
+			return err
+		}
+
+		output, err := tfmediaconvert.FindJobTemplateByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+const testAccJobTemplateSettings = `
+  settings = jsonencode({
+    outputGroups = [{
+      name = "File Group"
+      outputGroupSettings = {
+        type = "FILE_GROUP_SETTINGS"
+        fileGroupSettings = {
+          destination = "s3://example-bucket/output/"
+        }
+      }
+      outputs = [{
+        nameModifier = "_720p"
+        containerSettings = {
+          container = "MP4"
+        }
+        videoDescription = {
+          width  = 1280
+          height = 720
+          codecSettings = {
+            codec = "H_264"
+            h264Settings = {
+              rateControlMode = "QVBR"
+              maxBitrate      = 5000000
+            }
+          }
+        }
+      }]
+    }]
+  })
+`
+
+func testAccJobTemplateConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name = %[1]q
+%[2]s
+}
+`, rName, testAccJobTemplateSettings)
+}
+
+func testAccJobTemplateConfig_full(rName, accelerationMode string, priority int, statusUpdateInterval string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_media_convert_job_template" "test" {
+  name                   = %[1]q
+  category               = "test"
+  description            = %[1]q
+  priority               = %[3]d
+  queue                  = aws_media_convert_queue.test.arn
+  status_update_interval = %[4]q
+
+  acceleration_settings {
+    mode = %[2]q
+  }
+%[5]s
+}
+`, rName, accelerationMode, priority, statusUpdateInterval, testAccJobTemplateSettings)
+}
+
+func testAccJobTemplateConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name = %[1]q
+%[4]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccJobTemplateSettings)
+}
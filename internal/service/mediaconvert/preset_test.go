@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConvertPreset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_basic(rName, 1280, 720),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", regexache.MustCompile(`presets/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "category", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "settings"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPresetConfig_basic(rName, 1920, 1080),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertPreset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_basic(rName, 1280, 720),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconvert.ResourcePreset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertPreset_categoryAndDescription(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_categoryAndDescription(rName, "category1", "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "category", "category1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccPresetConfig_categoryAndDescription(rName, "category2", "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "category", "category2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertPreset_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconvert.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccPresetConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPresetConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPresetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := tfmediaconvert.GetAccountClient(ctx, acctest.Provider.Meta().(*conns.AWSClient))
+		if err != nil {
+			return err
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_convert_preset" {
+				continue
+			}
+
+			_, err := tfmediaconvert.FindPresetByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Media Convert Preset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPresetExists(ctx context.Context, n string, v *mediaconvert.Preset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := tfmediaconvert.GetAccountClient(ctx, acctest.Provider.Meta().(*conns.AWSClient))
+		if err != nil {
+			return err
+		}
+
+		output, err := tfmediaconvert.FindPresetByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPresetSettings(width, height int) string {
+	return fmt.Sprintf(`
+  settings = jsonencode({
+    containerSettings = {
+      container = "MP4"
+    }
+    videoDescription = {
+      width  = %[1]d
+      height = %[2]d
+      codecSettings = {
+        codec = "H_264"
+        h264Settings = {
+          rateControlMode = "QVBR"
+          maxBitrate      = 5000000
+        }
+      }
+    }
+    audioDescriptions = [{
+      codecSettings = {
+        codec = "AAC"
+        aacSettings = {
+          bitrate    = 96000
+          codingMode = "CODING_MODE_2_0"
+          sampleRate = 48000
+        }
+      }
+    }]
+  })
+`, width, height)
+}
+
+func testAccPresetConfig_basic(rName string, width, height int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+%[2]s
+}
+`, rName, testAccPresetSettings(width, height))
+}
+
+func testAccPresetConfig_categoryAndDescription(rName, category, description string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name        = %[1]q
+  category    = %[2]q
+  description = %[3]q
+%[4]s
+}
+`, rName, category, description, testAccPresetSettings(1280, 720))
+}
+
+func testAccPresetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+%[4]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccPresetSettings(1280, 720))
+}
+
+func testAccPresetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+%[6]s
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccPresetSettings(1280, 720))
+}
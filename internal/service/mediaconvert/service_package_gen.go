@@ -25,6 +25,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceElasticTranscoderPipeline,
+			TypeName: "aws_media_convert_elastic_transcoder_pipeline",
+			Name:     "Elastic Transcoder Pipeline",
+		},
+		{
+			Factory:  DataSourceElasticTranscoderPreset,
+			TypeName: "aws_media_convert_elastic_transcoder_preset",
+			Name:     "Elastic Transcoder Preset",
+		},
 		{
 			Factory:  DataSourceQueue,
 			TypeName: "aws_media_convert_queue",
@@ -35,6 +45,18 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceJobTemplate,
+			TypeName: "aws_media_convert_job_template",
+			Name:     "Job Template",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  ResourcePreset,
+			TypeName: "aws_media_convert_preset",
+			Name:     "Preset",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  ResourceQueue,
 			TypeName: "aws_media_convert_queue",
@@ -4,8 +4,15 @@
 package mediaconvert
 
 import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func expandReservationPlanSettings(config map[string]interface{}) *mediaconvert.ReservationPlanSettings {
@@ -39,3 +46,113 @@ func flattenReservationPlan(reservationPlan *mediaconvert.ReservationPlan) []int
 
 	return []interface{}{m}
 }
+
+func expandPresetSettings(s string) (*mediaconvert.PresetSettings, error) {
+	settings := &mediaconvert.PresetSettings{}
+
+	if err := jsonutil.UnmarshalJSON(settings, strings.NewReader(s)); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	return settings, nil
+}
+
+func expandJobTemplateSettings(s string) (*mediaconvert.JobTemplateSettings, error) {
+	settings := &mediaconvert.JobTemplateSettings{}
+
+	if err := jsonutil.UnmarshalJSON(settings, strings.NewReader(s)); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	return settings, nil
+}
+
+// flattenSettings renders MediaConvert settings using the API's JSON field names.
+func flattenSettings(settings interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(settings)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// suppressEquivalentSettingsDiffs suppresses differences between configured settings and
+// the settings returned by MediaConvert, which fills in defaults for omitted fields.
+func suppressEquivalentSettingsDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	var oldValue, newValue interface{}
+
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+
+	return settingsSubsetOf(newValue, oldValue)
+}
+
+// settingsSubsetOf returns whether every value configured in a is present with the same value in b.
+func settingsSubsetOf(a, b interface{}) bool {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+
+		if !ok {
+			return false
+		}
+
+		for k, v := range a {
+			if !settingsSubsetOf(v, b[k]) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if !settingsSubsetOf(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+func expandAccelerationSettings(tfMap map[string]interface{}) *mediaconvert.AccelerationSettings {
+	apiObject := &mediaconvert.AccelerationSettings{}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccelerationSettings(apiObject *mediaconvert.AccelerationSettings) []interface{} {
+	// Acceleration is reported as DISABLED when not configured.
+	if apiObject == nil || aws.StringValue(apiObject.Mode) == mediaconvert.AccelerationModeDisabled {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"mode": aws.StringValue(apiObject.Mode),
+	}
+
+	return []interface{}{tfMap}
+}
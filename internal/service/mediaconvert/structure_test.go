@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+)
+
+func TestSettingsSubsetOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configured string
+		remote     string
+		expected   bool
+	}{
+		"identical": {
+			configured: `{"containerSettings":{"container":"MP4"}}`,
+			remote:     `{"containerSettings":{"container":"MP4"}}`,
+			expected:   true,
+		},
+		"remote defaults": {
+			configured: `{"containerSettings":{"container":"MP4"}}`,
+			remote:     `{"containerSettings":{"container":"MP4","mp4Settings":{"cslgAtom":"INCLUDE"}}}`,
+			expected:   true,
+		},
+		"changed value": {
+			configured: `{"containerSettings":{"container":"MP4"}}`,
+			remote:     `{"containerSettings":{"container":"M2TS"}}`,
+			expected:   false,
+		},
+		"missing value": {
+			configured: `{"containerSettings":{"container":"MP4"},"videoDescription":{"width":1280}}`,
+			remote:     `{"containerSettings":{"container":"MP4"}}`,
+			expected:   false,
+		},
+		"list element defaults": {
+			configured: `{"audioDescriptions":[{"codecSettings":{"codec":"AAC"}}]}`,
+			remote:     `{"audioDescriptions":[{"audioTypeControl":"FOLLOW_INPUT","codecSettings":{"codec":"AAC"}}]}`,
+			expected:   true,
+		},
+		"list length": {
+			configured: `{"audioDescriptions":[{"codecSettings":{"codec":"AAC"}}]}`,
+			remote:     `{"audioDescriptions":[{"codecSettings":{"codec":"AAC"}},{"codecSettings":{"codec":"MP3"}}]}`,
+			expected:   false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var configured, remote interface{}
+
+			if err := json.Unmarshal([]byte(testCase.configured), &configured); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal([]byte(testCase.remote), &remote); err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := tfmediaconvert.SettingsSubsetOf(configured, remote), testCase.expected; got != want {
+				t.Errorf("SettingsSubsetOf() = %t, want %t", got, want)
+			}
+		})
+	}
+}
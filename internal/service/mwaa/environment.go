@@ -5,13 +5,22 @@ package mwaa
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/mwaa"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -29,8 +38,26 @@ import (
 
 const (
 	propagationTimeout = 2 * time.Minute
+
+	environmentPollInterval = 5 * time.Second
+)
+
+const (
+	lastUpdateStatusFailed = "FAILED"
+)
+
+const (
+	workerReplacementStrategyForced   = "FORCED"
+	workerReplacementStrategyGraceful = "GRACEFUL"
 )
 
+func workerReplacementStrategy_Values() []string {
+	return []string{
+		workerReplacementStrategyForced,
+		workerReplacementStrategyGraceful,
+	}
+}
+
 // @SDKResource("aws_mwaa_environment", name="Environment")
 // @Tags(identifierAttribute="arn")
 func ResourceEnvironment() *schema.Resource {
@@ -167,12 +194,24 @@ func ResourceEnvironment() *schema.Resource {
 					},
 				},
 			},
+			"max_webservers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(2, 5),
+			},
 			"max_workers": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
+			"min_webservers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(2, 5),
+			},
 			"min_workers": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -239,9 +278,10 @@ func ResourceEnvironment() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"startup_script_s3_object_version": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"startup_script_s3_path"},
 			},
 			"startup_script_s3_path": {
 				Type:     schema.TypeString,
@@ -268,6 +308,11 @@ func ResourceEnvironment() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"worker_replacement_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(workerReplacementStrategy_Values(), false),
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -287,6 +332,7 @@ func ResourceEnvironment() *schema.Resource {
 
 				return false
 			}),
+			resourceEnvironmentStartupScriptCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 	}
@@ -327,11 +373,19 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.LoggingConfiguration = expandEnvironmentLoggingConfiguration(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("max_webservers"); ok {
+		input.MaxWebservers = aws.Int64(int64(v.(int)))
+	}
+
 	// input.MaxWorkers = aws.Int64(int64(90))
 	if v, ok := d.GetOk("max_workers"); ok {
 		input.MaxWorkers = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("min_webservers"); ok {
+		input.MinWebservers = aws.Int64(int64(v.(int)))
+	}
+
 	if v, ok := d.GetOk("min_workers"); ok {
 		input.MinWorkers = aws.Int64(int64(v.(int)))
 	}
@@ -424,7 +478,9 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	if err := d.Set("logging_configuration", flattenLoggingConfiguration(environment.LoggingConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting logging_configuration: %s", err)
 	}
+	d.Set("max_webservers", environment.MaxWebservers)
 	d.Set("max_workers", environment.MaxWorkers)
+	d.Set("min_webservers", environment.MinWebservers)
 	d.Set("min_workers", environment.MinWorkers)
 	d.Set("name", environment.Name)
 	if err := d.Set("network_configuration", flattenNetworkConfiguration(environment.NetworkConfiguration)); err != nil {
@@ -454,7 +510,7 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	conn := meta.(*conns.AWSClient).MWAAConn(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("tags", "tags_all", "worker_replacement_strategy") {
 		input := &mwaa.UpdateEnvironmentInput{
 			Name: aws.String(d.Get("name").(string)),
 		}
@@ -488,10 +544,18 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.LoggingConfiguration = expandEnvironmentLoggingConfiguration(d.Get("logging_configuration").([]interface{}))
 		}
 
+		if d.HasChange("max_webservers") {
+			input.MaxWebservers = aws.Int64(int64(d.Get("max_webservers").(int)))
+		}
+
 		if d.HasChange("max_workers") {
 			input.MaxWorkers = aws.Int64(int64(d.Get("max_workers").(int)))
 		}
 
+		if d.HasChange("min_webservers") {
+			input.MinWebservers = aws.Int64(int64(d.Get("min_webservers").(int)))
+		}
+
 		if d.HasChange("min_workers") {
 			input.MinWorkers = aws.Int64(int64(d.Get("min_workers").(int)))
 		}
@@ -540,15 +604,30 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.WeeklyMaintenanceWindowStart = aws.String(d.Get("weekly_maintenance_window_start").(string))
 		}
 
-		_, err := conn.UpdateEnvironmentWithContext(ctx, input)
+		var optFns []request.Option
+
+		if v, ok := d.GetOk("worker_replacement_strategy"); ok {
+			optFns = append(optFns, withWorkerReplacementStrategy(v.(string)))
+		}
+
+		_, err := conn.UpdateEnvironmentWithContext(ctx, input, optFns...)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating MWAA Environment (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitEnvironmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		environment, err := waitEnvironmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for MWAA Environment (%s) update: %s", d.Id(), err)
 		}
+
+		// A failed Airflow version upgrade is rolled back and the environment returns to AVAILABLE.
+		if d.HasChange("airflow_version") {
+			if o, n := d.GetChange("airflow_version"); aws.StringValue(environment.AirflowVersion) != n.(string) {
+				return sdkdiag.AppendErrorf(diags, "upgrading MWAA Environment (%s) Airflow version from %s to %s: rolled back to %s", d.Id(), o, n, aws.StringValue(environment.AirflowVersion))
+			}
+		}
 	}
 
 	return append(diags, resourceEnvironmentRead(ctx, d, meta)...)
@@ -579,6 +658,72 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// resourceEnvironmentStartupScriptCustomizeDiff verifies at plan time that the startup script
+// (and, if pinned, the requested object version) exists in the source bucket.
+func resourceEnvironmentStartupScriptCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("source_bucket_arn", "startup_script_s3_object_version", "startup_script_s3_path") {
+		return nil
+	}
+
+	key := diff.Get("startup_script_s3_path").(string)
+
+	if key == "" {
+		return nil
+	}
+
+	// The bucket and object may be created in the same apply.
+	if !diff.NewValueKnown("source_bucket_arn") || !diff.NewValueKnown("startup_script_s3_object_version") || !diff.NewValueKnown("startup_script_s3_path") {
+		return nil
+	}
+
+	var versionID string
+
+	if diff.GetRawConfig().GetAttr("startup_script_s3_object_version").IsNull() {
+		// Without a pinned version MWAA uses the latest version of the new startup script.
+		if diff.HasChange("startup_script_s3_path") {
+			if err := diff.SetNewComputed("startup_script_s3_object_version"); err != nil {
+				return err
+			}
+		}
+	} else {
+		versionID = diff.Get("startup_script_s3_object_version").(string)
+	}
+
+	sourceBucketARN, err := arn.Parse(diff.Get("source_bucket_arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("parsing source_bucket_arn: %w", err)
+	}
+
+	bucket := sourceBucketARN.Resource
+	input := &s3.HeadObjectInput{
+		Bucket: aws_sdkv2.String(bucket),
+		Key:    aws_sdkv2.String(key),
+	}
+
+	if versionID != "" {
+		input.VersionId = aws_sdkv2.String(versionID)
+	}
+
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	_, err = conn.HeadObject(ctx, input)
+
+	if tfawserr_sdkv2.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		if versionID != "" {
+			return fmt.Errorf("startup script (s3://%s/%s) version %s not found", bucket, key, versionID)
+		}
+
+		return fmt.Errorf("startup script (s3://%s/%s) not found", bucket, key)
+	}
+
+	if err != nil {
+		return fmt.Errorf("checking startup script (s3://%s/%s): %w", bucket, key, err)
+	}
+
+	return nil
+}
+
 func environmentModuleLoggingConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -627,10 +772,13 @@ func FindEnvironmentByName(ctx context.Context, conn *mwaa.MWAA, name string) (*
 }
 
 func statusEnvironment(ctx context.Context, conn *mwaa.MWAA, name string) retry.StateRefreshFunc {
+	start := time.Now()
+
 	return func() (interface{}, string, error) {
 		environment, err := FindEnvironmentByName(ctx, conn, name)
 
 		if tfresource.NotFound(err) {
+			log.Printf("[DEBUG] MWAA Environment (%s) not found after %s", name, time.Since(start).Round(time.Second))
 			return nil, "", nil
 		}
 
@@ -638,16 +786,20 @@ func statusEnvironment(ctx context.Context, conn *mwaa.MWAA, name string) retry.
 			return nil, "", err
 		}
 
-		return environment, aws.StringValue(environment.Status), nil
+		status := aws.StringValue(environment.Status)
+		log.Printf("[DEBUG] MWAA Environment (%s) status %s after %s", name, status, time.Since(start).Round(time.Second))
+
+		return environment, status, nil
 	}
 }
 
 func waitEnvironmentCreated(ctx context.Context, conn *mwaa.MWAA, name string, timeout time.Duration) (*mwaa.Environment, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{mwaa.EnvironmentStatusCreating},
-		Target:  []string{mwaa.EnvironmentStatusAvailable},
-		Refresh: statusEnvironment(ctx, conn, name),
-		Timeout: timeout,
+		Pending:      []string{mwaa.EnvironmentStatusCreating},
+		Target:       []string{mwaa.EnvironmentStatusAvailable},
+		Refresh:      statusEnvironment(ctx, conn, name),
+		Timeout:      timeout,
+		PollInterval: environmentPollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -665,10 +817,11 @@ func waitEnvironmentCreated(ctx context.Context, conn *mwaa.MWAA, name string, t
 
 func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, timeout time.Duration) (*mwaa.Environment, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{mwaa.EnvironmentStatusUpdating, mwaa.EnvironmentStatusCreatingSnapshot},
-		Target:  []string{mwaa.EnvironmentStatusAvailable},
-		Refresh: statusEnvironment(ctx, conn, name),
-		Timeout: timeout,
+		Pending:      []string{mwaa.EnvironmentStatusUpdating, mwaa.EnvironmentStatusCreatingSnapshot, mwaa.EnvironmentStatusRollingBack},
+		Target:       []string{mwaa.EnvironmentStatusAvailable},
+		Refresh:      statusEnvironment(ctx, conn, name),
+		Timeout:      timeout,
+		PollInterval: environmentPollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -678,6 +831,15 @@ func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, t
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.LastUpdate.Error.ErrorCode), aws.StringValue(v.LastUpdate.Error.ErrorMessage)))
 		}
 
+		// An update that fails is rolled back and the environment returns to AVAILABLE.
+		if err == nil && v.LastUpdate != nil && aws.StringValue(v.LastUpdate.Status) == lastUpdateStatusFailed {
+			err = errors.New("update failed and was rolled back")
+
+			if v.LastUpdate.Error != nil {
+				err = fmt.Errorf("update failed and was rolled back: %s: %s", aws.StringValue(v.LastUpdate.Error.ErrorCode), aws.StringValue(v.LastUpdate.Error.ErrorMessage))
+			}
+		}
+
 		return v, err
 	}
 
@@ -686,10 +848,11 @@ func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, t
 
 func waitEnvironmentDeleted(ctx context.Context, conn *mwaa.MWAA, name string, timeout time.Duration) (*mwaa.Environment, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{mwaa.EnvironmentStatusDeleting},
-		Target:  []string{},
-		Refresh: statusEnvironment(ctx, conn, name),
-		Timeout: timeout,
+		Pending:      []string{mwaa.EnvironmentStatusDeleting},
+		Target:       []string{},
+		Refresh:      statusEnvironment(ctx, conn, name),
+		Timeout:      timeout,
+		PollInterval: environmentPollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -705,6 +868,46 @@ func waitEnvironmentDeleted(ctx context.Context, conn *mwaa.MWAA, name string, t
 	return nil, err
 }
 
+// The worker replacement strategy is not modeled by AWS SDK for Go v1, so it is added to
+// UpdateEnvironment request bodies by a request option.
+func withWorkerReplacementStrategy(strategy string) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Build.PushBack(func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			b, err := io.ReadAll(r.GetBody())
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			body := map[string]json.RawMessage{}
+
+			if len(b) > 0 {
+				if err := json.Unmarshal(b, &body); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			if body["WorkerReplacementStrategy"], err = json.Marshal(strategy); err != nil {
+				r.Error = err
+				return
+			}
+
+			if b, err = json.Marshal(body); err != nil {
+				r.Error = err
+				return
+			}
+
+			r.SetBufferBody(b)
+		})
+	}
+}
+
 func expandEnvironmentLoggingConfiguration(l []interface{}) *mwaa.LoggingConfigurationInput {
 	if len(l) == 0 || l[0] == nil {
 		return nil
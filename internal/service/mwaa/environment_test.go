@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mwaa"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -286,7 +287,9 @@ func TestAccMWAAEnvironment_full(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "logging_configuration.0.worker_logs.0.cloud_watch_log_group_arn"),
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.log_level", "WARNING"),
+					resource.TestCheckResourceAttr(resourceName, "max_webservers", "3"),
 					resource.TestCheckResourceAttr(resourceName, "max_workers", "20"),
+					resource.TestCheckResourceAttr(resourceName, "min_webservers", "2"),
 					resource.TestCheckResourceAttr(resourceName, "min_workers", "15"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "1"),
@@ -357,6 +360,48 @@ func TestAccMWAAEnvironment_pluginsS3ObjectVersion(t *testing.T) {
 	})
 }
 
+func TestAccMWAAEnvironment_startupScriptS3ObjectVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var environment1, environment2 mwaa.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mwaa_environment.test"
+	s3ObjectResourceName := "aws_s3_object.startup_script"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mwaa.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_startupScriptS3ObjectVersion(rName, "echo test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment1),
+					resource.TestCheckResourceAttr(resourceName, "startup_script_s3_path", "startup.sh"),
+					resource.TestCheckResourceAttrPair(resourceName, "startup_script_s3_object_version", s3ObjectResourceName, "version_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnvironmentConfig_startupScriptS3ObjectVersion(rName, "echo test-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment2),
+					testAccCheckEnvironmentNotRecreated(&environment2, &environment1),
+					resource.TestCheckResourceAttrPair(resourceName, "startup_script_s3_object_version", s3ObjectResourceName, "version_id"),
+				),
+			},
+			{
+				Config:      testAccEnvironmentConfig_startupScriptS3ObjectVersionMissing(rName),
+				ExpectError: regexache.MustCompile(`startup script \(s3://.+/missing.sh\) not found`),
+			},
+		},
+	})
+}
+
 func TestAccMWAAEnvironment_updateAirflowVersionMinor(t *testing.T) {
 	ctx := acctest.Context(t)
 	var environment1, environment2 mwaa.Environment
@@ -393,6 +438,47 @@ func TestAccMWAAEnvironment_updateAirflowVersionMinor(t *testing.T) {
 	})
 }
 
+func TestAccMWAAEnvironment_webservers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var environment1, environment2 mwaa.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mwaa.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_webservers(rName, 2, 3, "GRACEFUL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment1),
+					resource.TestCheckResourceAttr(resourceName, "max_webservers", "3"),
+					resource.TestCheckResourceAttr(resourceName, "min_webservers", "2"),
+					resource.TestCheckResourceAttr(resourceName, "worker_replacement_strategy", "GRACEFUL"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"worker_replacement_strategy"},
+			},
+			{
+				Config: testAccEnvironmentConfig_webservers(rName, 3, 5, "FORCED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment2),
+					testAccCheckEnvironmentNotRecreated(&environment2, &environment1),
+					resource.TestCheckResourceAttr(resourceName, "max_webservers", "5"),
+					resource.TestCheckResourceAttr(resourceName, "min_webservers", "3"),
+					resource.TestCheckResourceAttr(resourceName, "worker_replacement_strategy", "FORCED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEnvironmentExists(ctx context.Context, n string, v *mwaa.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -779,9 +865,11 @@ resource "aws_mwaa_environment" "test" {
     }
   }
 
-  max_workers = 20
-  min_workers = 15
-  name        = %[1]q
+  max_webservers = 3
+  max_workers    = 20
+  min_webservers = 2
+  min_workers    = 15
+  name           = %[1]q
 
   network_configuration {
     security_group_ids = [aws_security_group.test.id]
@@ -887,6 +975,65 @@ resource "aws_s3_object" "plugins" {
 `, rName, content))
 }
 
+func testAccEnvironmentConfig_startupScriptS3ObjectVersion(rName, content string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  startup_script_s3_path           = aws_s3_object.startup_script.key
+  startup_script_s3_object_version = aws_s3_object.startup_script.version_id
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+
+resource "aws_s3_object" "startup_script" {
+  # Must have bucket versioning enabled first
+  depends_on = [aws_s3_bucket_versioning.test]
+
+  bucket  = aws_s3_bucket.test.id
+  acl     = "private"
+  key     = "startup.sh"
+  content = %[2]q
+}
+`, rName, content))
+}
+
+func testAccEnvironmentConfig_startupScriptS3ObjectVersionMissing(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  startup_script_s3_path = "missing.sh"
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+
+resource "aws_s3_object" "startup_script" {
+  # Must have bucket versioning enabled first
+  depends_on = [aws_s3_bucket_versioning.test]
+
+  bucket  = aws_s3_bucket.test.id
+  acl     = "private"
+  key     = "startup.sh"
+  content = "echo test-updated"
+}
+`, rName))
+}
+
 func testAccEnvironmentConfig_airflowVersion(rName, airflowVersion string) string {
 	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
 resource "aws_mwaa_environment" "test" {
@@ -905,3 +1052,25 @@ resource "aws_mwaa_environment" "test" {
 }
 `, rName, airflowVersion))
 }
+
+func testAccEnvironmentConfig_webservers(rName string, minWebservers, maxWebservers int, workerReplacementStrategy string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_object.dags.key
+  environment_class  = "mw1.medium"
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+
+  min_webservers              = %[2]d
+  max_webservers              = %[3]d
+  worker_replacement_strategy = %[4]q
+}
+`, rName, minWebservers, maxWebservers, workerReplacementStrategy))
+}
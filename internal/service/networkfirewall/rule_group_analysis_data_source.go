@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_networkfirewall_rule_group_analysis")
+func DataSourceRuleGroupAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuleGroupAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			"analysis_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analysis_detail": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identified_rule_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"identified_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"identified_types": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceRuleGroupAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	arn := d.Get("arn").(string)
+	output, err := findRuleGroupAnalysisResultsByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Rule Group (%s) analysis results: %s", arn, err)
+	}
+
+	d.SetId(arn)
+	if err := d.Set("analysis_results", flattenAnalysisResults(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting analysis_results: %s", err)
+	}
+	identifiedTypes := make([]string, 0, len(output))
+	for _, v := range output {
+		identifiedTypes = append(identifiedTypes, aws.StringValue(v.IdentifiedType))
+	}
+	d.Set("identified_types", identifiedTypes)
+
+	return diags
+}
+
+// findRuleGroupAnalysisResultsByARN runs the stateless rule analyzer against the specified rule group.
+// Rules that forward traffic asymmetrically or match on TCP flags in only one direction are reported.
+func findRuleGroupAnalysisResultsByARN(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) ([]*networkfirewall.AnalysisResult, error) {
+	input := &networkfirewall.DescribeRuleGroupInput{
+		AnalyzeRuleGroup: aws.Bool(true),
+		RuleGroupArn:     aws.String(arn),
+	}
+
+	output, err := conn.DescribeRuleGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RuleGroupResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RuleGroupResponse.AnalysisResults, nil
+}
+
+func flattenAnalysisResults(apiObjects []*networkfirewall.AnalysisResult) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"analysis_detail":     aws.StringValue(apiObject.AnalysisDetail),
+			"identified_rule_ids": aws.StringValueSlice(apiObject.IdentifiedRuleIds),
+			"identified_type":     aws.StringValue(apiObject.IdentifiedType),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkFirewallRuleGroupAnalysisDataSource_asymmetricForwarding(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_networkfirewall_rule_group_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupAnalysisDataSourceConfig_asymmetricForwarding(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", "aws_networkfirewall_rule_group.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "analysis_results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "analysis_results.0.identified_type", "STATELESS_RULE_FORWARDING_ASYMMETRICALLY"),
+					resource.TestCheckResourceAttr(dataSourceName, "analysis_results.0.identified_rule_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "identified_types.*", "STATELESS_RULE_FORWARDING_ASYMMETRICALLY"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuleGroupAnalysisDataSourceConfig_asymmetricForwarding(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:forward_to_sfe"]
+
+            match_attributes {
+              protocols = [6]
+
+              destination {
+                address_definition = "10.0.0.0/16"
+              }
+
+              source {
+                address_definition = "0.0.0.0/0"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+data "aws_networkfirewall_rule_group_analysis" "test" {
+  arn = aws_networkfirewall_rule_group.test.arn
+}
+`, rName)
+}
@@ -37,6 +37,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceFirewallResourcePolicy,
 			TypeName: "aws_networkfirewall_resource_policy",
 		},
+		{
+			Factory:  DataSourceRuleGroupAnalysis,
+			TypeName: "aws_networkfirewall_rule_group_analysis",
+		},
 	}
 }
 
@@ -74,6 +78,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceTLSInspectionConfiguration,
+			TypeName: "aws_networkfirewall_tls_inspection_configuration",
+			Name:     "TLS Inspection Configuration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 	}
 }
 
@@ -0,0 +1,656 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_networkfirewall_tls_inspection_configuration", name="TLS Inspection Configuration")
+// @Tags(identifierAttribute="id")
+func ResourceTLSInspectionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTLSInspectionConfigurationCreate,
+		ReadWithoutTimeout:   resourceTLSInspectionConfigurationRead,
+		UpdateWithoutTimeout: resourceTLSInspectionConfigurationUpdate,
+		DeleteWithoutTimeout: resourceTLSInspectionConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     tlsCertificateDataSchema(),
+			},
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     tlsCertificateDataSchema(),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"encryption_configuration": encryptionConfigurationSchema(),
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z-]+$`), "must contain only alphanumeric characters and hyphens"),
+				),
+			},
+			"number_of_associations": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tls_inspection_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_certificate_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"certificate_authority_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"check_certificate_revocation_status": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"revoked_status_action": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(networkfirewall.RevocationCheckAction_Values(), false),
+												},
+												"unknown_status_action": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(networkfirewall.RevocationCheckAction_Values(), false),
+												},
+											},
+										},
+									},
+									"scope": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination":       addressSchema(),
+												"destination_ports": portRangeSchema(),
+												"protocols": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeInt,
+														ValidateFunc: validation.IntBetween(0, 255),
+													},
+												},
+												"source":       addressSchema(),
+												"source_ports": portRangeSchema(),
+											},
+										},
+									},
+									"server_certificate": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"resource_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tls_inspection_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTLSInspectionConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &networkfirewall.CreateTLSInspectionConfigurationInput{
+		Tags:                           getTagsIn(ctx),
+		TLSInspectionConfiguration:     expandTLSInspectionConfiguration(d.Get("tls_inspection_configuration").([]interface{})),
+		TLSInspectionConfigurationName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok {
+		input.EncryptionConfiguration = expandEncryptionConfiguration(v.([]interface{}))
+	}
+
+	output, err := conn.CreateTLSInspectionConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating NetworkFirewall TLS Inspection Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TLSInspectionConfigurationResponse.TLSInspectionConfigurationArn))
+
+	if _, err := waitTLSInspectionConfigurationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall TLS Inspection Configuration (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTLSInspectionConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceTLSInspectionConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	output, err := FindTLSInspectionConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] NetworkFirewall TLS Inspection Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall TLS Inspection Configuration (%s): %s", d.Id(), err)
+	}
+
+	response := output.TLSInspectionConfigurationResponse
+	d.Set("arn", response.TLSInspectionConfigurationArn)
+	if err := d.Set("certificate_authority", flattenTLSCertificateData(response.CertificateAuthority)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting certificate_authority: %s", err)
+	}
+	if err := d.Set("certificates", flattenTLSCertificateDatas(response.Certificates)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting certificates: %s", err)
+	}
+	d.Set("description", response.Description)
+	d.Set("encryption_configuration", flattenEncryptionConfiguration(response.EncryptionConfiguration))
+	d.Set("name", response.TLSInspectionConfigurationName)
+	d.Set("number_of_associations", response.NumberOfAssociations)
+	if err := d.Set("tls_inspection_configuration", flattenTLSInspectionConfiguration(output.TLSInspectionConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tls_inspection_configuration: %s", err)
+	}
+	d.Set("tls_inspection_configuration_id", response.TLSInspectionConfigurationId)
+	d.Set("update_token", output.UpdateToken)
+
+	setTagsOut(ctx, response.Tags)
+
+	return diags
+}
+
+func resourceTLSInspectionConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	if d.HasChanges("description", "encryption_configuration", "tls_inspection_configuration") {
+		input := &networkfirewall.UpdateTLSInspectionConfigurationInput{
+			EncryptionConfiguration:       expandEncryptionConfiguration(d.Get("encryption_configuration").([]interface{})),
+			TLSInspectionConfiguration:    expandTLSInspectionConfiguration(d.Get("tls_inspection_configuration").([]interface{})),
+			TLSInspectionConfigurationArn: aws.String(d.Id()),
+			UpdateToken:                   aws.String(d.Get("update_token").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateTLSInspectionConfigurationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall TLS Inspection Configuration (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitTLSInspectionConfigurationUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall TLS Inspection Configuration (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTLSInspectionConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceTLSInspectionConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	log.Printf("[DEBUG] Deleting NetworkFirewall TLS Inspection Configuration: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteTLSInspectionConfigurationWithContext(ctx, &networkfirewall.DeleteTLSInspectionConfigurationInput{
+			TLSInspectionConfigurationArn: aws.String(d.Id()),
+		})
+	}, networkfirewall.ErrCodeInvalidOperationException, "Unable to delete the object because it is still in use")
+
+	if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting NetworkFirewall TLS Inspection Configuration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTLSInspectionConfigurationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall TLS Inspection Configuration (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindTLSInspectionConfigurationByARN(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+	input := &networkfirewall.DescribeTLSInspectionConfigurationInput{
+		TLSInspectionConfigurationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeTLSInspectionConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TLSInspectionConfigurationResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusTLSInspectionConfiguration(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTLSInspectionConfigurationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.TLSInspectionConfigurationResponse.TLSInspectionConfigurationStatus), nil
+	}
+}
+
+func waitTLSInspectionConfigurationCreated(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string, timeout time.Duration) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{networkfirewall.ResourceStatusActive},
+		Refresh: statusTLSInspectionConfiguration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkfirewall.DescribeTLSInspectionConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTLSInspectionConfigurationUpdated(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string, timeout time.Duration) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{networkfirewall.ResourceStatusActive},
+		Refresh: statusTLSInspectionConfiguration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkfirewall.DescribeTLSInspectionConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTLSInspectionConfigurationDeleted(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string, timeout time.Duration) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{networkfirewall.ResourceStatusDeleting},
+		Target:  []string{},
+		Refresh: statusTLSInspectionConfiguration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkfirewall.DescribeTLSInspectionConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func tlsCertificateDataSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"certificate_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_serial": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func addressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address_definition": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+		},
+	}
+}
+
+func portRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 65535),
+				},
+				"to_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 65535),
+				},
+			},
+		},
+	}
+}
+
+func expandTLSInspectionConfiguration(tfList []interface{}) *networkfirewall.TLSInspectionConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &networkfirewall.TLSInspectionConfiguration{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &networkfirewall.TLSInspectionConfiguration{}
+
+	if v, ok := tfMap["server_certificate_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ServerCertificateConfigurations = expandServerCertificateConfigurations(v)
+	}
+
+	return apiObject
+}
+
+func expandServerCertificateConfigurations(tfList []interface{}) []*networkfirewall.ServerCertificateConfiguration {
+	apiObjects := make([]*networkfirewall.ServerCertificateConfiguration, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &networkfirewall.ServerCertificateConfiguration{}
+
+		if v, ok := tfMap["certificate_authority_arn"].(string); ok && v != "" {
+			apiObject.CertificateAuthorityArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["check_certificate_revocation_status"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			actions := &networkfirewall.CheckCertificateRevocationStatusActions{}
+
+			if v, ok := tfMap["revoked_status_action"].(string); ok && v != "" {
+				actions.RevokedStatusAction = aws.String(v)
+			}
+			if v, ok := tfMap["unknown_status_action"].(string); ok && v != "" {
+				actions.UnknownStatusAction = aws.String(v)
+			}
+
+			apiObject.CheckCertificateRevocationStatus = actions
+		}
+
+		if v, ok := tfMap["scope"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Scopes = expandServerCertificateScopes(v)
+		}
+
+		if v, ok := tfMap["server_certificate"].([]interface{}); ok && len(v) > 0 {
+			apiObject.ServerCertificates = expandServerCertificates(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandServerCertificateScopes(tfList []interface{}) []*networkfirewall.ServerCertificateScope {
+	apiObjects := make([]*networkfirewall.ServerCertificateScope, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &networkfirewall.ServerCertificateScope{}
+
+		if v, ok := tfMap["destination"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Destinations = expandAddresses(v.List())
+		}
+		if v, ok := tfMap["destination_ports"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.DestinationPorts = expandPortRanges(v.List())
+		}
+		if v, ok := tfMap["protocols"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Protocols = flex.ExpandInt64Set(v)
+		}
+		if v, ok := tfMap["source"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Sources = expandAddresses(v.List())
+		}
+		if v, ok := tfMap["source_ports"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.SourcePorts = expandPortRanges(v.List())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandServerCertificates(tfList []interface{}) []*networkfirewall.ServerCertificate {
+	apiObjects := make([]*networkfirewall.ServerCertificate, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &networkfirewall.ServerCertificate{}
+
+		if v, ok := tfMap["resource_arn"].(string); ok && v != "" {
+			apiObject.ResourceArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTLSInspectionConfiguration(apiObject *networkfirewall.TLSInspectionConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"server_certificate_configuration": flattenServerCertificateConfigurations(apiObject.ServerCertificateConfigurations),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServerCertificateConfigurations(apiObjects []*networkfirewall.ServerCertificateConfiguration) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"certificate_authority_arn": aws.StringValue(apiObject.CertificateAuthorityArn),
+			"scope":                     flattenServerCertificateScopes(apiObject.Scopes),
+			"server_certificate":        flattenServerCertificates(apiObject.ServerCertificates),
+		}
+
+		if v := apiObject.CheckCertificateRevocationStatus; v != nil {
+			tfMap["check_certificate_revocation_status"] = []interface{}{
+				map[string]interface{}{
+					"revoked_status_action": aws.StringValue(v.RevokedStatusAction),
+					"unknown_status_action": aws.StringValue(v.UnknownStatusAction),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenServerCertificateScopes(apiObjects []*networkfirewall.ServerCertificateScope) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"destination":       flattenAddresses(apiObject.Destinations),
+			"destination_ports": flattenPortRanges(apiObject.DestinationPorts),
+			"protocols":         flex.FlattenInt64Set(apiObject.Protocols),
+			"source":            flattenAddresses(apiObject.Sources),
+			"source_ports":      flattenPortRanges(apiObject.SourcePorts),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenServerCertificates(apiObjects []*networkfirewall.ServerCertificate) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"resource_arn": aws.StringValue(apiObject.ResourceArn),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTLSCertificateData(apiObject *networkfirewall.TlsCertificateData) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return flattenTLSCertificateDatas([]*networkfirewall.TlsCertificateData{apiObject})
+}
+
+func flattenTLSCertificateDatas(apiObjects []*networkfirewall.TlsCertificateData) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"certificate_arn":    aws.StringValue(apiObject.CertificateArn),
+			"certificate_serial": aws.StringValue(apiObject.CertificateSerial),
+			"status":             aws.StringValue(apiObject.Status),
+			"status_message":     aws.StringValue(apiObject.StatusMessage),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmanager
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_networkmanager_inventory_document")
+func DataSourceInventoryDocument() *schema.Resource {
+	locationSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"latitude": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"longitude": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+	tagsSchema := &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInventoryDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"devices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": locationSchema,
+						"model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serial_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"site_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchema,
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vendor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(inventoryFormat_Values(), false),
+			},
+			"links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bandwidth": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"download_speed": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"upload_speed": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"site_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchema,
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"sites": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": locationSchema,
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchema,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInventoryDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	content := d.Get("content").(string)
+	format := d.Get("format").(string)
+
+	if format == "" {
+		format = detectInventoryFormat(content)
+	}
+
+	doc, err := parseInventoryDoc(content, format)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Network Manager Inventory Document (%s): %s", format, err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(content)))
+	if err := d.Set("devices", flattenInventoryDevices(doc.Devices)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting devices: %s", err)
+	}
+	d.Set("format", format)
+	if err := d.Set("links", flattenInventoryLinks(doc.Links)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting links: %s", err)
+	}
+	if err := d.Set("sites", flattenInventorySites(doc.Sites)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sites: %s", err)
+	}
+
+	return diags
+}
+
+func flattenInventoryLocation(apiObject *InventoryLocation) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"address":   apiObject.Address,
+		"latitude":  apiObject.Latitude,
+		"longitude": apiObject.Longitude,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInventorySites(apiObjects []*InventorySite) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"description": apiObject.Description,
+			"location":    flattenInventoryLocation(apiObject.Location),
+			"name":        apiObject.Name,
+			"tags":        apiObject.Tags,
+		})
+	}
+
+	return tfList
+}
+
+func flattenInventoryDevices(apiObjects []*InventoryDevice) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"description":   apiObject.Description,
+			"location":      flattenInventoryLocation(apiObject.Location),
+			"model":         apiObject.Model,
+			"name":          apiObject.Name,
+			"serial_number": apiObject.SerialNumber,
+			"site_name":     apiObject.SiteName,
+			"tags":          apiObject.Tags,
+			"type":          apiObject.Type,
+			"vendor":        apiObject.Vendor,
+		})
+	}
+
+	return tfList
+}
+
+func flattenInventoryLinks(apiObjects []*InventoryLink) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"description":   apiObject.Description,
+			"name":          apiObject.Name,
+			"provider_name": apiObject.ProviderName,
+			"site_name":     apiObject.SiteName,
+			"tags":          apiObject.Tags,
+			"type":          apiObject.Type,
+		}
+
+		if v := apiObject.Bandwidth; v != nil {
+			tfMap["bandwidth"] = []interface{}{map[string]interface{}{
+				"download_speed": v.DownloadSpeed,
+				"upload_speed":   v.UploadSpeed,
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmanager_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerInventoryDocumentDataSource_csv(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_networkmanager_inventory_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInventoryDocumentDataSourceConfig_csv,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "format", "csv"),
+					resource.TestCheckResourceAttr(dataSourceName, "sites.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "sites.0.name", "hq"),
+					resource.TestCheckResourceAttr(dataSourceName, "sites.0.location.0.address", "1 Main St"),
+					resource.TestCheckResourceAttr(dataSourceName, "sites.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "sites.0.tags.env", "prod"),
+					resource.TestCheckResourceAttr(dataSourceName, "devices.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "devices.0.name", "hq-router-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "devices.0.site_name", "hq"),
+					resource.TestCheckResourceAttr(dataSourceName, "devices.0.vendor", "Cisco"),
+					resource.TestCheckResourceAttr(dataSourceName, "links.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "links.0.bandwidth.0.download_speed", "100"),
+					resource.TestCheckResourceAttr(dataSourceName, "links.0.bandwidth.0.upload_speed", "50"),
+					resource.TestCheckResourceAttr(dataSourceName, "links.0.provider_name", "Example ISP"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerInventoryDocumentDataSource_json(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_networkmanager_inventory_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInventoryDocumentDataSourceConfig_json,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "format", "json"),
+					resource.TestCheckResourceAttr(dataSourceName, "sites.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "sites.0.location.0.latitude", "47.6"),
+					resource.TestCheckResourceAttr(dataSourceName, "devices.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "devices.0.model", "ISR4451"),
+					resource.TestCheckResourceAttr(dataSourceName, "links.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerInventoryDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInventoryDocumentDataSourceConfig_invalid,
+				ExpectError: regexache.MustCompile(`line 3: device "r1" references unknown site "branch"`),
+			},
+		},
+	})
+}
+
+const testAccInventoryDocumentDataSourceConfig_csv = `
+data "aws_networkmanager_inventory_document" "test" {
+  content = <<EOT
+record_type,name,site_name,address,vendor,provider_name,download_speed,upload_speed,tags
+site,hq,,1 Main St,,,,,env=prod;team=net
+site,branch,,,,,,,
+device,hq-router-1,hq,,Cisco,,,,
+link,hq-isp-1,hq,,,Example ISP,100,50,
+EOT
+}
+`
+
+const testAccInventoryDocumentDataSourceConfig_json = `
+data "aws_networkmanager_inventory_document" "test" {
+  content = jsonencode({
+    sites = [{
+      name = "hq"
+      location = {
+        latitude  = "47.6"
+        longitude = "-122.3"
+      }
+    }]
+    devices = [{
+      name      = "hq-router-1"
+      site_name = "hq"
+      model     = "ISR4451"
+    }]
+  })
+}
+`
+
+const testAccInventoryDocumentDataSourceConfig_invalid = `
+data "aws_networkmanager_inventory_document" "test" {
+  format  = "csv"
+  content = <<EOT
+record_type,name,site_name
+site,hq,
+device,r1,branch
+EOT
+}
+`
@@ -0,0 +1,473 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmanager
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	inventoryFormatCSV  = "csv"
+	inventoryFormatJSON = "json"
+)
+
+func inventoryFormat_Values() []string {
+	return []string{
+		inventoryFormatCSV,
+		inventoryFormatJSON,
+	}
+}
+
+const (
+	inventoryRecordTypeDevice = "device"
+	inventoryRecordTypeLink   = "link"
+	inventoryRecordTypeSite   = "site"
+)
+
+// InventoryDoc is a WAN inventory of sites, devices and links.
+type InventoryDoc struct {
+	Devices []*InventoryDevice `json:"devices,omitempty"`
+	Links   []*InventoryLink   `json:"links,omitempty"`
+	Sites   []*InventorySite   `json:"sites,omitempty"`
+}
+
+type InventoryLocation struct {
+	Address   string `json:"address,omitempty"`
+	Latitude  string `json:"latitude,omitempty"`
+	Longitude string `json:"longitude,omitempty"`
+}
+
+type InventoryBandwidth struct {
+	DownloadSpeed int `json:"download_speed,omitempty"`
+	UploadSpeed   int `json:"upload_speed,omitempty"`
+}
+
+type InventorySite struct {
+	Description string             `json:"description,omitempty"`
+	Location    *InventoryLocation `json:"location,omitempty"`
+	Name        string             `json:"name"`
+	Tags        map[string]string  `json:"tags,omitempty"`
+}
+
+type InventoryDevice struct {
+	Description  string             `json:"description,omitempty"`
+	Location     *InventoryLocation `json:"location,omitempty"`
+	Model        string             `json:"model,omitempty"`
+	Name         string             `json:"name"`
+	SerialNumber string             `json:"serial_number,omitempty"`
+	SiteName     string             `json:"site_name,omitempty"`
+	Tags         map[string]string  `json:"tags,omitempty"`
+	Type         string             `json:"type,omitempty"`
+	Vendor       string             `json:"vendor,omitempty"`
+}
+
+type InventoryLink struct {
+	Bandwidth    *InventoryBandwidth `json:"bandwidth,omitempty"`
+	Description  string              `json:"description,omitempty"`
+	Name         string              `json:"name"`
+	ProviderName string              `json:"provider_name,omitempty"`
+	SiteName     string              `json:"site_name"`
+	Tags         map[string]string   `json:"tags,omitempty"`
+	Type         string              `json:"type,omitempty"`
+}
+
+// detectInventoryFormat returns the format of an inventory document based on its first non-whitespace character.
+func detectInventoryFormat(content string) string {
+	if v := strings.TrimSpace(content); strings.HasPrefix(v, "{") {
+		return inventoryFormatJSON
+	}
+
+	return inventoryFormatCSV
+}
+
+// parseInventoryDoc parses and validates an inventory document in the specified format.
+// All validation errors are returned, each prefixed with the location of the offending record.
+func parseInventoryDoc(content, format string) (*InventoryDoc, error) {
+	var doc *InventoryDoc
+	var locations inventoryRecordLocations
+	var err error
+
+	switch format {
+	case inventoryFormatCSV:
+		doc, locations, err = parseInventoryDocCSV(content)
+	case inventoryFormatJSON:
+		doc, locations, err = parseInventoryDocJSON(content)
+	default:
+		return nil, fmt.Errorf("unsupported inventory format (%s)", format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := doc.validate(locations); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// inventoryRecordLocations describes where each record was read from, for error messages.
+type inventoryRecordLocations struct {
+	devices, links, sites []string
+}
+
+func parseInventoryDocJSON(content string) (*InventoryDoc, inventoryRecordLocations, error) {
+	var locations inventoryRecordLocations
+	doc := &InventoryDoc{}
+
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(doc); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return nil, locations, fmt.Errorf("invalid JSON at offset %d: %w", syntaxErr.Offset, err)
+		}
+
+		return nil, locations, fmt.Errorf("invalid JSON inventory: %w", err)
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, locations, errors.New("invalid JSON inventory: unexpected data after top-level object")
+	}
+
+	for i := range doc.Devices {
+		locations.devices = append(locations.devices, fmt.Sprintf("devices[%d]", i))
+	}
+	for i := range doc.Links {
+		locations.links = append(locations.links, fmt.Sprintf("links[%d]", i))
+	}
+	for i := range doc.Sites {
+		locations.sites = append(locations.sites, fmt.Sprintf("sites[%d]", i))
+	}
+
+	return doc, locations, nil
+}
+
+var inventoryCSVColumns = []string{
+	"record_type",
+	"name",
+	"site_name",
+	"description",
+	"address",
+	"latitude",
+	"longitude",
+	"model",
+	"serial_number",
+	"type",
+	"vendor",
+	"provider_name",
+	"download_speed",
+	"upload_speed",
+	"tags",
+}
+
+func parseInventoryDocCSV(content string) (*InventoryDoc, inventoryRecordLocations, error) {
+	var locations inventoryRecordLocations
+	doc := &InventoryDoc{}
+
+	reader := csv.NewReader(bytes.NewReader([]byte(content)))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+
+	if err == io.EOF {
+		return nil, locations, errors.New("CSV inventory is empty; the first row must be a header")
+	}
+
+	if err != nil {
+		return nil, locations, fmt.Errorf("reading CSV inventory header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+
+	for i, v := range header {
+		v = strings.ToLower(strings.TrimSpace(v))
+
+		if !inventoryCSVColumnValid(v) {
+			return nil, locations, fmt.Errorf("CSV inventory header: unknown column %q; valid columns are %s", v, strings.Join(inventoryCSVColumns, ", "))
+		}
+
+		if _, ok := columns[v]; ok {
+			return nil, locations, fmt.Errorf("CSV inventory header: duplicate column %q", v)
+		}
+
+		columns[v] = i
+	}
+
+	for _, v := range []string{"record_type", "name"} {
+		if _, ok := columns[v]; !ok {
+			return nil, locations, fmt.Errorf("CSV inventory header: missing required column %q", v)
+		}
+	}
+
+	var errs []error
+
+	for {
+		record, err := reader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, locations, fmt.Errorf("reading CSV inventory: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		where := fmt.Sprintf("line %d", line)
+
+		if len(record) > len(header) {
+			errs = append(errs, fmt.Errorf("%s: %d fields, but header has %d columns", where, len(record), len(header)))
+			continue
+		}
+
+		get := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+
+			return ""
+		}
+
+		tags, err := parseInventoryCSVTags(get("tags"))
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", where, err))
+			continue
+		}
+
+		var location *InventoryLocation
+		if address, latitude, longitude := get("address"), get("latitude"), get("longitude"); address != "" || latitude != "" || longitude != "" {
+			location = &InventoryLocation{
+				Address:   address,
+				Latitude:  latitude,
+				Longitude: longitude,
+			}
+		}
+
+		switch recordType := strings.ToLower(get("record_type")); recordType {
+		case inventoryRecordTypeDevice:
+			doc.Devices = append(doc.Devices, &InventoryDevice{
+				Description:  get("description"),
+				Location:     location,
+				Model:        get("model"),
+				Name:         get("name"),
+				SerialNumber: get("serial_number"),
+				SiteName:     get("site_name"),
+				Tags:         tags,
+				Type:         get("type"),
+				Vendor:       get("vendor"),
+			})
+			locations.devices = append(locations.devices, where)
+		case inventoryRecordTypeLink:
+			link := &InventoryLink{
+				Description:  get("description"),
+				Name:         get("name"),
+				ProviderName: get("provider_name"),
+				SiteName:     get("site_name"),
+				Tags:         tags,
+				Type:         get("type"),
+			}
+
+			if download, upload := get("download_speed"), get("upload_speed"); download != "" || upload != "" {
+				link.Bandwidth = &InventoryBandwidth{}
+
+				if link.Bandwidth.DownloadSpeed, err = parseInventoryCSVSpeed("download_speed", download); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", where, err))
+					continue
+				}
+
+				if link.Bandwidth.UploadSpeed, err = parseInventoryCSVSpeed("upload_speed", upload); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", where, err))
+					continue
+				}
+			}
+
+			doc.Links = append(doc.Links, link)
+			locations.links = append(locations.links, where)
+		case inventoryRecordTypeSite:
+			doc.Sites = append(doc.Sites, &InventorySite{
+				Description: get("description"),
+				Location:    location,
+				Name:        get("name"),
+				Tags:        tags,
+			})
+			locations.sites = append(locations.sites, where)
+		default:
+			errs = append(errs, fmt.Errorf("%s: invalid record_type %q; must be one of %s, %s or %s", where, recordType, inventoryRecordTypeSite, inventoryRecordTypeDevice, inventoryRecordTypeLink))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, locations, errors.Join(errs...)
+	}
+
+	return doc, locations, nil
+}
+
+func inventoryCSVColumnValid(column string) bool {
+	for _, v := range inventoryCSVColumns {
+		if v == column {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseInventoryCSVTags parses tags in the form "key1=value1;key2=value2".
+func parseInventoryCSVTags(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	tags := make(map[string]string)
+
+	for _, pair := range strings.Split(s, ";") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		k, v, ok := strings.Cut(pair, "=")
+		k = strings.TrimSpace(k)
+
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid tag %q; tags must be in the form key1=value1;key2=value2", pair)
+		}
+
+		tags[k] = strings.TrimSpace(v)
+	}
+
+	return tags, nil
+}
+
+func parseInventoryCSVSpeed(column, s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.Atoi(s)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s %q is not an integer", column, s)
+	}
+
+	return v, nil
+}
+
+func (doc *InventoryDoc) validate(locations inventoryRecordLocations) error {
+	var errs []error
+
+	sites := make(map[string]struct{}, len(doc.Sites))
+
+	for i, site := range doc.Sites {
+		where := locations.sites[i]
+
+		if site == nil || site.Name == "" {
+			errs = append(errs, fmt.Errorf("%s: site name is required", where))
+			continue
+		}
+
+		if _, ok := sites[site.Name]; ok {
+			errs = append(errs, fmt.Errorf("%s: duplicate site name %q", where, site.Name))
+		}
+		sites[site.Name] = struct{}{}
+
+		errs = append(errs, site.Location.validate(where)...)
+	}
+
+	devices := make(map[string]struct{}, len(doc.Devices))
+
+	for i, device := range doc.Devices {
+		where := locations.devices[i]
+
+		if device == nil || device.Name == "" {
+			errs = append(errs, fmt.Errorf("%s: device name is required", where))
+			continue
+		}
+
+		if _, ok := devices[device.Name]; ok {
+			errs = append(errs, fmt.Errorf("%s: duplicate device name %q", where, device.Name))
+		}
+		devices[device.Name] = struct{}{}
+
+		if device.SiteName != "" {
+			if _, ok := sites[device.SiteName]; !ok {
+				errs = append(errs, fmt.Errorf("%s: device %q references unknown site %q", where, device.Name, device.SiteName))
+			}
+		}
+
+		errs = append(errs, device.Location.validate(where)...)
+	}
+
+	links := make(map[string]struct{}, len(doc.Links))
+
+	for i, link := range doc.Links {
+		where := locations.links[i]
+
+		if link == nil || link.Name == "" {
+			errs = append(errs, fmt.Errorf("%s: link name is required", where))
+			continue
+		}
+
+		if _, ok := links[link.Name]; ok {
+			errs = append(errs, fmt.Errorf("%s: duplicate link name %q", where, link.Name))
+		}
+		links[link.Name] = struct{}{}
+
+		if link.SiteName == "" {
+			errs = append(errs, fmt.Errorf("%s: link %q site_name is required", where, link.Name))
+		} else if _, ok := sites[link.SiteName]; !ok {
+			errs = append(errs, fmt.Errorf("%s: link %q references unknown site %q", where, link.Name, link.SiteName))
+		}
+
+		if link.Bandwidth == nil || (link.Bandwidth.DownloadSpeed == 0 && link.Bandwidth.UploadSpeed == 0) {
+			errs = append(errs, fmt.Errorf("%s: link %q requires a download_speed or upload_speed", where, link.Name))
+		} else if link.Bandwidth.DownloadSpeed < 0 || link.Bandwidth.UploadSpeed < 0 {
+			errs = append(errs, fmt.Errorf("%s: link %q bandwidth must not be negative", where, link.Name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (location *InventoryLocation) validate(where string) []error {
+	if location == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, v := range []struct {
+		name  string
+		value string
+		limit float64
+	}{
+		{"latitude", location.Latitude, 90},
+		{"longitude", location.Longitude, 180},
+	} {
+		if v.value == "" {
+			continue
+		}
+
+		f, err := strconv.ParseFloat(v.value, 64)
+
+		if err != nil || f < -v.limit || f > v.limit {
+			errs = append(errs, fmt.Errorf("%s: %s %q must be a number between %g and %g", where, v.name, v.value, -v.limit, v.limit))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmanager
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseInventoryDoc(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content     string
+		format      string
+		wantSites   int
+		wantDevices int
+		wantLinks   int
+		wantErrs    []string
+	}{
+		"csv valid": {
+			content: `record_type,name,site_name,address,latitude,longitude,vendor,provider_name,download_speed,upload_speed,tags
+# Sites
+site,hq,,1 Main St,47.6,-122.3,,,,,env=prod;team=net
+device,hq-router-1,hq,,,,Cisco,,,,
+link,hq-isp-1,hq,,,,,Example ISP,100,50,
+`,
+			format:      inventoryFormatCSV,
+			wantSites:   1,
+			wantDevices: 1,
+			wantLinks:   1,
+		},
+		"csv unknown column": {
+			content:  "record_type,name,colour\nsite,hq,blue\n",
+			format:   inventoryFormatCSV,
+			wantErrs: []string{`unknown column "colour"`},
+		},
+		"csv missing column": {
+			content:  "name\nhq\n",
+			format:   inventoryFormatCSV,
+			wantErrs: []string{`missing required column "record_type"`},
+		},
+		"csv invalid rows": {
+			content: `record_type,name,site_name,latitude,download_speed,tags
+site,hq,,91,,
+router,r1,,,,
+device,r2,branch,,,
+link,l1,hq,,fast,
+site,dc,,,,bad-tag
+`,
+			format: inventoryFormatCSV,
+			wantErrs: []string{
+				`line 3: invalid record_type "router"`,
+				`line 5: download_speed "fast" is not an integer`,
+				`line 6: invalid tag "bad-tag"`,
+			},
+		},
+		"csv validation": {
+			content: `record_type,name,site_name,latitude,download_speed
+site,hq,,91,
+site,hq,,,
+device,r2,branch,,
+link,l1,hq,,
+`,
+			format: inventoryFormatCSV,
+			wantErrs: []string{
+				`line 2: latitude "91" must be a number between -90 and 90`,
+				`line 3: duplicate site name "hq"`,
+				`line 4: device "r2" references unknown site "branch"`,
+				`line 5: link "l1" requires a download_speed or upload_speed`,
+			},
+		},
+		"json valid": {
+			content: `{
+  "sites": [{"name": "hq", "location": {"latitude": "47.6", "longitude": "-122.3"}, "tags": {"env": "prod"}}],
+  "devices": [{"name": "hq-router-1", "site_name": "hq", "vendor": "Cisco"}],
+  "links": [{"name": "hq-isp-1", "site_name": "hq", "bandwidth": {"download_speed": 100, "upload_speed": 50}}]
+}`,
+			format:      inventoryFormatJSON,
+			wantSites:   1,
+			wantDevices: 1,
+			wantLinks:   1,
+		},
+		"json unknown field": {
+			content:  `{"sites": [{"name": "hq", "colour": "blue"}]}`,
+			format:   inventoryFormatJSON,
+			wantErrs: []string{`unknown field "colour"`},
+		},
+		"json syntax error": {
+			content:  `{"sites": [}`,
+			format:   inventoryFormatJSON,
+			wantErrs: []string{"invalid JSON at offset"},
+		},
+		"json validation": {
+			content: `{
+  "sites": [{"name": ""}],
+  "links": [{"name": "l1", "bandwidth": {"download_speed": 10}}]
+}`,
+			format: inventoryFormatJSON,
+			wantErrs: []string{
+				"sites[0]: site name is required",
+				`links[0]: link "l1" site_name is required`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := parseInventoryDoc(testCase.content, testCase.format)
+
+			if len(testCase.wantErrs) > 0 {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				for _, want := range testCase.wantErrs {
+					if !strings.Contains(err.Error(), want) {
+						t.Errorf("error %q does not contain %q", err, want)
+					}
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(doc.Sites), testCase.wantSites; got != want {
+				t.Errorf("len(Sites) = %d, want %d", got, want)
+			}
+			if got, want := len(doc.Devices), testCase.wantDevices; got != want {
+				t.Errorf("len(Devices) = %d, want %d", got, want)
+			}
+			if got, want := len(doc.Links), testCase.wantLinks; got != want {
+				t.Errorf("len(Links) = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestDetectInventoryFormat(t *testing.T) {
+	t.Parallel()
+
+	if got, want := detectInventoryFormat("  \n{\"sites\": []}"), inventoryFormatJSON; got != want {
+		t.Errorf("detectInventoryFormat = %q, want %q", got, want)
+	}
+
+	if got, want := detectInventoryFormat("record_type,name\n"), inventoryFormatCSV; got != want {
+		t.Errorf("detectInventoryFormat = %q, want %q", got, want)
+	}
+}
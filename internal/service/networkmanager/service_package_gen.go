@@ -53,6 +53,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceGlobalNetworks,
 			TypeName: "aws_networkmanager_global_networks",
 		},
+		{
+			Factory:  DataSourceInventoryDocument,
+			TypeName: "aws_networkmanager_inventory_document",
+		},
 		{
 			Factory:  DataSourceLink,
 			TypeName: "aws_networkmanager_link",
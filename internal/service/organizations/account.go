@@ -6,6 +6,7 @@ package organizations
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -37,6 +38,11 @@ func ResourceAccount() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -138,7 +144,7 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "creating AWS Organizations Account (%s): %s", d.Get("name").(string), err)
 	}
 
-	output, err := waitAccountCreated(ctx, conn, aws.StringValue(s.Id))
+	output, err := waitAccountCreated(ctx, conn, aws.StringValue(s.Id), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for AWS Organizations Account (%s) create: %s", d.Get("name").(string), err)
@@ -148,23 +154,8 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("govcloud_id", output.GovCloudAccountId)
 
 	if v, ok := d.GetOk("parent_id"); ok {
-		oldParentAccountID, err := findParentAccountID(ctx, conn, d.Id())
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading AWS Organizations Account (%s) parent: %s", d.Id(), err)
-		}
-
-		if newParentAccountID := v.(string); newParentAccountID != oldParentAccountID {
-			input := &organizations.MoveAccountInput{
-				AccountId:           aws.String(d.Id()),
-				DestinationParentId: aws.String(newParentAccountID),
-				SourceParentId:      aws.String(oldParentAccountID),
-			}
-
-			log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
-			if _, err := conn.MoveAccountWithContext(ctx, input); err != nil {
-				return sdkdiag.AppendErrorf(diags, "moving AWS Organizations Account (%s): %s", d.Id(), err)
-			}
+		if err := moveAccount(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "moving AWS Organizations Account (%s): %s", d.Id(), err)
 		}
 	}
 
@@ -209,15 +200,7 @@ func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
 
 	if d.HasChange("parent_id") {
-		o, n := d.GetChange("parent_id")
-
-		input := &organizations.MoveAccountInput{
-			AccountId:           aws.String(d.Id()),
-			SourceParentId:      aws.String(o.(string)),
-			DestinationParentId: aws.String(n.(string)),
-		}
-
-		if _, err := conn.MoveAccountWithContext(ctx, input); err != nil {
+		if err := moveAccount(ctx, conn, d.Id(), d.Get("parent_id").(string)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "moving AWS Organizations Account (%s): %s", d.Id(), err)
 		}
 	}
@@ -234,9 +217,14 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	if close {
 		log.Printf("[DEBUG] Closing AWS Organizations Account: %s", d.Id())
-		_, err = conn.CloseAccountWithContext(ctx, &organizations.CloseAccountInput{
-			AccountId: aws.String(d.Id()),
-		})
+		_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete),
+			func() (interface{}, error) {
+				return conn.CloseAccountWithContext(ctx, &organizations.CloseAccountInput{
+					AccountId: aws.String(d.Id()),
+				})
+			},
+			organizations.ErrCodeConcurrentModificationException,
+		)
 	} else {
 		log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
 		_, err = conn.RemoveAccountFromOrganizationWithContext(ctx, &organizations.RemoveAccountFromOrganizationInput{
@@ -253,7 +241,7 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if close {
-		if _, err := waitAccountDeleted(ctx, conn, d.Id()); err != nil {
+		if _, err := waitAccountDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for AWS Organizations Account (%s) delete: %s", d.Id(), err)
 		}
 	}
@@ -327,6 +315,36 @@ func createAccount(ctx context.Context, conn *organizations.Organizations, name,
 	return outputRaw.(*organizations.CreateAccountOutput).CreateAccountStatus, nil
 }
 
+// moveAccount moves the specified account to a new parent.
+// The source parent is read from the API so that out-of-band moves don't cause the request to fail.
+func moveAccount(ctx context.Context, conn *organizations.Organizations, id, parentID string) error {
+	sourceParentID, err := findParentAccountID(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading parent: %w", err)
+	}
+
+	if sourceParentID == parentID {
+		return nil
+	}
+
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(id),
+		DestinationParentId: aws.String(parentID),
+		SourceParentId:      aws.String(sourceParentID),
+	}
+
+	log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute,
+		func() (interface{}, error) {
+			return conn.MoveAccountWithContext(ctx, input)
+		},
+		organizations.ErrCodeConcurrentModificationException,
+	)
+
+	return err
+}
+
 func findParentAccountID(ctx context.Context, conn *organizations.Organizations, id string) (string, error) {
 	input := &organizations.ListParentsInput{
 		ChildId: aws.String(id),
@@ -397,13 +415,13 @@ func statusCreateAccountState(ctx context.Context, conn *organizations.Organizat
 	}
 }
 
-func waitAccountCreated(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.CreateAccountStatus, error) {
+func waitAccountCreated(ctx context.Context, conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.CreateAccountStatus, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      []string{organizations.CreateAccountStateInProgress},
 		Target:       []string{organizations.CreateAccountStateSucceeded},
 		Refresh:      statusCreateAccountState(ctx, conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -435,13 +453,13 @@ func statusAccountStatus(ctx context.Context, conn *organizations.Organizations,
 	}
 }
 
-func waitAccountDeleted(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.Account, error) {
+func waitAccountDeleted(ctx context.Context, conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.Account, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      []string{organizations.AccountStatusPendingClosure, organizations.AccountStatusActive},
 		Target:       []string{},
 		Refresh:      statusAccountStatus(ctx, conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+// Policy types not yet modeled by the AWS SDK.
+const (
+	policyTypeDeclarativePolicyEC2    = "DECLARATIVE_POLICY_EC2"
+	policyTypeResourceControlPolicy   = "RESOURCE_CONTROL_POLICY"
+	effectivePolicyTypeDeclarativeEC2 = policyTypeDeclarativePolicyEC2
+)
+
+func policyType_Values() []string {
+	return append(organizations.PolicyType_Values(), policyTypeDeclarativePolicyEC2, policyTypeResourceControlPolicy)
+}
+
+func effectivePolicyType_Values() []string {
+	return append(organizations.EffectivePolicyType_Values(), effectivePolicyTypeDeclarativeEC2)
+}
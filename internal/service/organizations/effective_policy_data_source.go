@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_organizations_effective_policy", name="Effective Policy")
+func DataSourceEffectivePolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEffectivePolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"last_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(effectivePolicyType_Values(), false),
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceEffectivePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
+
+	policyType := d.Get("policy_type").(string)
+	targetID := d.Get("target_id").(string)
+	if targetID == "" {
+		targetID = meta.(*conns.AWSClient).AccountID
+	}
+
+	policy, err := findEffectivePolicyByTwoPartKey(ctx, conn, policyType, targetID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organizations Effective Policy (%s/%s): %s", targetID, policyType, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", targetID, policyType))
+	if v := policy.LastUpdatedTimestamp; v != nil {
+		d.Set("last_updated_timestamp", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_timestamp", nil)
+	}
+	d.Set("policy_content", policy.PolicyContent)
+	d.Set("policy_type", policy.PolicyType)
+	d.Set("target_id", policy.TargetId)
+
+	return diags
+}
+
+func findEffectivePolicyByTwoPartKey(ctx context.Context, conn *organizations.Organizations, policyType, targetID string) (*organizations.EffectivePolicy, error) {
+	input := &organizations.DescribeEffectivePolicyInput{
+		PolicyType: aws.String(policyType),
+		TargetId:   aws.String(targetID),
+	}
+
+	output, err := conn.DescribeEffectivePolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeEffectivePolicyNotFoundException, organizations.ErrCodeTargetNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EffectivePolicy, nil
+}
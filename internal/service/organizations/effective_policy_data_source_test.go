@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccEffectivePolicyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_effective_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	tagPolicyContent := `{ "tags": { "Product": { "tag_key": { "@@assign": "Product" }, "enforced_for": { "@@assign": [ "ec2:instance" ] } } } }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectivePolicyDataSourceConfig_basic(rName, tagPolicyContent),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_updated_timestamp"),
+					resource.TestMatchResourceAttr(dataSourceName, "policy_content", regexache.MustCompile(`"Product"`)),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", organizations.EffectivePolicyTypeTagPolicy),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_id", "aws_organizations_organization.test", "master_account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEffectivePolicyDataSourceConfig_basic(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = ["TAG_POLICY"]
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  name    = %[1]q
+  type    = "TAG_POLICY"
+  content = %[2]s
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = aws_organizations_policy.test.id
+  target_id = aws_organizations_organization.test.master_account_id
+}
+
+data "aws_organizations_effective_policy" "test" {
+  policy_type = "TAG_POLICY"
+
+  depends_on = [aws_organizations_policy_attachment.test]
+}
+`, rName, strconv.Quote(content))
+}
@@ -9,4 +9,5 @@ var (
 	FindOrganizationalUnitByID             = findOrganizationalUnitByID
 	FindPolicyByID                         = findPolicyByID
 	FindResourcePolicy                     = findResourcePolicy
+	ValidatePolicyContent                  = validatePolicyContent
 )
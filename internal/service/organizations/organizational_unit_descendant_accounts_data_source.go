@@ -76,6 +76,25 @@ func dataSourceOrganizationalUnitDescendantAccountsRead(ctx context.Context, d *
 	return diags
 }
 
+// FindActiveAccountIDsForParentAndBelow is called from the service/account package.
+func FindActiveAccountIDsForParentAndBelow(ctx context.Context, conn *organizations.Organizations, id string) ([]string, error) {
+	accounts, err := findAllAccountsForParentAndBelow(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []string
+
+	for _, v := range accounts {
+		if aws.StringValue(v.Status) == organizations.AccountStatusActive {
+			output = append(output, aws.StringValue(v.Id))
+		}
+	}
+
+	return output, nil
+}
+
 // findAllAccountsForParent recurses down an OU tree, returning all accounts at the specified parent and below.
 func findAllAccountsForParentAndBelow(ctx context.Context, conn *organizations.Organizations, id string) ([]*organizations.Account, error) {
 	var output []*organizations.Account
@@ -63,8 +63,10 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"disappears":             testAccPolicy_disappears,
 			"Type_AI_OPT_OUT":        testAccPolicy_type_AI_OPT_OUT,
 			"Type_Backup":            testAccPolicy_type_Backup,
+			"Type_RCP":               testAccPolicy_type_RCP,
 			"Type_SCP":               testAccPolicy_type_SCP,
 			"Type_Tag":               testAccPolicy_type_Tag,
+			"InvalidContent":         testAccPolicy_invalidContent,
 			"ImportAwsManagedPolicy": testAccPolicy_importManagedPolicy,
 		},
 		"PolicyAttachment": {
@@ -96,6 +98,9 @@ func TestAccOrganizations_serial(t *testing.T) {
 		"ResourceTags": {
 			"basic": testAccResourceTagsDataSource_basic,
 		},
+		"EffectivePolicyDataSource": {
+			"basic": testAccEffectivePolicyDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
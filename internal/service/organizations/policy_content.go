@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourcePolicyCustomizeDiff validates the policy content against the syntax of the policy type.
+func resourcePolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("content") || !d.NewValueKnown("type") {
+		return nil
+	}
+
+	if err := validatePolicyContent(d.Get("type").(string), d.Get("content").(string)); err != nil {
+		return fmt.Errorf("content: %w", err)
+	}
+
+	return nil
+}
+
+// validatePolicyContent checks the top-level structure of a policy document.
+// Authorization policies (SCPs and RCPs) use IAM policy syntax; the other policy types use
+// management policy syntax, see https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_management_syntax.html.
+func validatePolicyContent(policyType, content string) error {
+	var document map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return fmt.Errorf("policy must be a JSON object: %w", err)
+	}
+
+	switch policyType {
+	case organizations.PolicyTypeServiceControlPolicy, policyTypeResourceControlPolicy:
+		if _, ok := document["Version"]; !ok {
+			return fmt.Errorf("%s policy must contain a Version element", policyType)
+		}
+
+		statement, ok := document["Statement"]
+		if !ok {
+			return fmt.Errorf("%s policy must contain a Statement element", policyType)
+		}
+
+		var statements []map[string]json.RawMessage
+		if err := json.Unmarshal(statement, &statements); err != nil {
+			var v map[string]json.RawMessage
+			if err := json.Unmarshal(statement, &v); err != nil {
+				return errors.New("Statement must be an object or an array of objects")
+			}
+			statements = append(statements, v)
+		}
+
+		for i, v := range statements {
+			if _, ok := v["Effect"]; !ok {
+				return fmt.Errorf("Statement %d must contain an Effect element", i)
+			}
+		}
+	default:
+		if _, ok := document["Statement"]; ok {
+			return fmt.Errorf("%s policy must use management policy syntax, not IAM policy syntax", policyType)
+		}
+	}
+
+	return nil
+}
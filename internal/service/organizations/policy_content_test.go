@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"testing"
+
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+)
+
+func TestValidatePolicyContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policyType string
+		content    string
+		wantErr    bool
+	}{
+		"SCP single statement": {
+			policyType: "SERVICE_CONTROL_POLICY",
+			content:    `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+		},
+		"RCP statement list": {
+			policyType: "RESOURCE_CONTROL_POLICY",
+			content:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Principal": "*", "Action": "s3:*", "Resource": "*"}]}`,
+		},
+		"SCP missing Version": {
+			policyType: "SERVICE_CONTROL_POLICY",
+			content:    `{"Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+			wantErr:    true,
+		},
+		"RCP missing Effect": {
+			policyType: "RESOURCE_CONTROL_POLICY",
+			content:    `{"Version": "2012-10-17", "Statement": [{"Action": "s3:*", "Resource": "*"}]}`,
+			wantErr:    true,
+		},
+		"SCP invalid Statement": {
+			policyType: "SERVICE_CONTROL_POLICY",
+			content:    `{"Version": "2012-10-17", "Statement": "Allow"}`,
+			wantErr:    true,
+		},
+		"tag policy": {
+			policyType: "TAG_POLICY",
+			content:    `{"tags": {"Product": {"tag_key": {"@@assign": "Product"}}}}`,
+		},
+		"declarative policy": {
+			policyType: "DECLARATIVE_POLICY_EC2",
+			content:    `{"ec2_attributes": {"image_block_public_access": {"state": {"@@assign": "block_new_sharing"}}}}`,
+		},
+		"tag policy with IAM syntax": {
+			policyType: "TAG_POLICY",
+			content:    `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+			wantErr:    true,
+		},
+		"not an object": {
+			policyType: "BACKUP_POLICY",
+			content:    `[]`,
+			wantErr:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tforganizations.ValidatePolicyContent(testCase.policyType, testCase.content)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("ValidatePolicyContent() err = %v, wantErr = %t", err, want)
+			}
+		})
+	}
+}
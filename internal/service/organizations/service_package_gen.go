@@ -33,6 +33,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDelegatedServices,
 			TypeName: "aws_organizations_delegated_services",
 		},
+		{
+			Factory:  DataSourceEffectivePolicy,
+			TypeName: "aws_organizations_effective_policy",
+			Name:     "Effective Policy",
+		},
 		{
 			Factory:  DataSourceOrganization,
 			TypeName: "aws_organizations_organization",
@@ -19,7 +19,37 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newConnectorResource,
+			Name:    "Connector",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory: newDirectoryRegistrationResource,
+			Name:    "Directory Registration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory: newServicePrincipalNameResource,
+			Name:    "Service Principal Name",
+		},
+		{
+			Factory: newTemplateGroupAccessControlEntryResource,
+			Name:    "Template Group Access Control Entry",
+		},
+		{
+			Factory: newTemplateResource,
+			Name:    "Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
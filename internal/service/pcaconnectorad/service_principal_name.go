@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Service Principal Name")
+func newServicePrincipalNameResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &servicePrincipalNameResource{}
+	r.SetDefaultCreateTimeout(15 * time.Minute)
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
+}
+
+type servicePrincipalNameResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *servicePrincipalNameResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_service_principal_name"
+}
+
+func (r *servicePrincipalNameResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory_registration_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *servicePrincipalNameResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data servicePrincipalNameResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	input := &pcaconnectorad.CreateServicePrincipalNameInput{
+		ClientToken:              aws.String(sdkid.UniqueId()),
+		ConnectorArn:             fwflex.StringFromFramework(ctx, data.ConnectorARN),
+		DirectoryRegistrationArn: fwflex.StringFromFramework(ctx, data.DirectoryRegistrationARN),
+	}
+
+	_, err := conn.CreateServicePrincipalName(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Private CA Connector for Active Directory Service Principal Name", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	if _, err := waitServicePrincipalNameCreated(ctx, conn, data.DirectoryRegistrationARN.ValueString(), data.ConnectorARN.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Private CA Connector for Active Directory Service Principal Name (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *servicePrincipalNameResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data servicePrincipalNameResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	output, err := findServicePrincipalNameByTwoPartKey(ctx, conn, data.DirectoryRegistrationARN.ValueString(), data.ConnectorARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Service Principal Name (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ConnectorARN = fwtypes.ARNValue(aws.ToString(output.ConnectorArn))
+	data.DirectoryRegistrationARN = fwtypes.ARNValue(aws.ToString(output.DirectoryRegistrationArn))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *servicePrincipalNameResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// All attributes require replacement.
+	var data servicePrincipalNameResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *servicePrincipalNameResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data servicePrincipalNameResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	tflog.Debug(ctx, "deleting Private CA Connector for Active Directory Service Principal Name", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteServicePrincipalName(ctx, &pcaconnectorad.DeleteServicePrincipalNameInput{
+		ConnectorArn:             fwflex.StringFromFramework(ctx, data.ConnectorARN),
+		DirectoryRegistrationArn: fwflex.StringFromFramework(ctx, data.DirectoryRegistrationARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Private CA Connector for Active Directory Service Principal Name (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitServicePrincipalNameDeleted(ctx, conn, data.DirectoryRegistrationARN.ValueString(), data.ConnectorARN.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Private CA Connector for Active Directory Service Principal Name (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type servicePrincipalNameResourceModel struct {
+	ConnectorARN             fwtypes.ARN    `tfsdk:"connector_arn"`
+	DirectoryRegistrationARN fwtypes.ARN    `tfsdk:"directory_registration_arn"`
+	ID                       types.String   `tfsdk:"id"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+const (
+	servicePrincipalNameResourceIDPartCount = 2
+)
+
+func (data *servicePrincipalNameResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), servicePrincipalNameResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.DirectoryRegistrationARN = fwtypes.ARNValue(parts[0])
+	data.ConnectorARN = fwtypes.ARNValue(parts[1])
+
+	return nil
+}
+
+func (data *servicePrincipalNameResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.DirectoryRegistrationARN.ValueString(), data.ConnectorARN.ValueString()}, servicePrincipalNameResourceIDPartCount, false)))
+}
+
+func findServicePrincipalNameByTwoPartKey(ctx context.Context, conn *pcaconnectorad.Client, directoryRegistrationARN, connectorARN string) (*awstypes.ServicePrincipalName, error) {
+	input := &pcaconnectorad.GetServicePrincipalNameInput{
+		ConnectorArn:             aws.String(connectorARN),
+		DirectoryRegistrationArn: aws.String(directoryRegistrationARN),
+	}
+
+	output, err := conn.GetServicePrincipalName(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServicePrincipalName == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServicePrincipalName, nil
+}
+
+func statusServicePrincipalName(ctx context.Context, conn *pcaconnectorad.Client, directoryRegistrationARN, connectorARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findServicePrincipalNameByTwoPartKey(ctx, conn, directoryRegistrationARN, connectorARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitServicePrincipalNameCreated(ctx context.Context, conn *pcaconnectorad.Client, directoryRegistrationARN, connectorARN string, timeout time.Duration) (*awstypes.ServicePrincipalName, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ServicePrincipalNameStatusCreating),
+		Target:  enum.Slice(awstypes.ServicePrincipalNameStatusActive),
+		Refresh: statusServicePrincipalName(ctx, conn, directoryRegistrationARN, connectorARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ServicePrincipalName); ok {
+		if v := output.StatusReason; v != "" {
+			tfresource.SetLastError(err, errors.New(string(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServicePrincipalNameDeleted(ctx context.Context, conn *pcaconnectorad.Client, directoryRegistrationARN, connectorARN string, timeout time.Duration) (*awstypes.ServicePrincipalName, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ServicePrincipalNameStatusDeleting),
+		Target:  []string{},
+		Refresh: statusServicePrincipalName(ctx, conn, directoryRegistrationARN, connectorARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ServicePrincipalName); ok {
+		if v := output.StatusReason; v != "" {
+			tfresource.SetLastError(err, errors.New(string(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,1119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Template")
+// @Tags(identifierAttribute="id")
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateResource{}
+
+	return r, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *templateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_template"
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_schema": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"reenroll_all_certificate_holders": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateDefinitionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"template_v2": templateVersionBlock[templateV2Model](ctx, "template_v2", privateKeyAttributesV2Block(ctx), privateKeyFlagsBlock[privateKeyFlagsV2Model, awstypes.ClientCompatibilityV2](ctx)),
+						"template_v3": templateVersionBlock[templateV3Model](ctx, "template_v3", privateKeyAttributesV3Block(ctx), privateKeyFlagsBlock[privateKeyFlagsV3Model, awstypes.ClientCompatibilityV3](ctx, "require_alternate_signature_algorithm")),
+						"template_v4": templateVersionBlock[templateV4Model](ctx, "template_v4", privateKeyAttributesV3Block(ctx), privateKeyFlagsBlock[privateKeyFlagsV4Model, awstypes.ClientCompatibilityV4](ctx, "require_alternate_signature_algorithm", "require_same_key_renewal", "use_legacy_provider")),
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+// templateVersionBlock returns the schema for a template_v2, template_v3 or template_v4 block.
+// template_v3 and template_v4 additionally require a hash algorithm.
+// Exactly one of the blocks must be configured; this is checked in ValidateConfig.
+func templateVersionBlock[T any](ctx context.Context, name string, privateKeyAttributes, privateKeyFlags schema.ListNestedBlock) schema.ListNestedBlock {
+	attributes := map[string]schema.Attribute{
+		"superseded_templates": schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	}
+	if name != "template_v2" {
+		attributes["hash_algorithm"] = schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+			Required:   true,
+		}
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks: map[string]schema.Block{
+				"certificate_validity": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[certificateValidityModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"renewal_period":  validityPeriodBlock(ctx),
+							"validity_period": validityPeriodBlock(ctx),
+						},
+					},
+					Validators: requiredSingleBlockValidators(),
+				},
+				"enrollment_flags": flagsBlock[enrollmentFlagsModel](ctx, "enable_key_reuse_on_nt_token_keyset_storage_full", "include_symmetric_algorithms", "no_security_extension", "remove_invalid_certificate_from_personal_store", "user_interaction_required"),
+				"extensions": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[extensionsModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"application_policies": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPoliciesModel](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"critical": optionalBoolAttribute(),
+									},
+									Blocks: map[string]schema.Block{
+										"policy": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPolicyModel](ctx),
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"policy_object_identifier": schema.StringAttribute{
+														Optional: true,
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("policy_type")),
+														},
+													},
+													"policy_type": schema.StringAttribute{
+														CustomType: fwtypes.StringEnumType[awstypes.ApplicationPolicyType](),
+														Optional:   true,
+													},
+												},
+											},
+											Validators: []validator.List{
+												listvalidator.IsRequired(),
+												listvalidator.SizeAtLeast(1),
+											},
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+							},
+							"key_usage": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageModel](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"critical": optionalBoolAttribute(),
+									},
+									Blocks: map[string]schema.Block{
+										"usage_flags": flagsBlock[keyUsageFlagsModel](ctx, "data_encipherment", "digital_signature", "key_agreement", "key_encipherment", "non_repudiation"),
+									},
+								},
+								Validators: requiredSingleBlockValidators(),
+							},
+						},
+					},
+					Validators: requiredSingleBlockValidators(),
+				},
+				"general_flags":          flagsBlock[generalFlagsModel](ctx, "auto_enrollment", "machine_type"),
+				"private_key_attributes": privateKeyAttributes,
+				"private_key_flags":      privateKeyFlags,
+				"subject_name_flags":     flagsBlock[subjectNameFlagsModel](ctx, "require_common_name", "require_directory_path", "require_dns_as_cn", "require_email", "san_require_directory_guid", "san_require_dns", "san_require_domain_dns", "san_require_email", "san_require_spn", "san_require_upn"),
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func privateKeyAttributesV2Block(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesV2Model](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"crypto_providers": schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"key_spec": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+					Required:   true,
+				},
+				"minimal_key_length": schema.Int64Attribute{
+					Required: true,
+				},
+			},
+		},
+		Validators: requiredSingleBlockValidators(),
+	}
+}
+
+func privateKeyAttributesV3Block(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesV3Model](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"algorithm": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.PrivateKeyAlgorithm](),
+					Required:   true,
+				},
+				"crypto_providers": schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"key_spec": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+					Required:   true,
+				},
+				"minimal_key_length": schema.Int64Attribute{
+					Required: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"key_usage_property": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"property_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.KeyUsagePropertyType](),
+								Optional:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"property_flags": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyFlagsModel](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"decrypt":       optionalBoolAttribute(),
+										"key_agreement": optionalBoolAttribute(),
+										"sign":          optionalBoolAttribute(),
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+							},
+						},
+					},
+					Validators: requiredSingleBlockValidators(),
+				},
+			},
+		},
+		Validators: requiredSingleBlockValidators(),
+	}
+}
+
+func privateKeyFlagsBlock[T any, E enum.Valueser[E]](ctx context.Context, flagNames ...string) schema.ListNestedBlock {
+	attributes := map[string]schema.Attribute{
+		"client_version": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[E](),
+			Required:   true,
+		},
+		"exportable_key":                 optionalBoolAttribute(),
+		"strong_key_protection_required": optionalBoolAttribute(),
+	}
+	for _, v := range flagNames {
+		attributes[v] = optionalBoolAttribute()
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+		},
+		Validators: requiredSingleBlockValidators(),
+	}
+}
+
+func validityPeriodBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[validityPeriodModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"period": schema.Int64Attribute{
+					Required: true,
+				},
+				"period_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ValidityPeriodType](),
+					Required:   true,
+				},
+			},
+		},
+		Validators: requiredSingleBlockValidators(),
+	}
+}
+
+func flagsBlock[T any](ctx context.Context, flagNames ...string) schema.ListNestedBlock {
+	attributes := make(map[string]schema.Attribute, len(flagNames))
+	for _, v := range flagNames {
+		attributes[v] = optionalBoolAttribute()
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+		},
+		Validators: requiredSingleBlockValidators(),
+	}
+}
+
+// optionalBoolAttribute returns the schema for an optional flag.
+// Flags default to false as the API returns false for any flag that isn't set.
+func optionalBoolAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+func requiredSingleBlockValidators() []validator.List {
+	return []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeAtLeast(1),
+		listvalidator.SizeAtMost(1),
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	// AutoFlEx can't be used with the template definition because the API structure uses Go interfaces.
+	definition, diags := expandTemplateDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &pcaconnectorad.CreateTemplateInput{
+		ClientToken:  aws.String(sdkid.UniqueId()),
+		ConnectorArn: fwflex.StringFromFramework(ctx, data.ConnectorARN),
+		Definition:   definition,
+		Name:         fwflex.StringFromFramework(ctx, data.Name),
+		Tags:         getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Private CA Connector for Active Directory Template (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.TemplateArn)
+
+	template, err := findTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, template.Arn)
+	data.ObjectIdentifier = fwflex.StringToFramework(ctx, template.ObjectIdentifier)
+	data.PolicySchema = fwflex.Int32ToFramework(ctx, template.PolicySchema)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	output, err := findTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.ConnectorARN = fwflex.StringToFrameworkARN(ctx, output.ConnectorArn)
+	definition, diags := flattenTemplateDefinition(ctx, output.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Definition = definition
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.ObjectIdentifier = fwflex.StringToFramework(ctx, output.ObjectIdentifier)
+	data.PolicySchema = fwflex.Int32ToFramework(ctx, output.PolicySchema)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new templateResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		definition, diags := expandTemplateDefinition(ctx, new.Definition)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &pcaconnectorad.UpdateTemplateInput{
+			Definition:                    definition,
+			ReenrollAllCertificateHolders: fwflex.BoolFromFramework(ctx, new.ReenrollAllCertificateHolders),
+			TemplateArn:                   fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		_, err := conn.UpdateTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Private CA Connector for Active Directory Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	tflog.Debug(ctx, "deleting Private CA Connector for Active Directory Template", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteTemplate(ctx, &pcaconnectorad.DeleteTemplateInput{
+		TemplateArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *templateResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data templateResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := expandTemplateDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *templateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type templateResourceModel struct {
+	ARN                           types.String                                             `tfsdk:"arn"`
+	ConnectorARN                  fwtypes.ARN                                              `tfsdk:"connector_arn"`
+	Definition                    fwtypes.ListNestedObjectValueOf[templateDefinitionModel] `tfsdk:"definition"`
+	ID                            types.String                                             `tfsdk:"id"`
+	Name                          types.String                                             `tfsdk:"name"`
+	ObjectIdentifier              types.String                                             `tfsdk:"object_identifier"`
+	PolicySchema                  types.Int64                                              `tfsdk:"policy_schema"`
+	ReenrollAllCertificateHolders types.Bool                                               `tfsdk:"reenroll_all_certificate_holders"`
+	Tags                          types.Map                                                `tfsdk:"tags"`
+	TagsAll                       types.Map                                                `tfsdk:"tags_all"`
+}
+
+type templateDefinitionModel struct {
+	TemplateV2 fwtypes.ListNestedObjectValueOf[templateV2Model] `tfsdk:"template_v2"`
+	TemplateV3 fwtypes.ListNestedObjectValueOf[templateV3Model] `tfsdk:"template_v3"`
+	TemplateV4 fwtypes.ListNestedObjectValueOf[templateV4Model] `tfsdk:"template_v4"`
+}
+
+type templateV2Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV2Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV2Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetValueOf[types.String]                             `tfsdk:"superseded_templates"`
+}
+
+type templateV3Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                   `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV3Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV3Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetValueOf[types.String]                             `tfsdk:"superseded_templates"`
+}
+
+type templateV4Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                   `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV3Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV4Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetValueOf[types.String]                             `tfsdk:"superseded_templates"`
+}
+
+type certificateValidityModel struct {
+	RenewalPeriod  fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"renewal_period"`
+	ValidityPeriod fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"validity_period"`
+}
+
+type validityPeriodModel struct {
+	Period     types.Int64                                     `tfsdk:"period"`
+	PeriodType fwtypes.StringEnum[awstypes.ValidityPeriodType] `tfsdk:"period_type"`
+}
+
+type enrollmentFlagsModel struct {
+	EnableKeyReuseOnNtTokenKeysetStorageFull  types.Bool `tfsdk:"enable_key_reuse_on_nt_token_keyset_storage_full"`
+	IncludeSymmetricAlgorithms                types.Bool `tfsdk:"include_symmetric_algorithms"`
+	NoSecurityExtension                       types.Bool `tfsdk:"no_security_extension"`
+	RemoveInvalidCertificateFromPersonalStore types.Bool `tfsdk:"remove_invalid_certificate_from_personal_store"`
+	UserInteractionRequired                   types.Bool `tfsdk:"user_interaction_required"`
+}
+
+type extensionsModel struct {
+	ApplicationPolicies fwtypes.ListNestedObjectValueOf[applicationPoliciesModel] `tfsdk:"application_policies"`
+	KeyUsage            fwtypes.ListNestedObjectValueOf[keyUsageModel]            `tfsdk:"key_usage"`
+}
+
+type applicationPoliciesModel struct {
+	Critical types.Bool                                              `tfsdk:"critical"`
+	Policies fwtypes.ListNestedObjectValueOf[applicationPolicyModel] `tfsdk:"policy"`
+}
+
+type applicationPolicyModel struct {
+	PolicyObjectIdentifier types.String                                       `tfsdk:"policy_object_identifier"`
+	PolicyType             fwtypes.StringEnum[awstypes.ApplicationPolicyType] `tfsdk:"policy_type"`
+}
+
+type keyUsageModel struct {
+	Critical   types.Bool                                          `tfsdk:"critical"`
+	UsageFlags fwtypes.ListNestedObjectValueOf[keyUsageFlagsModel] `tfsdk:"usage_flags"`
+}
+
+type keyUsageFlagsModel struct {
+	DataEncipherment types.Bool `tfsdk:"data_encipherment"`
+	DigitalSignature types.Bool `tfsdk:"digital_signature"`
+	KeyAgreement     types.Bool `tfsdk:"key_agreement"`
+	KeyEncipherment  types.Bool `tfsdk:"key_encipherment"`
+	NonRepudiation   types.Bool `tfsdk:"non_repudiation"`
+}
+
+type generalFlagsModel struct {
+	AutoEnrollment types.Bool `tfsdk:"auto_enrollment"`
+	MachineType    types.Bool `tfsdk:"machine_type"`
+}
+
+type privateKeyAttributesV2Model struct {
+	CryptoProviders  fwtypes.SetValueOf[types.String]     `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec] `tfsdk:"key_spec"`
+	MinimalKeyLength types.Int64                          `tfsdk:"minimal_key_length"`
+}
+
+type privateKeyAttributesV3Model struct {
+	Algorithm        fwtypes.StringEnum[awstypes.PrivateKeyAlgorithm]       `tfsdk:"algorithm"`
+	CryptoProviders  fwtypes.SetValueOf[types.String]                       `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec]                   `tfsdk:"key_spec"`
+	KeyUsageProperty fwtypes.ListNestedObjectValueOf[keyUsagePropertyModel] `tfsdk:"key_usage_property"`
+	MinimalKeyLength types.Int64                                            `tfsdk:"minimal_key_length"`
+}
+
+type keyUsagePropertyModel struct {
+	PropertyFlags fwtypes.ListNestedObjectValueOf[keyUsagePropertyFlagsModel] `tfsdk:"property_flags"`
+	PropertyType  fwtypes.StringEnum[awstypes.KeyUsagePropertyType]           `tfsdk:"property_type"`
+}
+
+type keyUsagePropertyFlagsModel struct {
+	Decrypt      types.Bool `tfsdk:"decrypt"`
+	KeyAgreement types.Bool `tfsdk:"key_agreement"`
+	Sign         types.Bool `tfsdk:"sign"`
+}
+
+type privateKeyFlagsV2Model struct {
+	ClientVersion               fwtypes.StringEnum[awstypes.ClientCompatibilityV2] `tfsdk:"client_version"`
+	ExportableKey               types.Bool                                         `tfsdk:"exportable_key"`
+	StrongKeyProtectionRequired types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV3Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV3] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV4Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV4] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	RequireSameKeyRenewal              types.Bool                                         `tfsdk:"require_same_key_renewal"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+	UseLegacyProvider                  types.Bool                                         `tfsdk:"use_legacy_provider"`
+}
+
+type subjectNameFlagsModel struct {
+	RequireCommonName       types.Bool `tfsdk:"require_common_name"`
+	RequireDirectoryPath    types.Bool `tfsdk:"require_directory_path"`
+	RequireDNSAsCN          types.Bool `tfsdk:"require_dns_as_cn"`
+	RequireEmail            types.Bool `tfsdk:"require_email"`
+	SanRequireDirectoryGUID types.Bool `tfsdk:"san_require_directory_guid"`
+	SanRequireDNS           types.Bool `tfsdk:"san_require_dns"`
+	SanRequireDomainDNS     types.Bool `tfsdk:"san_require_domain_dns"`
+	SanRequireEmail         types.Bool `tfsdk:"san_require_email"`
+	SanRequireSPN           types.Bool `tfsdk:"san_require_spn"`
+	SanRequireUPN           types.Bool `tfsdk:"san_require_upn"`
+}
+
+func expandTemplateDefinition(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[templateDefinitionModel]) (awstypes.TemplateDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObj, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObj == nil {
+		return nil, diags
+	}
+
+	if tfObj.TemplateV2.IsUnknown() || tfObj.TemplateV3.IsUnknown() || tfObj.TemplateV4.IsUnknown() {
+		return nil, diags
+	}
+
+	if n := len(tfObj.TemplateV2.Elements()) + len(tfObj.TemplateV3.Elements()) + len(tfObj.TemplateV4.Elements()); n != 1 {
+		diags.AddAttributeError(path.Root("definition"), "Invalid Attribute Combination", "exactly one of template_v2, template_v3 or template_v4 must be specified")
+
+		return nil, diags
+	}
+
+	v2, d := tfObj.TemplateV2.ToPtr(ctx)
+	diags.Append(d...)
+	v3, d := tfObj.TemplateV3.ToPtr(ctx)
+	diags.Append(d...)
+	v4, d := tfObj.TemplateV4.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch {
+	case v2 != nil:
+		apiObject := awstypes.TemplateV2{}
+		diags.Append(fwflex.Expand(ctx, v2.CertificateValidity, &apiObject.CertificateValidity)...)
+		diags.Append(fwflex.Expand(ctx, v2.EnrollmentFlags, &apiObject.EnrollmentFlags)...)
+		diags.Append(fwflex.Expand(ctx, v2.GeneralFlags, &apiObject.GeneralFlags)...)
+		diags.Append(fwflex.Expand(ctx, v2.PrivateKeyAttributes, &apiObject.PrivateKeyAttributes)...)
+		diags.Append(fwflex.Expand(ctx, v2.PrivateKeyFlags, &apiObject.PrivateKeyFlags)...)
+		diags.Append(fwflex.Expand(ctx, v2.SubjectNameFlags, &apiObject.SubjectNameFlags)...)
+		diags.Append(fwflex.Expand(ctx, v2.SupersededTemplates, &apiObject.SupersededTemplates)...)
+		keyUsage, applicationPolicies, d := expandExtensions(ctx, v2.Extensions)
+		diags.Append(d...)
+		apiObject.Extensions = &awstypes.ExtensionsV2{
+			ApplicationPolicies: applicationPolicies,
+			KeyUsage:            keyUsage,
+		}
+
+		return &awstypes.TemplateDefinitionMemberTemplateV2{Value: apiObject}, diags
+
+	case v3 != nil:
+		apiObject := awstypes.TemplateV3{
+			HashAlgorithm: v3.HashAlgorithm.ValueEnum(),
+		}
+		diags.Append(fwflex.Expand(ctx, v3.CertificateValidity, &apiObject.CertificateValidity)...)
+		diags.Append(fwflex.Expand(ctx, v3.EnrollmentFlags, &apiObject.EnrollmentFlags)...)
+		diags.Append(fwflex.Expand(ctx, v3.GeneralFlags, &apiObject.GeneralFlags)...)
+		diags.Append(fwflex.Expand(ctx, v3.PrivateKeyFlags, &apiObject.PrivateKeyFlags)...)
+		diags.Append(fwflex.Expand(ctx, v3.SubjectNameFlags, &apiObject.SubjectNameFlags)...)
+		diags.Append(fwflex.Expand(ctx, v3.SupersededTemplates, &apiObject.SupersededTemplates)...)
+		keyUsage, applicationPolicies, d := expandExtensions(ctx, v3.Extensions)
+		diags.Append(d...)
+		apiObject.Extensions = &awstypes.ExtensionsV3{
+			ApplicationPolicies: applicationPolicies,
+			KeyUsage:            keyUsage,
+		}
+		privateKeyAttributes, d := v3.PrivateKeyAttributes.ToPtr(ctx)
+		diags.Append(d...)
+		if privateKeyAttributes != nil {
+			keyUsageProperty, d := expandKeyUsageProperty(ctx, privateKeyAttributes.KeyUsageProperty)
+			diags.Append(d...)
+			apiObject.PrivateKeyAttributes = &awstypes.PrivateKeyAttributesV3{
+				Algorithm:        privateKeyAttributes.Algorithm.ValueEnum(),
+				CryptoProviders:  fwflex.ExpandFrameworkStringValueSet(ctx, privateKeyAttributes.CryptoProviders),
+				KeySpec:          privateKeyAttributes.KeySpec.ValueEnum(),
+				KeyUsageProperty: keyUsageProperty,
+				MinimalKeyLength: fwflex.Int32FromFramework(ctx, privateKeyAttributes.MinimalKeyLength),
+			}
+		}
+
+		return &awstypes.TemplateDefinitionMemberTemplateV3{Value: apiObject}, diags
+
+	case v4 != nil:
+		apiObject := awstypes.TemplateV4{
+			HashAlgorithm: v4.HashAlgorithm.ValueEnum(),
+		}
+		diags.Append(fwflex.Expand(ctx, v4.CertificateValidity, &apiObject.CertificateValidity)...)
+		diags.Append(fwflex.Expand(ctx, v4.EnrollmentFlags, &apiObject.EnrollmentFlags)...)
+		diags.Append(fwflex.Expand(ctx, v4.GeneralFlags, &apiObject.GeneralFlags)...)
+		diags.Append(fwflex.Expand(ctx, v4.PrivateKeyFlags, &apiObject.PrivateKeyFlags)...)
+		diags.Append(fwflex.Expand(ctx, v4.SubjectNameFlags, &apiObject.SubjectNameFlags)...)
+		diags.Append(fwflex.Expand(ctx, v4.SupersededTemplates, &apiObject.SupersededTemplates)...)
+		keyUsage, applicationPolicies, d := expandExtensions(ctx, v4.Extensions)
+		diags.Append(d...)
+		apiObject.Extensions = &awstypes.ExtensionsV4{
+			ApplicationPolicies: applicationPolicies,
+			KeyUsage:            keyUsage,
+		}
+		privateKeyAttributes, d := v4.PrivateKeyAttributes.ToPtr(ctx)
+		diags.Append(d...)
+		if privateKeyAttributes != nil {
+			keyUsageProperty, d := expandKeyUsageProperty(ctx, privateKeyAttributes.KeyUsageProperty)
+			diags.Append(d...)
+			apiObject.PrivateKeyAttributes = &awstypes.PrivateKeyAttributesV4{
+				Algorithm:        privateKeyAttributes.Algorithm.ValueEnum(),
+				CryptoProviders:  fwflex.ExpandFrameworkStringValueSet(ctx, privateKeyAttributes.CryptoProviders),
+				KeySpec:          privateKeyAttributes.KeySpec.ValueEnum(),
+				KeyUsageProperty: keyUsageProperty,
+				MinimalKeyLength: fwflex.Int32FromFramework(ctx, privateKeyAttributes.MinimalKeyLength),
+			}
+		}
+
+		return &awstypes.TemplateDefinitionMemberTemplateV4{Value: apiObject}, diags
+	}
+
+	return nil, diags
+}
+
+func expandExtensions(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[extensionsModel]) (*awstypes.KeyUsage, *awstypes.ApplicationPolicies, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObj, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObj == nil {
+		return nil, nil, diags
+	}
+
+	var keyUsage *awstypes.KeyUsage
+	diags.Append(fwflex.Expand(ctx, tfObj.KeyUsage, &keyUsage)...)
+
+	applicationPolicies, d := expandApplicationPolicies(ctx, tfObj.ApplicationPolicies)
+	diags.Append(d...)
+
+	return keyUsage, applicationPolicies, diags
+}
+
+func expandApplicationPolicies(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[applicationPoliciesModel]) (*awstypes.ApplicationPolicies, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObj, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObj == nil {
+		return nil, diags
+	}
+
+	policies, d := tfObj.Policies.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.ApplicationPolicies{
+		Critical: fwflex.BoolFromFramework(ctx, tfObj.Critical),
+	}
+
+	for _, policy := range policies {
+		switch {
+		case !policy.PolicyObjectIdentifier.IsNull():
+			apiObject.Policies = append(apiObject.Policies, &awstypes.ApplicationPolicyMemberPolicyObjectIdentifier{
+				Value: policy.PolicyObjectIdentifier.ValueString(),
+			})
+		case !policy.PolicyType.IsNull():
+			apiObject.Policies = append(apiObject.Policies, &awstypes.ApplicationPolicyMemberPolicyType{
+				Value: policy.PolicyType.ValueEnum(),
+			})
+		}
+	}
+
+	return apiObject, diags
+}
+
+func expandKeyUsageProperty(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[keyUsagePropertyModel]) (awstypes.KeyUsageProperty, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObj, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObj == nil {
+		return nil, diags
+	}
+
+	propertyFlags, d := tfObj.PropertyFlags.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if tfObj.PropertyFlags.IsUnknown() || tfObj.PropertyType.IsUnknown() {
+		return nil, diags
+	}
+
+	if (propertyFlags == nil) == tfObj.PropertyType.IsNull() {
+		diags.AddError("Invalid Attribute Combination", "exactly one of key_usage_property.property_flags or key_usage_property.property_type must be specified")
+
+		return nil, diags
+	}
+
+	if propertyFlags != nil {
+		apiObject := &awstypes.KeyUsagePropertyMemberPropertyFlags{}
+		diags.Append(fwflex.Expand(ctx, propertyFlags, &apiObject.Value)...)
+
+		return apiObject, diags
+	}
+
+	return &awstypes.KeyUsagePropertyMemberPropertyType{
+		Value: tfObj.PropertyType.ValueEnum(),
+	}, diags
+}
+
+func flattenTemplateDefinition(ctx context.Context, apiObject awstypes.TemplateDefinition) (fwtypes.ListNestedObjectValueOf[templateDefinitionModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObj := &templateDefinitionModel{
+		TemplateV2: fwtypes.NewListNestedObjectValueOfNull[templateV2Model](ctx),
+		TemplateV3: fwtypes.NewListNestedObjectValueOfNull[templateV3Model](ctx),
+		TemplateV4: fwtypes.NewListNestedObjectValueOfNull[templateV4Model](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.TemplateDefinitionMemberTemplateV2:
+		tfModel := &templateV2Model{}
+		diags.Append(flattenNestedObject(ctx, v.Value.CertificateValidity, &tfModel.CertificateValidity)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.EnrollmentFlags, &tfModel.EnrollmentFlags)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.GeneralFlags, &tfModel.GeneralFlags)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.PrivateKeyAttributes, &tfModel.PrivateKeyAttributes)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.PrivateKeyFlags, &tfModel.PrivateKeyFlags)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.SubjectNameFlags, &tfModel.SubjectNameFlags)...)
+		diags.Append(fwflex.Flatten(ctx, v.Value.SupersededTemplates, &tfModel.SupersededTemplates)...)
+		if v := v.Value.Extensions; v != nil {
+			extensions, d := flattenExtensions(ctx, v.KeyUsage, v.ApplicationPolicies)
+			diags.Append(d...)
+			tfModel.Extensions = extensions
+		} else {
+			tfModel.Extensions = fwtypes.NewListNestedObjectValueOfNull[extensionsModel](ctx)
+		}
+
+		tfObj.TemplateV2 = fwtypes.NewListNestedObjectValueOfPtr(ctx, tfModel)
+
+	case *awstypes.TemplateDefinitionMemberTemplateV3:
+		tfModel := &templateV3Model{
+			HashAlgorithm: fwtypes.StringEnumValue(v.Value.HashAlgorithm),
+		}
+		diags.Append(flattenNestedObject(ctx, v.Value.CertificateValidity, &tfModel.CertificateValidity)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.EnrollmentFlags, &tfModel.EnrollmentFlags)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.GeneralFlags, &tfModel.GeneralFlags)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.PrivateKeyFlags, &tfModel.PrivateKeyFlags)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.SubjectNameFlags, &tfModel.SubjectNameFlags)...)
+		diags.Append(fwflex.Flatten(ctx, v.Value.SupersededTemplates, &tfModel.SupersededTemplates)...)
+		if v := v.Value.Extensions; v != nil {
+			extensions, d := flattenExtensions(ctx, v.KeyUsage, v.ApplicationPolicies)
+			diags.Append(d...)
+			tfModel.Extensions = extensions
+		} else {
+			tfModel.Extensions = fwtypes.NewListNestedObjectValueOfNull[extensionsModel](ctx)
+		}
+		if v := v.Value.PrivateKeyAttributes; v != nil {
+			privateKeyAttributes, d := flattenPrivateKeyAttributesV3(ctx, v.Algorithm, v.CryptoProviders, v.KeySpec, v.KeyUsageProperty, v.MinimalKeyLength)
+			diags.Append(d...)
+			tfModel.PrivateKeyAttributes = privateKeyAttributes
+		} else {
+			tfModel.PrivateKeyAttributes = fwtypes.NewListNestedObjectValueOfNull[privateKeyAttributesV3Model](ctx)
+		}
+
+		tfObj.TemplateV3 = fwtypes.NewListNestedObjectValueOfPtr(ctx, tfModel)
+
+	case *awstypes.TemplateDefinitionMemberTemplateV4:
+		tfModel := &templateV4Model{
+			HashAlgorithm: fwtypes.StringEnumValue(v.Value.HashAlgorithm),
+		}
+		diags.Append(flattenNestedObject(ctx, v.Value.CertificateValidity, &tfModel.CertificateValidity)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.EnrollmentFlags, &tfModel.EnrollmentFlags)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.GeneralFlags, &tfModel.GeneralFlags)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.PrivateKeyFlags, &tfModel.PrivateKeyFlags)...)
+		diags.Append(flattenNestedObject(ctx, v.Value.SubjectNameFlags, &tfModel.SubjectNameFlags)...)
+		diags.Append(fwflex.Flatten(ctx, v.Value.SupersededTemplates, &tfModel.SupersededTemplates)...)
+		if v := v.Value.Extensions; v != nil {
+			extensions, d := flattenExtensions(ctx, v.KeyUsage, v.ApplicationPolicies)
+			diags.Append(d...)
+			tfModel.Extensions = extensions
+		} else {
+			tfModel.Extensions = fwtypes.NewListNestedObjectValueOfNull[extensionsModel](ctx)
+		}
+		if v := v.Value.PrivateKeyAttributes; v != nil {
+			privateKeyAttributes, d := flattenPrivateKeyAttributesV3(ctx, v.Algorithm, v.CryptoProviders, v.KeySpec, v.KeyUsageProperty, v.MinimalKeyLength)
+			diags.Append(d...)
+			tfModel.PrivateKeyAttributes = privateKeyAttributes
+		} else {
+			tfModel.PrivateKeyAttributes = fwtypes.NewListNestedObjectValueOfNull[privateKeyAttributesV3Model](ctx)
+		}
+
+		tfObj.TemplateV4 = fwtypes.NewListNestedObjectValueOfPtr(ctx, tfModel)
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, tfObj), diags
+}
+
+func flattenExtensions(ctx context.Context, keyUsage *awstypes.KeyUsage, applicationPolicies *awstypes.ApplicationPolicies) (fwtypes.ListNestedObjectValueOf[extensionsModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObj := &extensionsModel{
+		ApplicationPolicies: fwtypes.NewListNestedObjectValueOfNull[applicationPoliciesModel](ctx),
+	}
+
+	diags.Append(flattenNestedObject(ctx, keyUsage, &tfObj.KeyUsage)...)
+
+	if applicationPolicies != nil {
+		var policies []*applicationPolicyModel
+
+		for _, v := range applicationPolicies.Policies {
+			policy := &applicationPolicyModel{
+				PolicyObjectIdentifier: types.StringNull(),
+				PolicyType:             fwtypes.StringEnumNull[awstypes.ApplicationPolicyType](),
+			}
+
+			switch v := v.(type) {
+			case *awstypes.ApplicationPolicyMemberPolicyObjectIdentifier:
+				policy.PolicyObjectIdentifier = types.StringValue(v.Value)
+			case *awstypes.ApplicationPolicyMemberPolicyType:
+				policy.PolicyType = fwtypes.StringEnumValue(v.Value)
+			default:
+				continue
+			}
+
+			policies = append(policies, policy)
+		}
+
+		tfObj.ApplicationPolicies = fwtypes.NewListNestedObjectValueOfPtr(ctx, &applicationPoliciesModel{
+			Critical: fwflex.BoolToFramework(ctx, applicationPolicies.Critical),
+			Policies: fwtypes.NewListNestedObjectValueOfSlice(ctx, policies),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, tfObj), diags
+}
+
+func flattenPrivateKeyAttributesV3(ctx context.Context, algorithm awstypes.PrivateKeyAlgorithm, cryptoProviders []string, keySpec awstypes.KeySpec, keyUsageProperty awstypes.KeyUsageProperty, minimalKeyLength *int32) (fwtypes.ListNestedObjectValueOf[privateKeyAttributesV3Model], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObj := &privateKeyAttributesV3Model{
+		Algorithm:        fwtypes.StringEnumValue(algorithm),
+		KeySpec:          fwtypes.StringEnumValue(keySpec),
+		KeyUsageProperty: fwtypes.NewListNestedObjectValueOfNull[keyUsagePropertyModel](ctx),
+		MinimalKeyLength: fwflex.Int32ToFramework(ctx, minimalKeyLength),
+	}
+	diags.Append(fwflex.Flatten(ctx, cryptoProviders, &tfObj.CryptoProviders)...)
+
+	switch v := keyUsageProperty.(type) {
+	case *awstypes.KeyUsagePropertyMemberPropertyFlags:
+		tfModel := &keyUsagePropertyModel{
+			PropertyType: fwtypes.StringEnumNull[awstypes.KeyUsagePropertyType](),
+		}
+		diags.Append(flattenNestedObject(ctx, &v.Value, &tfModel.PropertyFlags)...)
+		tfObj.KeyUsageProperty = fwtypes.NewListNestedObjectValueOfPtr(ctx, tfModel)
+
+	case *awstypes.KeyUsagePropertyMemberPropertyType:
+		tfObj.KeyUsageProperty = fwtypes.NewListNestedObjectValueOfPtr(ctx, &keyUsagePropertyModel{
+			PropertyFlags: fwtypes.NewListNestedObjectValueOfNull[keyUsagePropertyFlagsModel](ctx),
+			PropertyType:  fwtypes.StringEnumValue(v.Value),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, tfObj), diags
+}
+
+// flattenNestedObject flattens an AWS API structure that doesn't contain any Go interfaces
+// into a single element list nested object.
+func flattenNestedObject[T, U any](ctx context.Context, apiObject *T, tfList *fwtypes.ListNestedObjectValueOf[U]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		*tfList = fwtypes.NewListNestedObjectValueOfNull[U](ctx)
+
+		return diags
+	}
+
+	var tfObj U
+	diags.Append(fwflex.Flatten(ctx, apiObject, &tfObj)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*tfList = fwtypes.NewListNestedObjectValueOfPtr(ctx, &tfObj)
+
+	return diags
+}
+
+func findTemplateByARN(ctx context.Context, conn *pcaconnectorad.Client, arn string) (*awstypes.Template, error) {
+	input := &pcaconnectorad.GetTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Template, nil
+}
@@ -0,0 +1,509 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCAConnectorADTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Template
+	resourceName := "aws_pcaconnectorad_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PCAConnectorADEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "pca-connector-ad", regexache.MustCompile(`connector/.+/template/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_arn", "aws_pcaconnectorad_connector.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.certificate_validity.0.validity_period.0.period", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.certificate_validity.0.validity_period.0.period_type", "YEARS"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.extensions.0.application_policies.0.policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.extensions.0.application_policies.0.policy.0.policy_type", "CLIENT_AUTHENTICATION"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.extensions.0.key_usage.0.usage_flags.0.digital_signature", "true"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.general_flags.0.auto_enrollment", "false"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v3.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "object_identifier"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_schema"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reenroll_all_certificate_holders"},
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Template
+	resourceName := "aws_pcaconnectorad_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PCAConnectorADEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcaconnectorad.ResourceTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Template
+	resourceName := "aws_pcaconnectorad_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PCAConnectorADEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.certificate_validity.0.validity_period.0.period", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.general_flags.0.auto_enrollment", "false"),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_updated(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.certificate_validity.0.validity_period.0.period", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.extensions.0.application_policies.0.critical", "true"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.extensions.0.application_policies.0.policy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.extensions.0.application_policies.0.policy.1.policy_object_identifier", "1.3.6.1.5.5.7.3.2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.0.general_flags.0.auto_enrollment", "true"),
+					resource.TestCheckResourceAttr(resourceName, "reenroll_all_certificate_holders", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reenroll_all_certificate_holders"},
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplate_templateV4(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Template
+	resourceName := "aws_pcaconnectorad_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PCAConnectorADEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_templateV4(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.hash_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.private_key_attributes.0.algorithm", "RSA"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.private_key_attributes.0.key_usage_property.0.property_type", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.private_key_flags.0.client_version", "WINDOWS_SERVER_2012"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.private_key_flags.0.require_same_key_renewal", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Template
+	resourceName := "aws_pcaconnectorad_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PCAConnectorADEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_tags1(rName, domain, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reenroll_all_certificate_holders"},
+			},
+			{
+				Config: testAccTemplateConfig_tags2(rName, domain, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_tags1(rName, domain, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_template" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindTemplateByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Private CA Connector for Active Directory Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateExists(ctx context.Context, n string, v *awstypes.Template) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		output, err := tfpcaconnectorad.FindTemplateByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTemplateConfig_definitionV2() string {
+	return `
+  definition {
+    template_v2 {
+      certificate_validity {
+        renewal_period {
+          period      = 6
+          period_type = "WEEKS"
+        }
+
+        validity_period {
+          period      = 1
+          period_type = "YEARS"
+        }
+      }
+
+      enrollment_flags {
+        include_symmetric_algorithms = true
+        user_interaction_required    = false
+      }
+
+      extensions {
+        application_policies {
+          policy {
+            policy_type = "CLIENT_AUTHENTICATION"
+          }
+        }
+
+        key_usage {
+          usage_flags {
+            digital_signature = true
+            key_encipherment  = true
+          }
+        }
+      }
+
+      general_flags {}
+
+      private_key_attributes {
+        key_spec           = "KEY_EXCHANGE"
+        minimal_key_length = 2048
+      }
+
+      private_key_flags {
+        client_version = "WINDOWS_SERVER_2003"
+      }
+
+      subject_name_flags {
+        san_require_upn = true
+      }
+    }
+  }
+`
+}
+
+func testAccTemplateConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn = aws_pcaconnectorad_connector.test.arn
+  name          = %[1]q
+%[2]s
+}
+`, rName, testAccTemplateConfig_definitionV2()))
+}
+
+func testAccTemplateConfig_updated(rName, domain string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn                    = aws_pcaconnectorad_connector.test.arn
+  name                             = %[1]q
+  reenroll_all_certificate_holders = true
+
+  definition {
+    template_v2 {
+      certificate_validity {
+        renewal_period {
+          period      = 6
+          period_type = "WEEKS"
+        }
+
+        validity_period {
+          period      = 2
+          period_type = "YEARS"
+        }
+      }
+
+      enrollment_flags {
+        include_symmetric_algorithms = true
+        user_interaction_required    = false
+      }
+
+      extensions {
+        application_policies {
+          critical = true
+
+          policy {
+            policy_type = "CLIENT_AUTHENTICATION"
+          }
+
+          policy {
+            policy_object_identifier = "1.3.6.1.5.5.7.3.2"
+          }
+        }
+
+        key_usage {
+          usage_flags {
+            digital_signature = true
+            key_encipherment  = true
+          }
+        }
+      }
+
+      general_flags {
+        auto_enrollment = true
+      }
+
+      private_key_attributes {
+        key_spec           = "KEY_EXCHANGE"
+        minimal_key_length = 2048
+      }
+
+      private_key_flags {
+        client_version = "WINDOWS_SERVER_2003"
+      }
+
+      subject_name_flags {
+        san_require_upn = true
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTemplateConfig_templateV4(rName, domain string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn = aws_pcaconnectorad_connector.test.arn
+  name          = %[1]q
+
+  definition {
+    template_v4 {
+      hash_algorithm = "SHA256"
+
+      certificate_validity {
+        renewal_period {
+          period      = 6
+          period_type = "WEEKS"
+        }
+
+        validity_period {
+          period      = 2
+          period_type = "YEARS"
+        }
+      }
+
+      enrollment_flags {
+        include_symmetric_algorithms = true
+      }
+
+      extensions {
+        application_policies {
+          policy {
+            policy_type = "CLIENT_AUTHENTICATION"
+          }
+        }
+
+        key_usage {
+          usage_flags {
+            digital_signature = true
+          }
+        }
+      }
+
+      general_flags {
+        machine_type = true
+      }
+
+      private_key_attributes {
+        algorithm          = "RSA"
+        key_spec           = "SIGNATURE"
+        minimal_key_length = 2048
+
+        key_usage_property {
+          property_type = "ALL"
+        }
+      }
+
+      private_key_flags {
+        client_version           = "WINDOWS_SERVER_2012"
+        require_same_key_renewal = true
+      }
+
+      subject_name_flags {
+        san_require_dns = true
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTemplateConfig_tags1(rName, domain, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn = aws_pcaconnectorad_connector.test.arn
+  name          = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccTemplateConfig_definitionV2(), tagKey1, tagValue1))
+}
+
+func testAccTemplateConfig_tags2(rName, domain, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn = aws_pcaconnectorad_connector.test.arn
+  name          = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccTemplateConfig_definitionV2(), tagKey1, tagValue1, tagKey2, tagValue2))
+}
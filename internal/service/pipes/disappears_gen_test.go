@@ -0,0 +1,19 @@
+// Code generated by internal/generate/servicepackage/main.go; DO NOT EDIT.
+
+package pipes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Finders for use in disappears tests only.
+
+// FindPipeForDisappears reads the Pipe with the specified Terraform state.
+func FindPipeForDisappears(ctx context.Context, client *conns.AWSClient, is *terraform.InstanceState) error {
+	_, err := findPipeByName(ctx, client.PipesClient(ctx), is.ID)
+
+	return err
+}
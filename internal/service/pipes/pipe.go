@@ -31,6 +31,7 @@ import (
 
 // @SDKResource("aws_pipes_pipe", name="Pipe")
 // @Tags(identifierAttribute="arn")
+// @Testing(disappearsFinder="findPipeByName")
 func resourcePipe() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePipeCreate,
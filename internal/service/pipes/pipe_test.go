@@ -97,7 +97,7 @@ func TestAccPipesPipe_disappears(t *testing.T) {
 				Config: testAccPipeConfig_basicSQS(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPipeExists(ctx, resourceName, &pipe),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpipes.ResourcePipe(), resourceName),
+					acctest.CheckResourceDisappearsWithFinder(ctx, acctest.Provider, tfpipes.ResourcePipe(), tfpipes.FindPipeForDisappears, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
 			},
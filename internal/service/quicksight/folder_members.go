@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for resource registration to the Provider. DO NOT EDIT.
+// @FrameworkResource(name="Folder Members")
+func newResourceFolderMembers(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceFolderMembers{}, nil
+}
+
+const (
+	ResNameFolderMembers = "Folder Members"
+)
+
+type resourceFolderMembers struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceFolderMembers) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_quicksight_folder_members"
+}
+
+func (r *resourceFolderMembers) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_account_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"folder_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"member": schema.SetNestedBlock{
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"member_id": schema.StringAttribute{
+							Required: true,
+						},
+						"member_type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(quicksight.MemberType_Values()...),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceFolderMembers) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().QuickSightConn(ctx)
+
+	var plan resourceFolderMembersData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AWSAccountID.IsUnknown() || plan.AWSAccountID.IsNull() {
+		plan.AWSAccountID = types.StringValue(r.Meta().AccountID)
+	}
+	plan.ID = types.StringValue(createFolderId(plan.AWSAccountID.ValueString(), plan.FolderID.ValueString()))
+
+	var members []folderMemberData
+	resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Members that were added to the folder outside of Terraform are removed.
+	existing, err := findFolderMembers(ctx, conn, plan.AWSAccountID.ValueString(), plan.FolderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, ResNameFolderMembers, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if err := updateFolderMembers(ctx, conn, plan.AWSAccountID.ValueString(), plan.FolderID.ValueString(), existing, members); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, ResNameFolderMembers, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceFolderMembers) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().QuickSightConn(ctx)
+
+	var state resourceFolderMembersData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// To support import, parse the ID for the component keys and set
+	// individual values in state
+	awsAccountID, folderID, err := ParseFolderId(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionSetting, ResNameFolderMembers, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	members, err := findFolderMembers(ctx, conn, awsAccountID, folderID)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionSetting, ResNameFolderMembers, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.AWSAccountID = types.StringValue(awsAccountID)
+	state.FolderID = types.StringValue(folderID)
+
+	membersVal, d := flattenFolderMembers(ctx, members)
+	resp.Diagnostics.Append(d...)
+	state.Members = membersVal
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceFolderMembers) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().QuickSightConn(ctx)
+
+	var plan, state resourceFolderMembersData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Members.Equal(state.Members) {
+		var planMembers, stateMembers []folderMemberData
+		resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &planMembers, false)...)
+		resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &stateMembers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateFolderMembers(ctx, conn, plan.AWSAccountID.ValueString(), plan.FolderID.ValueString(), stateMembers, planMembers); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QuickSight, create.ErrActionUpdating, ResNameFolderMembers, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceFolderMembers) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().QuickSightConn(ctx)
+
+	var state resourceFolderMembersData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members []folderMemberData
+	resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := updateFolderMembers(ctx, conn, state.AWSAccountID.ValueString(), state.FolderID.ValueString(), members, nil); err != nil {
+		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionDeleting, ResNameFolderMembers, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceFolderMembers) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateFolderMembers adds the members in new that are not in old to the folder
+// and removes the members in old that are not in new.
+func updateFolderMembers(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, folderID string, old, new []folderMemberData) error {
+	oldKeys, newKeys := make(map[string]folderMemberData), make(map[string]folderMemberData)
+	for _, v := range old {
+		oldKeys[v.key()] = v
+	}
+	for _, v := range new {
+		newKeys[v.key()] = v
+	}
+
+	for k, v := range oldKeys {
+		if _, ok := newKeys[k]; ok {
+			continue
+		}
+
+		_, err := conn.DeleteFolderMembershipWithContext(ctx, &quicksight.DeleteFolderMembershipInput{
+			AwsAccountId: aws.String(awsAccountID),
+			FolderId:     aws.String(folderID),
+			MemberId:     aws.String(v.MemberID.ValueString()),
+			MemberType:   aws.String(v.MemberType.ValueString()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("removing %s (%s) from folder: %w", v.MemberType.ValueString(), v.MemberID.ValueString(), err)
+		}
+	}
+
+	for k, v := range newKeys {
+		if _, ok := oldKeys[k]; ok {
+			continue
+		}
+
+		_, err := conn.CreateFolderMembershipWithContext(ctx, &quicksight.CreateFolderMembershipInput{
+			AwsAccountId: aws.String(awsAccountID),
+			FolderId:     aws.String(folderID),
+			MemberId:     aws.String(v.MemberID.ValueString()),
+			MemberType:   aws.String(v.MemberType.ValueString()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("adding %s (%s) to folder: %w", v.MemberType.ValueString(), v.MemberID.ValueString(), err)
+		}
+	}
+
+	return nil
+}
+
+func findFolderMembers(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, folderID string) ([]folderMemberData, error) {
+	in := &quicksight.ListFolderMembersInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	var members []folderMemberData
+	var errs []error
+	err := conn.ListFolderMembersPagesWithContext(ctx, in, func(page *quicksight.ListFolderMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FolderMemberList {
+			memberType, err := folderMemberTypeFromARN(aws.StringValue(v.MemberArn))
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			members = append(members, folderMemberData{
+				MemberID:   types.StringValue(aws.StringValue(v.MemberId)),
+				MemberType: types.StringValue(memberType),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	return members, nil
+}
+
+// folderMemberTypeFromARN returns the folder member type for the specified asset ARN,
+// e.g. "arn:aws:quicksight:us-west-2:123456789012:dataset/example" is a DATASET.
+func folderMemberTypeFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("parsing folder member ARN (%s): %w", s, err)
+	}
+
+	resourceType, _, _ := strings.Cut(v.Resource, "/")
+	for _, memberType := range quicksight.MemberType_Values() {
+		if strings.EqualFold(resourceType, memberType) {
+			return memberType, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported folder member ARN (%s)", s)
+}
+
+func flattenFolderMembers(ctx context.Context, members []folderMemberData) (types.Set, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: folderMemberAttrTypes}
+
+	if len(members) == 0 {
+		return types.SetNull(elemType), nil
+	}
+
+	return types.SetValueFrom(ctx, elemType, members)
+}
+
+var (
+	folderMemberAttrTypes = map[string]attr.Type{
+		"member_id":   types.StringType,
+		"member_type": types.StringType,
+	}
+)
+
+type resourceFolderMembersData struct {
+	AWSAccountID types.String `tfsdk:"aws_account_id"`
+	FolderID     types.String `tfsdk:"folder_id"`
+	ID           types.String `tfsdk:"id"`
+	Members      types.Set    `tfsdk:"member"`
+}
+
+type folderMemberData struct {
+	MemberID   types.String `tfsdk:"member_id"`
+	MemberType types.String `tfsdk:"member_type"`
+}
+
+func (d folderMemberData) key() string {
+	return d.MemberType.ValueString() + "," + d.MemberID.ValueString()
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQuickSightFolderMembers_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_quicksight_folder_members.test"
+	folderResourceName := "aws_quicksight_folder.test"
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderMembersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderMembersConfig_basic(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderMembersExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "folder_id", folderResourceName, "folder_id"),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "member.*", map[string]string{
+						"member_id":   rId,
+						"member_type": quicksight.MemberTypeDataset,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolderMembers_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_quicksight_folder_members.test"
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderMembersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderMembersConfig_basic(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderMembersExists(ctx, resourceName, 1),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfquicksight.ResourceFolderMembers, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolderMembers_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_quicksight_folder_members.test"
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFolderMembersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderMembersConfig_basic(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderMembersExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "1"),
+				),
+			},
+			{
+				Config: testAccFolderMembersConfig_two(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderMembersExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "member.*", map[string]string{
+						"member_id":   rId,
+						"member_type": quicksight.MemberTypeDataset,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "member.*", map[string]string{
+						"member_id":   rId + "-2",
+						"member_type": quicksight.MemberTypeDataset,
+					}),
+				),
+			},
+			{
+				Config: testAccFolderMembersConfig_second(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderMembersExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "member.*", map[string]string{
+						"member_id":   rId + "-2",
+						"member_type": quicksight.MemberTypeDataset,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFolderMembersExists(ctx context.Context, resourceName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+		output, err := tfquicksight.FindFolderMembers(ctx, conn, rs.Primary.Attributes["aws_account_id"], rs.Primary.Attributes["folder_id"])
+		if err != nil {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameFolderMembers, rs.Primary.ID, err)
+		}
+
+		if len(output) != count {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameFolderMembers, rs.Primary.ID, fmt.Errorf("expected %d members, got %d", count, len(output)))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFolderMembersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_quicksight_folder_members" {
+				continue
+			}
+
+			output, err := tfquicksight.FindFolderMembers(ctx, conn, rs.Primary.Attributes["aws_account_id"], rs.Primary.Attributes["folder_id"])
+			if tfresource.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return create.Error(names.QuickSight, create.ErrActionCheckingDestroyed, tfquicksight.ResNameFolderMembers, rs.Primary.ID, nil)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccFolderMembersConfigBase(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfigBasic(rId, rName),
+		testAccFolderConfig_basic(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test2" {
+  data_set_id = "%[1]s-2"
+  name        = "%[2]s-2"
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+      upload_settings {
+        format = "JSON"
+      }
+    }
+  }
+}
+`, rId, rName))
+}
+
+func testAccFolderMembersConfig_basic(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccFolderMembersConfigBase(rId, rName),
+		`
+resource "aws_quicksight_folder_members" "test" {
+  folder_id = aws_quicksight_folder.test.folder_id
+
+  member {
+    member_type = "DATASET"
+    member_id   = aws_quicksight_data_set.test.data_set_id
+  }
+}
+`)
+}
+
+func testAccFolderMembersConfig_two(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccFolderMembersConfigBase(rId, rName),
+		`
+resource "aws_quicksight_folder_members" "test" {
+  folder_id = aws_quicksight_folder.test.folder_id
+
+  member {
+    member_type = "DATASET"
+    member_id   = aws_quicksight_data_set.test.data_set_id
+  }
+
+  member {
+    member_type = "DATASET"
+    member_id   = aws_quicksight_data_set.test2.data_set_id
+  }
+}
+`)
+}
+
+func testAccFolderMembersConfig_second(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccFolderMembersConfigBase(rId, rName),
+		`
+resource "aws_quicksight_folder_members" "test" {
+  folder_id = aws_quicksight_folder.test.folder_id
+
+  member {
+    member_type = "DATASET"
+    member_id   = aws_quicksight_data_set.test2.data_set_id
+  }
+}
+`)
+}
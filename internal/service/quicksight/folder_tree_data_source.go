@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_quicksight_folder_tree", name="Folder Tree")
+func DataSourceFolderTree() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFolderTreeRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"aws_account_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"folder_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"folders": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"arn": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"depth": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"folder_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"members": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"member_id": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										"member_type": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+									},
+								},
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"parent_folder_arn": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"include_members": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceFolderTreeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	folderID := d.Get("folder_id").(string)
+	id := createFolderId(awsAccountID, folderID)
+
+	root, err := FindFolderByID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading QuickSight Folder (%s): %s", id, err)
+	}
+
+	includeMembers := d.Get("include_members").(bool)
+	var folders []interface{}
+
+	// Walk the tree breadth first, searching for the subfolders of each folder in turn.
+	type node struct {
+		arn   string
+		depth int
+	}
+	queue := []node{{arn: aws.StringValue(root.Arn), depth: 0}}
+
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+
+		subfolders, err := findFoldersByParentFolderARN(ctx, conn, awsAccountID, parent.arn)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "searching QuickSight Folders (%s): %s", parent.arn, err)
+		}
+
+		for _, v := range subfolders {
+			tfMap := map[string]interface{}{
+				"arn":               aws.StringValue(v.Arn),
+				"depth":             parent.depth + 1,
+				"folder_id":         aws.StringValue(v.FolderId),
+				"name":              aws.StringValue(v.Name),
+				"parent_folder_arn": parent.arn,
+			}
+
+			if includeMembers {
+				members, err := findFolderMembers(ctx, conn, awsAccountID, aws.StringValue(v.FolderId))
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "listing QuickSight Folder (%s) members: %s", aws.StringValue(v.FolderId), err)
+				}
+
+				tfMap["members"] = flattenFolderTreeMembers(members)
+			}
+
+			folders = append(folders, tfMap)
+			queue = append(queue, node{arn: aws.StringValue(v.Arn), depth: parent.depth + 1})
+		}
+	}
+
+	d.SetId(id)
+	d.Set("arn", root.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("folder_id", root.FolderId)
+	if err := d.Set("folders", folders); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting folders: %s", err)
+	}
+	d.Set("name", root.Name)
+
+	return diags
+}
+
+func findFoldersByParentFolderARN(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, parentFolderARN string) ([]*quicksight.FolderSummary, error) {
+	input := &quicksight.SearchFoldersInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Filters: []*quicksight.FolderSearchFilter{
+			{
+				Name:     aws.String(quicksight.FolderFilterAttributeParentFolderArn),
+				Operator: aws.String(quicksight.FilterOperatorStringEquals),
+				Value:    aws.String(parentFolderARN),
+			},
+		},
+	}
+	var output []*quicksight.FolderSummary
+
+	err := conn.SearchFoldersPagesWithContext(ctx, input, func(page *quicksight.SearchFoldersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FolderSummaryList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenFolderTreeMembers(members []folderMemberData) []interface{} {
+	tfList := make([]interface{}, 0, len(members))
+
+	for _, v := range members {
+		tfList = append(tfList, map[string]interface{}{
+			"member_id":   v.MemberID.ValueString(),
+			"member_type": v.MemberType.ValueString(),
+		})
+	}
+
+	return tfList
+}
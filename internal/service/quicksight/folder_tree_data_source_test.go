@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccQuickSightFolderTreeDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_quicksight_folder_tree.test"
+	rootResourceName := "aws_quicksight_folder.root"
+	childResourceName := "aws_quicksight_folder.child"
+	grandchildResourceName := "aws_quicksight_folder.grandchild"
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderTreeDataSourceConfig_basic(rId, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", rootResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", rootResourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "folders.0.arn", childResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.0.depth", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "folders.0.parent_folder_arn", rootResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "folders.1.arn", grandchildResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.1.depth", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "folders.1.parent_folder_arn", childResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFolderTreeDataSourceConfig_basic(rId, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "root" {
+  folder_id = %[1]q
+  name      = %[2]q
+}
+
+resource "aws_quicksight_folder" "child" {
+  folder_id         = "%[1]s-child"
+  name              = "%[2]s-child"
+  parent_folder_arn = aws_quicksight_folder.root.arn
+}
+
+resource "aws_quicksight_folder" "grandchild" {
+  folder_id         = "%[1]s-grandchild"
+  name              = "%[2]s-grandchild"
+  parent_folder_arn = aws_quicksight_folder.child.arn
+}
+
+data "aws_quicksight_folder_tree" "test" {
+  folder_id = aws_quicksight_folder.root.folder_id
+
+  depends_on = [aws_quicksight_folder.grandchild]
+}
+`, rId, rName)
+}
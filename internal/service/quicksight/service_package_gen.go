@@ -21,6 +21,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceFolderMembers,
+			Name:    "Folder Members",
+		},
 		{
 			Factory: newResourceFolderMembership,
 			Name:    "Folder Membership",
@@ -65,6 +69,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_quicksight_data_set",
 			Name:     "Data Set",
 		},
+		{
+			Factory:  DataSourceFolderTree,
+			TypeName: "aws_quicksight_folder_tree",
+			Name:     "Folder Tree",
+		},
 		{
 			Factory:  DataSourceGroup,
 			TypeName: "aws_quicksight_group",
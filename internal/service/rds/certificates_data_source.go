@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_rds_certificates")
+func DataSourceCertificates() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCertificatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"customer_override": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"customer_override_valid_till": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thumbprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"valid_from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"valid_till": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"default_certificate_for_new_launches": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	input := &rds.DescribeCertificatesInput{}
+	var certificates []*rds.Certificate
+	var defaultCertificateForNewLaunches *string
+
+	err := conn.DescribeCertificatesPagesWithContext(ctx, input, func(page *rds.DescribeCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		if page.DefaultCertificateForNewLaunches != nil {
+			defaultCertificateForNewLaunches = page.DefaultCertificateForNewLaunches
+		}
+
+		for _, v := range page.Certificates {
+			if v != nil {
+				certificates = append(certificates, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Certificates: %s", err)
+	}
+
+	var ids []string
+	for _, v := range certificates {
+		ids = append(ids, aws.StringValue(v.CertificateIdentifier))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("certificates", flattenCertificates(certificates)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting certificates: %s", err)
+	}
+	d.Set("default_certificate_for_new_launches", defaultCertificateForNewLaunches)
+	d.Set("ids", ids)
+
+	return diags
+}
+
+func flattenCertificates(apiObjects []*rds.Certificate) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":                    aws.StringValue(apiObject.CertificateArn),
+			"certificate_identifier": aws.StringValue(apiObject.CertificateIdentifier),
+			"certificate_type":       aws.StringValue(apiObject.CertificateType),
+			"customer_override":      aws.BoolValue(apiObject.CustomerOverride),
+			"thumbprint":             aws.StringValue(apiObject.Thumbprint),
+		}
+
+		if v := apiObject.CustomerOverrideValidTill; v != nil {
+			tfMap["customer_override_valid_till"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.ValidFrom; v != nil {
+			tfMap["valid_from"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.ValidTill; v != nil {
+			tfMap["valid_till"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
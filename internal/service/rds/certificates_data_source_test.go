@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRDSCertificatesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_certificates.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccCertificatePreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificatesDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "certificates.#", 0),
+					acctest.MatchResourceAttrRegionalARNNoAccount(dataSourceName, "certificates.0.arn", "rds", regexache.MustCompile(`cert:rds-ca-[-0-9a-z]+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "certificates.0.certificate_type", "CA"),
+					resource.TestMatchResourceAttr(dataSourceName, "certificates.0.valid_from", regexache.MustCompile(acctest.RFC3339RegexPattern)),
+					resource.TestMatchResourceAttr(dataSourceName, "certificates.0.valid_till", regexache.MustCompile(acctest.RFC3339RegexPattern)),
+					resource.TestMatchResourceAttr(dataSourceName, "default_certificate_for_new_launches", regexache.MustCompile(`^rds-ca-[-0-9a-z]+$`)),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "ids.#", 0),
+				),
+			},
+		},
+	})
+}
+
+const testAccCertificatesDataSourceConfig_basic = `
+data "aws_rds_certificates" "test" {}
+`
@@ -429,7 +429,9 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 
 	setTagsOut(ctx, db.TagList)
 
-	return nil
+	diags = checkCACertificateExpiry(ctx, conn, diags, d.Id(), aws.StringValue(db.CACertificateIdentifier))
+
+	return diags
 }
 
 func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
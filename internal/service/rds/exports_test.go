@@ -11,6 +11,8 @@ var (
 
 	NewBlueGreenOrchestrator = newBlueGreenOrchestrator
 
+	ResourceShardGroup = newResourceShardGroup
+
 	WaitBlueGreenDeploymentDeleted   = waitBlueGreenDeploymentDeleted
 	WaitBlueGreenDeploymentAvailable = waitBlueGreenDeploymentAvailable
 
@@ -223,3 +223,32 @@ func FindReservedDBInstanceByID(ctx context.Context, conn *rds.RDS, id string) (
 
 	return output.ReservedDBInstances[0], nil
 }
+
+func FindCertificateByID(ctx context.Context, conn *rds.RDS, id string) (*rds.Certificate, error) {
+	input := &rds.DescribeCertificatesInput{
+		CertificateIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeCertificatesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeCertificateNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Certificates) == 0 || output.Certificates[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Certificates); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Certificates[0], nil
+}
@@ -1788,6 +1788,8 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	setTagsOut(ctx, v.TagList)
 
+	diags = checkCACertificateExpiry(ctx, conn, diags, aws.StringValue(v.DBInstanceIdentifier), aws.StringValue(v.CACertificateIdentifier))
+
 	return diags
 }
 
@@ -26,6 +26,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newResourceExportTask,
 		},
+		{
+			Factory: newResourceShardGroup,
+			Name:    "Shard Group",
+		},
 	}
 }
 
@@ -69,6 +73,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCertificate,
 			TypeName: "aws_rds_certificate",
 		},
+		{
+			Factory:  DataSourceCertificates,
+			TypeName: "aws_rds_certificates",
+		},
 		{
 			Factory:  DataSourceCluster,
 			TypeName: "aws_rds_cluster",
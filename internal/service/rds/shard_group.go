@@ -0,0 +1,456 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Shard Group")
+func newResourceShardGroup(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceShardGroup{}
+	r.SetDefaultCreateTimeout(45 * time.Minute)
+	r.SetDefaultUpdateTimeout(45 * time.Minute)
+	r.SetDefaultDeleteTimeout(45 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameShardGroup = "Shard Group"
+
+	// Use string constants as the RDS package does not provide status enums
+	ShardGroupStatusAvailable = "available"
+	ShardGroupStatusCreating  = "creating"
+	ShardGroupStatusDeleting  = "deleting"
+	ShardGroupStatusModifying = "modifying"
+	ShardGroupStatusRebooting = "rebooting"
+)
+
+type resourceShardGroup struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceShardGroup) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_rds_shard_group"
+}
+
+func (r *resourceShardGroup) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compute_redundancy": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 2),
+				},
+			},
+			"db_cluster_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"db_shard_group_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"db_shard_group_resource_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"max_acu": schema.Float64Attribute{
+				Required: true,
+			},
+			"min_acu": schema.Float64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"publicly_accessible": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceShardGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().RDSClient(ctx)
+
+	var plan resourceShardGroupData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &rds.CreateDBShardGroupInput{
+		DBClusterIdentifier:    aws.String(plan.DBClusterIdentifier.ValueString()),
+		DBShardGroupIdentifier: aws.String(plan.DBShardGroupIdentifier.ValueString()),
+		MaxACU:                 aws.Float64(plan.MaxACU.ValueFloat64()),
+	}
+	if !plan.ComputeRedundancy.IsNull() && !plan.ComputeRedundancy.IsUnknown() {
+		in.ComputeRedundancy = flex.Int32FromFramework(ctx, plan.ComputeRedundancy)
+	}
+	if !plan.MinACU.IsNull() && !plan.MinACU.IsUnknown() {
+		in.MinACU = aws.Float64(plan.MinACU.ValueFloat64())
+	}
+	if !plan.PubliclyAccessible.IsNull() && !plan.PubliclyAccessible.IsUnknown() {
+		in.PubliclyAccessible = aws.Bool(plan.PubliclyAccessible.ValueBool())
+	}
+
+	_, err := conn.CreateDBShardGroup(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionCreating, ResNameShardGroup, plan.DBShardGroupIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.DBShardGroupIdentifier.ValueString())
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	out, err := waitShardGroupCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForCreation, ResNameShardGroup, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The owning cluster is modified while the shard group is provisioned.
+	if _, err := waitDBClusterUpdated(ctx, r.Meta().RDSConn(ctx), plan.DBClusterIdentifier.ValueString(), createTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForCreation, ResNameShardGroup, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.refreshFromOutput(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceShardGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().RDSClient(ctx)
+
+	var state resourceShardGroupData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindShardGroupByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddWarning(
+			"AWS Resource Not Found During Refresh",
+			fmt.Sprintf("Automatically removing from Terraform State instead of returning the error, which may trigger resource recreation. Original Error: %s", err.Error()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ResNameShardGroup, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceShardGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().RDSClient(ctx)
+
+	var plan, state resourceShardGroupData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ComputeRedundancy.Equal(state.ComputeRedundancy) ||
+		!plan.MaxACU.Equal(state.MaxACU) ||
+		!plan.MinACU.Equal(state.MinACU) {
+		in := &rds.ModifyDBShardGroupInput{
+			DBShardGroupIdentifier: aws.String(plan.ID.ValueString()),
+			MaxACU:                 aws.Float64(plan.MaxACU.ValueFloat64()),
+		}
+		if !plan.ComputeRedundancy.IsNull() && !plan.ComputeRedundancy.IsUnknown() {
+			in.ComputeRedundancy = flex.Int32FromFramework(ctx, plan.ComputeRedundancy)
+		}
+		if !plan.MinACU.IsNull() && !plan.MinACU.IsUnknown() {
+			in.MinACU = aws.Float64(plan.MinACU.ValueFloat64())
+		}
+
+		_, err := conn.ModifyDBShardGroup(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.RDS, create.ErrActionUpdating, ResNameShardGroup, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		out, err := waitShardGroupUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForUpdate, ResNameShardGroup, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, r.Meta().RDSConn(ctx), plan.DBClusterIdentifier.ValueString(), updateTimeout); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForUpdate, ResNameShardGroup, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		plan.refreshFromOutput(ctx, out)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceShardGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().RDSClient(ctx)
+
+	var state resourceShardGroupData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteDBShardGroup(ctx, &rds.DeleteDBShardGroupInput{
+		DBShardGroupIdentifier: aws.String(state.ID.ValueString()),
+	})
+	if err != nil {
+		var nfe *awstypes.DBShardGroupNotFoundFault
+		if errors.As(err, &nfe) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionDeleting, ResNameShardGroup, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	if _, err := waitShardGroupDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForDeletion, ResNameShardGroup, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitDBClusterUpdated(ctx, r.Meta().RDSConn(ctx), state.DBClusterIdentifier.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionWaitingForDeletion, ResNameShardGroup, state.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourceShardGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func FindShardGroupByID(ctx context.Context, conn *rds.Client, id string) (*awstypes.DBShardGroup, error) {
+	in := &rds.DescribeDBShardGroupsInput{
+		DBShardGroupIdentifier: aws.String(id),
+	}
+
+	out, err := conn.DescribeDBShardGroups(ctx, in)
+	if err != nil {
+		var nfe *awstypes.DBShardGroupNotFoundFault
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || len(out.DBShardGroups) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if count := len(out.DBShardGroups); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, in)
+	}
+
+	return &out.DBShardGroups[0], nil
+}
+
+func statusShardGroup(ctx context.Context, conn *rds.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindShardGroupByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.ToString(out.Status), nil
+	}
+}
+
+func waitShardGroupCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.DBShardGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ShardGroupStatusCreating},
+		Target:     []string{ShardGroupStatusAvailable},
+		Refresh:    statusShardGroup(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.DBShardGroup); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitShardGroupUpdated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.DBShardGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ShardGroupStatusModifying, ShardGroupStatusRebooting},
+		Target:     []string{ShardGroupStatusAvailable},
+		Refresh:    statusShardGroup(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.DBShardGroup); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitShardGroupDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.DBShardGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ShardGroupStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusShardGroup(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.DBShardGroup); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+type resourceShardGroupData struct {
+	ARN                    types.String   `tfsdk:"arn"`
+	ComputeRedundancy      types.Int64    `tfsdk:"compute_redundancy"`
+	DBClusterIdentifier    types.String   `tfsdk:"db_cluster_identifier"`
+	DBShardGroupIdentifier types.String   `tfsdk:"db_shard_group_identifier"`
+	DBShardGroupResourceID types.String   `tfsdk:"db_shard_group_resource_id"`
+	Endpoint               types.String   `tfsdk:"endpoint"`
+	ID                     types.String   `tfsdk:"id"`
+	MaxACU                 types.Float64  `tfsdk:"max_acu"`
+	MinACU                 types.Float64  `tfsdk:"min_acu"`
+	PubliclyAccessible     types.Bool     `tfsdk:"publicly_accessible"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+// refreshFromOutput writes state data from an AWS response object
+func (rd *resourceShardGroupData) refreshFromOutput(ctx context.Context, out *awstypes.DBShardGroup) {
+	if out == nil {
+		return
+	}
+
+	rd.ID = flex.StringToFramework(ctx, out.DBShardGroupIdentifier)
+	rd.ARN = flex.StringToFramework(ctx, out.DBShardGroupArn)
+	rd.ComputeRedundancy = flex.Int32ToFramework(ctx, out.ComputeRedundancy)
+	rd.DBClusterIdentifier = flex.StringToFramework(ctx, out.DBClusterIdentifier)
+	rd.DBShardGroupIdentifier = flex.StringToFramework(ctx, out.DBShardGroupIdentifier)
+	rd.DBShardGroupResourceID = flex.StringToFramework(ctx, out.DBShardGroupResourceId)
+	rd.Endpoint = flex.StringToFramework(ctx, out.Endpoint)
+	rd.MaxACU = flex.Float64ToFramework(ctx, out.MaxACU)
+	rd.MinACU = flex.Float64ToFramework(ctx, out.MinACU)
+	rd.PubliclyAccessible = flex.BoolToFramework(ctx, out.PubliclyAccessible)
+}
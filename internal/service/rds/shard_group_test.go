@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	rdsv1 "github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSShardGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var shardGroup types.DBShardGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_shard_group.test"
+	// Requires an existing Aurora PostgreSQL Limitless Database DB cluster.
+	clusterID := acctest.SkipIfEnvVarNotSet(t, "RDS_LIMITLESS_CLUSTER_IDENTIFIER")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, rdsv1.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rdsv1.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckShardGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShardGroupConfig_basic(rName, clusterID, 16),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShardGroupExists(ctx, resourceName, &shardGroup),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexache.MustCompile(`shard-group:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "compute_redundancy", "0"),
+					resource.TestCheckResourceAttr(resourceName, "db_cluster_identifier", clusterID),
+					resource.TestCheckResourceAttr(resourceName, "db_shard_group_identifier", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "db_shard_group_resource_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "max_acu", "16"),
+					resource.TestCheckResourceAttrSet(resourceName, "min_acu"),
+					resource.TestCheckResourceAttrSet(resourceName, "publicly_accessible"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccShardGroupConfig_basic(rName, clusterID, 32),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShardGroupExists(ctx, resourceName, &shardGroup),
+					resource.TestCheckResourceAttr(resourceName, "max_acu", "32"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSShardGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var shardGroup types.DBShardGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_shard_group.test"
+	clusterID := acctest.SkipIfEnvVarNotSet(t, "RDS_LIMITLESS_CLUSTER_IDENTIFIER")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, rdsv1.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rdsv1.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckShardGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShardGroupConfig_basic(rName, clusterID, 16),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShardGroupExists(ctx, resourceName, &shardGroup),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfrds.ResourceShardGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSShardGroup_computeRedundancy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var shardGroup types.DBShardGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_shard_group.test"
+	clusterID := acctest.SkipIfEnvVarNotSet(t, "RDS_LIMITLESS_CLUSTER_IDENTIFIER")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, rdsv1.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rdsv1.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckShardGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShardGroupConfig_computeRedundancy(rName, clusterID, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShardGroupExists(ctx, resourceName, &shardGroup),
+					resource.TestCheckResourceAttr(resourceName, "compute_redundancy", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_acu", "28"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
+				),
+			},
+			{
+				Config: testAccShardGroupConfig_computeRedundancy(rName, clusterID, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShardGroupExists(ctx, resourceName, &shardGroup),
+					resource.TestCheckResourceAttr(resourceName, "compute_redundancy", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckShardGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_shard_group" {
+				continue
+			}
+
+			_, err := tfrds.FindShardGroupByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.RDS, create.ErrActionCheckingDestroyed, tfrds.ResNameShardGroup, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckShardGroupExists(ctx context.Context, name string, shardGroup *types.DBShardGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.RDS, create.ErrActionCheckingExistence, tfrds.ResNameShardGroup, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.RDS, create.ErrActionCheckingExistence, tfrds.ResNameShardGroup, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+		output, err := tfrds.FindShardGroupByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.RDS, create.ErrActionCheckingExistence, tfrds.ResNameShardGroup, rs.Primary.ID, err)
+		}
+
+		*shardGroup = *output
+
+		return nil
+	}
+}
+
+func testAccShardGroupConfig_basic(rName, clusterID string, maxACU int) string {
+	return fmt.Sprintf(`
+resource "aws_rds_shard_group" "test" {
+  db_shard_group_identifier = %[1]q
+  db_cluster_identifier     = %[2]q
+  max_acu                   = %[3]d
+}
+`, rName, clusterID, maxACU)
+}
+
+func testAccShardGroupConfig_computeRedundancy(rName, clusterID string, computeRedundancy int) string {
+	return fmt.Sprintf(`
+resource "aws_rds_shard_group" "test" {
+  db_shard_group_identifier = %[1]q
+  db_cluster_identifier     = %[2]q
+  compute_redundancy        = %[3]d
+  max_acu                   = 768
+  min_acu                   = 28
+  publicly_accessible       = false
+}
+`, rName, clusterID, computeRedundancy)
+}
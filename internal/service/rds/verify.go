@@ -4,9 +4,21 @@
 package rds
 
 import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
+// caCertificateExpiryWarningPeriod is how long before its CA certificate expires
+// that a DB instance starts reporting a warning.
+const caCertificateExpiryWarningPeriod = 30 * 24 * time.Hour
+
 // compareActualEngineVersion sets engine version related attributes
 //
 // `engine_version_actual` is always set to newVersion
@@ -34,3 +46,28 @@ func compareActualEngineVersion(d *schema.ResourceData, oldVersion, newVersion,
 
 	d.Set("engine_version", newVersion)
 }
+
+// checkCACertificateExpiry warns when the CA certificate attached to a DB instance
+// expires within caCertificateExpiryWarningPeriod.
+// Lookup failures are logged rather than returned so that reading the instance
+// doesn't require permission to describe certificates.
+func checkCACertificateExpiry(ctx context.Context, conn *rds.RDS, diags diag.Diagnostics, instanceID, caCertID string) diag.Diagnostics {
+	if caCertID == "" {
+		return diags
+	}
+
+	certificate, err := FindCertificateByID(ctx, conn, caCertID)
+
+	if err != nil {
+		log.Printf("[WARN] reading RDS Certificate (%s): %s", caCertID, err)
+		return diags
+	}
+
+	validTill := aws.TimeValue(certificate.ValidTill)
+
+	if validTill.IsZero() || time.Until(validTill) > caCertificateExpiryWarningPeriod {
+		return diags
+	}
+
+	return sdkdiag.AppendWarningf(diags, "RDS DB Instance (%s) CA certificate (%s) expires at %s; set ca_cert_identifier to a newer CA certificate", instanceID, caCertID, validTill.Format(time.RFC3339))
+}
@@ -53,10 +53,11 @@ func (r *resourceIndex) Metadata(_ context.Context, request resource.MetadataReq
 func (r *resourceIndex) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			names.AttrARN:     framework.ARNAttributeComputedOnly(),
-			names.AttrID:      framework.IDAttribute(),
-			names.AttrTags:    tftags.TagsAttribute(),
-			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrAdoptExisting: framework.AdoptExistingAttribute(),
+			names.AttrARN:           framework.ARNAttributeComputedOnly(),
+			names.AttrID:            framework.IDAttribute(),
+			names.AttrTags:          tftags.TagsAttribute(),
+			names.AttrTagsAll:       tftags.TagsAttributeComputedOnly(),
 			"type": schema.StringAttribute{
 				CustomType: fwtypes.StringEnumType[awstypes.IndexType](),
 				Required:   true,
@@ -90,6 +91,13 @@ func (r *resourceIndex) Create(ctx context.Context, request resource.CreateReque
 
 	output, err := conn.CreateIndex(ctx, input)
 
+	// Only one index is allowed per Region.
+	if errs.IsA[*awstypes.ConflictException](err) {
+		response.Diagnostics.Append(fwdiag.NewAlreadyExistsErrorDiagnostic("creating Resource Explorer Index", err.Error()))
+
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError("creating Resource Explorer Index", err.Error())
 
@@ -133,6 +141,40 @@ func (r *resourceIndex) Create(ctx context.Context, request resource.CreateReque
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+func (r *resourceIndex) AdoptExisting(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data indexResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+
+	output, err := waitIndexCreated(ctx, conn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError("reading existing Resource Explorer Index", err.Error())
+
+		return
+	}
+
+	// Keep the configured tags; the next refresh reports any drift.
+	tags := data.Tags
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.ARN
+	data.Tags = tags
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
 func (r *resourceIndex) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	var data indexResourceModel
 
@@ -165,6 +207,10 @@ func (r *resourceIndex) Read(ctx context.Context, request resource.ReadRequest,
 		return
 	}
 
+	if data.AdoptExisting.IsNull() {
+		data.AdoptExisting = types.BoolValue(false)
+	}
+
 	setTagsOut(ctx, output.Tags)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -230,6 +276,10 @@ func (r *resourceIndex) Delete(ctx context.Context, request resource.DeleteReque
 		Arn: flex.StringFromFramework(ctx, data.ARN),
 	})
 
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Explorer Index (%s)", data.ID.ValueString()), err.Error())
 
@@ -250,12 +300,13 @@ func (r *resourceIndex) ModifyPlan(ctx context.Context, request resource.ModifyP
 
 // See https://docs.aws.amazon.com/resource-explorer/latest/apireference/API_Index.html.
 type indexResourceModel struct {
-	ARN      types.String                           `tfsdk:"arn"`
-	ID       types.String                           `tfsdk:"id"`
-	Tags     types.Map                              `tfsdk:"tags"`
-	TagsAll  types.Map                              `tfsdk:"tags_all"`
-	Timeouts timeouts.Value                         `tfsdk:"timeouts"`
-	Type     fwtypes.StringEnum[awstypes.IndexType] `tfsdk:"type"`
+	AdoptExisting types.Bool                             `tfsdk:"adopt_existing"`
+	ARN           types.String                           `tfsdk:"arn"`
+	ID            types.String                           `tfsdk:"id"`
+	Tags          types.Map                              `tfsdk:"tags"`
+	TagsAll       types.Map                              `tfsdk:"tags_all"`
+	Timeouts      timeouts.Value                         `tfsdk:"timeouts"`
+	Type          fwtypes.StringEnum[awstypes.IndexType] `tfsdk:"type"`
 }
 
 func findIndex(ctx context.Context, conn *resourceexplorer2.Client) (*resourceexplorer2.GetIndexOutput, error) {
@@ -48,6 +48,45 @@ func testAccIndex_basic(t *testing.T) {
 	})
 }
 
+func testAccIndex_adoptExisting(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourceexplorer2_index.test"
+	adoptedResourceName := "aws_resourceexplorer2_index.adopted"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "false"),
+				),
+			},
+			{
+				Config:      testAccIndexConfig_adoptExisting(false),
+				ExpectError: regexache.MustCompile(`ConflictException`),
+			},
+			{
+				Config: testAccIndexConfig_adoptExisting(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, adoptedResourceName),
+					resource.TestCheckResourceAttr(adoptedResourceName, "adopt_existing", "true"),
+					resource.TestCheckResourceAttrPair(adoptedResourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(adoptedResourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(adoptedResourceName, "type", "LOCAL"),
+				),
+			},
+		},
+	})
+}
+
 func testAccIndex_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_resourceexplorer2_index.test"
@@ -227,6 +266,21 @@ resource "aws_resourceexplorer2_index" "test" {
 `, tagKey1, tagValue1, tagKey2, tagValue2)
 }
 
+func testAccIndexConfig_adoptExisting(adoptExisting bool) string {
+	return fmt.Sprintf(`
+resource "aws_resourceexplorer2_index" "test" {
+  type = "LOCAL"
+}
+
+resource "aws_resourceexplorer2_index" "adopted" {
+  adopt_existing = %[1]t
+  type           = "LOCAL"
+
+  depends_on = [aws_resourceexplorer2_index.test]
+}
+`, adoptExisting)
+}
+
 func testAccIndexConfig_type(typ string) string {
 	return fmt.Sprintf(`
 resource "aws_resourceexplorer2_index" "test" {
@@ -14,10 +14,14 @@ func TestAccResourceExplorer2_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Index": {
-			"basic":      testAccIndex_basic,
-			"disappears": testAccIndex_disappears,
-			"tags":       testAccIndex_tags,
-			"type":       testAccIndex_type,
+			"basic":         testAccIndex_basic,
+			"adoptExisting": testAccIndex_adoptExisting,
+			"disappears":    testAccIndex_disappears,
+			"tags":          testAccIndex_tags,
+			"type":          testAccIndex_type,
+		},
+		"SearchDataSource": {
+			"basic": testAccSearchDataSource_basic,
 		},
 		"View": {
 			"basic":       testAccView_basic,
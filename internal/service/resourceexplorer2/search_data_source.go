@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource(name="Search")
+func newDataSourceSearch(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceSearch{}, nil
+}
+
+type dataSourceSearch struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceSearch) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_resourceexplorer2_search"
+}
+
+func (d *dataSourceSearch) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"query_string": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1280),
+				},
+			},
+			"resource_arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"resource_count": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[resourceCountModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[resourceCountModel](ctx),
+				Computed:    true,
+			},
+			"resources": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[resourceModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[resourceModel](ctx),
+				Computed:    true,
+			},
+			"view_arn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceSearch) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data searchDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ResourceExplorer2Client(ctx)
+
+	input := &resourceexplorer2.SearchInput{
+		QueryString: flex.StringFromFramework(ctx, data.QueryString),
+		ViewArn:     flex.StringFromFramework(ctx, data.ViewARN),
+	}
+
+	var count *awstypes.ResourceCount
+	var resources []awstypes.Resource
+	var viewARN string
+
+	pages := resourceexplorer2.NewSearchPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("searching Resource Explorer (%s)", data.QueryString.ValueString()), err.Error())
+
+			return
+		}
+
+		if count == nil {
+			count = page.Count
+		}
+		resources = append(resources, page.Resources...)
+		viewARN = aws.ToString(page.ViewArn)
+	}
+
+	var resourceARNs []string
+	var resourceModels []*resourceModel
+
+	for _, v := range resources {
+		model, err := flattenResource(ctx, v)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer resource (%s) properties", aws.ToString(v.Arn)), err.Error())
+
+			return
+		}
+
+		resourceARNs = append(resourceARNs, aws.ToString(v.Arn))
+		resourceModels = append(resourceModels, model)
+	}
+
+	data.ID = types.StringValue(viewARN)
+	data.ResourceARNs = flex.FlattenFrameworkStringValueListLegacy(ctx, resourceARNs)
+	data.ResourceCount = fwtypes.NewListNestedObjectValueOfNull[resourceCountModel](ctx)
+	if count != nil {
+		data.ResourceCount = fwtypes.NewListNestedObjectValueOfPtr(ctx, &resourceCountModel{
+			Complete:       types.BoolValue(aws.ToBool(count.Complete)),
+			TotalResources: types.Int64Value(aws.ToInt64(count.TotalResources)),
+		})
+	}
+	data.Resources = fwtypes.NewListNestedObjectValueOfSlice(ctx, resourceModels)
+	data.ViewARN = types.StringValue(viewARN)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func flattenResource(ctx context.Context, apiObject awstypes.Resource) (*resourceModel, error) {
+	var properties []*resourcePropertyModel
+
+	for _, v := range apiObject.Properties {
+		property := &resourcePropertyModel{
+			Data:           types.StringNull(),
+			LastReportedAt: flattenTimestamp(v.LastReportedAt),
+			Name:           flex.StringToFramework(ctx, v.Name),
+		}
+
+		if v.Data != nil {
+			b, err := v.Data.MarshalSmithyDocument()
+
+			if err != nil {
+				return nil, err
+			}
+
+			property.Data = types.StringValue(string(b))
+		}
+
+		properties = append(properties, property)
+	}
+
+	return &resourceModel{
+		ARN:             flex.StringToFramework(ctx, apiObject.Arn),
+		LastReportedAt:  flattenTimestamp(apiObject.LastReportedAt),
+		OwningAccountID: flex.StringToFramework(ctx, apiObject.OwningAccountId),
+		Properties:      fwtypes.NewListNestedObjectValueOfSlice(ctx, properties),
+		Region:          flex.StringToFramework(ctx, apiObject.Region),
+		ResourceType:    flex.StringToFramework(ctx, apiObject.ResourceType),
+		Service:         flex.StringToFramework(ctx, apiObject.Service),
+	}, nil
+}
+
+func flattenTimestamp(v *time.Time) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(v.Format(time.RFC3339))
+}
+
+// See https://docs.aws.amazon.com/resource-explorer/latest/apireference/API_Search.html.
+type searchDataSourceModel struct {
+	ID            types.String                                        `tfsdk:"id"`
+	QueryString   types.String                                        `tfsdk:"query_string"`
+	ResourceARNs  types.List                                          `tfsdk:"resource_arns"`
+	ResourceCount fwtypes.ListNestedObjectValueOf[resourceCountModel] `tfsdk:"resource_count"`
+	Resources     fwtypes.ListNestedObjectValueOf[resourceModel]      `tfsdk:"resources"`
+	ViewARN       types.String                                        `tfsdk:"view_arn"`
+}
+
+type resourceCountModel struct {
+	Complete       types.Bool  `tfsdk:"complete"`
+	TotalResources types.Int64 `tfsdk:"total_resources"`
+}
+
+type resourceModel struct {
+	ARN             types.String                                           `tfsdk:"arn"`
+	LastReportedAt  types.String                                           `tfsdk:"last_reported_at"`
+	OwningAccountID types.String                                           `tfsdk:"owning_account_id"`
+	Properties      fwtypes.ListNestedObjectValueOf[resourcePropertyModel] `tfsdk:"properties"`
+	Region          types.String                                           `tfsdk:"region"`
+	ResourceType    types.String                                           `tfsdk:"resource_type"`
+	Service         types.String                                           `tfsdk:"service"`
+}
+
+type resourcePropertyModel struct {
+	Data           types.String `tfsdk:"data"`
+	LastReportedAt types.String `tfsdk:"last_reported_at"`
+	Name           types.String `tfsdk:"name"`
+}
@@ -10,4 +10,6 @@ var (
 
 	FindGroupByName          = findGroupByName
 	FindResourceByTwoPartKey = findResourceByTwoPartKey
+
+	ValidateGroupConfigurationItems = validateGroupConfigurationItems
 )
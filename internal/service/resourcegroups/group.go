@@ -6,13 +6,16 @@ package resourcegroups
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -112,7 +115,10 @@ func resourceGroup() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			groupConfigurationCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -291,6 +297,151 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
+// Service configuration types with parameters validated at plan time.
+// See https://docs.aws.amazon.com/ARG/latest/APIReference/about-slg.html.
+const (
+	groupConfigurationTypeCapacityReservationPool = "AWS::EC2::CapacityReservationPool"
+	groupConfigurationTypeGeneric                 = "AWS::ResourceGroups::Generic"
+	groupConfigurationTypeHostManagement          = "AWS::EC2::HostManagement"
+)
+
+const (
+	groupConfigurationParameterAllowedHostBasedLicenseConfigurations = "allowed-host-based-license-configurations"
+	groupConfigurationParameterAllowedHostFamilies                   = "allowed-host-families"
+	groupConfigurationParameterAllowedResourceTypes                  = "allowed-resource-types"
+	groupConfigurationParameterAnyHostBasedLicenseConfiguration      = "any-host-based-license-configuration"
+	groupConfigurationParameterAutoAllocateHost                      = "auto-allocate-host"
+	groupConfigurationParameterAutoReleaseHost                       = "auto-release-host"
+	groupConfigurationParameterDeletionProtection                    = "deletion-protection"
+)
+
+type groupConfigurationParameterKind int
+
+const (
+	groupConfigurationParameterKindList groupConfigurationParameterKind = iota
+	groupConfigurationParameterKindBool
+)
+
+// groupConfigurationTypeParameters lists the parameters supported by each validated configuration type.
+var groupConfigurationTypeParameters = map[string]map[string]groupConfigurationParameterKind{
+	groupConfigurationTypeCapacityReservationPool: {},
+	groupConfigurationTypeGeneric: {
+		groupConfigurationParameterAllowedResourceTypes: groupConfigurationParameterKindList,
+		groupConfigurationParameterDeletionProtection:   groupConfigurationParameterKindList,
+	},
+	groupConfigurationTypeHostManagement: {
+		groupConfigurationParameterAllowedHostBasedLicenseConfigurations: groupConfigurationParameterKindList,
+		groupConfigurationParameterAllowedHostFamilies:                   groupConfigurationParameterKindList,
+		groupConfigurationParameterAnyHostBasedLicenseConfiguration:      groupConfigurationParameterKindBool,
+		groupConfigurationParameterAutoAllocateHost:                      groupConfigurationParameterKindBool,
+		groupConfigurationParameterAutoReleaseHost:                       groupConfigurationParameterKindBool,
+	},
+}
+
+func groupConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("configuration") {
+		return nil
+	}
+
+	v, ok := d.GetOk("configuration")
+
+	if !ok || v.(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	items := expandGroupConfigurationItems(v.(*schema.Set).List())
+
+	if err := validateGroupConfigurationItems(items); err != nil {
+		return err
+	}
+
+	if _, ok := d.GetOk("resource_query"); ok {
+		for _, item := range items {
+			if typ := aws.ToString(item.Type); typ == groupConfigurationTypeCapacityReservationPool || typ == groupConfigurationTypeHostManagement {
+				return fmt.Errorf("configuration type %s cannot be combined with resource_query", typ)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateGroupConfigurationItems validates the parameters of known service configuration types
+// and the companion configurations they require. Unknown types are passed through unvalidated.
+func validateGroupConfigurationItems(items []types.GroupConfigurationItem) error {
+	var validationErrs []error
+	parametersByType := make(map[string]map[string][]string)
+
+	for _, item := range items {
+		typ := aws.ToString(item.Type)
+		parameters := make(map[string][]string)
+
+		for _, parameter := range item.Parameters {
+			parameters[aws.ToString(parameter.Name)] = parameter.Values
+		}
+
+		parametersByType[typ] = parameters
+
+		supported, ok := groupConfigurationTypeParameters[typ]
+
+		if !ok {
+			continue
+		}
+
+		for _, parameter := range item.Parameters {
+			name, values := aws.ToString(parameter.Name), parameter.Values
+			kind, ok := supported[name]
+
+			if !ok {
+				validationErrs = append(validationErrs, fmt.Errorf("configuration type %s: unsupported parameter %q", typ, name))
+				continue
+			}
+
+			switch kind {
+			case groupConfigurationParameterKindBool:
+				if len(values) != 1 || (values[0] != "true" && values[0] != "false") {
+					validationErrs = append(validationErrs, fmt.Errorf("configuration type %s: parameter %q must have a single value of \"true\" or \"false\"", typ, name))
+				}
+			case groupConfigurationParameterKindList:
+				if len(values) == 0 {
+					validationErrs = append(validationErrs, fmt.Errorf("configuration type %s: parameter %q must have at least one value", typ, name))
+				}
+			}
+		}
+	}
+
+	generic, hasGeneric := parametersByType[groupConfigurationTypeGeneric]
+
+	if v, ok := generic[groupConfigurationParameterDeletionProtection]; ok && !slices.Equal(v, []string{"UNLESS_EMPTY"}) {
+		validationErrs = append(validationErrs, fmt.Errorf("configuration type %s: parameter %q must have a single value of \"UNLESS_EMPTY\"", groupConfigurationTypeGeneric, groupConfigurationParameterDeletionProtection))
+	}
+
+	if _, ok := parametersByType[groupConfigurationTypeCapacityReservationPool]; ok {
+		if !hasGeneric || !slices.Contains(generic[groupConfigurationParameterAllowedResourceTypes], "AWS::EC2::CapacityReservation") {
+			validationErrs = append(validationErrs, fmt.Errorf("configuration type %s requires a %s configuration with %q including \"AWS::EC2::CapacityReservation\"", groupConfigurationTypeCapacityReservationPool, groupConfigurationTypeGeneric, groupConfigurationParameterAllowedResourceTypes))
+		}
+	}
+
+	if hostManagement, ok := parametersByType[groupConfigurationTypeHostManagement]; ok {
+		if !hasGeneric || !slices.Contains(generic[groupConfigurationParameterAllowedResourceTypes], "AWS::EC2::Host") {
+			validationErrs = append(validationErrs, fmt.Errorf("configuration type %s requires a %s configuration with %q including \"AWS::EC2::Host\"", groupConfigurationTypeHostManagement, groupConfigurationTypeGeneric, groupConfigurationParameterAllowedResourceTypes))
+		}
+
+		if hasGeneric && !slices.Equal(generic[groupConfigurationParameterDeletionProtection], []string{"UNLESS_EMPTY"}) {
+			validationErrs = append(validationErrs, fmt.Errorf("configuration type %s requires a %s configuration with %q set to \"UNLESS_EMPTY\"", groupConfigurationTypeHostManagement, groupConfigurationTypeGeneric, groupConfigurationParameterDeletionProtection))
+		}
+
+		_, allowed := hostManagement[groupConfigurationParameterAllowedHostBasedLicenseConfigurations]
+		_, anyLicense := hostManagement[groupConfigurationParameterAnyHostBasedLicenseConfiguration]
+
+		if allowed == anyLicense {
+			validationErrs = append(validationErrs, fmt.Errorf("configuration type %s requires exactly one of %q or %q", groupConfigurationTypeHostManagement, groupConfigurationParameterAllowedHostBasedLicenseConfigurations, groupConfigurationParameterAnyHostBasedLicenseConfiguration))
+		}
+	}
+
+	return errors.Join(validationErrs...)
+}
+
 func findGroupByName(ctx context.Context, conn *resourcegroups.Client, name string) (*types.Group, error) {
 	input := &resourcegroups.GetGroupInput{
 		GroupName: aws.String(name),
@@ -6,9 +6,11 @@ package resourcegroups_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -20,6 +22,122 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidateGroupConfigurationItems(t *testing.T) {
+	t.Parallel()
+
+	parameter := func(name string, values ...string) types.GroupConfigurationParameter {
+		return types.GroupConfigurationParameter{Name: aws.String(name), Values: values}
+	}
+	item := func(typ string, parameters ...types.GroupConfigurationParameter) types.GroupConfigurationItem {
+		return types.GroupConfigurationItem{Type: aws.String(typ), Parameters: parameters}
+	}
+
+	testCases := map[string]struct {
+		items         []types.GroupConfigurationItem
+		expectedError *regexp.Regexp
+	}{
+		"capacity reservation pool": {
+			items: []types.GroupConfigurationItem{
+				item("AWS::EC2::CapacityReservationPool"),
+				item("AWS::ResourceGroups::Generic", parameter("allowed-resource-types", "AWS::EC2::CapacityReservation")),
+			},
+		},
+		"capacity reservation pool without generic": {
+			items: []types.GroupConfigurationItem{
+				item("AWS::EC2::CapacityReservationPool"),
+			},
+			expectedError: regexache.MustCompile(`requires a AWS::ResourceGroups::Generic configuration`),
+		},
+		"capacity reservation pool with parameters": {
+			items: []types.GroupConfigurationItem{
+				item("AWS::EC2::CapacityReservationPool", parameter("auto-allocate-host", "true")),
+				item("AWS::ResourceGroups::Generic", parameter("allowed-resource-types", "AWS::EC2::CapacityReservation")),
+			},
+			expectedError: regexache.MustCompile(`unsupported parameter "auto-allocate-host"`),
+		},
+		"host management": {
+			items: []types.GroupConfigurationItem{
+				item("AWS::EC2::HostManagement",
+					parameter("allowed-host-families", "mac1"),
+					parameter("any-host-based-license-configuration", "true"),
+					parameter("auto-allocate-host", "false"),
+				),
+				item("AWS::ResourceGroups::Generic",
+					parameter("allowed-resource-types", "AWS::EC2::Host"),
+					parameter("deletion-protection", "UNLESS_EMPTY"),
+				),
+			},
+		},
+		"host management invalid boolean": {
+			items: []types.GroupConfigurationItem{
+				item("AWS::EC2::HostManagement",
+					parameter("any-host-based-license-configuration", "true"),
+					parameter("auto-release-host", "yes"),
+				),
+				item("AWS::ResourceGroups::Generic",
+					parameter("allowed-resource-types", "AWS::EC2::Host"),
+					parameter("deletion-protection", "UNLESS_EMPTY"),
+				),
+			},
+			expectedError: regexache.MustCompile(`parameter "auto-release-host" must have a single value of "true" or "false"`),
+		},
+		"host management without license configuration": {
+			items: []types.GroupConfigurationItem{
+				item("AWS::EC2::HostManagement"),
+				item("AWS::ResourceGroups::Generic",
+					parameter("allowed-resource-types", "AWS::EC2::Host"),
+					parameter("deletion-protection", "UNLESS_EMPTY"),
+				),
+			},
+			expectedError: regexache.MustCompile(`requires exactly one of`),
+		},
+		"host management without deletion protection": {
+			items: []types.GroupConfigurationItem{
+				item("AWS::EC2::HostManagement", parameter("any-host-based-license-configuration", "true")),
+				item("AWS::ResourceGroups::Generic", parameter("allowed-resource-types", "AWS::EC2::Host")),
+			},
+			expectedError: regexache.MustCompile(`"deletion-protection" set to "UNLESS_EMPTY"`),
+		},
+		"generic invalid deletion protection": {
+			items: []types.GroupConfigurationItem{
+				item("AWS::ResourceGroups::Generic", parameter("deletion-protection", "ALWAYS")),
+			},
+			expectedError: regexache.MustCompile(`parameter "deletion-protection" must have a single value of "UNLESS_EMPTY"`),
+		},
+		"unknown type": {
+			items: []types.GroupConfigurationItem{
+				item("AWS::NetworkFirewall::RuleGroup", parameter("anything", "goes")),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfresourcegroups.ValidateGroupConfigurationItems(testCase.items)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccResourceGroupsGroup_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.Group
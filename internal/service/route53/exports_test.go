@@ -5,9 +5,11 @@ package route53
 
 // Exports for use in tests only.
 var (
-	CIDRLocationParseResourceID  = cidrLocationParseResourceID
-	FindCIDRCollectionByID       = findCIDRCollectionByID
-	FindCIDRLocationByTwoPartKey = findCIDRLocationByTwoPartKey
-	ResourceCIDRCollection       = newResourceCIDRCollection
-	ResourceCIDRLocation         = newResourceCIDRLocation
+	CIDRLocationParseResourceID          = cidrLocationParseResourceID
+	ChunkChanges                         = chunkChanges
+	FindCIDRCollectionByID               = findCIDRCollectionByID
+	FindCIDRLocationByTwoPartKey         = findCIDRLocationByTwoPartKey
+	FindSimpleResourceRecordSetsByZoneID = findSimpleResourceRecordSetsByZoneID
+	ResourceCIDRCollection               = newResourceCIDRCollection
+	ResourceCIDRLocation                 = newResourceCIDRLocation
 )
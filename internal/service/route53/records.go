@@ -0,0 +1,491 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+const (
+	changeBatchMaxResourceRecords = 1000
+	changeBatchMaxValueCharacters = 32000
+)
+
+// @SDKResource("aws_route53_records")
+func ResourceRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecordsCreate,
+		ReadWithoutTimeout:   resourceRecordsRead,
+		UpdateWithoutTimeout: resourceRecordsUpdate,
+		DeleteWithoutTimeout: resourceRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRecordsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"zone_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 32),
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+					},
+				},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	zoneRecord, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zoneRecord.HostedZone.Name)
+
+	// Use CREATE so that existing DNS records managed in another way are not overwritten.
+	var changes []*route53.Change
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionCreate),
+			ResourceRecordSet: expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName),
+		})
+	}
+
+	d.SetId(zoneID)
+
+	if err := changeResourceRecordSetsInBatches(ctx, conn, zoneID, changes, "Managed by Terraform"); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneRecord, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Records (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zoneRecord.HostedZone.Name)
+	recordSets, err := findSimpleResourceRecordSetsByZoneID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	// Only the records already managed by this resource are refreshed.
+	// Records that have been deleted outside of Terraform are dropped from state.
+	var tfList []interface{}
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		name := tfMap["name"].(string)
+
+		apiObject, ok := recordSets[recordsKey(ExpandRecordName(name, zoneName), tfMap["type"].(string))]
+		if !ok {
+			continue
+		}
+
+		v := flattenRecordsResourceRecordSet(apiObject, name)
+		if recordsAliasEqual(tfMap["alias"], v["alias"]) {
+			// Keep the configured alias name, which is normalized by Route 53.
+			v["alias"] = tfMap["alias"]
+		}
+
+		tfList = append(tfList, v)
+	}
+
+	d.Set("record", tfList)
+	d.Set("zone_id", d.Id())
+
+	return diags
+}
+
+func resourceRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneRecord, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zoneRecord.HostedZone.Name)
+	recordSets, err := findSimpleResourceRecordSetsByZoneID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	o, n := d.GetChange("record")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	want := make(map[string]*route53.ResourceRecordSet)
+	for _, tfMapRaw := range ns.List() {
+		apiObject := expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName)
+		want[recordsKey(aws.StringValue(apiObject.Name), aws.StringValue(apiObject.Type))] = apiObject
+	}
+
+	// Deletions are ordered before upserts so that a record's type can be
+	// changed (e.g. from CNAME to A) within the same change batch.
+	var deletions, upserts []*route53.Change
+	for _, tfMapRaw := range os.Difference(ns).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		key := recordsKey(ExpandRecordName(tfMap["name"].(string), zoneName), tfMap["type"].(string))
+
+		if _, ok := want[key]; ok {
+			continue
+		}
+
+		// The record set to be deleted must match the current value exactly.
+		if apiObject, ok := recordSets[key]; ok {
+			deletions = append(deletions, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: apiObject,
+			})
+		}
+	}
+
+	for _, tfMapRaw := range ns.Difference(os).List() {
+		upserts = append(upserts, &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName),
+		})
+	}
+
+	if err := changeResourceRecordSetsInBatches(ctx, conn, d.Id(), append(deletions, upserts...), "Managed by Terraform"); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneRecord, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zoneRecord.HostedZone.Name)
+	recordSets, err := findSimpleResourceRecordSetsByZoneID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	var changes []*route53.Change
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if apiObject, ok := recordSets[recordsKey(ExpandRecordName(tfMap["name"].(string), zoneName), tfMap["type"].(string))]; ok {
+			changes = append(changes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: apiObject,
+			})
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Route 53 Records: %s", d.Id())
+	if err := changeResourceRecordSetsInBatches(ctx, conn, d.Id(), changes, "Deleted by Terraform"); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceRecordsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Id())
+	zoneRecord, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	zoneName := strings.TrimSuffix(aws.StringValue(zoneRecord.HostedZone.Name), ".")
+	recordSets, err := findSimpleResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Records (%s): %w", zoneID, err)
+	}
+
+	// All records other than the zone apex NS and SOA records are imported.
+	var tfList []interface{}
+	for _, apiObject := range recordSets {
+		name := strings.ToLower(strings.TrimSuffix(CleanRecordName(aws.StringValue(apiObject.Name)), "."))
+
+		if t := aws.StringValue(apiObject.Type); name == zoneName && (t == route53.RRTypeNs || t == route53.RRTypeSoa) {
+			continue
+		}
+
+		// Record names are made relative to the zone, other than for the zone apex.
+		tfList = append(tfList, flattenRecordsResourceRecordSet(apiObject, strings.TrimSuffix(name, "."+zoneName)))
+	}
+
+	d.SetId(zoneID)
+	if err := d.Set("record", tfList); err != nil {
+		return nil, fmt.Errorf("setting record: %w", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findSimpleResourceRecordSetsByZoneID returns the zone's record sets that use simple routing, keyed by name and type.
+func findSimpleResourceRecordSetsByZoneID(ctx context.Context, conn *route53.Route53, zoneID string) (map[string]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	output := make(map[string]*route53.ResourceRecordSet)
+
+	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v == nil || v.SetIdentifier != nil {
+				continue
+			}
+
+			output[recordsKey(CleanRecordName(aws.StringValue(v.Name)), aws.StringValue(v.Type))] = v
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// changeResourceRecordSetsInBatches submits the changes in as few change batches as the
+// ChangeResourceRecordSets request limits allow and waits for all of them to be in sync.
+func changeResourceRecordSetsInBatches(ctx context.Context, conn *route53.Route53, zoneID string, changes []*route53.Change, comment string) error {
+	var changeIDs []string
+
+	for _, batch := range chunkChanges(changes) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: batch,
+				Comment: aws.String(comment),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		log.Printf("[DEBUG] Changing %d Route 53 record sets in zone: %s", len(batch), zoneID)
+		changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
+
+		if err != nil {
+			return err
+		}
+
+		changeIDs = append(changeIDs, CleanChangeID(aws.StringValue(changeInfo.Id)))
+	}
+
+	for _, id := range changeIDs {
+		if err := WaitForRecordSetToSync(ctx, conn, id); err != nil {
+			return fmt.Errorf("waiting for change (%s): %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// chunkChanges splits changes into batches that stay within the per-request limits on
+// the number of resource record elements and the total characters in their values.
+// UPSERT changes count twice against both limits.
+func chunkChanges(changes []*route53.Change) [][]*route53.Change {
+	var batches [][]*route53.Change
+	var batch []*route53.Change
+	var records, characters int
+
+	for _, change := range changes {
+		r, c := 1, 0
+		if rrs := change.ResourceRecordSet; rrs != nil && len(rrs.ResourceRecords) > 0 {
+			r = len(rrs.ResourceRecords)
+			for _, v := range rrs.ResourceRecords {
+				c += len(aws.StringValue(v.Value))
+			}
+		}
+		if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+			r, c = 2*r, 2*c
+		}
+
+		if len(batch) > 0 && (records+r > changeBatchMaxResourceRecords || characters+c > changeBatchMaxValueCharacters) {
+			batches = append(batches, batch)
+			batch, records, characters = nil, 0, 0
+		}
+
+		batch = append(batch, change)
+		records += r
+		characters += c
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+func recordsKey(name, recordType string) string {
+	return FQDN(strings.ToLower(name)) + "_" + strings.ToUpper(recordType)
+}
+
+func recordsAliasEqual(o, n interface{}) bool {
+	ol, nl := o.([]interface{}), n.([]interface{})
+
+	if len(ol) != len(nl) {
+		return false
+	}
+
+	if len(ol) == 0 {
+		return true
+	}
+
+	om, nm := ol[0].(map[string]interface{}), nl[0].(map[string]interface{})
+
+	return NormalizeAliasName(om["name"]) == NormalizeAliasName(nm["name"]) &&
+		om["zone_id"].(string) == nm["zone_id"].(string) &&
+		om["evaluate_target_health"].(bool) == nm["evaluate_target_health"].(bool)
+}
+
+func expandRecordsResourceRecordSet(tfMap map[string]interface{}, zoneName string) *route53.ResourceRecordSet {
+	recordType := tfMap["type"].(string)
+	apiObject := &route53.ResourceRecordSet{
+		Name: aws.String(ExpandRecordName(tfMap["name"].(string), zoneName)),
+		Type: aws.String(recordType),
+	}
+
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		alias := v[0].(map[string]interface{})
+		apiObject.AliasTarget = &route53.AliasTarget{
+			DNSName:              aws.String(alias["name"].(string)),
+			EvaluateTargetHealth: aws.Bool(alias["evaluate_target_health"].(bool)),
+			HostedZoneId:         aws.String(alias["zone_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["records"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRecords = expandResourceRecords(v.List(), recordType)
+	}
+
+	if v, ok := tfMap["ttl"].(int); ok && v != 0 {
+		apiObject.TTL = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenRecordsResourceRecordSet(apiObject *route53.ResourceRecordSet, name string) map[string]interface{} {
+	recordType := aws.StringValue(apiObject.Type)
+	tfMap := map[string]interface{}{
+		"name":    name,
+		"records": flex.FlattenStringValueSet(FlattenResourceRecords(apiObject.ResourceRecords, recordType)),
+		"ttl":     int(aws.Int64Value(apiObject.TTL)),
+		"type":    recordType,
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	} else {
+		tfMap["alias"] = []interface{}{}
+	}
+
+	return tfMap
+}
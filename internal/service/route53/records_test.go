@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestChunkChanges(t *testing.T) {
+	t.Parallel()
+
+	newChange := func(action string, values ...string) *route53.Change {
+		var records []*route53.ResourceRecord
+		for _, v := range values {
+			records = append(records, &route53.ResourceRecord{Value: aws.String(v)})
+		}
+
+		return &route53.Change{
+			Action: aws.String(action),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				ResourceRecords: records,
+			},
+		}
+	}
+
+	repeat := func(n int, change *route53.Change) []*route53.Change {
+		var changes []*route53.Change
+		for i := 0; i < n; i++ {
+			changes = append(changes, change)
+		}
+		return changes
+	}
+
+	testCases := []struct {
+		name    string
+		changes []*route53.Change
+		want    []int
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:    "single batch",
+			changes: repeat(1000, newChange(route53.ChangeActionCreate, "127.0.0.1")),
+			want:    []int{1000},
+		},
+		{
+			name:    "record limit",
+			changes: repeat(1001, newChange(route53.ChangeActionDelete, "127.0.0.1")),
+			want:    []int{1000, 1},
+		},
+		{
+			name:    "upserts count twice",
+			changes: repeat(501, newChange(route53.ChangeActionUpsert, "127.0.0.1")),
+			want:    []int{500, 1},
+		},
+		{
+			name:    "character limit",
+			changes: repeat(5, newChange(route53.ChangeActionCreate, strings.Repeat("x", 10000))),
+			want:    []int{3, 2},
+		},
+		{
+			name:    "alias",
+			changes: repeat(1001, newChange(route53.ChangeActionCreate)),
+			want:    []int{1000, 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []int
+			for _, batch := range tfroute53.ChunkChanges(testCase.changes) {
+				got = append(got, len(batch))
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(testCase.want) {
+				t.Errorf("got batch sizes %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccRoute53Records_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 5),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "5"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "record0",
+						"records.#": "1",
+						"ttl":       "30",
+						"type":      "A",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "record.*.records.*", "127.0.0.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 2),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceRecords(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_updated(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": "record0",
+						"ttl":  "300",
+						"type": "A",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": "record1",
+						"type": "CNAME",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "txt",
+						"records.#": "2",
+						"type":      "TXT",
+					}),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_records" {
+				continue
+			}
+
+			output, err := tfroute53.FindSimpleResourceRecordSetsByZoneID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range output {
+				if t := aws.StringValue(v.Type); t != route53.RRTypeNs && t != route53.RRTypeSoa {
+					return fmt.Errorf("Route 53 Records %s still exist", rs.Primary.ID)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		output, err := tfroute53.FindSimpleResourceRecordSetsByZoneID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var got int
+		for _, v := range output {
+			if t := aws.StringValue(v.Type); t != route53.RRTypeNs && t != route53.RRTypeSoa {
+				got++
+			}
+		}
+
+		if got != want {
+			return fmt.Errorf("Route 53 Records %s: got %d records, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccRecordsConfig_basic(zoneName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  dynamic "record" {
+    for_each = range(%[2]d)
+
+    content {
+      name    = "record${record.value}"
+      records = ["127.0.0.${record.value}"]
+      ttl     = 30
+      type    = "A"
+    }
+  }
+}
+`, zoneName, count)
+}
+
+func testAccRecordsConfig_updated(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "record0"
+    records = ["127.0.0.10"]
+    ttl     = 300
+    type    = "A"
+  }
+
+  record {
+    name    = "record1"
+    records = ["record0.${aws_route53_zone.test.name}"]
+    ttl     = 30
+    type    = "CNAME"
+  }
+
+  record {
+    name    = "txt"
+    records = ["v=spf1 -all", "hello world"]
+    ttl     = 30
+    type    = "TXT"
+  }
+}
+`, zoneName)
+}
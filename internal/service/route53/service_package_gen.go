@@ -75,6 +75,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRecord,
 			TypeName: "aws_route53_record",
 		},
+		{
+			Factory:  ResourceRecords,
+			TypeName: "aws_route53_records",
+		},
 		{
 			Factory:  ResourceTrafficPolicy,
 			TypeName: "aws_route53_traffic_policy",
@@ -48,6 +48,14 @@ func TestAccS3DirectoryBucket_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccDirectoryBucketImportStateIDConsoleURLFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+				SkipFunc:                func() (bool, error) { return acctest.Partition() != names.StandardPartitionID, nil },
+			},
 		},
 	})
 }
@@ -123,6 +131,17 @@ func testAccCheckDirectoryBucketDestroy(ctx context.Context) resource.TestCheckF
 	}
 }
 
+func testAccDirectoryBucketImportStateIDConsoleURLFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("https://s3.console.aws.amazon.com/s3/buckets/%[1]s?region=%[2]s&bucketType=directory", rs.Primary.ID, acctest.Region()), nil
+	}
+}
+
 func testAccCheckDirectoryBucketExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
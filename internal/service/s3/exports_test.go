@@ -34,6 +34,7 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	ObjectChecksum                        = objectChecksum
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
 	ValidBucketName                       = validBucketName
 
@@ -6,8 +6,12 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"log"
 	"net/http"
@@ -644,14 +648,133 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 		d.SetNewComputed("etag")
 	}
 
+	// The ETag of an object encrypted with SSE-KMS isn't an MD5 digest of its content,
+	// so detect content changes made outside Terraform by comparing checksums instead.
+	if d.Id() != "" {
+		if err := objectChecksumCustomizeDiff(d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var objectChecksumAttributes = map[types.ChecksumAlgorithm]string{
+	types.ChecksumAlgorithmCrc32:  "checksum_crc32",
+	types.ChecksumAlgorithmCrc32c: "checksum_crc32c",
+	types.ChecksumAlgorithmSha1:   "checksum_sha1",
+	types.ChecksumAlgorithmSha256: "checksum_sha256",
+}
+
+// objectChecksumCustomizeDiff plans a new upload when the checksum of the configured content
+// doesn't match the checksum of the object in S3.
+func objectChecksumCustomizeDiff(d *schema.ResourceDiff) error {
+	algorithm := types.ChecksumAlgorithm(d.Get("checksum_algorithm").(string))
+	attribute, ok := objectChecksumAttributes[algorithm]
+	if !ok {
+		return nil
+	}
+
+	for _, key := range []string{"content", "content_base64", "source"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	old := d.Get(attribute).(string)
+	// Objects uploaded in multiple parts have a checksum of checksums, suffixed with the part count.
+	if old == "" || strings.Contains(old, "-") {
+		return nil
+	}
+
+	body, err := objectContent(d)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	new, err := objectChecksum(body, algorithm)
+	if err != nil {
+		return err
+	}
+
+	if new != old {
+		if err := d.SetNew(attribute, new); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("version_id")
+	}
+
 	return nil
 }
 
+// objectContent returns the configured content of an object.
+func objectContent(d verify.ResourceDiffer) (io.ReadCloser, error) {
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("opening S3 object source (%s): %w", path, err)
+		}
+
+		return file, nil
+	}
+
+	if v, ok := d.GetOk("content"); ok {
+		return io.NopCloser(strings.NewReader(v.(string))), nil
+	}
+
+	if v, ok := d.GetOk("content_base64"); ok {
+		contentRaw, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("decoding content_base64: %w", err)
+		}
+
+		return io.NopCloser(bytes.NewReader(contentRaw)), nil
+	}
+
+	return io.NopCloser(bytes.NewReader([]byte{})), nil
+}
+
+// objectChecksum returns the base64-encoded checksum of the specified content, as calculated by S3.
+func objectChecksum(r io.Reader, algorithm types.ChecksumAlgorithm) (string, error) {
+	var h hash.Hash
+
+	switch algorithm {
+	case types.ChecksumAlgorithmCrc32:
+		h = crc32.NewIEEE()
+	case types.ChecksumAlgorithmCrc32c:
+		h = crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	case types.ChecksumAlgorithmSha1:
+		h = sha1.New()
+	case types.ChecksumAlgorithmSha256:
+		h = sha256.New()
+	default:
+		return "", fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", fmt.Errorf("calculating %s checksum: %w", algorithm, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
 		"checksum_algorithm",
+		"checksum_crc32",
+		"checksum_crc32c",
+		"checksum_sha1",
+		"checksum_sha256",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -105,6 +106,49 @@ func TestSDKv1CompatibleCleanKey(t *testing.T) {
 	}
 }
 
+func TestObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		algorithm types.ChecksumAlgorithm
+		want      string
+	}{
+		{
+			algorithm: types.ChecksumAlgorithmCrc32,
+			want:      "q/d4Ig==",
+		},
+		{
+			algorithm: types.ChecksumAlgorithmCrc32c,
+			want:      "MZiXzQ==",
+		},
+		{
+			algorithm: types.ChecksumAlgorithmSha1,
+			want:      "gCVvOanTCGUKyQ2b6acqlWJFRXQ=",
+		},
+		{
+			algorithm: types.ChecksumAlgorithmSha256,
+			want:      "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg=",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(string(testCase.algorithm), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.ObjectChecksum(strings.NewReader("ABCDEFGHIJKLMNOPQRSTUVWXYZ"), testCase.algorithm)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("ObjectChecksum(%q) = %q, want %q", testCase.algorithm, got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestAccS3Object_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -663,6 +707,31 @@ func TestAccS3Object_updatesWithVersioningViaAccessPoint(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_viaMultiRegionAccessPoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	multiRegionAccessPointResourceName := "aws_s3control_multi_region_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_viaMultiRegionAccessPoint(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "signed with SigV4a"),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", multiRegionAccessPointResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_kms(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -1665,6 +1734,40 @@ func TestAccS3Object_checksumAlgorithm(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_checksumAlgorithmDriftKMS(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumAlgorithmKMS(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
+					testAccCheckObjectPutBody(ctx, resourceName, "abcdefghijklmnopqrstuvwxyz"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithmKMS(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_keyWithSlashesMigrated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -2093,6 +2196,31 @@ func testAccCheckObjectUpdateTags(ctx context.Context, n string, oldTags, newTag
 	}
 }
 
+// testAccCheckObjectPutBody overwrites the object's content outside of Terraform, keeping its encryption settings and checksum algorithm.
+func testAccCheckObjectPutBody(ctx context.Context, n, body string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := &s3.PutObjectInput{
+			Body:              strings.NewReader(body),
+			Bucket:            aws.String(rs.Primary.Attributes["bucket"]),
+			ChecksumAlgorithm: types.ChecksumAlgorithm(rs.Primary.Attributes["checksum_algorithm"]),
+			Key:               aws.String(tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"])),
+		}
+
+		if v := rs.Primary.Attributes["kms_key_id"]; v != "" {
+			input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+			input.SSEKMSKeyId = aws.String(v)
+		}
+
+		_, err := conn.PutObject(ctx, input)
+
+		return err
+	}
+}
+
 func testAccCheckObjectCheckTags(ctx context.Context, n string, expectedTags map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
@@ -2277,6 +2405,31 @@ resource "aws_s3_object" "test" {
 `, rName, bucketVersioning, source)
 }
 
+func testAccObjectConfig_viaMultiRegionAccessPoint(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  details {
+    name = %[1]q
+
+    region {
+      bucket = aws_s3_bucket.test.id
+    }
+  }
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3control_multi_region_access_point.test.arn
+  key     = "test-key"
+  content = "signed with SigV4a"
+}
+`, rName)
+}
+
 func testAccObjectConfig_kmsID(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "kms_key_1" {}
@@ -2777,6 +2930,28 @@ resource "aws_s3_object" "object" {
 `, rName, checksumAlgorithm)
 }
 
+func testAccObjectConfig_checksumAlgorithmKMS(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket     = aws_s3_bucket.test.bucket
+  key        = "test-key"
+  content    = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+  kms_key_id = aws_kms_key.test.arn
+
+  checksum_algorithm = "SHA256"
+}
+`, rName)
+}
+
 func testAccObjectConfig_keyWithSlashes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -26,6 +26,11 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 			// See https://github.com/hashicorp/terraform-provider-aws/issues/33028.
 			o.Region = names.GlobalRegionID
 		}
+		// Requests to Multi-Region Access Point ARNs are signed with SigV4a.
+		// Only override the value resolved from the environment and shared configuration files when set in provider configuration.
+		if config["s3_disable_multi_region_access_points"].(bool) {
+			o.DisableMultiRegionAccessPoints = true
+		}
 		o.UsePathStyle = config["s3_use_path_style"].(bool)
 
 		o.Retryer = conns.AddIsErrorRetryables(cfg.Retryer().(aws.RetryerV2), retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
@@ -70,7 +70,8 @@ func (r *accessGrantsInstanceResource) Schema(ctx context.Context, request resou
 					fwvalidators.AWSAccountID(),
 				},
 			},
-			names.AttrID: framework.IDAttribute(),
+			names.AttrAdoptExisting: framework.AdoptExistingAttribute(),
+			names.AttrID:            framework.IDAttribute(),
 			"identity_center_application_arn": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -109,6 +110,13 @@ func (r *accessGrantsInstanceResource) Create(ctx context.Context, request resou
 
 	output, err := conn.CreateAccessGrantsInstance(ctx, input)
 
+	// Only one instance is allowed per account per Region.
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceAlreadyExists) {
+		response.Diagnostics.Append(fwdiag.NewAlreadyExistsErrorDiagnostic(fmt.Sprintf("creating S3 Access Grants Instance (%s)", data.AccountID.ValueString()), err.Error()))
+
+		return
+	}
+
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Access Grants Instance (%s)", data.AccountID.ValueString()), err.Error())
 
@@ -124,6 +132,38 @@ func (r *accessGrantsInstanceResource) Create(ctx context.Context, request resou
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+func (r *accessGrantsInstanceResource) AdoptExisting(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data accessGrantsInstanceResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(r.Meta().AccountID)
+	}
+
+	output, err := findAccessGrantsInstance(ctx, conn, data.AccountID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading existing S3 Access Grants Instance (%s)", data.AccountID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Keep the configured IAM Identity Center instance and tags; the next refresh and plan report any drift.
+	data.AccessGrantsInstanceARN = flex.StringToFramework(ctx, output.AccessGrantsInstanceArn)
+	data.AccessGrantsInstanceID = flex.StringToFramework(ctx, output.AccessGrantsInstanceId)
+	data.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
 func (r *accessGrantsInstanceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	var data accessGrantsInstanceResourceModel
 
@@ -161,6 +201,10 @@ func (r *accessGrantsInstanceResource) Read(ctx context.Context, request resourc
 	data.AccessGrantsInstanceID = flex.StringToFramework(ctx, output.AccessGrantsInstanceId)
 	data.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterArn)
 
+	if data.AdoptExisting.IsNull() {
+		data.AdoptExisting = types.BoolValue(false)
+	}
+
 	tags, err := listTags(ctx, conn, data.AccessGrantsInstanceARN.ValueString(), data.AccountID.ValueString())
 
 	if err != nil {
@@ -308,6 +352,7 @@ type accessGrantsInstanceResourceModel struct {
 	AccessGrantsInstanceARN      types.String `tfsdk:"access_grants_instance_arn"`
 	AccessGrantsInstanceID       types.String `tfsdk:"access_grants_instance_id"`
 	AccountID                    types.String `tfsdk:"account_id"`
+	AdoptExisting                types.Bool   `tfsdk:"adopt_existing"`
 	ID                           types.String `tfsdk:"id"`
 	IdentityCenterApplicationARN types.String `tfsdk:"identity_center_application_arn"`
 	IdentityCenterARN            fwtypes.ARN  `tfsdk:"identity_center_arn"`
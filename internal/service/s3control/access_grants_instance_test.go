@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -48,6 +49,42 @@ func testAccAccessGrantsInstance_basic(t *testing.T) {
 	})
 }
 
+func testAccAccessGrantsInstance_adoptExisting(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_access_grants_instance.test"
+	adoptedResourceName := "aws_s3control_access_grants_instance.adopted"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "false"),
+				),
+			},
+			{
+				Config:      testAccAccessGrantsInstanceConfig_adoptExisting(false),
+				ExpectError: regexache.MustCompile(`AccessGrantsInstanceAlreadyExists`),
+			},
+			{
+				Config: testAccAccessGrantsInstanceConfig_adoptExisting(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(ctx, adoptedResourceName),
+					resource.TestCheckResourceAttr(adoptedResourceName, "adopt_existing", "true"),
+					resource.TestCheckResourceAttrPair(adoptedResourceName, "access_grants_instance_arn", resourceName, "access_grants_instance_arn"),
+					resource.TestCheckResourceAttrPair(adoptedResourceName, "access_grants_instance_id", resourceName, "access_grants_instance_id"),
+					resource.TestCheckResourceAttrPair(adoptedResourceName, "id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAccessGrantsInstance_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_s3control_access_grants_instance.test"
@@ -205,6 +242,18 @@ resource "aws_s3control_access_grants_instance" "test" {}
 `
 }
 
+func testAccAccessGrantsInstanceConfig_adoptExisting(adoptExisting bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_access_grants_instance" "test" {}
+
+resource "aws_s3control_access_grants_instance" "adopted" {
+  adopt_existing = %[1]t
+
+  depends_on = [aws_s3control_access_grants_instance.test]
+}
+`, adoptExisting)
+}
+
 func testAccAccessGrantsInstanceConfig_tags1(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_s3control_access_grants_instance" "test" {
@@ -15,6 +15,7 @@ func TestAccS3ControlAccessGrants_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Instance": {
 			"basic":          testAccAccessGrantsInstance_basic,
+			"adoptExisting":  testAccAccessGrantsInstance_adoptExisting,
 			"disappears":     testAccAccessGrantsInstance_disappears,
 			"tags":           testAccAccessGrantsInstance_tags,
 			"identityCenter": testAccAccessGrantsInstance_identityCenter,
@@ -0,0 +1,1456 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var batchOperationsJobOperations = []string{
+	"operation.0.lambda_invoke",
+	"operation.0.s3_delete_object_tagging",
+	"operation.0.s3_initiate_restore_object",
+	"operation.0.s3_put_object_acl",
+	"operation.0.s3_put_object_copy",
+	"operation.0.s3_put_object_tagging",
+}
+
+// @SDKResource("aws_s3control_batch_operations_job", name="Batch Operations Job")
+// @Tags
+func resourceBatchOperationsJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBatchOperationsJobCreate,
+		ReadWithoutTimeout:   resourceBatchOperationsJobRead,
+		UpdateWithoutTimeout: resourceBatchOperationsJobUpdate,
+		DeleteWithoutTimeout: resourceBatchOperationsJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.JobManifestFieldName](),
+										},
+									},
+									"format": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.JobManifestFormat](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: batchOperationsJobOperations,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"invocation_schema_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"1.0", "2.0"}, false),
+									},
+									"user_arguments": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"s3_delete_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: batchOperationsJobOperations,
+							Elem: &schema.Resource{
+								// No options currently; just existence of "s3_delete_object_tagging".
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: batchOperationsJobOperations,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"glacier_job_tier": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3GlacierJobTier](),
+									},
+								},
+							},
+						},
+						"s3_put_object_acl": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: batchOperationsJobOperations,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_control_policy": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_control_list": {
+													Type:          schema.TypeList,
+													Optional:      true,
+													ForceNew:      true,
+													MaxItems:      1,
+													ConflictsWith: []string{"operation.0.s3_put_object_acl.0.access_control_policy.0.canned_access_control_list"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"grant": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem:     batchOperationsJobGrantSchema(),
+															},
+															"owner": {
+																Type:     schema.TypeList,
+																Required: true,
+																ForceNew: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"display_name": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"id": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"canned_access_control_list": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.S3CannedAccessControlList](),
+													ConflictsWith:    []string{"operation.0.s3_put_object_acl.0.access_control_policy.0.access_control_list"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: batchOperationsJobOperations,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3CannedAccessControlList](),
+									},
+									"checksum_algorithm": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ChecksumAlgorithm](),
+									},
+									"metadata_directive": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3MetadataDirective](),
+									},
+									"new_object_metadata": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cache_control": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_disposition": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_encoding": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_language": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"sse_algorithm": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.S3SSEAlgorithm](),
+												},
+												"user_metadata": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"storage_class": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3StorageClass](),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: batchOperationsJobOperations,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportFormat](),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportScope](),
+						},
+					},
+				},
+			},
+			"requested_job_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.RequestedJobStatus](),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_update_reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func batchOperationsJobGrantSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"grantee": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type_identifier": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.S3GranteeTypeIdentifier](),
+						},
+					},
+				},
+			},
+			"permission": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.S3Permission](),
+			},
+		},
+	}
+}
+
+func resourceBatchOperationsJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Priority:             aws.Int32(int32(d.Get("priority").(int))),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		Tags:                 getTagsInS3(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Manifest = expandJobManifest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("operation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Operation = expandJobOperation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("report"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Report = expandJobReport(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateJob(ctx, input)
+	}, errCodeInvalidRequest)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Batch Operations Job: %s", err)
+	}
+
+	jobID := aws.ToString(outputRaw.(*s3control.CreateJobOutput).JobId)
+	d.SetId(BatchOperationsJobCreateResourceID(accountID, jobID))
+
+	if _, err := waitBatchOperationsJobCreated(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for S3 Batch Operations Job (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("requested_job_status"); ok {
+		if err := updateBatchOperationsJobStatus(ctx, conn, accountID, jobID, types.RequestedJobStatus(v.(string)), d.Get("status_update_reason").(string)); err != nil {
+			return diag.Errorf("updating S3 Batch Operations Job (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBatchOperationsJobRead(ctx, d, meta)
+}
+
+func resourceBatchOperationsJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, jobID, err := BatchOperationsJobParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	job, err := findBatchOperationsJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Batch Operations Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", job.JobArn)
+	d.Set("confirmation_required", job.ConfirmationRequired)
+	d.Set("description", job.Description)
+	d.Set("job_id", job.JobId)
+	if job.Manifest != nil {
+		if err := d.Set("manifest", []interface{}{flattenJobManifest(job.Manifest)}); err != nil {
+			return diag.Errorf("setting manifest: %s", err)
+		}
+	} else {
+		d.Set("manifest", nil)
+	}
+	if job.Operation != nil {
+		if err := d.Set("operation", []interface{}{flattenJobOperation(job.Operation)}); err != nil {
+			return diag.Errorf("setting operation: %s", err)
+		}
+	} else {
+		d.Set("operation", nil)
+	}
+	d.Set("priority", job.Priority)
+	if job.Report != nil {
+		if err := d.Set("report", []interface{}{flattenJobReport(job.Report)}); err != nil {
+			return diag.Errorf("setting report: %s", err)
+		}
+	} else {
+		d.Set("report", nil)
+	}
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+
+	tags, err := batchOperationsJobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return diag.Errorf("listing tags for S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	setTagsOutS3(ctx, tagsS3(tags))
+
+	return nil
+}
+
+func resourceBatchOperationsJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, jobID, err := BatchOperationsJobParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  int32(d.Get("priority").(int)),
+		}
+
+		_, err := conn.UpdateJobPriority(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating S3 Batch Operations Job (%s) priority: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("requested_job_status") {
+		if v, ok := d.GetOk("requested_job_status"); ok {
+			if err := updateBatchOperationsJobStatus(ctx, conn, accountID, jobID, types.RequestedJobStatus(v.(string)), d.Get("status_update_reason").(string)); err != nil {
+				return diag.Errorf("updating S3 Batch Operations Job (%s) status: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := batchOperationsJobUpdateTags(ctx, conn, accountID, jobID, o, n); err != nil {
+			return diag.Errorf("updating S3 Batch Operations Job (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBatchOperationsJobRead(ctx, d, meta)
+}
+
+func resourceBatchOperationsJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, jobID, err := BatchOperationsJobParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Batch Operations jobs can't be deleted, only cancelled.
+	// Jobs in a terminal state are retained by S3 for 90 days.
+	job, err := findBatchOperationsJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	switch job.Status {
+	case types.JobStatusCancelled, types.JobStatusCancelling, types.JobStatusComplete, types.JobStatusCompleting, types.JobStatusFailed, types.JobStatusFailing:
+		log.Printf("[DEBUG] S3 Batch Operations Job (%s) is %s, removing from state", d.Id(), job.Status)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+	err = updateBatchOperationsJobStatus(ctx, conn, accountID, jobID, types.RequestedJobStatusCancelled, "Terraform resource destroyed")
+
+	if errs.IsA[*types.NotFoundException](err) || errs.IsA[*types.JobStatusException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("cancelling S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const batchOperationsJobResourceIDSeparator = ":"
+
+func BatchOperationsJobCreateResourceID(accountID, jobID string) string {
+	parts := []string{accountID, jobID}
+	id := strings.Join(parts, batchOperationsJobResourceIDSeparator)
+
+	return id
+}
+
+func BatchOperationsJobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, batchOperationsJobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sjob-id", id, batchOperationsJobResourceIDSeparator)
+}
+
+func updateBatchOperationsJobStatus(ctx context.Context, conn *s3control.Client, accountID, jobID string, status types.RequestedJobStatus, reason string) error {
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: status,
+	}
+
+	if reason != "" {
+		input.StatusUpdateReason = aws.String(reason)
+	}
+
+	_, err := conn.UpdateJobStatus(ctx, input)
+
+	return err
+}
+
+func findBatchOperationsJobByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, jobID string) (*types.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+func statusBatchOperationsJob(ctx context.Context, conn *s3control.Client, accountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBatchOperationsJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// waitBatchOperationsJobCreated waits for the job's manifest to be read and the job to either await confirmation or be queued.
+func waitBatchOperationsJobCreated(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.JobStatusNew, types.JobStatusPreparing),
+		Target: enum.Slice(
+			types.JobStatusActive,
+			types.JobStatusComplete,
+			types.JobStatusCompleting,
+			types.JobStatusPaused,
+			types.JobStatusPausing,
+			types.JobStatusReady,
+			types.JobStatusSuspended,
+		),
+		Refresh:    statusBatchOperationsJob(ctx, conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		if reasons := output.FailureReasons; len(reasons) > 0 {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(reasons[0].FailureCode), aws.ToString(reasons[0].FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func batchOperationsJobListTags(ctx context.Context, conn *s3control.Client, accountID, jobID string) (tftags.KeyValueTags, error) {
+	input := &s3control.GetJobTaggingInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.GetJobTagging(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTagsS3(ctx, output.Tags), nil
+}
+
+func batchOperationsJobUpdateTags(ctx context.Context, conn *s3control.Client, accountID, jobID string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := batchOperationsJobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("listing tags: %s", err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3control.PutJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Tags:      tagsS3(newTags.Merge(ignoredTags)),
+		}
+
+		_, err := conn.PutJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("setting tags: %s", err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3control.DeleteJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+		}
+
+		_, err := conn.DeleteJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting tags: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func expandJobManifest(tfMap map[string]interface{}) *types.JobManifest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifest{}
+
+	if v, ok := tfMap["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Location = expandJobManifestLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Spec = expandJobManifestSpec(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandJobManifestLocation(tfMap map[string]interface{}) *types.JobManifestLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestLocation{}
+
+	if v, ok := tfMap["etag"].(string); ok && v != "" {
+		apiObject.ETag = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_arn"].(string); ok && v != "" {
+		apiObject.ObjectArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_version_id"].(string); ok && v != "" {
+		apiObject.ObjectVersionId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestSpec(tfMap map[string]interface{}) *types.JobManifestSpec {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestSpec{}
+
+	if v, ok := tfMap["fields"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Fields = flex.ExpandStringyValueList[types.JobManifestFieldName](v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = types.JobManifestFormat(v)
+	}
+
+	return apiObject
+}
+
+func expandJobOperation(tfMap map[string]interface{}) *types.JobOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LambdaInvoke = expandLambdaInvokeOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_delete_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3DeleteObjectTagging = &types.S3DeleteObjectTaggingOperation{}
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3InitiateRestoreObject = expandS3InitiateRestoreObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_acl"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectAcl = expandS3SetObjectAclOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectCopy = expandS3CopyObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3PutObjectTagging = &types.S3SetObjectTaggingOperation{
+			TagSet: []types.S3Tag{},
+		}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["tag_set"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.S3PutObjectTagging.TagSet = expandS3Tags(v)
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandLambdaInvokeOperation(tfMap map[string]interface{}) *types.LambdaInvokeOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.LambdaInvokeOperation{}
+
+	if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["invocation_schema_version"].(string); ok && v != "" {
+		apiObject.InvocationSchemaVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_arguments"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserArguments = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandS3InitiateRestoreObjectOperation(tfMap map[string]interface{}) *types.S3InitiateRestoreObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3InitiateRestoreObjectOperation{}
+
+	if v, ok := tfMap["expiration_in_days"].(int); ok && v != 0 {
+		apiObject.ExpirationInDays = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+		apiObject.GlacierJobTier = types.S3GlacierJobTier(v)
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectAclOperation(tfMap map[string]interface{}) *types.S3SetObjectAclOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3SetObjectAclOperation{}
+
+	if v, ok := tfMap["access_control_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AccessControlPolicy = expandS3AccessControlPolicy(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3AccessControlPolicy(tfMap map[string]interface{}) *types.S3AccessControlPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3AccessControlPolicy{}
+
+	if v, ok := tfMap["access_control_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AccessControlList = expandS3AccessControlList(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = types.S3CannedAccessControlList(v)
+	}
+
+	return apiObject
+}
+
+func expandS3AccessControlList(tfMap map[string]interface{}) *types.S3AccessControlList {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3AccessControlList{}
+
+	if v, ok := tfMap["grant"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Grants = expandS3Grants(v)
+	}
+
+	if v, ok := tfMap["owner"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Owner = &types.S3ObjectOwner{}
+
+		if v, ok := tfMap["display_name"].(string); ok && v != "" {
+			apiObject.Owner.DisplayName = aws.String(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Owner.ID = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3Grants(tfList []interface{}) []types.S3Grant {
+	var apiObjects []types.S3Grant
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.S3Grant{}
+
+		if v, ok := tfMap["grantee"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Grantee = &types.S3Grantee{}
+
+			if v, ok := tfMap["display_name"].(string); ok && v != "" {
+				apiObject.Grantee.DisplayName = aws.String(v)
+			}
+
+			if v, ok := tfMap["identifier"].(string); ok && v != "" {
+				apiObject.Grantee.Identifier = aws.String(v)
+			}
+
+			if v, ok := tfMap["type_identifier"].(string); ok && v != "" {
+				apiObject.Grantee.TypeIdentifier = types.S3GranteeTypeIdentifier(v)
+			}
+		}
+
+		if v, ok := tfMap["permission"].(string); ok && v != "" {
+			apiObject.Permission = types.S3Permission(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3CopyObjectOperation(tfMap map[string]interface{}) *types.S3CopyObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3CopyObjectOperation{}
+
+	if v, ok := tfMap["bucket_key_enabled"].(bool); ok {
+		apiObject.BucketKeyEnabled = v
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = types.S3CannedAccessControlList(v)
+	}
+
+	if v, ok := tfMap["checksum_algorithm"].(string); ok && v != "" {
+		apiObject.ChecksumAlgorithm = types.S3ChecksumAlgorithm(v)
+	}
+
+	if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+		apiObject.MetadataDirective = types.S3MetadataDirective(v)
+	}
+
+	if v, ok := tfMap["new_object_metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NewObjectMetadata = expandS3ObjectMetadata(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.NewObjectTagging = expandS3Tags(v)
+	}
+
+	if v, ok := tfMap["requester_pays"].(bool); ok {
+		apiObject.RequesterPays = v
+	}
+
+	if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = types.S3StorageClass(v)
+	}
+
+	if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+		apiObject.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].(string); ok && v != "" {
+		apiObject.TargetResource = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3ObjectMetadata(tfMap map[string]interface{}) *types.S3ObjectMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ObjectMetadata{}
+
+	if v, ok := tfMap["cache_control"].(string); ok && v != "" {
+		apiObject.CacheControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_disposition"].(string); ok && v != "" {
+		apiObject.ContentDisposition = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_encoding"].(string); ok && v != "" {
+		apiObject.ContentEncoding = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_language"].(string); ok && v != "" {
+		apiObject.ContentLanguage = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_type"].(string); ok && v != "" {
+		apiObject.ContentType = aws.String(v)
+	}
+
+	if v, ok := tfMap["sse_algorithm"].(string); ok && v != "" {
+		apiObject.SSEAlgorithm = types.S3SSEAlgorithm(v)
+	}
+
+	if v, ok := tfMap["user_metadata"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserMetadata = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Tags(tfMap map[string]interface{}) []types.S3Tag {
+	apiObjects := make([]types.S3Tag, 0, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, types.S3Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandJobReport(tfMap map[string]interface{}) *types.JobReport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobReport{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = v
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = types.JobReportFormat(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = types.JobReportScope(v)
+	}
+
+	return apiObject
+}
+
+func flattenJobManifest(apiObject *types.JobManifest) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = []interface{}{map[string]interface{}{
+			"etag":              aws.ToString(v.ETag),
+			"object_arn":        aws.ToString(v.ObjectArn),
+			"object_version_id": aws.ToString(v.ObjectVersionId),
+		}}
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = []interface{}{map[string]interface{}{
+			"fields": enum.Slice(v.Fields...),
+			"format": v.Format,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenJobOperation(apiObject *types.JobOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LambdaInvoke; v != nil {
+		tfMap["lambda_invoke"] = []interface{}{map[string]interface{}{
+			"function_arn":              aws.ToString(v.FunctionArn),
+			"invocation_schema_version": aws.ToString(v.InvocationSchemaVersion),
+			"user_arguments":            v.UserArguments,
+		}}
+	}
+
+	if apiObject.S3DeleteObjectTagging != nil {
+		tfMap["s3_delete_object_tagging"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.S3InitiateRestoreObject; v != nil {
+		tfMap["s3_initiate_restore_object"] = []interface{}{map[string]interface{}{
+			"expiration_in_days": aws.ToInt32(v.ExpirationInDays),
+			"glacier_job_tier":   v.GlacierJobTier,
+		}}
+	}
+
+	if v := apiObject.S3PutObjectAcl; v != nil {
+		m := map[string]interface{}{}
+
+		if v := v.AccessControlPolicy; v != nil {
+			m["access_control_policy"] = []interface{}{flattenS3AccessControlPolicy(v)}
+		}
+
+		tfMap["s3_put_object_acl"] = []interface{}{m}
+	}
+
+	if v := apiObject.S3PutObjectCopy; v != nil {
+		tfMap["s3_put_object_copy"] = []interface{}{flattenS3CopyObjectOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectTagging; v != nil {
+		tfMap["s3_put_object_tagging"] = []interface{}{map[string]interface{}{
+			"tag_set": flattenS3Tags(v.TagSet),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3AccessControlPolicy(apiObject *types.S3AccessControlPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"canned_access_control_list": apiObject.CannedAccessControlList,
+	}
+
+	if v := apiObject.AccessControlList; v != nil {
+		m := map[string]interface{}{}
+
+		if v := v.Grants; len(v) > 0 {
+			m["grant"] = flattenS3Grants(v)
+		}
+
+		if v := v.Owner; v != nil {
+			m["owner"] = []interface{}{map[string]interface{}{
+				"display_name": aws.ToString(v.DisplayName),
+				"id":           aws.ToString(v.ID),
+			}}
+		}
+
+		tfMap["access_control_list"] = []interface{}{m}
+	}
+
+	return tfMap
+}
+
+func flattenS3Grants(apiObjects []types.S3Grant) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"permission": apiObject.Permission,
+		}
+
+		if v := apiObject.Grantee; v != nil {
+			tfMap["grantee"] = []interface{}{map[string]interface{}{
+				"display_name":    aws.ToString(v.DisplayName),
+				"identifier":      aws.ToString(v.Identifier),
+				"type_identifier": v.TypeIdentifier,
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenS3CopyObjectOperation(apiObject *types.S3CopyObjectOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_key_enabled":         apiObject.BucketKeyEnabled,
+		"canned_access_control_list": apiObject.CannedAccessControlList,
+		"checksum_algorithm":         apiObject.ChecksumAlgorithm,
+		"metadata_directive":         apiObject.MetadataDirective,
+		"new_object_tagging":         flattenS3Tags(apiObject.NewObjectTagging),
+		"requester_pays":             apiObject.RequesterPays,
+		"sse_aws_kms_key_id":         aws.ToString(apiObject.SSEAwsKmsKeyId),
+		"storage_class":              apiObject.StorageClass,
+		"target_key_prefix":          aws.ToString(apiObject.TargetKeyPrefix),
+		"target_resource":            aws.ToString(apiObject.TargetResource),
+	}
+
+	if v := apiObject.NewObjectMetadata; v != nil {
+		tfMap["new_object_metadata"] = []interface{}{map[string]interface{}{
+			"cache_control":       aws.ToString(v.CacheControl),
+			"content_disposition": aws.ToString(v.ContentDisposition),
+			"content_encoding":    aws.ToString(v.ContentEncoding),
+			"content_language":    aws.ToString(v.ContentLanguage),
+			"content_type":        aws.ToString(v.ContentType),
+			"sse_algorithm":       v.SSEAlgorithm,
+			"user_metadata":       v.UserMetadata,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3Tags(apiObjects []types.S3Tag) map[string]interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{}, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap[aws.ToString(apiObject.Key)] = aws.ToString(apiObject.Value)
+	}
+
+	return tfMap
+}
+
+func flattenJobReport(apiObject *types.JobReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket":       aws.ToString(apiObject.Bucket),
+		"enabled":      apiObject.Enabled,
+		"format":       apiObject.Format,
+		"prefix":       aws.ToString(apiObject.Prefix),
+		"report_scope": apiObject.ReportScope,
+	}
+
+	return tfMap
+}
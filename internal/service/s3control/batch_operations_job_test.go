@@ -0,0 +1,710 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlBatchOperationsJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_putObjectTagging(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.format", "S3BatchOperations_CSV_20180820"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", string(types.JobStatusSuspended)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBatchOperationsJobConfig_putObjectTagging(rName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+					resource.TestCheckResourceAttr(resourceName, "status", string(types.JobStatusSuspended)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlBatchOperationsJob_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBatchOperationsJobConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccBatchOperationsJobConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlBatchOperationsJob_requestedJobStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_putObjectTagging(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", string(types.JobStatusSuspended)),
+				),
+			},
+			{
+				Config: testAccBatchOperationsJobConfig_requestedJobStatus(rName, string(types.RequestedJobStatusCancelled)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "requested_job_status", string(types.RequestedJobStatusCancelled)),
+					resource.TestCheckResourceAttr(resourceName, "status_update_reason", "testing"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlBatchOperationsJob_putObjectCopy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_putObjectCopy(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.0.metadata_directive", "COPY"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.0.storage_class", "STANDARD_IA"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_copy.0.target_key_prefix", "copied/"),
+					resource.TestCheckResourceAttrPair(resourceName, "operation.0.s3_put_object_copy.0.target_resource", "aws_s3_bucket.target", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "report.0.bucket", "aws_s3_bucket.target", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.format", "Report_CSV_20180820"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.prefix", "reports"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.report_scope", "FailedTasksOnly"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlBatchOperationsJob_initiateRestoreObject(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_initiateRestoreObject(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_initiate_restore_object.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_initiate_restore_object.0.expiration_in_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_initiate_restore_object.0.glacier_job_tier", "BULK"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlBatchOperationsJob_putObjectACL(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_putObjectACL(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_acl.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_acl.0.access_control_policy.0.canned_access_control_list", "bucket-owner-full-control"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlBatchOperationsJob_lambdaInvoke(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_batch_operations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchOperationsJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchOperationsJobConfig_lambdaInvoke(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchOperationsJobExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.lambda_invoke.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "operation.0.lambda_invoke.0.function_arn", "aws_lambda_function.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.lambda_invoke.0.invocation_schema_version", "2.0"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.lambda_invoke.0.user_arguments.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.lambda_invoke.0.user_arguments.Key1", "Value1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckBatchOperationsJobDestroy verifies that jobs are no longer runnable.
+// Batch Operations jobs can't be deleted and are retained by S3 for 90 days.
+func testAccCheckBatchOperationsJobDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_batch_operations_job" {
+				continue
+			}
+
+			accountID, jobID, err := tfs3control.BatchOperationsJobParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			output, err := tfs3control.FindBatchOperationsJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+			if err != nil {
+				return err
+			}
+
+			switch output.Status {
+			case types.JobStatusCancelled, types.JobStatusCancelling, types.JobStatusComplete, types.JobStatusCompleting, types.JobStatusFailed, types.JobStatusFailing:
+				continue
+			}
+
+			return fmt.Errorf("S3 Batch Operations Job %s still %s", rs.Primary.ID, output.Status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBatchOperationsJobExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		accountID, jobID, err := tfs3control.BatchOperationsJobParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		_, err = tfs3control.FindBatchOperationsJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+		return err
+	}
+}
+
+func testAccBatchOperationsJobConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "object"
+  content = "test"
+}
+
+resource "aws_s3_object" "manifest" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "manifest.csv"
+  content = "${aws_s3_bucket.test.bucket},${aws_s3_object.object.key}\n"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "batchoperations.s3.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:PutObject",
+        "s3:PutObjectAcl",
+        "s3:PutObjectTagging",
+        "s3:RestoreObject",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccBatchOperationsJobConfig_putObjectTagging(rName string, priority int) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_batch_operations_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  confirmation_required = true
+  description           = %[1]q
+  priority              = %[2]d
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key1 = "Value1"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+}
+`, rName, priority))
+}
+
+func testAccBatchOperationsJobConfig_requestedJobStatus(rName, status string) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_batch_operations_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  confirmation_required = true
+  description           = %[1]q
+  priority              = 10
+  requested_job_status  = %[2]q
+  role_arn              = aws_iam_role.test.arn
+  status_update_reason  = "testing"
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key1 = "Value1"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+}
+`, rName, status))
+}
+
+func testAccBatchOperationsJobConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_batch_operations_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_delete_object_tagging {}
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccBatchOperationsJobConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_batch_operations_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_delete_object_tagging {}
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccBatchOperationsJobConfig_putObjectCopy(rName string) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "target" {
+  bucket = "%[1]s-target"
+}
+
+resource "aws_s3control_batch_operations_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_copy {
+      metadata_directive = "COPY"
+      storage_class      = "STANDARD_IA"
+      target_key_prefix  = "copied/"
+      target_resource    = aws_s3_bucket.target.arn
+    }
+  }
+
+  report {
+    bucket       = aws_s3_bucket.target.arn
+    enabled      = true
+    format       = "Report_CSV_20180820"
+    prefix       = "reports"
+    report_scope = "FailedTasksOnly"
+  }
+}
+`, rName))
+}
+
+func testAccBatchOperationsJobConfig_initiateRestoreObject(rName string) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), `
+resource "aws_s3control_batch_operations_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_initiate_restore_object {
+      expiration_in_days = 7
+      glacier_job_tier   = "BULK"
+    }
+  }
+
+  report {
+    enabled = false
+  }
+}
+`)
+}
+
+func testAccBatchOperationsJobConfig_putObjectACL(rName string) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), `
+resource "aws_s3control_batch_operations_job" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_acl {
+      access_control_policy {
+        canned_access_control_list = "bucket-owner-full-control"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+}
+`)
+}
+
+func testAccBatchOperationsJobConfig_lambdaInvoke(rName string) string {
+	return acctest.ConfigCompose(testAccBatchOperationsJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  handler       = "index.handler"
+  role          = aws_iam_role.lambda.arn
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_iam_role_policy" "lambda" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "lambda:InvokeFunction"
+      Effect   = "Allow"
+      Resource = aws_lambda_function.test.arn
+    }]
+  })
+}
+
+resource "aws_s3control_batch_operations_job" "test" {
+  depends_on = [aws_iam_role_policy.test, aws_iam_role_policy.lambda]
+
+  confirmation_required = true
+  priority              = 10
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = aws_s3_object.manifest.arn
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    lambda_invoke {
+      function_arn              = aws_lambda_function.test.arn
+      invocation_schema_version = "2.0"
+
+      user_arguments = {
+        Key1 = "Value1"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+}
+`, rName))
+}
@@ -6,6 +6,7 @@ package s3control
 // Error code constants missing from AWS Go SDK:
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3control/#pkg-constants
 const (
+	errCodeAccessGrantsInstanceAlreadyExists    = "AccessGrantsInstanceAlreadyExists"
 	errCodeAccessGrantsLocationNotEmptyError    = "AccessGrantsLocationNotEmptyError"
 	errCodeInvalidBucketState                   = "InvalidBucketState"
 	errCodeInvalidIAMRole                       = "InvalidIamRole"
@@ -12,11 +12,13 @@ var (
 	ResourceAccessPoint                        = resourceAccessPoint
 	ResourceAccessPointPolicy                  = resourceAccessPointPolicy
 	ResourceAccountPublicAccessBlock           = resourceAccountPublicAccessBlock
+	ResourceBatchOperationsJob                 = resourceBatchOperationsJob
 	ResourceBucket                             = resourceBucket
 	ResourceBucketLifecycleConfiguration       = resourceBucketLifecycleConfiguration
 	ResourceBucketPolicy                       = resourceBucketPolicy
 	ResourceMultiRegionAccessPoint             = resourceMultiRegionAccessPoint
 	ResourceMultiRegionAccessPointPolicy       = resourceMultiRegionAccessPointPolicy
+	ResourceMultiRegionAccessPointRoutes       = resourceMultiRegionAccessPointRoutes
 	ResourceObjectLambdaAccessPoint            = resourceObjectLambdaAccessPoint
 	ResourceObjectLambdaAccessPointPolicy      = resourceObjectLambdaAccessPointPolicy
 	ResourceStorageLensConfiguration           = resourceStorageLensConfiguration
@@ -27,6 +29,7 @@ var (
 	FindAccessGrantsLocationByTwoPartKey                   = findAccessGrantsLocationByTwoPartKey
 	FindAccessPointByTwoPartKey                            = findAccessPointByTwoPartKey
 	FindAccessPointPolicyAndStatusByTwoPartKey             = findAccessPointPolicyAndStatusByTwoPartKey
+	FindBatchOperationsJobByTwoPartKey                     = findBatchOperationsJobByTwoPartKey
 	FindBucketByTwoPartKey                                 = findBucketByTwoPartKey
 	FindBucketLifecycleConfigurationByTwoPartKey           = findBucketLifecycleConfigurationByTwoPartKey
 	FindBucketPolicyByTwoPartKey                           = findBucketPolicyByTwoPartKey
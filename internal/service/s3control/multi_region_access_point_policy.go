@@ -79,6 +79,10 @@ func resourceMultiRegionAccessPointPolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"is_public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"proposed": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -119,6 +123,10 @@ func resourceMultiRegionAccessPointPolicyCreate(ctx context.Context, d *schema.R
 		return diag.Errorf("waiting for S3 Multi-Region Access Point Policy (%s) create: %s", d.Id(), err)
 	}
 
+	if err := waitMultiRegionAccessPointPolicyEstablished(ctx, conn, accountID, aws.ToString(input.Details.Name), aws.ToString(input.Details.Policy), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for S3 Multi-Region Access Point Policy (%s) establish: %s", d.Id(), err)
+	}
+
 	return resourceMultiRegionAccessPointPolicyRead(ctx, d, meta)
 }
 
@@ -166,6 +174,17 @@ func resourceMultiRegionAccessPointPolicyRead(ctx context.Context, d *schema.Res
 		d.Set("proposed", nil)
 	}
 
+	policyStatus, err := findMultiRegionAccessPointPolicyStatusByTwoPartKey(ctx, conn, accountID, name)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("is_public", false)
+	case err != nil:
+		return diag.Errorf("reading S3 Multi-Region Access Point Policy (%s) status: %s", d.Id(), err)
+	default:
+		d.Set("is_public", policyStatus.IsPublic)
+	}
+
 	return nil
 }
 
@@ -198,6 +217,10 @@ func resourceMultiRegionAccessPointPolicyUpdate(ctx context.Context, d *schema.R
 		return diag.Errorf("waiting for S3 Multi-Region Access Point Policy (%s) update: %s", d.Id(), err)
 	}
 
+	if err := waitMultiRegionAccessPointPolicyEstablished(ctx, conn, accountID, aws.ToString(input.Details.Name), aws.ToString(input.Details.Policy), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for S3 Multi-Region Access Point Policy (%s) establish: %s", d.Id(), err)
+	}
+
 	return resourceMultiRegionAccessPointPolicyRead(ctx, d, meta)
 }
 
@@ -230,6 +253,60 @@ func findMultiRegionAccessPointPolicyDocumentByTwoPartKey(ctx context.Context, c
 	return output.Policy, nil
 }
 
+func findMultiRegionAccessPointPolicyStatusByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, name string) (*types.PolicyStatus, error) {
+	input := &s3control.GetMultiRegionAccessPointPolicyStatusInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetMultiRegionAccessPointPolicyStatus(ctx, input, func(o *s3control.Options) {
+		// All Multi-Region Access Point actions are routed to the US West (Oregon) Region.
+		o.Region = names.USWest2RegionID
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchMultiRegionAccessPoint) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Established == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Established, nil
+}
+
+func waitMultiRegionAccessPointPolicyEstablished(ctx context.Context, conn *s3control.Client, accountID, name, policy string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		output, err := findMultiRegionAccessPointPolicyDocumentByTwoPartKey(ctx, conn, accountID, name)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		if output.Established == nil {
+			return false, nil
+		}
+
+		return verify.PolicyStringsEquivalent(aws.ToString(output.Established.Policy), policy), nil
+	},
+		tfresource.WaitOpts{
+			Delay:      5 * time.Second,
+			MinTimeout: 5 * time.Second,
+		},
+	)
+}
+
 func expandPutMultiRegionAccessPointPolicyInput_(tfMap map[string]interface{}) *types.PutMultiRegionAccessPointPolicyInput {
 	if tfMap == nil {
 		return nil
@@ -43,8 +43,10 @@ func TestAccS3ControlMultiRegionAccessPointPolicy_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "details.0.name", multiRegionAccessPointName),
 					resource.TestCheckResourceAttrSet(resourceName, "details.0.policy"),
 					resource.TestCheckResourceAttrSet(resourceName, "established"),
+					resource.TestCheckResourceAttr(resourceName, "is_public", "false"),
 					resource.TestCheckResourceAttrSet(resourceName, "proposed"),
 					resource.TestCheckResourceAttrPair(resourceName, "details.0.policy", resourceName, "proposed"),
+					resource.TestCheckResourceAttrPair(resourceName, "details.0.policy", resourceName, "established"),
 				),
 			},
 			{
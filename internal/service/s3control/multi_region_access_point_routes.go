@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_multi_region_access_point_routes")
+func resourceMultiRegionAccessPointRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMultiRegionAccessPointRoutesPut,
+		ReadWithoutTimeout:   resourceMultiRegionAccessPointRoutesRead,
+		UpdateWithoutTimeout: resourceMultiRegionAccessPointRoutesPut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"mrap": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"route": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+						"traffic_dial_percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{0, 100}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	multiRegionAccessPointRoutesResourceIDPartCount = 2
+)
+
+func resourceMultiRegionAccessPointRoutesPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	mrap := d.Get("mrap").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{accountID, mrap}, multiRegionAccessPointRoutesResourceIDPartCount, false))
+	routes := expandMultiRegionAccessPointRoutes(d.Get("route").(*schema.Set).List())
+	input := &s3control.SubmitMultiRegionAccessPointRoutesInput{
+		AccountId:    aws.String(accountID),
+		Mrap:         aws.String(mrap),
+		RouteUpdates: routes,
+	}
+
+	_, err := conn.SubmitMultiRegionAccessPointRoutes(ctx, input, func(o *s3control.Options) {
+		// Routing control actions are served by a limited set of Regions which includes US West (Oregon).
+		o.Region = names.USWest2RegionID
+	})
+
+	if err != nil {
+		return diag.Errorf("submitting S3 Multi-Region Access Point Routes (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := waitMultiRegionAccessPointRoutesUpdated(ctx, conn, accountID, mrap, routes, timeout); err != nil {
+		return diag.Errorf("waiting for S3 Multi-Region Access Point Routes (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceMultiRegionAccessPointRoutesRead(ctx, d, meta)
+}
+
+func resourceMultiRegionAccessPointRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), multiRegionAccessPointRoutesResourceIDPartCount, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, mrap := parts[0], parts[1]
+	routes, err := findMultiRegionAccessPointRoutesByTwoPartKey(ctx, conn, accountID, mrap)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Multi-Region Access Point Routes (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Multi-Region Access Point Routes (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("mrap", mrap)
+	if err := d.Set("route", flattenMultiRegionAccessPointRoutes(routes)); err != nil {
+		return diag.Errorf("setting route: %s", err)
+	}
+
+	return nil
+}
+
+func findMultiRegionAccessPointRoutesByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, mrap string) ([]types.MultiRegionAccessPointRoute, error) {
+	input := &s3control.GetMultiRegionAccessPointRoutesInput{
+		AccountId: aws.String(accountID),
+		Mrap:      aws.String(mrap),
+	}
+
+	output, err := conn.GetMultiRegionAccessPointRoutes(ctx, input, func(o *s3control.Options) {
+		// Routing control actions are served by a limited set of Regions which includes US West (Oregon).
+		o.Region = names.USWest2RegionID
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchMultiRegionAccessPoint) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Routes) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Routes, nil
+}
+
+func waitMultiRegionAccessPointRoutesUpdated(ctx context.Context, conn *s3control.Client, accountID, mrap string, expected []types.MultiRegionAccessPointRoute, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		output, err := findMultiRegionAccessPointRoutesByTwoPartKey(ctx, conn, accountID, mrap)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		actual := make(map[string]int32, len(output))
+		for _, v := range output {
+			actual[aws.ToString(v.Bucket)] = aws.ToInt32(v.TrafficDialPercentage)
+		}
+
+		for _, v := range expected {
+			if dial, ok := actual[aws.ToString(v.Bucket)]; !ok || dial != aws.ToInt32(v.TrafficDialPercentage) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	},
+		tfresource.WaitOpts{
+			ContinuousTargetOccurence: 2,
+			MinTimeout:                5 * time.Second,
+		},
+	)
+}
+
+func expandMultiRegionAccessPointRoutes(tfList []interface{}) []types.MultiRegionAccessPointRoute {
+	var apiObjects []types.MultiRegionAccessPointRoute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.MultiRegionAccessPointRoute{
+			TrafficDialPercentage: aws.Int32(int32(tfMap["traffic_dial_percentage"].(int))),
+		}
+
+		if v, ok := tfMap["bucket"].(string); ok && v != "" {
+			apiObject.Bucket = aws.String(v)
+		}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Region = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenMultiRegionAccessPointRoutes(apiObjects []types.MultiRegionAccessPointRoute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"bucket":                  aws.ToString(apiObject.Bucket),
+			"region":                  aws.ToString(apiObject.Region),
+			"traffic_dial_percentage": int(aws.ToInt32(apiObject.TrafficDialPercentage)),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlMultiRegionAccessPointRoutes_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3control_multi_region_access_point_routes.test"
+	bucket1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucket2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		// Multi-Region Access Point Routes cannot be deleted.
+		// Ensure parent resource is destroyed instead.
+		CheckDestroy: testAccCheckMultiRegionAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointRoutesConfig_basic(bucket1Name, bucket2Name, rName, 100, 0),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "mrap", "aws_s3control_multi_region_access_point.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"bucket":                  bucket1Name,
+						"traffic_dial_percentage": "100",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"bucket":                  bucket2Name,
+						"traffic_dial_percentage": "0",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMultiRegionAccessPointRoutesConfig_basic(bucket1Name, bucket2Name, rName, 0, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"bucket":                  bucket1Name,
+						"traffic_dial_percentage": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"bucket":                  bucket2Name,
+						"traffic_dial_percentage": "100",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccMultiRegionAccessPointRoutesConfig_basic(bucketName1, bucketName2, multiRegionAccessPointName string, dial1, dial2 int) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_region" "alternate" {
+  provider = awsalternate
+}
+
+resource "aws_s3_bucket" "test1" {
+  provider = aws
+
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket" "test2" {
+  provider = awsalternate
+
+  bucket        = %[2]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  provider = aws
+
+  details {
+    name = %[3]q
+
+    region {
+      bucket = aws_s3_bucket.test1.id
+    }
+
+    region {
+      bucket = aws_s3_bucket.test2.id
+    }
+  }
+}
+
+resource "aws_s3control_multi_region_access_point_routes" "test" {
+  mrap = aws_s3control_multi_region_access_point.test.arn
+
+  route {
+    bucket                  = aws_s3_bucket.test1.id
+    region                  = data.aws_region.current.name
+    traffic_dial_percentage = %[4]d
+  }
+
+  route {
+    bucket                  = aws_s3_bucket.test2.id
+    region                  = data.aws_region.alternate.name
+    traffic_dial_percentage = %[5]d
+  }
+}
+`, bucketName1, bucketName2, multiRegionAccessPointName, dial1, dial2))
+}
@@ -71,6 +71,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAccessPointPolicy,
 			TypeName: "aws_s3control_access_point_policy",
 		},
+		{
+			Factory:  resourceBatchOperationsJob,
+			TypeName: "aws_s3control_batch_operations_job",
+			Name:     "Batch Operations Job",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  resourceBucket,
 			TypeName: "aws_s3control_bucket",
@@ -93,6 +99,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceMultiRegionAccessPointPolicy,
 			TypeName: "aws_s3control_multi_region_access_point_policy",
 		},
+		{
+			Factory:  resourceMultiRegionAccessPointRoutes,
+			TypeName: "aws_s3control_multi_region_access_point_routes",
+		},
 		{
 			Factory:  resourceObjectLambdaAccessPoint,
 			TypeName: "aws_s3control_object_lambda_access_point",
@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Studio app lifecycle management (idle shutdown) is not modeled by AWS SDK for Go v1, so it is added to
+// request bodies and read from response bodies by request options.
+
+const (
+	lifecycleManagementDisabled = "DISABLED"
+	lifecycleManagementEnabled  = "ENABLED"
+)
+
+func lifecycleManagement_Values() []string {
+	return []string{
+		lifecycleManagementDisabled,
+		lifecycleManagementEnabled,
+	}
+}
+
+type appLifecycleManagement struct {
+	IdleSettings *idleSettings `json:"IdleSettings,omitempty"`
+}
+
+type idleSettings struct {
+	IdleTimeoutInMinutes    *int64  `json:"IdleTimeoutInMinutes,omitempty"`
+	LifecycleManagement     *string `json:"LifecycleManagement,omitempty"`
+	MaxIdleTimeoutInMinutes *int64  `json:"MaxIdleTimeoutInMinutes,omitempty"`
+	MinIdleTimeoutInMinutes *int64  `json:"MinIdleTimeoutInMinutes,omitempty"`
+}
+
+// appLifecycleManagements maps the dotted path of an app settings member of a request or response body,
+// e.g. "DefaultUserSettings.JupyterLabAppSettings", to its lifecycle management.
+type appLifecycleManagements map[string]*appLifecycleManagement
+
+// appLifecycleManagementAppSettings maps the app settings attributes that support lifecycle management to their API members.
+var appLifecycleManagementAppSettings = map[string]string{
+	"code_editor_app_settings": "CodeEditorAppSettings",
+	"jupyter_lab_app_settings": "JupyterLabAppSettings",
+}
+
+// withAppLifecycleManagementRequest returns a request option that merges the specified lifecycle managements
+// into the request body, creating any missing members along their paths.
+func withAppLifecycleManagementRequest(apiObjects appLifecycleManagements) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Build.PushBack(func(r *request.Request) {
+			if r.Error != nil || len(apiObjects) == 0 {
+				return
+			}
+
+			b, err := io.ReadAll(r.GetBody())
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			body := map[string]json.RawMessage{}
+
+			if len(b) > 0 {
+				if err := json.Unmarshal(b, &body); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			for path, apiObject := range apiObjects {
+				if apiObject == nil {
+					continue
+				}
+
+				keys := append(strings.Split(path, "."), "AppLifecycleManagement")
+
+				if err := setJSONMember(body, keys, apiObject); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			if b, err = json.Marshal(body); err != nil {
+				r.Error = err
+				return
+			}
+
+			r.SetBufferBody(b)
+		})
+	}
+}
+
+// withAppLifecycleManagementResponse returns a request option that reads the lifecycle management
+// of each of the specified app settings members of the response body into apiObjects.
+func withAppLifecycleManagementResponse(apiObjects appLifecycleManagements, paths ...string) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Unmarshal.PushFront(func(r *request.Request) {
+			b, err := io.ReadAll(r.HTTPResponse.Body)
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			r.HTTPResponse.Body = io.NopCloser(bytes.NewReader(b))
+
+			for _, path := range paths {
+				v, ok := getJSONMember(b, append(strings.Split(path, "."), "AppLifecycleManagement"))
+
+				if !ok {
+					continue
+				}
+
+				var apiObject appLifecycleManagement
+
+				if err := json.Unmarshal(v, &apiObject); err == nil {
+					apiObjects[path] = &apiObject
+				}
+			}
+		})
+	}
+}
+
+func setJSONMember(body map[string]json.RawMessage, keys []string, v interface{}) error {
+	var err error
+
+	if len(keys) == 1 {
+		body[keys[0]], err = json.Marshal(v)
+
+		return err
+	}
+
+	member := map[string]json.RawMessage{}
+
+	if raw, ok := body[keys[0]]; ok && len(raw) > 0 && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &member); err != nil {
+			return err
+		}
+	}
+
+	if err := setJSONMember(member, keys[1:], v); err != nil {
+		return err
+	}
+
+	body[keys[0]], err = json.Marshal(member)
+
+	return err
+}
+
+func getJSONMember(b []byte, keys []string) (json.RawMessage, bool) {
+	for _, key := range keys {
+		body := map[string]json.RawMessage{}
+
+		if err := json.Unmarshal(b, &body); err != nil {
+			return nil, false
+		}
+
+		v, ok := body[key]
+
+		if !ok || string(v) == "null" {
+			return nil, false
+		}
+
+		b = v
+	}
+
+	return b, true
+}
+
+func appLifecycleManagementSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"idle_settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"idle_timeout_in_minutes": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(60, 525600),
+							},
+							"lifecycle_management": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(lifecycleManagement_Values(), false),
+							},
+							"max_idle_timeout_in_minutes": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(60, 525600),
+							},
+							"min_idle_timeout_in_minutes": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(60, 525600),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func spaceAppLifecycleManagementSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"idle_settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"idle_timeout_in_minutes": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(60, 525600),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandAppLifecycleManagements adds the lifecycle management of each app settings block of the
+// specified settings block to apiObjects, keyed by the settings' API member.
+func expandAppLifecycleManagements(apiObjects appLifecycleManagements, member string, l []interface{}) {
+	if len(l) == 0 || l[0] == nil {
+		return
+	}
+
+	m := l[0].(map[string]interface{})
+
+	for key, appMember := range appLifecycleManagementAppSettings {
+		v, ok := m[key].([]interface{})
+
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		if v, ok := v[0].(map[string]interface{})["app_lifecycle_management"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObjects[member+"."+appMember] = expandAppLifecycleManagement(v)
+		}
+	}
+}
+
+func expandAppLifecycleManagement(l []interface{}) *appLifecycleManagement {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &appLifecycleManagement{}
+
+	if v, ok := m["idle_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.IdleSettings = expandIdleSettings(v[0].(map[string]interface{}))
+	}
+
+	return config
+}
+
+func expandIdleSettings(m map[string]interface{}) *idleSettings {
+	config := &idleSettings{}
+
+	if v, ok := m["idle_timeout_in_minutes"].(int); ok && v > 0 {
+		config.IdleTimeoutInMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["lifecycle_management"].(string); ok && v != "" {
+		config.LifecycleManagement = aws.String(v)
+	}
+
+	if v, ok := m["max_idle_timeout_in_minutes"].(int); ok && v > 0 {
+		config.MaxIdleTimeoutInMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["min_idle_timeout_in_minutes"].(int); ok && v > 0 {
+		config.MinIdleTimeoutInMinutes = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+// flattenAppLifecycleManagements sets the lifecycle management of each app settings block of the
+// specified flattened settings block from apiObjects.
+func flattenAppLifecycleManagements(tfList []map[string]interface{}, member string, apiObjects appLifecycleManagements, flatten func(*appLifecycleManagement) []map[string]interface{}) {
+	if len(tfList) == 0 {
+		return
+	}
+
+	for key, appMember := range appLifecycleManagementAppSettings {
+		apiObject := apiObjects[member+"."+appMember]
+
+		if apiObject == nil {
+			continue
+		}
+
+		appSettings, _ := tfList[0][key].([]map[string]interface{})
+
+		if len(appSettings) == 0 {
+			appSettings = []map[string]interface{}{{}}
+			tfList[0][key] = appSettings
+		}
+
+		appSettings[0]["app_lifecycle_management"] = flatten(apiObject)
+	}
+}
+
+func flattenAppLifecycleManagement(apiObject *appLifecycleManagement) []map[string]interface{} {
+	if apiObject == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.IdleSettings; v != nil {
+		m["idle_settings"] = []map[string]interface{}{{
+			"idle_timeout_in_minutes":     aws.Int64Value(v.IdleTimeoutInMinutes),
+			"lifecycle_management":        aws.StringValue(v.LifecycleManagement),
+			"max_idle_timeout_in_minutes": aws.Int64Value(v.MaxIdleTimeoutInMinutes),
+			"min_idle_timeout_in_minutes": aws.Int64Value(v.MinIdleTimeoutInMinutes),
+		}}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSpaceAppLifecycleManagement(apiObject *appLifecycleManagement) []map[string]interface{} {
+	if apiObject == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.IdleSettings; v != nil {
+		m["idle_settings"] = []map[string]interface{}{{
+			"idle_timeout_in_minutes": aws.Int64Value(v.IdleTimeoutInMinutes),
+		}}
+	}
+
+	return []map[string]interface{}{m}
+}
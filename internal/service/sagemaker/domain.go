@@ -67,11 +67,141 @@ func ResourceDomain() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"custom_file_system_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"efs_file_system_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"file_system_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"file_system_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"custom_posix_user_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"gid": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1001),
+									},
+									"uid": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(10000),
+									},
+								},
+							},
+						},
 						"execution_role": {
 							Type:         schema.TypeString,
 							Required:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"jupyter_lab_app_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_lifecycle_management": appLifecycleManagementSchema(),
+									"code_repository": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"repository_url": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+									"custom_image": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 200,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"app_image_config_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"image_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"image_version_number": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"default_resource_spec": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"instance_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(sagemaker.AppInstanceType_Values(), false),
+												},
+												"lifecycle_config_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_version_alias": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"sagemaker_image_version_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"lifecycle_config_arns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+						},
 						"jupyter_server_app_settings": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -213,6 +343,32 @@ func ResourceDomain() *schema.Resource {
 							MaxItems: 5,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"space_storage_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_ebs_storage_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"default_ebs_volume_size_in_gb": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+												"maximum_ebs_volume_size_in_gb": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -348,6 +504,28 @@ func ResourceDomain() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"app_lifecycle_management": appLifecycleManagementSchema(),
+									"custom_image": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 200,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"app_image_config_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"image_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"image_version_number": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+											},
+										},
+									},
 									"default_resource_spec": {
 										Type:     schema.TypeList,
 										Optional: true,
@@ -452,6 +630,7 @@ func ResourceDomain() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"app_lifecycle_management": appLifecycleManagementSchema(),
 									"code_repository": {
 										Type:     schema.TypeSet,
 										Optional: true,
@@ -1032,8 +1211,10 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.KmsKeyId = aws.String(v.(string))
 	}
 
+	lifecycleManagements := expandDomainAppLifecycleManagements(d)
+
 	log.Printf("[DEBUG] SageMaker Domain create config: %#v", *input)
-	output, err := conn.CreateDomainWithContext(ctx, input)
+	output, err := conn.CreateDomainWithContext(ctx, input, withAppLifecycleManagementRequest(lifecycleManagements))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating SageMaker Domain: %s", err)
 	}
@@ -1057,7 +1238,8 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
 
-	domain, err := FindDomainByName(ctx, conn, d.Id())
+	lifecycleManagements := appLifecycleManagements{}
+	domain, err := FindDomainByName(ctx, conn, d.Id(), withAppLifecycleManagementResponse(lifecycleManagements, domainAppLifecycleManagementPaths...))
 	if err != nil {
 		if !d.IsNewResource() && tfresource.NotFound(err) {
 			d.SetId("")
@@ -1085,11 +1267,15 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "setting subnet_ids for SageMaker Domain (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("default_user_settings", flattenUserSettings(domain.DefaultUserSettings)); err != nil {
+	defaultUserSettings := flattenUserSettings(domain.DefaultUserSettings)
+	flattenAppLifecycleManagements(defaultUserSettings, "DefaultUserSettings", lifecycleManagements, flattenAppLifecycleManagement)
+	if err := d.Set("default_user_settings", defaultUserSettings); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting default_user_settings for SageMaker Domain (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("default_space_settings", flattenDefaultSpaceSettings(domain.DefaultSpaceSettings)); err != nil {
+	defaultSpaceSettings := flattenDefaultSpaceSettings(domain.DefaultSpaceSettings)
+	flattenAppLifecycleManagements(defaultSpaceSettings, "DefaultSpaceSettings", lifecycleManagements, flattenAppLifecycleManagement)
+	if err := d.Set("default_space_settings", defaultSpaceSettings); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting default_space_settings for SageMaker Domain (%s): %s", d.Id(), err)
 	}
 
@@ -1122,7 +1308,7 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		log.Printf("[DEBUG] SageMaker Domain update config: %#v", *input)
-		_, err := conn.UpdateDomainWithContext(ctx, input)
+		_, err := conn.UpdateDomainWithContext(ctx, input, withAppLifecycleManagementRequest(expandDomainAppLifecycleManagements(d)))
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating SageMaker Domain: %s", err)
 		}
@@ -1135,6 +1321,22 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceDomainRead(ctx, d, meta)...)
 }
 
+// domainAppLifecycleManagementPaths are the app settings members of a domain that support lifecycle management.
+var domainAppLifecycleManagementPaths = []string{
+	"DefaultSpaceSettings.JupyterLabAppSettings",
+	"DefaultUserSettings.CodeEditorAppSettings",
+	"DefaultUserSettings.JupyterLabAppSettings",
+}
+
+func expandDomainAppLifecycleManagements(d *schema.ResourceData) appLifecycleManagements {
+	apiObjects := appLifecycleManagements{}
+
+	expandAppLifecycleManagements(apiObjects, "DefaultSpaceSettings", d.Get("default_space_settings").([]interface{}))
+	expandAppLifecycleManagements(apiObjects, "DefaultUserSettings", d.Get("default_user_settings").([]interface{}))
+
+	return apiObjects
+}
+
 func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
@@ -1373,6 +1575,10 @@ func expandDomainCodeEditorAppSettings(l []interface{}) *sagemaker.CodeEditorApp
 
 	config := &sagemaker.CodeEditorAppSettings{}
 
+	if v, ok := m["custom_image"].([]interface{}); ok && len(v) > 0 {
+		config.CustomImages = expandDomainCustomImages(v)
+	}
+
 	if v, ok := m["default_resource_spec"].([]interface{}); ok && len(v) > 0 {
 		config.DefaultResourceSpec = expandResourceSpec(v)
 	}
@@ -1949,6 +2155,10 @@ func flattenDomainCodeEditorAppSettings(config *sagemaker.CodeEditorAppSettings)
 
 	m := map[string]interface{}{}
 
+	if config.CustomImages != nil {
+		m["custom_image"] = flattenDomainCustomImages(config.CustomImages)
+	}
+
 	if config.DefaultResourceSpec != nil {
 		m["default_resource_spec"] = flattenResourceSpec(config.DefaultResourceSpec)
 	}
@@ -2239,10 +2449,22 @@ func expanDefaultSpaceSettings(l []interface{}) *sagemaker.DefaultSpaceSettings
 
 	config := &sagemaker.DefaultSpaceSettings{}
 
+	if v, ok := m["custom_file_system_config"].([]interface{}); ok && len(v) > 0 {
+		config.CustomFileSystemConfigs = expandCustomFileSystemConfigs(v)
+	}
+
+	if v, ok := m["custom_posix_user_config"].([]interface{}); ok && len(v) > 0 {
+		config.CustomPosixUserConfig = expandCustomPOSIXUserConfig(v)
+	}
+
 	if v, ok := m["execution_role"].(string); ok && v != "" {
 		config.ExecutionRole = aws.String(v)
 	}
 
+	if v, ok := m["jupyter_lab_app_settings"].([]interface{}); ok && len(v) > 0 {
+		config.JupyterLabAppSettings = expandDomainJupyterLabAppSettings(v)
+	}
+
 	if v, ok := m["jupyter_server_app_settings"].([]interface{}); ok && len(v) > 0 {
 		config.JupyterServerAppSettings = expandDomainJupyterServerAppSettings(v)
 	}
@@ -2255,6 +2477,10 @@ func expanDefaultSpaceSettings(l []interface{}) *sagemaker.DefaultSpaceSettings
 		config.SecurityGroups = flex.ExpandStringSet(v)
 	}
 
+	if v, ok := m["space_storage_settings"].([]interface{}); ok && len(v) > 0 {
+		config.SpaceStorageSettings = expandDefaultSpaceStorageSettings(v)
+	}
+
 	return config
 }
 
@@ -2265,10 +2491,22 @@ func flattenDefaultSpaceSettings(config *sagemaker.DefaultSpaceSettings) []map[s
 
 	m := map[string]interface{}{}
 
+	if config.CustomFileSystemConfigs != nil {
+		m["custom_file_system_config"] = flattenCustomFileSystemConfigs(config.CustomFileSystemConfigs)
+	}
+
+	if config.CustomPosixUserConfig != nil {
+		m["custom_posix_user_config"] = flattenCustomPOSIXUserConfig(config.CustomPosixUserConfig)
+	}
+
 	if config.ExecutionRole != nil {
 		m["execution_role"] = aws.StringValue(config.ExecutionRole)
 	}
 
+	if config.JupyterLabAppSettings != nil {
+		m["jupyter_lab_app_settings"] = flattenDomainJupyterLabAppSettings(config.JupyterLabAppSettings)
+	}
+
 	if config.JupyterServerAppSettings != nil {
 		m["jupyter_server_app_settings"] = flattenDomainJupyterServerAppSettings(config.JupyterServerAppSettings)
 	}
@@ -2281,6 +2519,10 @@ func flattenDefaultSpaceSettings(config *sagemaker.DefaultSpaceSettings) []map[s
 		m["security_groups"] = flex.FlattenStringSet(config.SecurityGroups)
 	}
 
+	if config.SpaceStorageSettings != nil {
+		m["space_storage_settings"] = flattenDefaultSpaceStorageSettings(config.SpaceStorageSettings)
+	}
+
 	return []map[string]interface{}{m}
 }
 
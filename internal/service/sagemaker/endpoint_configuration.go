@@ -224,6 +224,12 @@ func ResourceEndpointConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -322,7 +328,7 @@ func ResourceEndpointConfiguration() *schema.Resource {
 						},
 						"model_name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 							ForceNew: true,
 						},
 						"serverless_config": {
@@ -445,7 +451,7 @@ func ResourceEndpointConfiguration() *schema.Resource {
 						},
 						"model_name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 							ForceNew: true,
 						},
 						"serverless_config": {
@@ -511,6 +517,10 @@ func resourceEndpointConfigurationCreate(ctx context.Context, d *schema.Resource
 		Tags:               getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("execution_role_arn"); ok {
+		createOpts.ExecutionRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("kms_key_arn"); ok {
 		createOpts.KmsKeyId = aws.String(v.(string))
 	}
@@ -556,6 +566,7 @@ func resourceEndpointConfigurationRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("arn", endpointConfig.EndpointConfigArn)
 	d.Set("name", endpointConfig.EndpointConfigName)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(endpointConfig.EndpointConfigName)))
+	d.Set("execution_role_arn", endpointConfig.ExecutionRoleArn)
 	d.Set("kms_key_arn", endpointConfig.KmsKeyId)
 
 	if err := d.Set("production_variants", flattenProductionVariants(endpointConfig.ProductionVariants)); err != nil {
@@ -613,8 +624,10 @@ func expandProductionVariants(configured []interface{}) []*sagemaker.ProductionV
 	for _, lRaw := range configured {
 		data := lRaw.(map[string]interface{})
 
-		l := &sagemaker.ProductionVariant{
-			ModelName: aws.String(data["model_name"].(string)),
+		l := &sagemaker.ProductionVariant{}
+
+		if v, ok := data["model_name"].(string); ok && v != "" {
+			l.ModelName = aws.String(v)
 		}
 
 		if v, ok := data["initial_instance_count"].(int); ok && v > 0 {
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -175,12 +176,12 @@ func FindDeviceFleetByName(ctx context.Context, conn *sagemaker.SageMaker, id st
 
 // FindDomainByName returns the domain corresponding to the specified domain id.
 // Returns nil if no domain is found.
-func FindDomainByName(ctx context.Context, conn *sagemaker.SageMaker, domainID string) (*sagemaker.DescribeDomainOutput, error) {
+func FindDomainByName(ctx context.Context, conn *sagemaker.SageMaker, domainID string, optFns ...request.Option) (*sagemaker.DescribeDomainOutput, error) {
 	input := &sagemaker.DescribeDomainInput{
 		DomainId: aws.String(domainID),
 	}
 
-	output, err := conn.DescribeDomainWithContext(ctx, input)
+	output, err := conn.DescribeDomainWithContext(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
 		return nil, &retry.NotFoundError{
@@ -227,13 +228,13 @@ func FindFeatureGroupByName(ctx context.Context, conn *sagemaker.SageMaker, name
 
 // FindUserProfileByName returns the domain corresponding to the specified domain id.
 // Returns nil if no domain is found.
-func FindUserProfileByName(ctx context.Context, conn *sagemaker.SageMaker, domainID, userProfileName string) (*sagemaker.DescribeUserProfileOutput, error) {
+func FindUserProfileByName(ctx context.Context, conn *sagemaker.SageMaker, domainID, userProfileName string, optFns ...request.Option) (*sagemaker.DescribeUserProfileOutput, error) {
 	input := &sagemaker.DescribeUserProfileInput{
 		DomainId:        aws.String(domainID),
 		UserProfileName: aws.String(userProfileName),
 	}
 
-	output, err := conn.DescribeUserProfileWithContext(ctx, input)
+	output, err := conn.DescribeUserProfileWithContext(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
 		return nil, &retry.NotFoundError{
@@ -591,13 +592,13 @@ func FindNotebookInstanceByName(ctx context.Context, conn *sagemaker.SageMaker,
 	return output, nil
 }
 
-func FindSpaceByName(ctx context.Context, conn *sagemaker.SageMaker, domainId, name string) (*sagemaker.DescribeSpaceOutput, error) {
+func FindSpaceByName(ctx context.Context, conn *sagemaker.SageMaker, domainId, name string, optFns ...request.Option) (*sagemaker.DescribeSpaceOutput, error) {
 	input := &sagemaker.DescribeSpaceInput{
 		SpaceName: aws.String(name),
 		DomainId:  aws.String(domainId),
 	}
 
-	output, err := conn.DescribeSpaceWithContext(ctx, input)
+	output, err := conn.DescribeSpaceWithContext(ctx, input, optFns...)
 
 	if tfawserr.ErrMessageContains(err, "ValidationException", "RecordNotFound") {
 		return nil, &retry.NotFoundError{
@@ -641,3 +642,53 @@ func FindPipelineByName(ctx context.Context, conn *sagemaker.SageMaker, name str
 
 	return output, nil
 }
+
+func FindInferenceComponentByName(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	input := &sagemaker.DescribeInferenceComponentInput{
+		InferenceComponentName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInferenceComponentWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, "ValidationException", "Could not find") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMlflowTrackingServerByName(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeMlflowTrackingServerOutput, error) {
+	input := &sagemaker.DescribeMlflowTrackingServerInput{
+		TrackingServerName: aws.String(name),
+	}
+
+	output, err := conn.DescribeMlflowTrackingServerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
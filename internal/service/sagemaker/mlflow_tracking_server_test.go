@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSageMakerMlflowTrackingServer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var trackingServer sagemaker.DescribeMlflowTrackingServerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_mlflow_tracking_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMlflowTrackingServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMlflowTrackingServerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMlflowTrackingServerExists(ctx, resourceName, &trackingServer),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "sagemaker", regexache.MustCompile(`mlflow-tracking-server/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "automatic_model_registration", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "mlflow_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_server_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_server_size", "Small"),
+					resource.TestCheckResourceAttrSet(resourceName, "tracking_server_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "weekly_maintenance_window_start"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerMlflowTrackingServer_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var trackingServer sagemaker.DescribeMlflowTrackingServerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_mlflow_tracking_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMlflowTrackingServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMlflowTrackingServerConfig_full(rName, "Small", "Tue:03:30", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMlflowTrackingServerExists(ctx, resourceName, &trackingServer),
+					resource.TestCheckResourceAttr(resourceName, "automatic_model_registration", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_server_size", "Small"),
+					resource.TestCheckResourceAttr(resourceName, "weekly_maintenance_window_start", "Tue:03:30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMlflowTrackingServerConfig_full(rName, "Medium", "Sun:22:00", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMlflowTrackingServerExists(ctx, resourceName, &trackingServer),
+					resource.TestCheckResourceAttr(resourceName, "automatic_model_registration", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_server_size", "Medium"),
+					resource.TestCheckResourceAttr(resourceName, "weekly_maintenance_window_start", "Sun:22:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSageMakerMlflowTrackingServer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var trackingServer sagemaker.DescribeMlflowTrackingServerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_mlflow_tracking_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMlflowTrackingServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMlflowTrackingServerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMlflowTrackingServerExists(ctx, resourceName, &trackingServer),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsagemaker.ResourceMlflowTrackingServer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMlflowTrackingServerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sagemaker_mlflow_tracking_server" {
+				continue
+			}
+
+			_, err := tfsagemaker.FindMlflowTrackingServerByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SageMaker MLflow Tracking Server %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMlflowTrackingServerExists(ctx context.Context, n string, v *sagemaker.DescribeMlflowTrackingServerOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker MLflow Tracking Server ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn(ctx)
+
+		output, err := tfsagemaker.FindMlflowTrackingServerByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMlflowTrackingServerConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  path               = "/"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = [
+      "s3:Get*",
+      "s3:Put*",
+      "s3:List*",
+    ]
+    resources = [
+      aws_s3_bucket.test.arn,
+      "${aws_s3_bucket.test.arn}/*",
+    ]
+  }
+
+  statement {
+    actions = [
+      "sagemaker:AddTags",
+      "sagemaker:CreateModelPackageGroup",
+      "sagemaker:CreateModelPackage",
+      "sagemaker:DescribeModelPackageGroup",
+      "sagemaker:UpdateModelPackage",
+    ]
+    resources = ["*"]
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  name   = %[1]q
+  role   = aws_iam_role.test.id
+  policy = data.aws_iam_policy_document.test.json
+}
+`, rName)
+}
+
+func testAccMlflowTrackingServerConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMlflowTrackingServerConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_mlflow_tracking_server" "test" {
+  tracking_server_name = %[1]q
+  role_arn             = aws_iam_role.test.arn
+  artifact_store_uri   = "s3://${aws_s3_bucket.test.bucket}/mlflow"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccMlflowTrackingServerConfig_full(rName, size, maintenanceWindowStart string, automaticModelRegistration bool) string {
+	return acctest.ConfigCompose(testAccMlflowTrackingServerConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_mlflow_tracking_server" "test" {
+  tracking_server_name            = %[1]q
+  role_arn                        = aws_iam_role.test.arn
+  artifact_store_uri              = "s3://${aws_s3_bucket.test.bucket}/mlflow"
+  tracking_server_size            = %[2]q
+  weekly_maintenance_window_start = %[3]q
+  automatic_model_registration    = %[4]t
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, size, maintenanceWindowStart, automaticModelRegistration))
+}
@@ -68,6 +68,8 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"efs":                                                    testAccDomain_efs,
 			"posix":                                                  testAccDomain_posix,
 			"spaceStorageSettings":                                   testAccDomain_spaceStorageSettings,
+			"jupyterLabAppSettingsIdleSettings":                      testAccDomain_jupyterLabAppSettingsIdleSettings,
+			"defaultSpaceSettingsJupyterLabAppSettings":              testAccDomain_defaultSpaceSettingsJupyterLabAppSettings,
 		},
 		"FlowDefinition": {
 			"basic":                          testAccFlowDefinition_basic,
@@ -84,6 +86,9 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"kernelGatewayAppSettings_lifecycleConfig": testAccSpace_kernelGatewayAppSettings_lifecycleconfig,
 			"kernelGatewayAppSettings_imageConfig":     testAccSpace_kernelGatewayAppSettings_imageconfig,
 			"jupyterServerAppSettings":                 testAccSpace_jupyterServerAppSettings,
+			"jupyterLabAppSettings":                    testAccSpace_jupyterLabAppSettings,
+			"codeEditorAppSettings":                    testAccSpace_codeEditorAppSettings,
+			"spaceSharingSettings":                     testAccSpace_spaceSharingSettings,
 		},
 		"UserProfile": {
 			"basic":                           testAccUserProfile_basic,
@@ -95,6 +100,7 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"kernelGatewayAppSettings_lifecycleConfig": testAccUserProfile_kernelGatewayAppSettings_lifecycleconfig,
 			"kernelGatewayAppSettings_imageConfig":     testAccUserProfile_kernelGatewayAppSettings_imageconfig,
 			"jupyterServerAppSettings":                 testAccUserProfile_jupyterServerAppSettings,
+			"jupyterLabAppSettingsIdleSettings":        testAccUserProfile_jupyterLabAppSettingsIdleSettings,
 		},
 		"Workforce": {
 			"disappears":     testAccWorkforce_disappears,
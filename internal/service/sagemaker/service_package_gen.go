@@ -138,6 +138,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceImageVersion,
 			TypeName: "aws_sagemaker_image_version",
 		},
+		{
+			Factory:  ResourceInferenceComponent,
+			TypeName: "aws_sagemaker_inference_component",
+			Name:     "Inference Component",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceMlflowTrackingServer,
+			TypeName: "aws_sagemaker_mlflow_tracking_server",
+			Name:     "MLflow Tracking Server",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceModel,
 			TypeName: "aws_sagemaker_model",
@@ -55,6 +55,20 @@ func ResourceSpace() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"ownership_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"owner_user_profile_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"space_display_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -65,6 +79,132 @@ func ResourceSpace() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"app_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.AppType_Values(), false),
+						},
+						"code_editor_app_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_lifecycle_management": spaceAppLifecycleManagementSchema(),
+									"default_resource_spec": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"instance_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(sagemaker.AppInstanceType_Values(), false),
+												},
+												"lifecycle_config_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_version_alias": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"sagemaker_image_version_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"custom_file_system": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"efs_file_system": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"file_system_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"jupyter_lab_app_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_lifecycle_management": spaceAppLifecycleManagementSchema(),
+									"code_repository": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"repository_url": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+									"default_resource_spec": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"instance_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(sagemaker.AppInstanceType_Values(), false),
+												},
+												"lifecycle_config_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_version_alias": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"sagemaker_image_version_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"jupyter_server_app_settings": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -200,6 +340,46 @@ func ResourceSpace() *schema.Resource {
 								},
 							},
 						},
+						"space_storage_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ebs_storage_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ebs_volume_size_in_gb": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(5, 16384),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"space_sharing_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sharing_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.SharingType_Values(), false),
+						},
 					},
 				},
 			},
@@ -239,8 +419,16 @@ func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.SpaceDisplayName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("ownership_settings"); ok && len(v.([]interface{})) > 0 {
+		input.OwnershipSettings = expandOwnershipSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("space_sharing_settings"); ok && len(v.([]interface{})) > 0 {
+		input.SpaceSharingSettings = expandSpaceSharingSettings(v.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] SageMaker Space create config: %#v", *input)
-	out, err := conn.CreateSpaceWithContext(ctx, input)
+	out, err := conn.CreateSpaceWithContext(ctx, input, withAppLifecycleManagementRequest(expandSpaceAppLifecycleManagements(d)))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating SageMaker Space: %s", err)
 	}
@@ -263,7 +451,8 @@ func resourceSpaceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "reading SageMaker Space (%s): %s", d.Id(), err)
 	}
 
-	Space, err := FindSpaceByName(ctx, conn, domainID, name)
+	lifecycleManagements := appLifecycleManagements{}
+	Space, err := FindSpaceByName(ctx, conn, domainID, name, withAppLifecycleManagementResponse(lifecycleManagements, spaceAppLifecycleManagementPaths...))
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
 			d.SetId("")
@@ -281,10 +470,20 @@ func resourceSpaceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("space_name", Space.SpaceName)
 	d.Set("url", Space.Url)
 
-	if err := d.Set("space_settings", flattenSpaceSettings(Space.SpaceSettings)); err != nil {
+	if err := d.Set("ownership_settings", flattenOwnershipSettings(Space.OwnershipSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ownership_settings for SageMaker Space (%s): %s", d.Id(), err)
+	}
+
+	spaceSettings := flattenSpaceSettings(Space.SpaceSettings)
+	flattenAppLifecycleManagements(spaceSettings, "SpaceSettings", lifecycleManagements, flattenSpaceAppLifecycleManagement)
+	if err := d.Set("space_settings", spaceSettings); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting space_settings for SageMaker Space (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("space_sharing_settings", flattenSpaceSharingSettings(Space.SpaceSharingSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting space_sharing_settings for SageMaker Space (%s): %s", d.Id(), err)
+	}
+
 	return diags
 }
 
@@ -310,7 +509,7 @@ func resourceSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 
 		log.Printf("[DEBUG] SageMaker Space update config: %#v", *input)
-		_, err := conn.UpdateSpaceWithContext(ctx, input)
+		_, err := conn.UpdateSpaceWithContext(ctx, input, withAppLifecycleManagementRequest(expandSpaceAppLifecycleManagements(d)))
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating SageMaker Space: %s", err)
 		}
@@ -323,6 +522,20 @@ func resourceSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	return append(diags, resourceSpaceRead(ctx, d, meta)...)
 }
 
+// spaceAppLifecycleManagementPaths are the app settings members of a space that support lifecycle management.
+var spaceAppLifecycleManagementPaths = []string{
+	"SpaceSettings.CodeEditorAppSettings",
+	"SpaceSettings.JupyterLabAppSettings",
+}
+
+func expandSpaceAppLifecycleManagements(d *schema.ResourceData) appLifecycleManagements {
+	apiObjects := appLifecycleManagements{}
+
+	expandAppLifecycleManagements(apiObjects, "SpaceSettings", d.Get("space_settings").([]interface{}))
+
+	return apiObjects
+}
+
 func resourceSpaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
@@ -378,6 +591,22 @@ func expandSpaceSettings(l []interface{}) *sagemaker.SpaceSettings {
 
 	config := &sagemaker.SpaceSettings{}
 
+	if v, ok := m["app_type"].(string); ok && v != "" {
+		config.AppType = aws.String(v)
+	}
+
+	if v, ok := m["code_editor_app_settings"].([]interface{}); ok && len(v) > 0 {
+		config.CodeEditorAppSettings = expandSpaceCodeEditorAppSettings(v)
+	}
+
+	if v, ok := m["custom_file_system"].([]interface{}); ok && len(v) > 0 {
+		config.CustomFileSystems = expandCustomFileSystems(v)
+	}
+
+	if v, ok := m["jupyter_lab_app_settings"].([]interface{}); ok && len(v) > 0 {
+		config.JupyterLabAppSettings = expandSpaceJupyterLabAppSettings(v)
+	}
+
 	if v, ok := m["jupyter_server_app_settings"].([]interface{}); ok && len(v) > 0 {
 		config.JupyterServerAppSettings = expandDomainJupyterServerAppSettings(v)
 	}
@@ -386,6 +615,10 @@ func expandSpaceSettings(l []interface{}) *sagemaker.SpaceSettings {
 		config.KernelGatewayAppSettings = expandDomainKernelGatewayAppSettings(v)
 	}
 
+	if v, ok := m["space_storage_settings"].([]interface{}); ok && len(v) > 0 {
+		config.SpaceStorageSettings = expandSpaceStorageSettings(v)
+	}
+
 	return config
 }
 
@@ -394,7 +627,21 @@ func flattenSpaceSettings(config *sagemaker.SpaceSettings) []map[string]interfac
 		return []map[string]interface{}{}
 	}
 
-	m := map[string]interface{}{}
+	m := map[string]interface{}{
+		"app_type": aws.StringValue(config.AppType),
+	}
+
+	if config.CodeEditorAppSettings != nil {
+		m["code_editor_app_settings"] = flattenSpaceCodeEditorAppSettings(config.CodeEditorAppSettings)
+	}
+
+	if config.CustomFileSystems != nil {
+		m["custom_file_system"] = flattenCustomFileSystems(config.CustomFileSystems)
+	}
+
+	if config.JupyterLabAppSettings != nil {
+		m["jupyter_lab_app_settings"] = flattenSpaceJupyterLabAppSettings(config.JupyterLabAppSettings)
+	}
 
 	if config.JupyterServerAppSettings != nil {
 		m["jupyter_server_app_settings"] = flattenDomainJupyterServerAppSettings(config.JupyterServerAppSettings)
@@ -404,5 +651,209 @@ func flattenSpaceSettings(config *sagemaker.SpaceSettings) []map[string]interfac
 		m["kernel_gateway_app_settings"] = flattenDomainKernelGatewayAppSettings(config.KernelGatewayAppSettings)
 	}
 
+	if config.SpaceStorageSettings != nil {
+		m["space_storage_settings"] = flattenSpaceStorageSettings(config.SpaceStorageSettings)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandSpaceCodeEditorAppSettings(l []interface{}) *sagemaker.SpaceCodeEditorAppSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.SpaceCodeEditorAppSettings{}
+
+	if v, ok := m["default_resource_spec"].([]interface{}); ok && len(v) > 0 {
+		config.DefaultResourceSpec = expandResourceSpec(v)
+	}
+
+	return config
+}
+
+func expandSpaceJupyterLabAppSettings(l []interface{}) *sagemaker.SpaceJupyterLabAppSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.SpaceJupyterLabAppSettings{}
+
+	if v, ok := m["code_repository"].(*schema.Set); ok && v.Len() > 0 {
+		config.CodeRepositories = expandCodeRepositories(v.List())
+	}
+
+	if v, ok := m["default_resource_spec"].([]interface{}); ok && len(v) > 0 {
+		config.DefaultResourceSpec = expandResourceSpec(v)
+	}
+
+	return config
+}
+
+func expandCustomFileSystems(l []interface{}) []*sagemaker.CustomFileSystem {
+	apiObjects := make([]*sagemaker.CustomFileSystem, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &sagemaker.CustomFileSystem{}
+
+		if v, ok := tfMap["efs_file_system"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.EFSFileSystem = &sagemaker.EFSFileSystem{
+				FileSystemId: aws.String(v[0].(map[string]interface{})["file_system_id"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSpaceStorageSettings(l []interface{}) *sagemaker.SpaceStorageSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.SpaceStorageSettings{}
+
+	if v, ok := m["ebs_storage_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.EbsStorageSettings = &sagemaker.EbsStorageSettings{
+			EbsVolumeSizeInGb: aws.Int64(int64(v[0].(map[string]interface{})["ebs_volume_size_in_gb"].(int))),
+		}
+	}
+
+	return config
+}
+
+func expandOwnershipSettings(l []interface{}) *sagemaker.OwnershipSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.OwnershipSettings{
+		OwnerUserProfileName: aws.String(m["owner_user_profile_name"].(string)),
+	}
+
+	return config
+}
+
+func expandSpaceSharingSettings(l []interface{}) *sagemaker.SpaceSharingSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.SpaceSharingSettings{
+		SharingType: aws.String(m["sharing_type"].(string)),
+	}
+
+	return config
+}
+
+func flattenSpaceCodeEditorAppSettings(config *sagemaker.SpaceCodeEditorAppSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.DefaultResourceSpec != nil {
+		m["default_resource_spec"] = flattenResourceSpec(config.DefaultResourceSpec)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSpaceJupyterLabAppSettings(config *sagemaker.SpaceJupyterLabAppSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.CodeRepositories != nil {
+		m["code_repository"] = flattenCodeRepositories(config.CodeRepositories)
+	}
+
+	if config.DefaultResourceSpec != nil {
+		m["default_resource_spec"] = flattenResourceSpec(config.DefaultResourceSpec)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenCustomFileSystems(apiObjects []*sagemaker.CustomFileSystem) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.EFSFileSystem; v != nil {
+			tfMap["efs_file_system"] = []interface{}{map[string]interface{}{
+				"file_system_id": aws.StringValue(v.FileSystemId),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSpaceStorageSettings(config *sagemaker.SpaceStorageSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := config.EbsStorageSettings; v != nil {
+		m["ebs_storage_settings"] = []map[string]interface{}{{
+			"ebs_volume_size_in_gb": aws.Int64Value(v.EbsVolumeSizeInGb),
+		}}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenOwnershipSettings(config *sagemaker.OwnershipSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"owner_user_profile_name": aws.StringValue(config.OwnerUserProfileName),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSpaceSharingSettings(config *sagemaker.SpaceSharingSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"sharing_type": aws.StringValue(config.SharingType),
+	}
+
 	return []map[string]interface{}{m}
 }
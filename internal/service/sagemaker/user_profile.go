@@ -198,6 +198,28 @@ func ResourceUserProfile() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"app_lifecycle_management": appLifecycleManagementSchema(),
+									"custom_image": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 200,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"app_image_config_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"image_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"image_version_number": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+											},
+										},
+									},
 									"default_resource_spec": {
 										Type:     schema.TypeList,
 										Optional: true,
@@ -301,6 +323,7 @@ func ResourceUserProfile() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"app_lifecycle_management": appLifecycleManagementSchema(),
 									"code_repository": {
 										Type:     schema.TypeSet,
 										Optional: true,
@@ -740,7 +763,7 @@ func resourceUserProfileCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	log.Printf("[DEBUG] SageMaker User Profile create config: %#v", *input)
-	output, err := conn.CreateUserProfileWithContext(ctx, input)
+	output, err := conn.CreateUserProfileWithContext(ctx, input, withAppLifecycleManagementRequest(expandUserProfileAppLifecycleManagements(d)))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating SageMaker User Profile: %s", err)
 	}
@@ -769,7 +792,8 @@ func resourceUserProfileRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "reading SageMaker User Profile (%s): %s", d.Id(), err)
 	}
 
-	userProfile, err := FindUserProfileByName(ctx, conn, domainID, userProfileName)
+	lifecycleManagements := appLifecycleManagements{}
+	userProfile, err := FindUserProfileByName(ctx, conn, domainID, userProfileName, withAppLifecycleManagementResponse(lifecycleManagements, userProfileAppLifecycleManagementPaths...))
 	if err != nil {
 		if !d.IsNewResource() && tfresource.NotFound(err) {
 			d.SetId("")
@@ -787,7 +811,9 @@ func resourceUserProfileRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("single_sign_on_user_value", userProfile.SingleSignOnUserValue)
 	d.Set("user_profile_name", userProfile.UserProfileName)
 
-	if err := d.Set("user_settings", flattenUserSettings(userProfile.UserSettings)); err != nil {
+	userSettings := flattenUserSettings(userProfile.UserSettings)
+	flattenAppLifecycleManagements(userSettings, "UserSettings", lifecycleManagements, flattenAppLifecycleManagement)
+	if err := d.Set("user_settings", userSettings); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting user_settings for SageMaker User Profile (%s): %s", d.Id(), err)
 	}
 
@@ -809,7 +835,7 @@ func resourceUserProfileUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		log.Printf("[DEBUG] SageMaker User Profile update config: %#v", *input)
-		_, err := conn.UpdateUserProfileWithContext(ctx, input)
+		_, err := conn.UpdateUserProfileWithContext(ctx, input, withAppLifecycleManagementRequest(expandUserProfileAppLifecycleManagements(d)))
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating SageMaker User Profile: %s", err)
 		}
@@ -822,6 +848,20 @@ func resourceUserProfileUpdate(ctx context.Context, d *schema.ResourceData, meta
 	return append(diags, resourceUserProfileRead(ctx, d, meta)...)
 }
 
+// userProfileAppLifecycleManagementPaths are the app settings members of a user profile that support lifecycle management.
+var userProfileAppLifecycleManagementPaths = []string{
+	"UserSettings.CodeEditorAppSettings",
+	"UserSettings.JupyterLabAppSettings",
+}
+
+func expandUserProfileAppLifecycleManagements(d *schema.ResourceData) appLifecycleManagements {
+	apiObjects := appLifecycleManagements{}
+
+	expandAppLifecycleManagements(apiObjects, "UserSettings", d.Get("user_settings").([]interface{}))
+
+	return apiObjects
+}
+
 func resourceUserProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
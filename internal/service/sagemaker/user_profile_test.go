@@ -307,6 +307,44 @@ func testAccUserProfile_jupyterServerAppSettings(t *testing.T) {
 	})
 }
 
+func testAccUserProfile_jupyterLabAppSettingsIdleSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain sagemaker.DescribeUserProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_user_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserProfileConfig_jupyterLabAppSettingsIdleSettings(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserProfileExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.0.jupyter_lab_app_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.0.jupyter_lab_app_settings.0.app_lifecycle_management.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.0.jupyter_lab_app_settings.0.app_lifecycle_management.0.idle_settings.0.idle_timeout_in_minutes", "120"),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.0.jupyter_lab_app_settings.0.app_lifecycle_management.0.idle_settings.0.lifecycle_management", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserProfileConfig_jupyterLabAppSettingsIdleSettings(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserProfileExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.0.jupyter_lab_app_settings.0.app_lifecycle_management.0.idle_settings.0.lifecycle_management", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccUserProfile_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var domain sagemaker.DescribeUserProfileOutput
@@ -544,6 +582,28 @@ resource "aws_sagemaker_user_profile" "test" {
 `, rName))
 }
 
+func testAccUserProfileConfig_jupyterLabAppSettingsIdleSettings(rName, lifecycleManagement string) string {
+	return acctest.ConfigCompose(testAccUserProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_user_profile" "test" {
+  domain_id         = aws_sagemaker_domain.test.id
+  user_profile_name = %[1]q
+
+  user_settings {
+    execution_role = aws_iam_role.test.arn
+
+    jupyter_lab_app_settings {
+      app_lifecycle_management {
+        idle_settings {
+          idle_timeout_in_minutes = 120
+          lifecycle_management    = %[2]q
+        }
+      }
+    }
+  }
+}
+`, rName, lifecycleManagement))
+}
+
 func testAccUserProfileConfig_kernelGatewayAppSettingsLifecycle(rName string) string {
 	return acctest.ConfigCompose(testAccUserProfileConfig_base(rName), fmt.Sprintf(`
 resource "aws_sagemaker_studio_lifecycle_config" "test" {
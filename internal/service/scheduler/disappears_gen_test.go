@@ -0,0 +1,19 @@
+// Code generated by internal/generate/servicepackage/main.go; DO NOT EDIT.
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Finders for use in disappears tests only.
+
+// FindScheduleGroupForDisappears reads the Schedule Group with the specified Terraform state.
+func FindScheduleGroupForDisappears(ctx context.Context, client *conns.AWSClient, is *terraform.InstanceState) error {
+	_, err := findScheduleGroupByName(ctx, client.SchedulerClient(ctx), is.ID)
+
+	return err
+}
@@ -27,6 +27,7 @@ import (
 
 // @SDKResource("aws_scheduler_schedule_group", name="Schedule Group")
 // @Tags(identifierAttribute="arn")
+// @Testing(disappearsFinder="findScheduleGroupByName")
 func ResourceScheduleGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceScheduleGroupCreate,
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -261,7 +262,7 @@ func TestAccSchedulerSchedule_disappears(t *testing.T) {
 				Config: testAccScheduleConfig_basic(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfscheduler.ResourceSchedule(), resourceName),
+					acctest.CheckResourceDisappearsWithFinder(ctx, acctest.Provider, tfscheduler.ResourceSchedule(), testAccFindSchedule, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -1650,6 +1651,18 @@ func testAccCheckScheduleExists(ctx context.Context, t *testing.T, name string,
 	}
 }
 
+func testAccFindSchedule(ctx context.Context, client *conns.AWSClient, is *terraform.InstanceState) error {
+	groupName, scheduleName, err := tfscheduler.ResourceScheduleParseID(is.ID)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tfscheduler.FindScheduleByTwoPartKey(ctx, client.SchedulerClient(ctx), groupName, scheduleName)
+
+	return err
+}
+
 const testAccScheduleConfig_base = `
 data "aws_caller_identity" "main" {}
 data "aws_partition" "main" {}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_secretsmanager_secret_versions")
+func DataSourceSecretVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSecretVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"include_deprecated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_accessed_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_stages": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecretVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerConn(ctx)
+
+	secretID := d.Get("secret_id").(string)
+	input := &secretsmanager.ListSecretVersionIdsInput{
+		IncludeDeprecated: aws.Bool(d.Get("include_deprecated").(bool)),
+		SecretId:          aws.String(secretID),
+	}
+
+	var arn, name string
+	var versions []*secretsmanager.SecretVersionsListEntry
+
+	err := conn.ListSecretVersionIdsPagesWithContext(ctx, input, func(page *secretsmanager.ListSecretVersionIdsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		arn, name = aws.StringValue(page.ARN), aws.StringValue(page.Name)
+
+		for _, v := range page.Versions {
+			if v != nil {
+				versions = append(versions, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
+		err = &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret (%s) versions: %s", secretID, err)
+	}
+
+	d.SetId(arn)
+	d.Set("arn", arn)
+	d.Set("name", name)
+	if err := d.Set("versions", flattenSecretVersionsListEntries(versions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting versions: %s", err)
+	}
+
+	return diags
+}
+
+func flattenSecretVersionsListEntries(apiObjects []*secretsmanager.SecretVersionsListEntry) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"version_id":     aws.StringValue(apiObject.VersionId),
+			"version_stages": aws.StringValueSlice(apiObject.VersionStages),
+		}
+
+		if v := apiObject.CreatedDate; v != nil {
+			tfMap["created_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastAccessedDate; v != nil {
+			tfMap["last_accessed_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
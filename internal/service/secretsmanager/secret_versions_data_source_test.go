@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSecretsManagerSecretVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	secretResourceName := "aws_secretsmanager_secret.test"
+	datasourceName := "data.aws_secretsmanager_secret_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", secretResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", secretResourceName, "name"),
+					resource.TestCheckResourceAttr(datasourceName, "versions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "versions.*.version_stages.*", "AWSCURRENT"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "versions.*.version_stages.*", "AWSPREVIOUS"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "versions.*.version_id", "aws_secretsmanager_secret_version.current", "version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretVersionsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "previous" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "previous-string"
+}
+
+resource "aws_secretsmanager_secret_version" "current" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "current-string"
+
+  depends_on = [aws_secretsmanager_secret_version.previous]
+}
+
+data "aws_secretsmanager_secret_versions" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+
+  depends_on = [aws_secretsmanager_secret_version.current]
+}
+`, rName)
+}
@@ -41,6 +41,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSecretVersion,
 			TypeName: "aws_secretsmanager_secret_version",
 		},
+		{
+			Factory:  DataSourceSecretVersions,
+			TypeName: "aws_secretsmanager_secret_versions",
+		},
 		{
 			Factory:  DataSourceSecrets,
 			TypeName: "aws_secretsmanager_secrets",
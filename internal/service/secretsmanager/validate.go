@@ -36,3 +36,16 @@ func validSecretNamePrefix(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
+
+func validScheduleExpression(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexache.MustCompile(`^(rate\([1-9][0-9]* (hours|days)\)|cron\(\S+( \S+){5}\))$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a rate expression in hours or days, e.g. rate(10 days), or a cron expression with six fields, e.g. cron(0 16 1,15 * ? *)", k))
+	}
+	if len(value) > 256 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be greater than 256 characters", k))
+	}
+	return
+}
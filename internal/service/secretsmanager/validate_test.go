@@ -64,3 +64,47 @@ func TestValidSecretNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "rate(10 days)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rate(4 hours)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "cron(0 16 1,15 * ? *)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rate(10 minutes)",
+			ErrCount: 1,
+		},
+		{
+			Value:    "rate(0 days)",
+			ErrCount: 1,
+		},
+		{
+			Value:    "cron(0 16 * * ?)",
+			ErrCount: 1,
+		},
+		{
+			Value:    "every 10 days",
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validScheduleExpression(tc.Value, "schedule_expression")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
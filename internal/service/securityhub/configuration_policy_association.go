@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_securityhub_configuration_policy_association")
+func ResourceConfigurationPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyAssociationCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyAssociationRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyAssociationUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexache.MustCompile(`^(\d{12}|ou-[0-9a-z]{4,32}-[0-9a-z]{8,32}|r-[0-9a-z]{4,32})$`),
+					"must be an AWS account ID, organizational unit ID or root ID",
+				),
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	targetID := d.Get("target_id").(string)
+	input := &securityhub.StartConfigurationPolicyAssociationInput{
+		ConfigurationPolicyIdentifier: aws.String(d.Get("policy_id").(string)),
+		Target:                        expandTarget(targetID),
+	}
+
+	_, err := conn.StartConfigurationPolicyAssociation(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Hub Configuration Policy Association (%s): %s", targetID, err)
+	}
+
+	d.SetId(targetID)
+
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfigurationPolicyAssociationRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	output, err := FindConfigurationPolicyAssociationByTargetID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_id", output.ConfigurationPolicyId)
+	d.Set("target_id", output.TargetId)
+
+	return diags
+}
+
+func resourceConfigurationPolicyAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	input := &securityhub.StartConfigurationPolicyAssociationInput{
+		ConfigurationPolicyIdentifier: aws.String(d.Get("policy_id").(string)),
+		Target:                        expandTarget(d.Id()),
+	}
+
+	_, err := conn.StartConfigurationPolicyAssociation(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Security Hub Configuration Policy Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy Association (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfigurationPolicyAssociationRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy Association: %s", d.Id())
+	_, err := conn.StartConfigurationPolicyDisassociation(ctx, &securityhub.StartConfigurationPolicyDisassociationInput{
+		ConfigurationPolicyIdentifier: aws.String(d.Get("policy_id").(string)),
+		Target:                        expandTarget(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Hub Configuration Policy Association (%s): %s", d.Id(), err)
+	}
+
+	// After disassociation the target inherits its parent's policy.
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindConfigurationPolicyAssociationByTargetID returns the policy applied directly to the target.
+// Policies a target inherits from its parent are treated as not found.
+func FindConfigurationPolicyAssociationByTargetID(ctx context.Context, conn *securityhub.Client, targetID string) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	output, err := findConfigurationPolicyAssociationByTargetID(ctx, conn, targetID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.AssociationType != types.AssociationTypeApplied {
+		return nil, &retry.NotFoundError{
+			Message: string(output.AssociationType),
+		}
+	}
+
+	return output, nil
+}
+
+func findConfigurationPolicyAssociationByTargetID(ctx context.Context, conn *securityhub.Client, targetID string) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	input := &securityhub.GetConfigurationPolicyAssociationInput{
+		Target: expandTarget(targetID),
+	}
+
+	output, err := conn.GetConfigurationPolicyAssociation(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusConfigurationPolicyAssociation(ctx context.Context, conn *securityhub.Client, targetID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findConfigurationPolicyAssociationByTargetID(ctx, conn, targetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.AssociationStatus), nil
+	}
+}
+
+func waitConfigurationPolicyAssociationSucceeded(ctx context.Context, conn *securityhub.Client, targetID string, timeout time.Duration) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ConfigurationPolicyAssociationStatusPending),
+		Target:  enum.Slice(types.ConfigurationPolicyAssociationStatusSuccess),
+		Refresh: statusConfigurationPolicyAssociation(ctx, conn, targetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.GetConfigurationPolicyAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.AssociationStatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandTarget(targetID string) types.Target {
+	switch {
+	case strings.HasPrefix(targetID, "ou-"):
+		return &types.TargetMemberOrganizationalUnitId{Value: targetID}
+	case strings.HasPrefix(targetID, "r-"):
+		return &types.TargetMemberRootId{Value: targetID}
+	default:
+		return &types.TargetMemberAccountId{Value: targetID}
+	}
+}
@@ -5,7 +5,10 @@ package securityhub
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub"
@@ -26,12 +29,18 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 		CreateWithoutTimeout: resourceOrganizationConfigurationUpdate,
 		ReadWithoutTimeout:   resourceOrganizationConfigurationRead,
 		UpdateWithoutTimeout: resourceOrganizationConfigurationUpdate,
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourceOrganizationConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Second),
+			Update: schema.DefaultTimeout(180 * time.Second),
+			Delete: schema.DefaultTimeout(180 * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"auto_enable": {
 				Type:     schema.TypeBool,
@@ -43,7 +52,24 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: enum.Validate[types.AutoEnableStandards](),
 			},
+			"organization_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.OrganizationConfigurationConfigurationType](),
+						},
+					},
+				},
+			},
 		},
+
+		CustomizeDiff: checkOrganizationConfigurationType,
 	}
 }
 
@@ -59,6 +85,14 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		input.AutoEnableStandards = types.AutoEnableStandards(v.(string))
 	}
 
+	if v, ok := d.GetOk("organization_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OrganizationConfiguration = expandOrganizationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+
+		if input.OrganizationConfiguration.ConfigurationType == types.OrganizationConfigurationConfigurationTypeCentral {
+			input.AutoEnableStandards = types.AutoEnableStandardsNone
+		}
+	}
+
 	_, err := conn.UpdateOrganizationConfiguration(ctx, input)
 
 	if err != nil {
@@ -69,6 +103,15 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		d.SetId(meta.(*conns.AWSClient).AccountID)
 	}
 
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitOrganizationConfigurationEnabled(ctx, conn, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Organization Configuration (%s) update: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceOrganizationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -90,10 +133,73 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 
 	d.Set("auto_enable", output.AutoEnable)
 	d.Set("auto_enable_standards", output.AutoEnableStandards)
+	if err := d.Set("organization_configuration", flattenOrganizationConfiguration(output.OrganizationConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting organization_configuration: %s", err)
+	}
 
 	return diags
 }
 
+func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Central configuration must be switched back to local configuration before
+	// the delegated administrator account can be removed.
+	if v, ok := d.GetOk("organization_configuration"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil ||
+		v.([]interface{})[0].(map[string]interface{})["configuration_type"].(string) != string(types.OrganizationConfigurationConfigurationTypeCentral) {
+		return diags
+	}
+
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	input := &securityhub.UpdateOrganizationConfigurationInput{
+		AutoEnable:          aws.Bool(false),
+		AutoEnableStandards: types.AutoEnableStandardsNone,
+		OrganizationConfiguration: &types.OrganizationConfiguration{
+			ConfigurationType: types.OrganizationConfigurationConfigurationTypeLocal,
+		},
+	}
+
+	_, err := conn.UpdateOrganizationConfiguration(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Hub Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOrganizationConfigurationEnabled(ctx, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Organization Configuration (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// checkOrganizationConfigurationType enforces the settings central configuration requires.
+// With central configuration, member accounts are governed by configuration policies instead.
+func checkOrganizationConfigurationType(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("organization_configuration")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	if v.([]interface{})[0].(map[string]interface{})["configuration_type"].(string) != string(types.OrganizationConfigurationConfigurationTypeCentral) {
+		return nil
+	}
+
+	if d.Get("auto_enable").(bool) {
+		return fmt.Errorf(`"auto_enable" must be false when "configuration_type" is %q`, types.OrganizationConfigurationConfigurationTypeCentral)
+	}
+
+	if v := d.Get("auto_enable_standards").(string); v != "" && v != string(types.AutoEnableStandardsNone) {
+		return fmt.Errorf(`"auto_enable_standards" must be %q when "configuration_type" is %q`, types.AutoEnableStandardsNone, types.OrganizationConfigurationConfigurationTypeCentral)
+	}
+
+	return nil
+}
+
 func FindOrganizationConfiguration(ctx context.Context, conn *securityhub.Client) (*securityhub.DescribeOrganizationConfigurationOutput, error) {
 	input := &securityhub.DescribeOrganizationConfigurationInput{}
 
@@ -110,9 +216,78 @@ func FindOrganizationConfiguration(ctx context.Context, conn *securityhub.Client
 		return nil, err
 	}
 
-	if output == nil || output.OrganizationConfiguration == nil {
+	if output == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
 	return output, nil
 }
+
+func statusOrganizationConfiguration(ctx context.Context, conn *securityhub.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationConfiguration(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Accounts that predate central configuration don't report a status.
+		if output.OrganizationConfiguration == nil {
+			return output, string(types.OrganizationConfigurationStatusEnabled), nil
+		}
+
+		return output, string(output.OrganizationConfiguration.Status), nil
+	}
+}
+
+func waitOrganizationConfigurationEnabled(ctx context.Context, conn *securityhub.Client, timeout time.Duration) (*securityhub.DescribeOrganizationConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.OrganizationConfigurationStatusPending),
+		Target:                    enum.Slice(types.OrganizationConfigurationStatusEnabled),
+		Refresh:                   statusOrganizationConfiguration(ctx, conn),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.DescribeOrganizationConfigurationOutput); ok {
+		if output.OrganizationConfiguration != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.OrganizationConfiguration.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandOrganizationConfiguration(tfMap map[string]interface{}) *types.OrganizationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.OrganizationConfiguration{}
+
+	if v, ok := tfMap["configuration_type"].(string); ok && v != "" {
+		apiObject.ConfigurationType = types.OrganizationConfigurationConfigurationType(v)
+	}
+
+	return apiObject
+}
+
+func flattenOrganizationConfiguration(apiObject *types.OrganizationConfiguration) []interface{} {
+	if apiObject == nil || apiObject.ConfigurationType == "" {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"configuration_type": string(apiObject.ConfigurationType),
+	}
+
+	return []interface{}{tfMap}
+}
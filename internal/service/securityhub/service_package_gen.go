@@ -36,6 +36,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceActionTarget,
 			TypeName: "aws_securityhub_action_target",
 		},
+		{
+			Factory:  ResourceConfigurationPolicy,
+			TypeName: "aws_securityhub_configuration_policy",
+		},
+		{
+			Factory:  ResourceConfigurationPolicyAssociation,
+			TypeName: "aws_securityhub_configuration_policy_association",
+		},
 		{
 			Factory:  ResourceFindingAggregator,
 			TypeName: "aws_securityhub_finding_aggregator",
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Data Lake Organization Configuration")
+func newDataLakeOrganizationConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dataLakeOrganizationConfigurationResource{}
+
+	return r, nil
+}
+
+const (
+	ResNameDataLakeOrganizationConfiguration = "Data Lake Organization Configuration"
+)
+
+type dataLakeOrganizationConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_securitylake_data_lake_organization_configuration"
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"auto_enable_new_account": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[dataLakeAutoEnableNewAccountConfigurationModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"source": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[dataLakeAutoEnableNewAccountSourceModel](ctx),
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"source_name": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AwsLogSourceName](),
+										Required:   true,
+									},
+									"source_version": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data dataLakeOrganizationConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateDataLakeOrganizationConfigurationInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.CreateDataLakeOrganizationConfiguration(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionCreating, ResNameDataLakeOrganizationConfiguration, r.Meta().AccountID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	output, err := findDataLakeOrganizationConfiguration(ctx, conn)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionReading, ResNameDataLakeOrganizationConfiguration, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data dataLakeOrganizationConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findDataLakeOrganizationConfiguration(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionReading, ResNameDataLakeOrganizationConfiguration, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var old, new dataLakeOrganizationConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &new)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &old)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.AutoEnableNewAccount.Equal(old.AutoEnableNewAccount) {
+		// There is no update API, so remove the current configuration and add the new one.
+		deleteInput := &securitylake.DeleteDataLakeOrganizationConfigurationInput{}
+		resp.Diagnostics.Append(flex.Expand(ctx, old, deleteInput)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.DeleteDataLakeOrganizationConfiguration(ctx, deleteInput)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SecurityLake, create.ErrActionUpdating, ResNameDataLakeOrganizationConfiguration, new.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		createInput := &securitylake.CreateDataLakeOrganizationConfigurationInput{}
+		resp.Diagnostics.Append(flex.Expand(ctx, new, createInput)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err = conn.CreateDataLakeOrganizationConfiguration(ctx, createInput)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SecurityLake, create.ErrActionUpdating, ResNameDataLakeOrganizationConfiguration, new.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		output, err := findDataLakeOrganizationConfiguration(ctx, conn)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SecurityLake, create.ErrActionReading, ResNameDataLakeOrganizationConfiguration, new.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(flex.Flatten(ctx, output, &new)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data dataLakeOrganizationConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.DeleteDataLakeOrganizationConfigurationInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteDataLakeOrganizationConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionDeleting, ResNameDataLakeOrganizationConfiguration, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findDataLakeOrganizationConfiguration(ctx context.Context, conn *securitylake.Client) (*securitylake.GetDataLakeOrganizationConfigurationOutput, error) {
+	input := &securitylake.GetDataLakeOrganizationConfigurationInput{}
+
+	output, err := conn.GetDataLakeOrganizationConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AutoEnableNewAccount) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type dataLakeOrganizationConfigurationResourceModel struct {
+	AutoEnableNewAccount fwtypes.SetNestedObjectValueOf[dataLakeAutoEnableNewAccountConfigurationModel] `tfsdk:"auto_enable_new_account"`
+	ID                   types.String                                                                   `tfsdk:"id"`
+}
+
+type dataLakeAutoEnableNewAccountConfigurationModel struct {
+	Region  types.String                                                            `tfsdk:"region"`
+	Sources fwtypes.SetNestedObjectValueOf[dataLakeAutoEnableNewAccountSourceModel] `tfsdk:"source"`
+}
+
+type dataLakeAutoEnableNewAccountSourceModel struct {
+	SourceName    fwtypes.StringEnum[awstypes.AwsLogSourceName] `tfsdk:"source_name"`
+	SourceVersion types.String                                  `tfsdk:"source_version"`
+}
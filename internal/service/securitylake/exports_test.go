@@ -5,9 +5,17 @@ package securitylake
 
 // Exports for use in tests only.
 var (
-	ResourceAWSLogSource = newAWSLogSourceResource
-	ResourceDataLake     = newDataLakeResource
+	ResourceAWSLogSource                      = newAWSLogSourceResource
+	ResourceCustomLogSource                   = newCustomLogSourceResource
+	ResourceDataLake                          = newDataLakeResource
+	ResourceDataLakeOrganizationConfiguration = newDataLakeOrganizationConfigurationResource
+	ResourceSubscriber                        = newSubscriberResource
+	ResourceSubscriberNotification            = newSubscriberNotificationResource
 
-	FindAWSLogSourceBySourceName = findAWSLogSourceBySourceName
-	FindDataLakeByARN            = findDataLakeByARN
+	FindAWSLogSourceBySourceName             = findAWSLogSourceBySourceName
+	FindCustomLogSourceBySourceName          = findCustomLogSourceBySourceName
+	FindDataLakeByARN                        = findDataLakeByARN
+	FindDataLakeOrganizationConfiguration    = findDataLakeOrganizationConfiguration
+	FindSubscriberByID                       = findSubscriberByID
+	FindSubscriberNotificationBySubscriberID = findSubscriberNotificationBySubscriberID
 )
@@ -18,6 +18,10 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"disappears":  testAccAWSLogSource_disappears,
 			"multiRegion": testAccAWSLogSource_multiRegion,
 		},
+		"CustomLogSource": {
+			"basic":      testAccCustomLogSource_basic,
+			"disappears": testAccCustomLogSource_disappears,
+		},
 		"DataLake": {
 			"basic":           testAccDataLake_basic,
 			"disappears":      testAccDataLake_disappears,
@@ -26,6 +30,21 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"lifecycleUpdate": testAccDataLake_lifeCycleUpdate,
 			"replication":     testAccDataLake_replication,
 		},
+		"DataLakeOrganizationConfiguration": {
+			"basic":      testAccDataLakeOrganizationConfiguration_basic,
+			"disappears": testAccDataLakeOrganizationConfiguration_disappears,
+		},
+		"Subscriber": {
+			"basic":      testAccSubscriber_basic,
+			"disappears": testAccSubscriber_disappears,
+			"tags":       testAccSubscriber_tags,
+			"update":     testAccSubscriber_update,
+		},
+		"SubscriberNotification": {
+			"sqs":        testAccSubscriberNotification_sqs,
+			"https":      testAccSubscriberNotification_https,
+			"disappears": testAccSubscriberNotification_disappears,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -24,6 +24,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newAWSLogSourceResource,
 			Name:    "AWS Log Source",
 		},
+		{
+			Factory: newCustomLogSourceResource,
+			Name:    "Custom Log Source",
+		},
+		{
+			Factory: newDataLakeOrganizationConfigurationResource,
+			Name:    "Data Lake Organization Configuration",
+		},
 		{
 			Factory: newDataLakeResource,
 			Name:    "Data Lake",
@@ -31,6 +39,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory: newSubscriberNotificationResource,
+			Name:    "Subscriber Notification",
+		},
+		{
+			Factory: newSubscriberResource,
+			Name:    "Subscriber",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 	}
 }
 
@@ -0,0 +1,607 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber")
+// @Tags(identifierAttribute="arn")
+func newSubscriberResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &subscriberResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameSubscriber = "Subscriber"
+)
+
+type subscriberResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *subscriberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_securitylake_subscriber"
+}
+
+func (r *subscriberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	logSourceResourceBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberLogSourceResourceModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"source_name": schema.StringAttribute{
+						Required: true,
+					},
+					"source_version": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccessType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"arn":        framework.ARNAttributeComputedOnly(),
+			names.AttrID: framework.IDAttribute(),
+			"resource_share_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_share_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_description": schema.StringAttribute{
+				Optional: true,
+			},
+			"subscriber_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_name": schema.StringAttribute{
+				Required: true,
+			},
+			"subscriber_status": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_log_source_resource":    logSourceResourceBlock(),
+						"custom_log_source_resource": logSourceResourceBlock(),
+					},
+				},
+			},
+			"subscriber_identity": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[awsIdentityModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *subscriberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data subscriberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We can't use AutoFlEx with the top-level resource model because the API structure uses Go interfaces.
+	sources, diags := expandSubscriberSources(ctx, data.Sources)
+	resp.Diagnostics.Append(diags...)
+	identity, diags := expandAWSIdentity(ctx, data.SubscriberIdentity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateSubscriberInput{
+		Sources:               sources,
+		SubscriberDescription: flex.StringFromFramework(ctx, data.SubscriberDescription),
+		SubscriberIdentity:    identity,
+		SubscriberName:        flex.StringFromFramework(ctx, data.SubscriberName),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	if !data.AccessType.IsUnknown() && !data.AccessType.IsNull() {
+		input.AccessTypes = []awstypes.AccessType{data.AccessType.ValueEnum()}
+	}
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionCreating, ResNameSubscriber, data.SubscriberName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = flex.StringToFramework(ctx, output.Subscriber.SubscriberId)
+
+	subscriber, err := waitSubscriberCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionWaitingForCreation, ResNameSubscriber, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *subscriberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data subscriberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscriber, err := findSubscriberByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionReading, ResNameSubscriber, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *subscriberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var old, new subscriberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &new)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &old)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Sources.Equal(old.Sources) ||
+		!new.SubscriberDescription.Equal(old.SubscriberDescription) ||
+		!new.SubscriberIdentity.Equal(old.SubscriberIdentity) ||
+		!new.SubscriberName.Equal(old.SubscriberName) {
+		sources, diags := expandSubscriberSources(ctx, new.Sources)
+		resp.Diagnostics.Append(diags...)
+		identity, diags := expandAWSIdentity(ctx, new.SubscriberIdentity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		input := &securitylake.UpdateSubscriberInput{
+			Sources:               sources,
+			SubscriberDescription: flex.StringFromFramework(ctx, new.SubscriberDescription),
+			SubscriberId:          flex.StringFromFramework(ctx, new.ID),
+			SubscriberIdentity:    identity,
+			SubscriberName:        flex.StringFromFramework(ctx, new.SubscriberName),
+		}
+
+		_, err := conn.UpdateSubscriber(ctx, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SecurityLake, create.ErrActionUpdating, ResNameSubscriber, new.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		subscriber, err := waitSubscriberUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SecurityLake, create.ErrActionWaitingForUpdate, ResNameSubscriber, new.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(new.refreshFromOutput(ctx, subscriber)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.SubscriberStatus = old.SubscriberStatus
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
+}
+
+func (r *subscriberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	var data subscriberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionDeleting, ResNameSubscriber, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err = waitSubscriberDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionWaitingForDeletion, ResNameSubscriber, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *subscriberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, req, resp)
+}
+
+func findSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*awstypes.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
+
+func statusSubscriber(ctx context.Context, conn *securitylake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSubscriberByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SubscriberStatus), nil
+	}
+}
+
+func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusPending),
+		Target:  enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusPending),
+		Target:  enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusDeactivated, awstypes.SubscriberStatusPending, awstypes.SubscriberStatusReady),
+		Target:  []string{},
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type subscriberResourceModel struct {
+	AccessType            fwtypes.StringEnum[awstypes.AccessType]                `tfsdk:"access_type"`
+	ID                    types.String                                           `tfsdk:"id"`
+	ResourceShareARN      types.String                                           `tfsdk:"resource_share_arn"`
+	ResourceShareName     types.String                                           `tfsdk:"resource_share_name"`
+	RoleARN               types.String                                           `tfsdk:"role_arn"`
+	S3BucketARN           types.String                                           `tfsdk:"s3_bucket_arn"`
+	Sources               fwtypes.ListNestedObjectValueOf[subscriberSourceModel] `tfsdk:"source"`
+	SubscriberARN         types.String                                           `tfsdk:"arn"`
+	SubscriberDescription types.String                                           `tfsdk:"subscriber_description"`
+	SubscriberEndpoint    types.String                                           `tfsdk:"subscriber_endpoint"`
+	SubscriberIdentity    fwtypes.ListNestedObjectValueOf[awsIdentityModel]      `tfsdk:"subscriber_identity"`
+	SubscriberName        types.String                                           `tfsdk:"subscriber_name"`
+	SubscriberStatus      types.String                                           `tfsdk:"subscriber_status"`
+	Tags                  types.Map                                              `tfsdk:"tags"`
+	TagsAll               types.Map                                              `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                         `tfsdk:"timeouts"`
+}
+
+func (model *subscriberResourceModel) refreshFromOutput(ctx context.Context, output *awstypes.SubscriberResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(output.AccessTypes) > 0 {
+		model.AccessType = fwtypes.StringEnumValue(output.AccessTypes[0])
+	} else {
+		model.AccessType = fwtypes.StringEnumNull[awstypes.AccessType]()
+	}
+	model.ResourceShareARN = flex.StringToFramework(ctx, output.ResourceShareArn)
+	model.ResourceShareName = flex.StringToFramework(ctx, output.ResourceShareName)
+	model.RoleARN = flex.StringToFramework(ctx, output.RoleArn)
+	model.S3BucketARN = flex.StringToFramework(ctx, output.S3BucketArn)
+	model.Sources = flattenSubscriberSources(ctx, output.Sources)
+	model.SubscriberARN = flex.StringToFramework(ctx, output.SubscriberArn)
+	model.SubscriberDescription = flex.StringToFramework(ctx, output.SubscriberDescription)
+	model.SubscriberEndpoint = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+	model.SubscriberName = flex.StringToFramework(ctx, output.SubscriberName)
+	model.SubscriberStatus = flex.StringValueToFramework(ctx, output.SubscriberStatus)
+
+	var identity awsIdentityModel
+	diags.Append(flex.Flatten(ctx, output.SubscriberIdentity, &identity)...)
+	if diags.HasError() {
+		return diags
+	}
+	model.SubscriberIdentity = fwtypes.NewListNestedObjectValueOfPtr(ctx, &identity)
+
+	return diags
+}
+
+type subscriberSourceModel struct {
+	AWSLogSourceResource    fwtypes.ListNestedObjectValueOf[subscriberLogSourceResourceModel] `tfsdk:"aws_log_source_resource"`
+	CustomLogSourceResource fwtypes.ListNestedObjectValueOf[subscriberLogSourceResourceModel] `tfsdk:"custom_log_source_resource"`
+}
+
+type subscriberLogSourceResourceModel struct {
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+func expandSubscriberSources(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[subscriberSourceModel]) ([]awstypes.LogSourceResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.LogSourceResource
+
+	for _, v := range data {
+		awsLogSource, d := v.AWSLogSourceResource.ToPtr(ctx)
+		diags.Append(d...)
+		customLogSource, d := v.CustomLogSourceResource.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		switch {
+		case awsLogSource != nil && customLogSource == nil:
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName:    awstypes.AwsLogSourceName(awsLogSource.SourceName.ValueString()),
+					SourceVersion: flex.StringFromFramework(ctx, awsLogSource.SourceVersion),
+				},
+			})
+		case customLogSource != nil && awsLogSource == nil:
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName:    flex.StringFromFramework(ctx, customLogSource.SourceName),
+					SourceVersion: flex.StringFromFramework(ctx, customLogSource.SourceVersion),
+				},
+			})
+		default:
+			diags.AddError("invalid source", "exactly one of aws_log_source_resource or custom_log_source_resource must be specified")
+			return nil, diags
+		}
+	}
+
+	return apiObjects, diags
+}
+
+func flattenSubscriberSources(ctx context.Context, apiObjects []awstypes.LogSourceResource) fwtypes.ListNestedObjectValueOf[subscriberSourceModel] {
+	var data []*subscriberSourceModel
+
+	for _, apiObject := range apiObjects {
+		source := &subscriberSourceModel{
+			AWSLogSourceResource:    fwtypes.NewListNestedObjectValueOfNull[subscriberLogSourceResourceModel](ctx),
+			CustomLogSourceResource: fwtypes.NewListNestedObjectValueOfNull[subscriberLogSourceResourceModel](ctx),
+		}
+
+		switch v := apiObject.(type) {
+		case *awstypes.LogSourceResourceMemberAwsLogSource:
+			source.AWSLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &subscriberLogSourceResourceModel{
+				SourceName:    flex.StringValueToFramework(ctx, v.Value.SourceName),
+				SourceVersion: flex.StringToFramework(ctx, v.Value.SourceVersion),
+			})
+		case *awstypes.LogSourceResourceMemberCustomLogSource:
+			source.CustomLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &subscriberLogSourceResourceModel{
+				SourceName:    flex.StringToFramework(ctx, v.Value.SourceName),
+				SourceVersion: flex.StringToFramework(ctx, v.Value.SourceVersion),
+			})
+		default:
+			continue
+		}
+
+		data = append(data, source)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSlice(ctx, data)
+}
+
+func expandAWSIdentity(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[awsIdentityModel]) (*awstypes.AwsIdentity, diag.Diagnostics) {
+	data, diags := tfList.ToPtr(ctx)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	return &awstypes.AwsIdentity{
+		ExternalId: flex.StringFromFramework(ctx, data.ExternalID),
+		Principal:  flex.StringFromFramework(ctx, data.Principal),
+	}, diags
+}
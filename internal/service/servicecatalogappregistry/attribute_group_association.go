@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalogappregistry
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalogappregistry"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalogappregistry/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Attribute Group Association")
+func newResourceAttributeGroupAssociation(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAttributeGroupAssociation{}, nil
+}
+
+const (
+	ResNameAttributeGroupAssociation = "Attribute Group Association"
+
+	attributeGroupAssociationIDPartCount = 2
+)
+
+type resourceAttributeGroupAssociation struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceAttributeGroupAssociation) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_servicecatalogappregistry_attribute_group_association"
+}
+
+func (r *resourceAttributeGroupAssociation) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"attribute_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *resourceAttributeGroupAssociation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().ServiceCatalogAppRegistryClient(ctx)
+
+	var plan resourceAttributeGroupAssociationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := plan.ApplicationID.ValueString()
+	attributeGroupID := plan.AttributeGroupID.ValueString()
+	id, _ := intflex.FlattenResourceId([]string{applicationID, attributeGroupID}, attributeGroupAssociationIDPartCount, false)
+	plan.ID = types.StringValue(id)
+
+	in := &servicecatalogappregistry.AssociateAttributeGroupInput{
+		Application:    aws.String(applicationID),
+		AttributeGroup: aws.String(attributeGroupID),
+	}
+
+	_, err := conn.AssociateAttributeGroup(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionCreating, ResNameAttributeGroupAssociation, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceAttributeGroupAssociation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().ServiceCatalogAppRegistryClient(ctx)
+
+	var state resourceAttributeGroupAssociationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(state.ID.ValueString(), attributeGroupAssociationIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionSetting, ResNameAttributeGroupAssociation, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	err = findAttributeGroupAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionSetting, ResNameAttributeGroupAssociation, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ApplicationID = types.StringValue(parts[0])
+	state.AttributeGroupID = types.StringValue(parts[1])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAttributeGroupAssociation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No-op update
+}
+
+func (r *resourceAttributeGroupAssociation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().ServiceCatalogAppRegistryClient(ctx)
+
+	var state resourceAttributeGroupAssociationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &servicecatalogappregistry.DisassociateAttributeGroupInput{
+		Application:    aws.String(state.ApplicationID.ValueString()),
+		AttributeGroup: aws.String(state.AttributeGroupID.ValueString()),
+	}
+
+	_, err := conn.DisassociateAttributeGroup(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionDeleting, ResNameAttributeGroupAssociation, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceAttributeGroupAssociation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func findAttributeGroupAssociationByTwoPartKey(ctx context.Context, conn *servicecatalogappregistry.Client, applicationID, attributeGroupID string) error {
+	in := &servicecatalogappregistry.ListAssociatedAttributeGroupsInput{
+		Application: aws.String(applicationID),
+	}
+
+	pages := servicecatalogappregistry.NewListAssociatedAttributeGroupsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.AttributeGroups {
+			if v == attributeGroupID {
+				return nil
+			}
+		}
+	}
+
+	return &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+type resourceAttributeGroupAssociationData struct {
+	ApplicationID    types.String `tfsdk:"application_id"`
+	AttributeGroupID types.String `tfsdk:"attribute_group_id"`
+	ID               types.String `tfsdk:"id"`
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalogappregistry_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalogappregistry"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfservicecatalogappregistry "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceCatalogAppRegistryAttributeGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributeGroup servicecatalogappregistry.GetAttributeGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAttributeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupConfig_basic(rName, "owner", "team1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(ctx, resourceName, &attributeGroup),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"owner":"team1"}`),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "servicecatalog", regexache.MustCompile(`/attribute-groups/.+$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAttributeGroupConfig_basic(rName, "owner", "team2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(ctx, resourceName, &attributeGroup),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"owner":"team2"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryAttributeGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributeGroup servicecatalogappregistry.GetAttributeGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAttributeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupConfig_basic(rName, "owner", "team1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(ctx, resourceName, &attributeGroup),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfservicecatalogappregistry.ResourceAttributeGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAttributeGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_servicecatalogappregistry_attribute_group" {
+				continue
+			}
+
+			_, err := tfservicecatalogappregistry.FindAttributeGroupByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.ServiceCatalogAppRegistry, create.ErrActionCheckingDestroyed, tfservicecatalogappregistry.ResNameAttributeGroup, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.ServiceCatalogAppRegistry, create.ErrActionCheckingDestroyed, tfservicecatalogappregistry.ResNameAttributeGroup, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAttributeGroupExists(ctx context.Context, name string, attributeGroup *servicecatalogappregistry.GetAttributeGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ServiceCatalogAppRegistry, create.ErrActionCheckingExistence, tfservicecatalogappregistry.ResNameAttributeGroup, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ServiceCatalogAppRegistry, create.ErrActionCheckingExistence, tfservicecatalogappregistry.ResNameAttributeGroup, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryClient(ctx)
+
+		output, err := tfservicecatalogappregistry.FindAttributeGroupByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.ServiceCatalogAppRegistry, create.ErrActionCheckingExistence, tfservicecatalogappregistry.ResNameAttributeGroup, rs.Primary.ID, err)
+		}
+
+		*attributeGroup = *output
+
+		return nil
+	}
+}
+
+func testAccAttributeGroupConfig_basic(rName, key, value string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name        = %[1]q
+  description = "test"
+
+  attributes = jsonencode({
+    %[2]q = %[3]q
+  })
+}
+`, rName, key, value)
+}
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalogappregistry
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalogappregistry"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalogappregistry/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Resource Association")
+func newResourceResourceAssociation(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceResourceAssociation{}, nil
+}
+
+const (
+	ResNameResourceAssociation = "Resource Association"
+
+	resourceAssociationIDPartCount = 3
+)
+
+type resourceResourceAssociation struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceResourceAssociation) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_servicecatalogappregistry_resource_association"
+}
+
+func (r *resourceResourceAssociation) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"apply_application_tag": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"resource": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.ResourceType](),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceResourceAssociation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().ServiceCatalogAppRegistryClient(ctx)
+
+	var plan resourceResourceAssociationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := plan.ApplicationID.ValueString()
+	resourceType := plan.ResourceType.ValueString()
+	resourceName := plan.Resource.ValueString()
+	id, _ := intflex.FlattenResourceId([]string{applicationID, resourceType, resourceName}, resourceAssociationIDPartCount, false)
+	plan.ID = types.StringValue(id)
+
+	in := &servicecatalogappregistry.AssociateResourceInput{
+		Application:  aws.String(applicationID),
+		Resource:     aws.String(resourceName),
+		ResourceType: awstypes.ResourceType(resourceType),
+	}
+
+	if plan.ApplyApplicationTag.ValueBool() {
+		in.Options = []awstypes.AssociationOption{awstypes.AssociationOptionApplyApplicationTag}
+	}
+
+	out, err := conn.AssociateResource(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionCreating, ResNameResourceAssociation, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ResourceARN = flex.StringToFramework(ctx, out.ResourceArn)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceResourceAssociation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().ServiceCatalogAppRegistryClient(ctx)
+
+	var state resourceResourceAssociationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findResourceAssociationByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionSetting, ResNameResourceAssociation, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The ID parts have already been validated by the finder.
+	parts, _ := intflex.ExpandResourceId(state.ID.ValueString(), resourceAssociationIDPartCount, false)
+
+	state.ApplicationID = types.StringValue(parts[0])
+	state.ApplyApplicationTag = types.BoolValue(slices.Contains(out.Options, awstypes.AssociationOptionApplyApplicationTag))
+	state.Resource = types.StringValue(parts[2])
+	state.ResourceARN = flex.StringToFramework(ctx, out.Resource.Arn)
+	state.ResourceType = types.StringValue(parts[1])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceResourceAssociation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No-op update
+}
+
+func (r *resourceResourceAssociation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().ServiceCatalogAppRegistryClient(ctx)
+
+	var state resourceResourceAssociationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &servicecatalogappregistry.DisassociateResourceInput{
+		Application:  aws.String(state.ApplicationID.ValueString()),
+		Resource:     aws.String(state.Resource.ValueString()),
+		ResourceType: awstypes.ResourceType(state.ResourceType.ValueString()),
+	}
+
+	_, err := conn.DisassociateResource(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionDeleting, ResNameResourceAssociation, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceResourceAssociation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func findResourceAssociationByID(ctx context.Context, conn *servicecatalogappregistry.Client, id string) (*servicecatalogappregistry.GetAssociatedResourceOutput, error) {
+	parts, err := intflex.ExpandResourceId(id, resourceAssociationIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &servicecatalogappregistry.GetAssociatedResourceInput{
+		Application:  aws.String(parts[0]),
+		Resource:     aws.String(parts[2]),
+		ResourceType: awstypes.ResourceType(parts[1]),
+	}
+
+	out, err := conn.GetAssociatedResource(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Resource == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceResourceAssociationData struct {
+	ApplicationID       types.String `tfsdk:"application_id"`
+	ApplyApplicationTag types.Bool   `tfsdk:"apply_application_tag"`
+	ID                  types.String `tfsdk:"id"`
+	Resource            types.String `tfsdk:"resource"`
+	ResourceARN         types.String `tfsdk:"resource_arn"`
+	ResourceType        types.String `tfsdk:"resource_type"`
+}
@@ -94,3 +94,28 @@ func findServiceQuotaByID(ctx context.Context, conn *servicequotas.Client, servi
 
 	return output.Quota, nil
 }
+
+func findRequestedServiceQuotaChangeByID(ctx context.Context, conn *servicequotas.Client, id string) (*types.RequestedServiceQuotaChange, error) {
+	input := &servicequotas.GetRequestedServiceQuotaChangeInput{
+		RequestId: aws.String(id),
+	}
+
+	output, err := conn.GetRequestedServiceQuotaChange(ctx, input)
+
+	var nsr *types.NoSuchResourceException
+	if errors.As(err, &nsr) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RequestedQuota == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RequestedQuota, nil
+}
@@ -46,6 +46,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceServiceQuota,
 			TypeName: "aws_servicequotas_service_quota",
 		},
+		{
+			Factory:  DataSourceServiceQuotas,
+			TypeName: "aws_servicequotas_service_quotas",
+		},
 	}
 }
 
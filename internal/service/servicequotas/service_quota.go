@@ -5,9 +5,9 @@ package servicequotas
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -32,6 +32,11 @@ func ResourceServiceQuota() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"adjustable": {
 				Type:     schema.TypeBool,
@@ -129,6 +134,11 @@ func ResourceServiceQuota() *schema.Resource {
 				Type:     schema.TypeFloat,
 				Required: true,
 			},
+			"wait_for_approval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -176,7 +186,16 @@ func resourceServiceQuotaCreate(ctx context.Context, d *schema.ResourceData, met
 			return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: empty result", d.Id())
 		}
 
-		d.Set("request_id", output.RequestedQuota.Id)
+		requestID := aws.ToString(output.RequestedQuota.Id)
+		d.Set("request_id", requestID)
+
+		if d.Get("wait_for_approval").(bool) {
+			diags = append(diags, waitServiceQuotaIncreaseRequest(ctx, conn, d.Id(), requestID, d.Timeout(schema.TimeoutCreate))...)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
 	}
 
 	return append(diags, resourceServiceQuotaRead(ctx, d, meta)...)
@@ -225,14 +244,9 @@ func resourceServiceQuotaRead(ctx context.Context, d *schema.ResourceData, meta
 	requestID := d.Get("request_id").(string)
 
 	if requestID != "" {
-		input := &servicequotas.GetRequestedServiceQuotaChangeInput{
-			RequestId: aws.String(requestID),
-		}
-
-		output, err := conn.GetRequestedServiceQuotaChange(ctx, input)
+		output, err := findRequestedServiceQuotaChangeByID(ctx, conn, requestID)
 
-		var nsr *types.NoSuchResourceException
-		if errors.As(err, &nsr) {
+		if tfresource.NotFound(err) {
 			d.Set("request_id", "")
 			d.Set("request_status", "")
 			return diags
@@ -242,17 +256,13 @@ func resourceServiceQuotaRead(ctx context.Context, d *schema.ResourceData, meta
 			return sdkdiag.AppendErrorf(diags, "getting Service Quotas Requested Service Quota Change (%s): %s", requestID, err)
 		}
 
-		if output == nil || output.RequestedQuota == nil {
-			return sdkdiag.AppendErrorf(diags, "getting Service Quotas Requested Service Quota Change (%s): empty result", requestID)
-		}
-
-		d.Set("request_status", output.RequestedQuota.Status)
+		d.Set("request_status", output.Status)
 
-		switch output.RequestedQuota.Status {
+		switch output.Status {
 		case types.RequestStatusApproved, types.RequestStatusCaseClosed, types.RequestStatusDenied:
 			d.Set("request_id", "")
 		case types.RequestStatusCaseOpened, types.RequestStatusPending:
-			d.Set("value", output.RequestedQuota.DesiredValue)
+			d.Set("value", output.DesiredValue)
 		}
 	}
 
@@ -263,32 +273,62 @@ func resourceServiceQuotaUpdate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceQuotasClient(ctx)
 
-	value := d.Get("value").(float64)
-	serviceCode, quotaCode, err := resourceServiceQuotaParseID(d.Id())
+	if d.HasChange("value") {
+		value := d.Get("value").(float64)
+		serviceCode, quotaCode, err := resourceServiceQuotaParseID(d.Id())
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting Service Quota (%s): %s", d.Id(), err)
-	}
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Service Quota (%s): %s", d.Id(), err)
+		}
 
-	input := &servicequotas.RequestServiceQuotaIncreaseInput{
-		DesiredValue: aws.Float64(value),
-		QuotaCode:    aws.String(quotaCode),
-		ServiceCode:  aws.String(serviceCode),
+		input := &servicequotas.RequestServiceQuotaIncreaseInput{
+			DesiredValue: aws.Float64(value),
+			QuotaCode:    aws.String(quotaCode),
+			ServiceCode:  aws.String(serviceCode),
+		}
+
+		output, err := conn.RequestServiceQuotaIncrease(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: %s", d.Id(), err)
+		}
+
+		if output == nil || output.RequestedQuota == nil {
+			return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: empty result", d.Id())
+		}
+
+		requestID := aws.ToString(output.RequestedQuota.Id)
+		d.Set("request_id", requestID)
+
+		if d.Get("wait_for_approval").(bool) {
+			diags = append(diags, waitServiceQuotaIncreaseRequest(ctx, conn, d.Id(), requestID, d.Timeout(schema.TimeoutUpdate))...)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
 	}
 
-	output, err := conn.RequestServiceQuotaIncrease(ctx, input)
+	return append(diags, resourceServiceQuotaRead(ctx, d, meta)...)
+}
+
+// waitServiceQuotaIncreaseRequest waits for an increase request to be approved automatically.
+// Requests that are escalated to AWS Support cannot complete within a Terraform run, so they
+// produce a warning and are tracked through request_status instead.
+func waitServiceQuotaIncreaseRequest(ctx context.Context, conn *servicequotas.Client, id, requestID string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output, err := waitRequestedServiceQuotaChangeResolved(ctx, conn, requestID, timeout)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: %s", d.Id(), err)
+		return sdkdiag.AppendErrorf(diags, "waiting for Service Quota (%s) increase request (%s) approval: %s", id, requestID, err)
 	}
 
-	if output == nil || output.RequestedQuota == nil {
-		return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: empty result", d.Id())
+	if output.Status == types.RequestStatusCaseOpened {
+		return sdkdiag.AppendWarningf(diags, "Service Quota (%s) increase request (%s) was not automatically approved and has been escalated to AWS Support (case %s)", id, requestID, aws.ToString(output.CaseId))
 	}
 
-	d.Set("request_id", output.RequestedQuota.Id)
-
-	return append(diags, resourceServiceQuotaRead(ctx, d, meta)...)
+	return diags
 }
 
 func resourceServiceQuotaParseID(id string) (string, string, error) {
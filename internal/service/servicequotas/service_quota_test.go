@@ -237,6 +237,52 @@ func TestAccServiceQuotasServiceQuota_Value_increaseOnUpdate(t *testing.T) {
 	})
 }
 
+func TestAccServiceQuotasServiceQuota_Value_waitForApproval(t *testing.T) {
+	ctx := acctest.Context(t)
+	quotaCode := os.Getenv("SERVICEQUOTAS_INCREASE_WAIT_QUOTA_CODE")
+	if quotaCode == "" {
+		t.Skip(
+			"Environment variable SERVICEQUOTAS_INCREASE_WAIT_QUOTA_CODE is not set. " +
+				"WARNING: This test will submit a real service quota increase!")
+	}
+
+	serviceCode := os.Getenv("SERVICEQUOTAS_INCREASE_WAIT_SERVICE_CODE")
+	if serviceCode == "" {
+		t.Skip(
+			"Environment variable SERVICEQUOTAS_INCREASE_WAIT_SERVICE_CODE is not set. " +
+				"WARNING: This test will submit a real service quota increase!")
+	}
+
+	value := os.Getenv("SERVICEQUOTAS_INCREASE_WAIT_VALUE")
+	if value == "" {
+		t.Skip(
+			"Environment variable SERVICEQUOTAS_INCREASE_WAIT_VALUE is not set. " +
+				"WARNING: This test will submit a real service quota increase!")
+	}
+
+	resourceName := "aws_servicequotas_service_quota.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceQuotasEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceQuotaConfig_waitForApproval(serviceCode, quotaCode, value),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "quota_code", quotaCode),
+					resource.TestCheckResourceAttr(resourceName, "request_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "request_status", "APPROVED"),
+					resource.TestCheckResourceAttr(resourceName, "service_code", serviceCode),
+					resource.TestCheckResourceAttr(resourceName, "value", value),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_approval", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceQuotasServiceQuota_permissionError(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
@@ -279,6 +325,17 @@ resource "aws_servicequotas_service_quota" "test" {
 `, quotaCode, serviceCode, value)
 }
 
+func testAccServiceQuotaConfig_waitForApproval(serviceCode, quotaCode, value string) string {
+	return fmt.Sprintf(`
+resource "aws_servicequotas_service_quota" "test" {
+  quota_code        = %[1]q
+  service_code      = %[2]q
+  value             = %[3]s
+  wait_for_approval = true
+}
+`, quotaCode, serviceCode, value)
+}
+
 func testAccServiceQuotaConfig_permissionError(serviceCode, quotaCode string) string {
 	policy := `{
   "Version": "2012-10-17",
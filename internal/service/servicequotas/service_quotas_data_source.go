@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_servicequotas_service_quotas")
+func DataSourceServiceQuotas() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceQuotasRead,
+
+		Schema: map[string]*schema.Schema{
+			"include_usage": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"adjustable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"global_quota": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"quota_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usage_metric": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_dimensions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"class": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"resource": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"service": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"metric_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"metric_namespace": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"metric_statistic_recommendation": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"usage_value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceQuotasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasClient(ctx)
+
+	serviceCode := d.Get("service_code").(string)
+
+	// Applied values are only returned for quotas that have one, so start from the defaults.
+	defaultQuotas, err := findServiceQuotaDefaults(ctx, conn, serviceCode)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Default Service Quotas (%s): %s", serviceCode, err)
+	}
+
+	appliedQuotas, err := findServiceQuotas(ctx, conn, serviceCode)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Quotas (%s): %s", serviceCode, err)
+	}
+
+	appliedValues := make(map[string]types.ServiceQuota, len(appliedQuotas))
+	for _, v := range appliedQuotas {
+		if v.Value != nil {
+			appliedValues[aws.ToString(v.QuotaCode)] = v
+		}
+	}
+
+	includeUsage := d.Get("include_usage").(bool)
+	quotas := make([]interface{}, 0, len(defaultQuotas))
+
+	for _, defaultQuota := range defaultQuotas {
+		quotaCode := aws.ToString(defaultQuota.QuotaCode)
+		tfMap := map[string]interface{}{
+			"adjustable":    defaultQuota.Adjustable,
+			"arn":           aws.ToString(defaultQuota.QuotaArn),
+			"default_value": aws.ToFloat64(defaultQuota.Value),
+			"global_quota":  defaultQuota.GlobalQuota,
+			"quota_code":    quotaCode,
+			"quota_name":    aws.ToString(defaultQuota.QuotaName),
+			"unit":          aws.ToString(defaultQuota.Unit),
+			"usage_metric":  flattenUsageMetric(defaultQuota.UsageMetric),
+			"value":         aws.ToFloat64(defaultQuota.Value),
+		}
+
+		if v, ok := appliedValues[quotaCode]; ok {
+			tfMap["arn"] = aws.ToString(v.QuotaArn)
+			tfMap["value"] = aws.ToFloat64(v.Value)
+		}
+
+		if includeUsage && defaultQuota.UsageMetric != nil {
+			usage, err := findUsageMetricValue(ctx, meta.(*conns.AWSClient).CloudWatchConn(ctx), defaultQuota.UsageMetric)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Service Quota (%s/%s) usage: %s", serviceCode, quotaCode, err)
+			}
+
+			if usage != nil {
+				tfMap["usage_value"] = aws.ToFloat64(usage)
+			}
+		}
+
+		quotas = append(quotas, tfMap)
+	}
+
+	d.SetId(serviceCode)
+
+	if err := d.Set("quotas", quotas); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting quotas: %s", err)
+	}
+
+	return diags
+}
+
+func findServiceQuotaDefaults(ctx context.Context, conn *servicequotas.Client, serviceCode string) ([]types.ServiceQuota, error) {
+	input := &servicequotas.ListAWSDefaultServiceQuotasInput{
+		ServiceCode: aws.String(serviceCode),
+	}
+	var output []types.ServiceQuota
+
+	paginator := servicequotas.NewListAWSDefaultServiceQuotasPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Quotas...)
+	}
+
+	return output, nil
+}
+
+func findServiceQuotas(ctx context.Context, conn *servicequotas.Client, serviceCode string) ([]types.ServiceQuota, error) {
+	input := &servicequotas.ListServiceQuotasInput{
+		ServiceCode: aws.String(serviceCode),
+	}
+	var output []types.ServiceQuota
+
+	paginator := servicequotas.NewListServiceQuotasPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Quotas...)
+	}
+
+	return output, nil
+}
+
+// findUsageMetricValue returns the most recent value of a quota's usage metric over the last hour,
+// using the statistic that Service Quotas recommends. A nil value means no usage has been reported.
+func findUsageMetricValue(ctx context.Context, conn *cloudwatch.CloudWatch, metric *types.MetricInfo) (*float64, error) {
+	statistic := aws.ToString(metric.MetricStatisticRecommendation)
+	if statistic == "" {
+		statistic = cloudwatch.StatisticMaximum
+	}
+
+	var dimensions []*cloudwatch.Dimension
+	for k, v := range metric.MetricDimensions {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws_sdkv1.String(k),
+			Value: aws_sdkv1.String(v),
+		})
+	}
+
+	now := time.Now()
+	input := &cloudwatch.GetMetricStatisticsInput{
+		Dimensions: dimensions,
+		EndTime:    aws_sdkv1.Time(now),
+		MetricName: metric.MetricName,
+		Namespace:  metric.MetricNamespace,
+		Period:     aws_sdkv1.Int64(int64(time.Hour.Seconds())),
+		StartTime:  aws_sdkv1.Time(now.Add(-time.Hour)),
+		Statistics: aws_sdkv1.StringSlice([]string{statistic}),
+	}
+
+	output, err := conn.GetMetricStatisticsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *cloudwatch.Datapoint
+	for _, v := range output.Datapoints {
+		if v == nil {
+			continue
+		}
+
+		if latest == nil || aws_sdkv1.TimeValue(v.Timestamp).After(aws_sdkv1.TimeValue(latest.Timestamp)) {
+			latest = v
+		}
+	}
+
+	if latest == nil {
+		return nil, nil
+	}
+
+	switch statistic {
+	case cloudwatch.StatisticAverage:
+		return latest.Average, nil
+	case cloudwatch.StatisticMinimum:
+		return latest.Minimum, nil
+	case cloudwatch.StatisticSampleCount:
+		return latest.SampleCount, nil
+	case cloudwatch.StatisticSum:
+		return latest.Sum, nil
+	default:
+		return latest.Maximum, nil
+	}
+}
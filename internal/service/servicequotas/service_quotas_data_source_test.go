@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceQuotasServiceQuotasDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	const dataSourceName = "data.aws_servicequotas_service_quotas.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceQuotasEndpointID)
+			preCheckServiceQuotaSet(ctx, setQuotaServiceCode, setQuotaQuotaCode, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceQuotasEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceQuotasDataSourceConfig_basic(setQuotaServiceCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "include_usage", "false"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "quotas.#", 0),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "quotas.*", map[string]string{
+						"adjustable":    "true",
+						"default_value": "5",
+						"global_quota":  "false",
+						"quota_code":    setQuotaQuotaCode,
+						"quota_name":    setQuotaQuotaName,
+						"unit":          "None",
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "service_code", setQuotaServiceCode),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceQuotasServiceQuotasDataSource_includeUsage(t *testing.T) {
+	ctx := acctest.Context(t)
+	const dataSourceName = "data.aws_servicequotas_service_quotas.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceQuotasEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceQuotasEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceQuotasDataSourceConfig_includeUsage(hasUsageMetricServiceCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "include_usage", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "quotas.*", map[string]string{
+						"quota_code":                      hasUsageMetricQuotaCode,
+						"quota_name":                      hasUsageMetricQuotaName,
+						"usage_metric.#":                  "1",
+						"usage_metric.0.metric_name":      "ResourceCount",
+						"usage_metric.0.metric_namespace": "AWS/Usage",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceQuotasDataSourceConfig_basic(serviceCode string) string {
+	return fmt.Sprintf(`
+data "aws_servicequotas_service_quotas" "test" {
+  service_code = %[1]q
+}
+`, serviceCode)
+}
+
+func testAccServiceQuotasDataSourceConfig_includeUsage(serviceCode string) string {
+	return fmt.Sprintf(`
+data "aws_servicequotas_service_quotas" "test" {
+  include_usage = true
+  service_code  = %[1]q
+}
+`, serviceCode)
+}
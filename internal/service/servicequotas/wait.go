@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusRequestedServiceQuotaChange(ctx context.Context, conn *servicequotas.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findRequestedServiceQuotaChangeByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// waitRequestedServiceQuotaChangeResolved waits for a quota increase request to be
+// either approved or handed over to AWS Support. Only automatically approved requests
+// reach APPROVED within a Terraform run; CASE_OPENED is returned so the caller can decide.
+func waitRequestedServiceQuotaChangeResolved(ctx context.Context, conn *servicequotas.Client, id string, timeout time.Duration) (*types.RequestedServiceQuotaChange, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.RequestStatusPending),
+		Target:  enum.Slice(types.RequestStatusApproved, types.RequestStatusCaseOpened),
+		Refresh: statusRequestedServiceQuotaChange(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.RequestedServiceQuotaChange); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -40,6 +40,11 @@ func ResourceEmailIdentity() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -79,6 +84,11 @@ func ResourceEmailIdentity() *schema.Resource {
 								},
 							),
 						},
+						"domain_signing_private_key_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							RequiredWith: []string{"dkim_signing_attributes.0.domain_signing_private_key"},
+						},
 						"domain_signing_selector": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -123,10 +133,19 @@ func ResourceEmailIdentity() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"verification_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"verified_for_sending_status": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"wait_for_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -164,6 +183,12 @@ func resourceEmailIdentityCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(d.Get("email_identity").(string))
 
+	if d.Get("wait_for_verification").(bool) {
+		if _, err := waitEmailIdentityVerified(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.DiagError(names.SESV2, create.ErrActionWaitingForCreation, ResNameEmailIdentity, d.Id(), err)
+		}
+	}
+
 	return resourceEmailIdentityRead(ctx, d, meta)
 }
 
@@ -201,6 +226,7 @@ func resourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, meta
 	if out.DkimAttributes != nil {
 		tfMap := flattenDKIMAttributes(out.DkimAttributes)
 		tfMap["domain_signing_private_key"] = d.Get("dkim_signing_attributes.0.domain_signing_private_key").(string)
+		tfMap["domain_signing_private_key_version"] = d.Get("dkim_signing_attributes.0.domain_signing_private_key_version").(int)
 		tfMap["domain_signing_selector"] = d.Get("dkim_signing_attributes.0.domain_signing_selector").(string)
 
 		if err := d.Set("dkim_signing_attributes", []interface{}{tfMap}); err != nil {
@@ -211,6 +237,7 @@ func resourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set("identity_type", string(out.IdentityType))
+	d.Set("verification_status", string(out.VerificationStatus))
 	d.Set("verified_for_sending_status", out.VerifiedForSendingStatus)
 
 	return nil
@@ -235,6 +262,7 @@ func resourceEmailIdentityUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	// Changing domain_signing_private_key_version re-sends the BYODKIM key and selector, rotating the key.
 	if d.HasChanges("dkim_signing_attributes") {
 		in := &sesv2.PutEmailIdentityDkimSigningAttributesInput{
 			EmailIdentity:           aws.String(d.Id()),
@@ -253,6 +281,12 @@ func resourceEmailIdentityUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.Get("wait_for_verification").(bool) && d.HasChanges("dkim_signing_attributes", "wait_for_verification") {
+		if _, err := waitEmailIdentityVerified(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.SESV2, create.ErrActionWaitingForUpdate, ResNameEmailIdentity, d.Id(), err)
+		}
+	}
+
 	return resourceEmailIdentityRead(ctx, d, meta)
 }
 
@@ -301,6 +335,44 @@ func FindEmailIdentityByID(ctx context.Context, conn *sesv2.Client, id string) (
 	return out, nil
 }
 
+func statusEmailIdentityVerification(ctx context.Context, conn *sesv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindEmailIdentityByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.VerificationStatus), nil
+	}
+}
+
+func waitEmailIdentityVerified(ctx context.Context, conn *sesv2.Client, id string, timeout time.Duration) (*sesv2.GetEmailIdentityOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VerificationStatusNotStarted, types.VerificationStatusPending, types.VerificationStatusTemporaryFailure),
+		Target:  enum.Slice(types.VerificationStatusSuccess),
+		Refresh: statusEmailIdentityVerification(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*sesv2.GetEmailIdentityOutput); ok {
+		if v := out.VerificationInfo; v != nil && v.ErrorType != "" {
+			tfresource.SetLastError(err, errors.New(string(v.ErrorType)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
 func expandDKIMSigningAttributes(tfMap map[string]interface{}) *types.DkimSigningAttributes {
 	if tfMap == nil {
 		return nil
@@ -316,7 +388,8 @@ func expandDKIMSigningAttributes(tfMap map[string]interface{}) *types.DkimSignin
 		a.DomainSigningSelector = aws.String(v)
 	}
 
-	if v, ok := tfMap["next_signing_key_length"].(string); ok && v != "" {
+	// The key length of a BYODKIM key is determined by the key itself.
+	if v, ok := tfMap["next_signing_key_length"].(string); ok && v != "" && a.DomainSigningPrivateKey == nil {
 		a.NextSigningKeyLength = types.DkimSigningKeyLength(v)
 	}
 
@@ -328,14 +401,15 @@ func getSigningAttributesOrigin(tfMap map[string]interface{}) types.DkimSigningA
 		return types.DkimSigningAttributesOriginAwsSes
 	}
 
-	if v, ok := tfMap["next_signing_key_length"].(string); ok && v != "" {
-		return types.DkimSigningAttributesOriginAwsSes
-	}
-
+	// next_signing_key_length is also computed, so a configured private key takes precedence.
 	if v, ok := tfMap["domain_signing_private_key"].(string); ok && v != "" {
 		return types.DkimSigningAttributesOriginExternal
 	}
 
+	if v, ok := tfMap["next_signing_key_length"].(string); ok && v != "" {
+		return types.DkimSigningAttributesOriginAwsSes
+	}
+
 	if v, ok := tfMap["domain_signing_selector"].(string); ok && v != "" {
 		return types.DkimSigningAttributesOriginExternal
 	}
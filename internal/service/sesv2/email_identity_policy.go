@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sesv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sesv2_email_identity_policy", name="Email Identity Policy")
+func ResourceEmailIdentityPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEmailIdentityPolicyCreate,
+		ReadWithoutTimeout:   resourceEmailIdentityPolicyRead,
+		UpdateWithoutTimeout: resourceEmailIdentityPolicyUpdate,
+		DeleteWithoutTimeout: resourceEmailIdentityPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email_identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, dashes, and underscores"),
+				),
+			},
+		},
+	}
+}
+
+const (
+	ResNameEmailIdentityPolicy = "Email Identity Policy"
+
+	emailIdentityPolicyIDSeparator = "|"
+)
+
+func resourceEmailIdentityPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	emailIdentity := d.Get("email_identity").(string)
+	policyName := d.Get("policy_name").(string)
+	id := emailIdentityPolicyCreateResourceID(emailIdentity, policyName)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+	if err != nil {
+		return create.DiagError(names.SESV2, create.ErrActionCreating, ResNameEmailIdentityPolicy, id, err)
+	}
+
+	in := &sesv2.CreateEmailIdentityPolicyInput{
+		EmailIdentity: aws.String(emailIdentity),
+		Policy:        aws.String(policy),
+		PolicyName:    aws.String(policyName),
+	}
+
+	out, err := conn.CreateEmailIdentityPolicy(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SESV2, create.ErrActionCreating, ResNameEmailIdentityPolicy, id, err)
+	}
+
+	if out == nil {
+		return create.DiagError(names.SESV2, create.ErrActionCreating, ResNameEmailIdentityPolicy, id, errors.New("empty output"))
+	}
+
+	d.SetId(id)
+
+	return resourceEmailIdentityPolicyRead(ctx, d, meta)
+}
+
+func resourceEmailIdentityPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	emailIdentity, policyName, err := emailIdentityPolicyParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.SESV2, create.ErrActionReading, ResNameEmailIdentityPolicy, d.Id(), err)
+	}
+
+	policy, err := FindEmailIdentityPolicyByTwoPartKey(ctx, conn, emailIdentity, policyName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESV2 EmailIdentityPolicy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SESV2, create.ErrActionReading, ResNameEmailIdentityPolicy, d.Id(), err)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), policy)
+	if err != nil {
+		return create.DiagError(names.SESV2, create.ErrActionReading, ResNameEmailIdentityPolicy, d.Id(), err)
+	}
+
+	d.Set("email_identity", emailIdentity)
+	d.Set("policy", policyToSet)
+	d.Set("policy_name", policyName)
+
+	return nil
+}
+
+func resourceEmailIdentityPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+	if err != nil {
+		return create.DiagError(names.SESV2, create.ErrActionUpdating, ResNameEmailIdentityPolicy, d.Id(), err)
+	}
+
+	in := &sesv2.UpdateEmailIdentityPolicyInput{
+		EmailIdentity: aws.String(d.Get("email_identity").(string)),
+		Policy:        aws.String(policy),
+		PolicyName:    aws.String(d.Get("policy_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating SESV2 EmailIdentityPolicy (%s): %#v", d.Id(), in)
+	_, err = conn.UpdateEmailIdentityPolicy(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SESV2, create.ErrActionUpdating, ResNameEmailIdentityPolicy, d.Id(), err)
+	}
+
+	return resourceEmailIdentityPolicyRead(ctx, d, meta)
+}
+
+func resourceEmailIdentityPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	log.Printf("[INFO] Deleting SESV2 EmailIdentityPolicy %s", d.Id())
+
+	_, err := conn.DeleteEmailIdentityPolicy(ctx, &sesv2.DeleteEmailIdentityPolicyInput{
+		EmailIdentity: aws.String(d.Get("email_identity").(string)),
+		PolicyName:    aws.String(d.Get("policy_name").(string)),
+	})
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.SESV2, create.ErrActionDeleting, ResNameEmailIdentityPolicy, d.Id(), err)
+	}
+
+	return nil
+}
+
+func emailIdentityPolicyCreateResourceID(emailIdentity, policyName string) string {
+	return strings.Join([]string{emailIdentity, policyName}, emailIdentityPolicyIDSeparator)
+}
+
+func emailIdentityPolicyParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, emailIdentityPolicyIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EMAIL_IDENTITY%[2]sPOLICY_NAME", id, emailIdentityPolicyIDSeparator)
+}
+
+func FindEmailIdentityPolicyByTwoPartKey(ctx context.Context, conn *sesv2.Client, emailIdentity, policyName string) (string, error) {
+	in := &sesv2.GetEmailIdentityPoliciesInput{
+		EmailIdentity: aws.String(emailIdentity),
+	}
+	out, err := conn.GetEmailIdentityPolicies(ctx, in)
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return "", &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return "", err
+	}
+
+	if out == nil {
+		return "", tfresource.NewEmptyResultError(in)
+	}
+
+	policy, ok := out.Policies[policyName]
+	if !ok {
+		return "", &retry.NotFoundError{
+			LastRequest: in,
+		}
+	}
+
+	return policy, nil
+}
@@ -234,6 +234,40 @@ func TestAccSESV2EmailIdentity_domainSigning(t *testing.T) {
 	})
 }
 
+func TestAccSESV2EmailIdentity_domainSigningPrivateKeyVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomDomainName()
+	resourceName := "aws_sesv2_email_identity.test"
+
+	key := verify.Base64Encode([]byte(acctest.TLSRSAPrivateKeyPEM(t, 2048)))
+	selector := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEmailIdentityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfig_domainSigningPrivateKeyVersion(rName, key, selector, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.domain_signing_private_key_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", "EXTERNAL"),
+				),
+			},
+			{
+				Config: testAccEmailIdentityConfig_domainSigningPrivateKeyVersion(rName, key, selector, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.domain_signing_private_key_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", "EXTERNAL"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSESV2EmailIdentity_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := acctest.RandomEmailAddress(acctest.RandomDomainName())
@@ -387,6 +421,20 @@ resource "aws_sesv2_email_identity" "test" {
 `, rName, domainSigningPrivateKey, domainSigningSelector)
 }
 
+func testAccEmailIdentityConfig_domainSigningPrivateKeyVersion(rName, domainSigningPrivateKey, domainSigningSelector string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+
+  dkim_signing_attributes {
+    domain_signing_private_key         = %[2]q
+    domain_signing_private_key_version = %[4]d
+    domain_signing_selector            = %[3]q
+  }
+}
+`, rName, domainSigningPrivateKey, domainSigningSelector, version)
+}
+
 func testAccEmailIdentityConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_sesv2_email_identity" "test" {
@@ -8,4 +8,8 @@ var (
 	ResourceDRTAccessRoleARNAssociation       = newResourceDRTAccessRoleARNAssociation
 	ResourceDRTAccessLogBucketAssociation     = newResourceDRTAccessLogBucketAssociation
 	ResourceApplicationLayerAutomaticResponse = newResourceApplicationLayerAutomaticResponse
+	ResourceProactiveEngagement               = newResourceProactiveEngagement
+	ResourceSubscription                      = newResourceSubscription
+
+	FindSubscription = findSubscription
 )
@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Proactive Engagement")
+func newResourceProactiveEngagement(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceProactiveEngagement{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameProactiveEngagement = "Proactive Engagement"
+)
+
+type resourceProactiveEngagement struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceProactiveEngagement) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_shield_proactive_engagement"
+}
+
+func (r *resourceProactiveEngagement) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"emergency_contact": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"contact_notes": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 1024),
+							},
+						},
+						"email_address": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 150),
+							},
+						},
+						"phone_number": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 16),
+								stringvalidator.RegexMatches(
+									regexache.MustCompile(`^\+[1-9]\d{1,14}$`),
+									"must be in E.164 format",
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 10),
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceProactiveEngagement) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var plan resourceProactiveEngagementData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := r.Meta().AccountID
+
+	err := updateProactiveEngagement(ctx, conn, expandEmergencyContacts(ctx, plan.EmergencyContacts), plan.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionCreating, ResNameProactiveEngagement, accountID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	_, err = waitProactiveEngagementUpdated(ctx, conn, plan.Enabled.ValueBool(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionWaitingForCreation, ResNameProactiveEngagement, accountID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(accountID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceProactiveEngagement) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var state resourceProactiveEngagementData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := findSubscription(ctx, conn)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionSetting, ResNameProactiveEngagement, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := conn.DescribeEmergencyContactSettingsWithContext(ctx, &shield.DescribeEmergencyContactSettingsInput{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionSetting, ResNameProactiveEngagement, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if subscription.ProactiveEngagementStatus == nil && len(out.EmergencyContactList) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Enabled = types.BoolValue(aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled)
+	state.EmergencyContacts = flattenEmergencyContacts(ctx, out.EmergencyContactList)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProactiveEngagement) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var plan, state resourceProactiveEngagementData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateProactiveEngagement(ctx, conn, expandEmergencyContacts(ctx, plan.EmergencyContacts), plan.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionUpdating, ResNameProactiveEngagement, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+	_, err = waitProactiveEngagementUpdated(ctx, conn, plan.Enabled.ValueBool(), updateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionWaitingForUpdate, ResNameProactiveEngagement, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceProactiveEngagement) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().ShieldConn(ctx)
+
+	var state resourceProactiveEngagementData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateProactiveEngagement(ctx, conn, []*shield.EmergencyContact{}, false)
+	if err != nil {
+		var nfe *shield.ResourceNotFoundException
+		if tfresource.NotFound(err) || errors.As(err, &nfe) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionDeleting, ResNameProactiveEngagement, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitProactiveEngagementUpdated(ctx, conn, false, deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Shield, create.ErrActionWaitingForDeletion, ResNameProactiveEngagement, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceProactiveEngagement) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateProactiveEngagement sets the emergency contacts and then moves proactive engagement
+// into the requested state. Proactive engagement that has never been configured on the
+// subscription must be initialized with AssociateProactiveEngagementDetails.
+func updateProactiveEngagement(ctx context.Context, conn *shield.Shield, contacts []*shield.EmergencyContact, enabled bool) error {
+	subscription, err := findSubscription(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	status := aws.StringValue(subscription.ProactiveEngagementStatus)
+
+	if status == "" && enabled {
+		_, err := conn.AssociateProactiveEngagementDetailsWithContext(ctx, &shield.AssociateProactiveEngagementDetailsInput{
+			EmergencyContactList: contacts,
+		})
+
+		return err
+	}
+
+	if status == shield.ProactiveEngagementStatusEnabled && !enabled {
+		if _, err := conn.DisableProactiveEngagementWithContext(ctx, &shield.DisableProactiveEngagementInput{}); err != nil {
+			return err
+		}
+	}
+
+	if _, err := conn.UpdateEmergencyContactSettingsWithContext(ctx, &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: contacts,
+	}); err != nil {
+		return err
+	}
+
+	if status != shield.ProactiveEngagementStatusEnabled && enabled {
+		if _, err := conn.EnableProactiveEngagementWithContext(ctx, &shield.EnableProactiveEngagementInput{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func waitProactiveEngagementUpdated(ctx context.Context, conn *shield.Shield, enabled bool, timeout time.Duration) (*shield.Subscription, error) {
+	target := shield.ProactiveEngagementStatusDisabled
+	if enabled {
+		target = shield.ProactiveEngagementStatusEnabled
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{shield.ProactiveEngagementStatusPending},
+		Target:  []string{target},
+		Refresh: statusProactiveEngagement(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*shield.Subscription); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusProactiveEngagement(ctx context.Context, conn *shield.Shield) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findSubscription(ctx, conn)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(out.ProactiveEngagementStatus)
+		if status == "" {
+			status = shield.ProactiveEngagementStatusDisabled
+		}
+
+		return out, status, nil
+	}
+}
+
+func expandEmergencyContacts(ctx context.Context, tfList []emergencyContactData) []*shield.EmergencyContact {
+	apiObjects := make([]*shield.EmergencyContact, 0, len(tfList))
+
+	for _, tfObject := range tfList {
+		apiObjects = append(apiObjects, &shield.EmergencyContact{
+			ContactNotes: flex.StringFromFramework(ctx, tfObject.ContactNotes),
+			EmailAddress: flex.StringFromFramework(ctx, tfObject.EmailAddress),
+			PhoneNumber:  flex.StringFromFramework(ctx, tfObject.PhoneNumber),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenEmergencyContacts(ctx context.Context, apiObjects []*shield.EmergencyContact) []emergencyContactData {
+	tfList := make([]emergencyContactData, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, emergencyContactData{
+			ContactNotes: flex.StringToFramework(ctx, apiObject.ContactNotes),
+			EmailAddress: flex.StringToFramework(ctx, apiObject.EmailAddress),
+			PhoneNumber:  flex.StringToFramework(ctx, apiObject.PhoneNumber),
+		})
+	}
+
+	return tfList
+}
+
+type resourceProactiveEngagementData struct {
+	EmergencyContacts []emergencyContactData `tfsdk:"emergency_contact"`
+	Enabled           types.Bool             `tfsdk:"enabled"`
+	ID                types.String           `tfsdk:"id"`
+	Timeouts          timeouts.Value         `tfsdk:"timeouts"`
+}
+
+type emergencyContactData struct {
+	ContactNotes types.String `tfsdk:"contact_notes"`
+	EmailAddress types.String `tfsdk:"email_address"`
+	PhoneNumber  types.String `tfsdk:"phone_number"`
+}
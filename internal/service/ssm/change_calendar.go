@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// changeCalendarNamesSchema returns the schema of the argument that opts a resource in to
+// Change Calendar gated applies.
+func changeCalendarNamesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 1,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// checkChangeCalendarsOpen returns an error unless the combined state of the Change Calendars
+// configured in the resource's `change_calendar_names` argument is OPEN.
+// It is a no-op for resources that haven't opted in.
+func checkChangeCalendarsOpen(ctx context.Context, conn *ssm.SSM, d *schema.ResourceData) error {
+	v, ok := d.GetOk("change_calendar_names")
+
+	if !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	calendarNames := flex.ExpandStringList(v.([]interface{}))
+	input := &ssm.GetCalendarStateInput{
+		CalendarNames: calendarNames,
+	}
+
+	output, err := conn.GetCalendarStateWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("reading SSM Change Calendar (%s) state: %w", strings.Join(aws.StringValueSlice(calendarNames), ","), err)
+	}
+
+	if state := aws.StringValue(output.State); state != ssm.CalendarStateOpen {
+		message := fmt.Sprintf("SSM Change Calendar (%s) state is %s", strings.Join(aws.StringValueSlice(calendarNames), ","), state)
+
+		if v := aws.StringValue(output.NextTransitionTime); v != "" {
+			message += fmt.Sprintf(", next transition at %s", v)
+		}
+
+		return errors.New(message)
+	}
+
+	return nil
+}
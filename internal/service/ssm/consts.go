@@ -5,8 +5,24 @@ package ssm
 
 import (
 	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+// Just-in-time node access approval policy document types.
+// These are not modeled in the AWS SDK for Go v1.
+const (
+	documentTypeAutoApprovalPolicy   = "AutoApprovalPolicy"
+	documentTypeManualApprovalPolicy = "ManualApprovalPolicy"
+)
+
+func documentType_Values() []string {
+	return append(ssm.DocumentType_Values(),
+		documentTypeAutoApprovalPolicy,
+		documentTypeManualApprovalPolicy,
+	)
+}
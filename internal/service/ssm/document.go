@@ -109,7 +109,7 @@ func ResourceDocument() *schema.Resource {
 			"document_type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringInSlice(ssm.DocumentType_Values(), false),
+				ValidateFunc: validation.StringInSlice(documentType_Values(), false),
 			},
 			"document_version": {
 				Type:     schema.TypeString,
@@ -90,3 +90,33 @@ func FindServiceSettingByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm
 
 	return output.ServiceSetting, nil
 }
+
+// FindParametersByPath returns the decrypted parameters in the hierarchy under the specified path.
+func FindParametersByPath(ctx context.Context, conn *ssm.SSM, path string) ([]*ssm.Parameter, error) {
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+	var output []*ssm.Parameter
+
+	err := conn.GetParametersByPathPagesWithContext(ctx, input, func(page *ssm.GetParametersByPathOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -54,6 +54,7 @@ func ResourceParameter() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"change_calendar_names": changeCalendarNamesSchema(),
 			"data_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -150,6 +151,10 @@ func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	name := d.Get("name").(string)
 
+	if err := checkChangeCalendarsOpen(ctx, conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameter (%s): %s", name, err)
+	}
+
 	value := d.Get("value").(string)
 	if v, ok := d.Get("insecure_value").(string); ok && v != "" {
 		value = v
@@ -303,7 +308,11 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn(ctx)
 
-	if d.HasChangesExcept("overwrite", "tags", "tags_all") {
+	if d.HasChangesExcept("change_calendar_names", "overwrite", "tags", "tags_all") {
+		if err := checkChangeCalendarsOpen(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Parameter (%s): %s", d.Id(), err)
+		}
+
 		value := d.Get("value").(string)
 
 		if v, ok := d.Get("insecure_value").(string); ok && v != "" {
@@ -356,6 +365,10 @@ func resourceParameterDelete(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn(ctx)
 
+	if err := checkChangeCalendarsOpen(ctx, conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameter (%s): %s", d.Id(), err)
+	}
+
 	_, err := conn.DeleteParameterWithContext(ctx, &ssm.DeleteParameterInput{
 		Name: aws.String(d.Get("name").(string)),
 	})
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+const (
+	// DeleteParameters accepts at most 10 parameter names per call.
+	parametersDeleteBatchSize = 10
+)
+
+// @SDKResource("aws_ssm_parameters", name="Parameters")
+func ResourceParameters() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParametersCreate,
+		ReadWithoutTimeout:   resourceParametersRead,
+		UpdateWithoutTimeout: resourceParametersUpdate,
+		DeleteWithoutTimeout: resourceParametersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"change_calendar_names": changeCalendarNamesSchema(),
+			"key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^/.*[^/]$`), "must start with a slash (/) and must not end with a slash"),
+			},
+			"tier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ssm.ParameterTierStandard,
+				ValidateFunc: validation.StringInSlice(ssm.ParameterTier_Values(), false),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ssm.ParameterTypeString,
+				ValidateFunc: validation.StringInSlice(ssm.ParameterType_Values(), false),
+			},
+			"values": {
+				Type:      schema.TypeMap,
+				Required:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.AllDiag(
+					validation.MapKeyMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+(/[0-9A-Za-z_.-]+)*$`), "must be a parameter name relative to path"),
+					validation.MapKeyLenBetween(1, 1011),
+				),
+			},
+			"versions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ComputedIf("arns", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("values")
+			}),
+			customdiff.ComputedIf("versions", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChanges("key_id", "tier", "type", "values")
+			}),
+		),
+	}
+}
+
+func resourceParametersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	if err := checkChangeCalendarsOpen(ctx, conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameters: %s", err)
+	}
+
+	path := d.Get("path").(string)
+
+	for k, v := range d.Get("values").(map[string]interface{}) {
+		if err := putParametersValue(ctx, conn, d, path, k, v.(string), false); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating SSM Parameter (%s): %s", parametersName(path, k), err)
+		}
+	}
+
+	d.SetId(path)
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	path := d.Id()
+	parameters, err := FindParametersByPath(ctx, conn, path)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	// Only the parameters in state are tracked, so that parameters under the path managed elsewhere are left alone.
+	// On import there is nothing in state yet and all parameters under the path are adopted.
+	keys := d.Get("values").(map[string]interface{})
+	arns, values, versions := map[string]interface{}{}, map[string]interface{}{}, map[string]interface{}{}
+	var parameterTypes []string
+
+	for _, parameter := range parameters {
+		k := strings.TrimPrefix(aws.StringValue(parameter.Name), path+"/")
+
+		if _, ok := keys[k]; !ok && len(keys) > 0 {
+			continue
+		}
+
+		arns[k] = aws.StringValue(parameter.ARN)
+		values[k] = aws.StringValue(parameter.Value)
+		versions[k] = aws.Int64Value(parameter.Version)
+		if v := aws.StringValue(parameter.Type); !slices.Contains(parameterTypes, v) {
+			parameterTypes = append(parameterTypes, v)
+		}
+	}
+
+	if !d.IsNewResource() && len(values) == 0 {
+		log.Printf("[WARN] SSM Parameters (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("arns", arns)
+	d.Set("path", path)
+	// The type is shared by all parameters and can only be read back if it's unambiguous.
+	if len(parameterTypes) == 1 {
+		d.Set("type", parameterTypes[0])
+	}
+	d.Set("values", values)
+	d.Set("versions", versions)
+
+	return diags
+}
+
+func resourceParametersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	if !d.HasChanges("key_id", "tier", "type", "values") {
+		return append(diags, resourceParametersRead(ctx, d, meta)...)
+	}
+
+	if err := checkChangeCalendarsOpen(ctx, conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	path := d.Id()
+	o, n := d.GetChange("values")
+	old, new := o.(map[string]interface{}), n.(map[string]interface{})
+	// A change to any of the shared arguments rewrites every parameter.
+	all := d.HasChanges("key_id", "tier", "type")
+
+	for k, v := range new {
+		if ov, ok := old[k]; ok && ov == v && !all {
+			continue
+		}
+
+		_, exists := old[k]
+
+		if err := putParametersValue(ctx, conn, d, path, k, v.(string), exists); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Parameter (%s): %s", parametersName(path, k), err)
+		}
+	}
+
+	var removed []string
+
+	for k := range old {
+		if _, ok := new[k]; !ok {
+			removed = append(removed, parametersName(path, k))
+		}
+	}
+
+	if err := deleteParameters(ctx, conn, removed); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	if err := checkChangeCalendarsOpen(ctx, conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	var names []string
+
+	for k := range d.Get("values").(map[string]interface{}) {
+		names = append(names, parametersName(d.Id(), k))
+	}
+
+	log.Printf("[DEBUG] Deleting SSM Parameters: %s", d.Id())
+	if err := deleteParameters(ctx, conn, names); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func parametersName(path, key string) string {
+	return path + "/" + key
+}
+
+func putParametersValue(ctx context.Context, conn *ssm.SSM, d *schema.ResourceData, path, key, value string, overwrite bool) error {
+	parameterType := d.Get("type").(string)
+	input := &ssm.PutParameterInput{
+		Name:      aws.String(parametersName(path, key)),
+		Overwrite: aws.Bool(overwrite),
+		Tier:      aws.String(d.Get("tier").(string)),
+		Type:      aws.String(parameterType),
+		Value:     aws.String(value),
+	}
+
+	if v, ok := d.GetOk("key_id"); ok && parameterType == ssm.ParameterTypeSecureString {
+		input.KeyId = aws.String(v.(string))
+	}
+
+	_, err := conn.PutParameterWithContext(ctx, input)
+
+	return err
+}
+
+// deleteParameters deletes the specified parameters in batches.
+// Parameters that no longer exist are ignored.
+func deleteParameters(ctx context.Context, conn *ssm.SSM, names []string) error {
+	for _, chunk := range tfslices.Chunks(names, parametersDeleteBatchSize) {
+		input := &ssm.DeleteParametersInput{
+			Names: aws.StringSlice(chunk),
+		}
+
+		if _, err := conn.DeleteParametersWithContext(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+)
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "arns.%", "3"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arns.db/host", "ssm", fmt.Sprintf("parameter/%s/db/host", rName)),
+					resource.TestCheckResourceAttr(resourceName, "path", "/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierStandard),
+					resource.TestCheckResourceAttr(resourceName, "type", ssm.ParameterTypeString),
+					resource.TestCheckResourceAttr(resourceName, "values.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "values.db/host", "db.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "values.db/port", "5432"),
+					resource.TestCheckResourceAttr(resourceName, "values.log_level", "info"),
+					resource.TestCheckResourceAttr(resourceName, "versions.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "versions.log_level", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tier"},
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 3),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceParameters(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 3),
+				),
+			},
+			{
+				Config: testAccParametersConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+					resource.TestCheckResourceAttr(resourceName, "values.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "values.db/host", "db.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "values.log_level", "debug"),
+					resource.TestCheckResourceAttr(resourceName, "values.feature", "on"),
+					resource.TestCheckNoResourceAttr(resourceName, "values.db/port"),
+					resource.TestCheckResourceAttr(resourceName, "versions.db/host", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.feature", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions.log_level", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_changeCalendar(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParametersConfig_changeCalendar(rName, "DEFAULT_CLOSED"),
+				ExpectError: regexache.MustCompile(`state is CLOSED`),
+			},
+			{
+				Config: testAccParametersConfig_changeCalendar(rName, "DEFAULT_OPEN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "change_calendar_names.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "change_calendar_names.0", "aws_ssm_document.test", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParametersExists(ctx context.Context, n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		output, err := tfssm.FindParametersByPath(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != count {
+			return fmt.Errorf("SSM Parameters (%s): got %d parameters, want %d", rs.Primary.ID, got, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameters" {
+				continue
+			}
+
+			output, err := tfssm.FindParametersByPath(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("SSM Parameters %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccParametersConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  values = {
+    "db/host"   = "db.example.com"
+    "db/port"   = "5432"
+    "log_level" = "info"
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+  tier = "Advanced"
+
+  values = {
+    "db/host"   = "db.example.com"
+    "feature"   = "on"
+    "log_level" = "debug"
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_changeCalendar(rName, calendarType string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name            = "%[1]s-%[2]s"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<EOT
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:%[2]s
+X-WR-CALDESC:
+END:VCALENDAR
+EOT
+}
+
+resource "aws_ssm_parameters" "test" {
+  path                  = "/%[1]s"
+  change_calendar_names = [aws_ssm_document.test.name]
+
+  values = {
+    "log_level" = "info"
+  }
+}
+`, rName, calendarType)
+}
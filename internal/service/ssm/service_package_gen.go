@@ -105,6 +105,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Parameter",
 			},
 		},
+		{
+			Factory:  ResourceParameters,
+			TypeName: "aws_ssm_parameters",
+			Name:     "Parameters",
+		},
 		{
 			Factory:  ResourcePatchBaseline,
 			TypeName: "aws_ssm_patch_baseline",
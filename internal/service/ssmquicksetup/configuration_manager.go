@@ -0,0 +1,504 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmquicksetup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmquicksetup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmquicksetup/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Configuration Manager")
+// @Tags(identifierAttribute="id")
+func newConfigurationManagerResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &configurationManagerResource{}
+	r.SetDefaultCreateTimeout(20 * time.Minute)
+	r.SetDefaultUpdateTimeout(20 * time.Minute)
+	r.SetDefaultDeleteTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type configurationManagerResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *configurationManagerResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssmquicksetup_configuration_manager"
+}
+
+func (r *configurationManagerResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"manager_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"status_summaries": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[statusSummaryModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[statusSummaryModel](ctx),
+				Computed:    true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"configuration_definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[configurationDefinitionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"local_deployment_administration_role_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"local_deployment_execution_role_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"parameters": schema.MapAttribute{
+							CustomType:  fwtypes.NewMapTypeOf[types.String](ctx),
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						names.AttrType: schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"type_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *configurationManagerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configurationManagerResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMQuickSetupClient(ctx)
+
+	input := &ssmquicksetup.CreateConfigurationManagerInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateConfigurationManager(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSM Quick Setup Configuration Manager (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.ManagerArn)
+
+	manager, err := waitConfigurationManagerCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SSM Quick Setup Configuration Manager (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flattenComputed(ctx, manager)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configurationManagerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configurationManagerResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMQuickSetupClient(ctx)
+
+	output, err := findConfigurationManagerByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Quick Setup Configuration Manager (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configurationManagerResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new configurationManagerResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMQuickSetupClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		input := &ssmquicksetup.UpdateConfigurationManagerInput{
+			Description: fwflex.StringFromFramework(ctx, new.Description),
+			ManagerArn:  fwflex.StringFromFramework(ctx, new.ID),
+			Name:        fwflex.StringFromFramework(ctx, new.Name),
+		}
+
+		_, err := conn.UpdateConfigurationManager(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSM Quick Setup Configuration Manager (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.ConfigurationDefinitions.Equal(old.ConfigurationDefinitions) {
+		definition, diags := new.ConfigurationDefinitions.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &ssmquicksetup.UpdateConfigurationDefinitionInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, definition, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.ManagerArn = fwflex.StringFromFramework(ctx, new.ID)
+
+		_, err := conn.UpdateConfigurationDefinition(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSM Quick Setup Configuration Manager (%s) configuration definition (%s)", new.ID.ValueString(), aws.ToString(input.Id)), err.Error())
+
+			return
+		}
+
+		manager, err := waitConfigurationManagerUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for SSM Quick Setup Configuration Manager (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flattenComputed(ctx, manager)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.StatusSummaries = old.StatusSummaries
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configurationManagerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configurationManagerResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMQuickSetupClient(ctx)
+
+	tflog.Debug(ctx, "deleting SSM Quick Setup Configuration Manager", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteConfigurationManager(ctx, &ssmquicksetup.DeleteConfigurationManagerInput{
+		ManagerArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSM Quick Setup Configuration Manager (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitConfigurationManagerDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SSM Quick Setup Configuration Manager (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *configurationManagerResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type configurationManagerResourceModel struct {
+	ConfigurationDefinitions fwtypes.ListNestedObjectValueOf[configurationDefinitionModel] `tfsdk:"configuration_definition"`
+	Description              types.String                                                  `tfsdk:"description"`
+	ID                       types.String                                                  `tfsdk:"id"`
+	ManagerARN               types.String                                                  `tfsdk:"manager_arn"`
+	Name                     types.String                                                  `tfsdk:"name"`
+	StatusSummaries          fwtypes.ListNestedObjectValueOf[statusSummaryModel]           `tfsdk:"status_summaries"`
+	Tags                     types.Map                                                     `tfsdk:"tags"`
+	TagsAll                  types.Map                                                     `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                                `tfsdk:"timeouts"`
+}
+
+// flattenComputed sets the values that are only known once the configuration has been deployed.
+func (data *configurationManagerResourceModel) flattenComputed(ctx context.Context, manager *ssmquicksetup.GetConfigurationManagerOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ManagerARN = fwflex.StringToFramework(ctx, manager.ManagerArn)
+
+	var definitions fwtypes.ListNestedObjectValueOf[configurationDefinitionModel]
+	diags.Append(fwflex.Flatten(ctx, manager.ConfigurationDefinitions, &definitions)...)
+	if diags.HasError() {
+		return diags
+	}
+	data.ConfigurationDefinitions = definitions
+
+	var statusSummaries fwtypes.ListNestedObjectValueOf[statusSummaryModel]
+	diags.Append(fwflex.Flatten(ctx, manager.StatusSummaries, &statusSummaries)...)
+	if diags.HasError() {
+		return diags
+	}
+	data.StatusSummaries = statusSummaries
+
+	return diags
+}
+
+type configurationDefinitionModel struct {
+	ID                                   types.String                     `tfsdk:"id"`
+	LocalDeploymentAdministrationRoleARN fwtypes.ARN                      `tfsdk:"local_deployment_administration_role_arn"`
+	LocalDeploymentExecutionRoleName     types.String                     `tfsdk:"local_deployment_execution_role_name"`
+	Parameters                           fwtypes.MapValueOf[types.String] `tfsdk:"parameters"`
+	Type                                 types.String                     `tfsdk:"type"`
+	TypeVersion                          types.String                     `tfsdk:"type_version"`
+}
+
+type statusSummaryModel struct {
+	Status        fwtypes.StringEnum[awstypes.Status]     `tfsdk:"status"`
+	StatusMessage types.String                            `tfsdk:"status_message"`
+	StatusType    fwtypes.StringEnum[awstypes.StatusType] `tfsdk:"status_type"`
+}
+
+func findConfigurationManagerByARN(ctx context.Context, conn *ssmquicksetup.Client, arn string) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
+	input := &ssmquicksetup.GetConfigurationManagerInput{
+		ManagerArn: aws.String(arn),
+	}
+
+	output, err := conn.GetConfigurationManager(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// deploymentStatus returns the status summary of the configuration manager's most recent deployment.
+func deploymentStatus(output *ssmquicksetup.GetConfigurationManagerOutput) *awstypes.StatusSummary {
+	for _, v := range output.StatusSummaries {
+		if v.StatusType == awstypes.StatusTypeDeployment {
+			return &v
+		}
+	}
+
+	return nil
+}
+
+func statusConfigurationManager(ctx context.Context, conn *ssmquicksetup.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findConfigurationManagerByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := deploymentStatus(output)
+
+		if status == nil {
+			return output, "", nil
+		}
+
+		return output, string(status.Status), nil
+	}
+}
+
+func waitConfigurationManagerCreated(ctx context.Context, conn *ssmquicksetup.Client, arn string, timeout time.Duration) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: append([]string{""}, enum.Slice(awstypes.StatusInitializing, awstypes.StatusDeploying)...),
+		Target:  enum.Slice(awstypes.StatusSucceeded),
+		Refresh: statusConfigurationManager(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
+		if status := deploymentStatus(output); status != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(status.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConfigurationManagerUpdated(ctx context.Context, conn *ssmquicksetup.Client, arn string, timeout time.Duration) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.StatusInitializing, awstypes.StatusDeploying),
+		Target:                    enum.Slice(awstypes.StatusSucceeded),
+		Refresh:                   statusConfigurationManager(ctx, conn, arn),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
+		if status := deploymentStatus(output); status != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(status.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConfigurationManagerDeleted(ctx context.Context, conn *ssmquicksetup.Client, arn string, timeout time.Duration) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusDeleting, awstypes.StatusStopping, awstypes.StatusSucceeded),
+		Target:  []string{},
+		Refresh: statusConfigurationManager(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
+		if status := deploymentStatus(output); status != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(status.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmquicksetup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmquicksetup"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmquicksetup "github.com/hashicorp/terraform-provider-aws/internal/service/ssmquicksetup"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMQuickSetupConfigurationManager_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssmquicksetup.GetConfigurationManagerOutput
+	resourceName := "aws_ssmquicksetup_configuration_manager.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMQuickSetupEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMQuickSetupEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationManagerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationManagerConfig_patchPolicy(rName, "Scan"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration_definition.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration_definition.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_definition.0.parameters.ConfigurationOptionsPatchOperation", "Scan"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_definition.0.type", "AWSQuickSetupType-PatchPolicy"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "manager_arn"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "manager_arn", "ssm-quicksetup", regexache.MustCompile(`configuration-manager/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "status_summaries.#"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMQuickSetupConfigurationManager_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssmquicksetup.GetConfigurationManagerOutput
+	resourceName := "aws_ssmquicksetup_configuration_manager.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMQuickSetupEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMQuickSetupEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationManagerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationManagerConfig_patchPolicy(rName, "Scan"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfssmquicksetup.ResourceConfigurationManager, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMQuickSetupConfigurationManager_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ssmquicksetup.GetConfigurationManagerOutput
+	resourceName := "aws_ssmquicksetup_configuration_manager.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMQuickSetupEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMQuickSetupEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationManagerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationManagerConfig_patchPolicy(rName, "Scan"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "configuration_definition.0.parameters.ConfigurationOptionsPatchOperation", "Scan"),
+				),
+			},
+			{
+				Config: testAccConfigurationManagerConfig_patchPolicy(rName, "ScanAndInstall"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &v2),
+					testAccCheckConfigurationManagerNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "configuration_definition.0.parameters.ConfigurationOptionsPatchOperation", "ScanAndInstall"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMQuickSetupConfigurationManager_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ssmquicksetup.GetConfigurationManagerOutput
+	resourceName := "aws_ssmquicksetup_configuration_manager.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMQuickSetupEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMQuickSetupEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationManagerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationManagerConfig_description(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationManagerConfig_description(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &v2),
+					testAccCheckConfigurationManagerNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMQuickSetupConfigurationManager_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssmquicksetup.GetConfigurationManagerOutput
+	resourceName := "aws_ssmquicksetup_configuration_manager.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMQuickSetupEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMQuickSetupEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationManagerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationManagerConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationManagerConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConfigurationManagerConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationManagerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationManagerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMQuickSetupClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmquicksetup_configuration_manager" {
+				continue
+			}
+
+			_, err := tfssmquicksetup.FindConfigurationManagerByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM Quick Setup Configuration Manager %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigurationManagerExists(ctx context.Context, n string, v *ssmquicksetup.GetConfigurationManagerOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMQuickSetupClient(ctx)
+
+		output, err := tfssmquicksetup.FindConfigurationManagerByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConfigurationManagerNotRecreated(before, after *ssmquicksetup.GetConfigurationManagerOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToTime(before.CreatedAt), aws.ToTime(after.CreatedAt); !before.Equal(after) {
+			return fmt.Errorf("SSM Quick Setup Configuration Manager recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccConfigurationManagerConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_ssm_patch_baseline" "test" {
+  owner            = "AWS"
+  name_prefix      = "AWS-AmazonLinux2DefaultPatchBaseline"
+  operating_system = "AMAZON_LINUX_2"
+}
+
+locals {
+  patch_policy_name = %[1]q
+
+  selected_patch_baselines = jsonencode({
+    (data.aws_ssm_patch_baseline.test.operating_system) = {
+      "value" : data.aws_ssm_patch_baseline.test.id
+      "label" : data.aws_ssm_patch_baseline.test.name
+      "description" : data.aws_ssm_patch_baseline.test.description
+      "disabled" : false
+    }
+  })
+}
+`, rName)
+}
+
+func testAccConfigurationManagerConfig_patchPolicy(rName, operation string) string {
+	return acctest.ConfigCompose(testAccConfigurationManagerConfig_base(rName), fmt.Sprintf(`
+resource "aws_ssmquicksetup_configuration_manager" "test" {
+  name = %[1]q
+
+  configuration_definition {
+    type = "AWSQuickSetupType-PatchPolicy"
+
+    parameters = {
+      "ConfigurationOptionsPatchOperation" : %[2]q,
+      "ConfigurationOptionsScanValue" : "cron(0 1 * * ? *)",
+      "ConfigurationOptionsScanNextInterval" : "false",
+      "PatchBaselineRegion" : data.aws_region.current.name,
+      "PatchBaselineUseDefault" : "default",
+      "PatchPolicyName" : local.patch_policy_name,
+      "SelectedPatchBaselines" : local.selected_patch_baselines,
+      "OutputLogEnableS3" : "false",
+      "RateControlConcurrency" : "10%%",
+      "RateControlErrorThreshold" : "2%%",
+      "IsPolicyAttachAllowed" : "false",
+      "TargetAccounts" : data.aws_caller_identity.current.account_id,
+      "TargetRegions" : data.aws_region.current.name,
+      "TargetType" : "*"
+    }
+  }
+}
+`, rName, operation))
+}
+
+func testAccConfigurationManagerConfig_description(rName, description string) string {
+	return acctest.ConfigCompose(testAccConfigurationManagerConfig_base(rName), fmt.Sprintf(`
+resource "aws_ssmquicksetup_configuration_manager" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  configuration_definition {
+    type = "AWSQuickSetupType-PatchPolicy"
+
+    parameters = {
+      "ConfigurationOptionsPatchOperation" : "Scan",
+      "ConfigurationOptionsScanValue" : "cron(0 1 * * ? *)",
+      "PatchBaselineRegion" : data.aws_region.current.name,
+      "PatchBaselineUseDefault" : "default",
+      "PatchPolicyName" : local.patch_policy_name,
+      "SelectedPatchBaselines" : local.selected_patch_baselines,
+      "TargetAccounts" : data.aws_caller_identity.current.account_id,
+      "TargetRegions" : data.aws_region.current.name,
+      "TargetType" : "*"
+    }
+  }
+}
+`, rName, description))
+}
+
+func testAccConfigurationManagerConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConfigurationManagerConfig_base(rName), fmt.Sprintf(`
+resource "aws_ssmquicksetup_configuration_manager" "test" {
+  name = %[1]q
+
+  configuration_definition {
+    type = "AWSQuickSetupType-PatchPolicy"
+
+    parameters = {
+      "ConfigurationOptionsPatchOperation" : "Scan",
+      "ConfigurationOptionsScanValue" : "cron(0 1 * * ? *)",
+      "PatchBaselineRegion" : data.aws_region.current.name,
+      "PatchBaselineUseDefault" : "default",
+      "PatchPolicyName" : local.patch_policy_name,
+      "SelectedPatchBaselines" : local.selected_patch_baselines,
+      "TargetAccounts" : data.aws_caller_identity.current.account_id,
+      "TargetRegions" : data.aws_region.current.name,
+      "TargetType" : "*"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccConfigurationManagerConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConfigurationManagerConfig_base(rName), fmt.Sprintf(`
+resource "aws_ssmquicksetup_configuration_manager" "test" {
+  name = %[1]q
+
+  configuration_definition {
+    type = "AWSQuickSetupType-PatchPolicy"
+
+    parameters = {
+      "ConfigurationOptionsPatchOperation" : "Scan",
+      "ConfigurationOptionsScanValue" : "cron(0 1 * * ? *)",
+      "PatchBaselineRegion" : data.aws_region.current.name,
+      "PatchBaselineUseDefault" : "default",
+      "PatchPolicyName" : local.patch_policy_name,
+      "SelectedPatchBaselines" : local.selected_patch_baselines,
+      "TargetAccounts" : data.aws_caller_identity.current.account_id,
+      "TargetRegions" : data.aws_region.current.name,
+      "TargetType" : "*"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -5,6 +5,7 @@ package transfer
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -37,8 +39,11 @@ func ResourceCertificate() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"active_date": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -68,8 +73,11 @@ func ResourceCertificate() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 200),
 			},
 			"inactive_date": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
 			},
 			"private_key": {
 				Type:         schema.TypeString,
@@ -89,7 +97,10 @@ func ResourceCertificate() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customizeCertificateDiff,
+		),
 	}
 }
 
@@ -103,6 +114,11 @@ func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, meta
 		Usage:       aws.String(d.Get("usage").(string)),
 	}
 
+	if v, ok := d.GetOk("active_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ActiveDate = aws.Time(v)
+	}
+
 	if v, ok := d.GetOk("certificate_chain"); ok {
 		input.CertificateChain = aws.String(v.(string))
 	}
@@ -111,6 +127,11 @@ func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("inactive_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.InactiveDate = aws.Time(v)
+	}
+
 	if v, ok := d.GetOk("private_key"); ok {
 		input.PrivateKey = aws.String(v.(string))
 	}
@@ -142,13 +163,21 @@ func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "reading Transfer Certificate (%s): %s", d.Id(), err)
 	}
 
-	d.Set("active_date", aws.ToTime(output.ActiveDate).Format(time.RFC3339))
+	if output.ActiveDate != nil {
+		d.Set("active_date", aws.ToTime(output.ActiveDate).Format(time.RFC3339))
+	} else {
+		d.Set("active_date", nil)
+	}
 	d.Set("arn", output.Arn)
 	d.Set("certificate", output.Certificate)
 	d.Set("certificate_chain", output.CertificateChain)
 	d.Set("certificate_id", output.CertificateId)
 	d.Set("description", output.Description)
-	d.Set("inactive_date", aws.ToTime(output.InactiveDate).Format(time.RFC3339))
+	if output.InactiveDate != nil {
+		d.Set("inactive_date", aws.ToTime(output.InactiveDate).Format(time.RFC3339))
+	} else {
+		d.Set("inactive_date", nil)
+	}
 	d.Set("usage", output.Usage)
 	setTagsOut(ctx, output.Tags)
 
@@ -159,10 +188,23 @@ func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).TransferConn(ctx)
 
-	if d.HasChange("description") {
+	if d.HasChangesExcept("tags", "tags_all") {
 		input := &transfer.UpdateCertificateInput{
 			CertificateId: aws.String(d.Id()),
-			Description:   aws.String(d.Get("description").(string)),
+		}
+
+		if d.HasChange("active_date") {
+			v, _ := time.Parse(time.RFC3339, d.Get("active_date").(string))
+			input.ActiveDate = aws.Time(v)
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("inactive_date") {
+			v, _ := time.Parse(time.RFC3339, d.Get("inactive_date").(string))
+			input.InactiveDate = aws.Time(v)
 		}
 
 		_, err := conn.UpdateCertificateWithContext(ctx, input)
@@ -194,3 +236,24 @@ func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return diags
 }
+
+// customizeCertificateDiff ensures that a certificate's active period is not empty.
+// Rotating a certificate is done by importing the replacement with an active date that
+// falls before the inactive date of the certificate it replaces, so both are valid during the overlap.
+func customizeCertificateDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	activeDate, err := time.Parse(time.RFC3339, d.Get("active_date").(string))
+	if err != nil {
+		return nil
+	}
+
+	inactiveDate, err := time.Parse(time.RFC3339, d.Get("inactive_date").(string))
+	if err != nil {
+		return nil
+	}
+
+	if !inactiveDate.After(activeDate) {
+		return fmt.Errorf("inactive_date (%s) must be after active_date (%s)", inactiveDate.Format(time.RFC3339), activeDate.Format(time.RFC3339))
+	}
+
+	return nil
+}
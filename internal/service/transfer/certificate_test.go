@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -296,6 +298,73 @@ func TestAccTransferCertificate_description(t *testing.T) {
 	})
 }
 
+func TestAccTransferCertificate_rotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var current, next transfer.DescribedCertificate
+	resourceName := "aws_transfer_certificate.test"
+	nextResourceName := "aws_transfer_certificate.next"
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, acctest.RandomSubdomain())
+	nextKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	nextCertificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, nextKey, acctest.RandomSubdomain())
+	now := time.Now().UTC().Truncate(time.Minute)
+	activeDate := now.Add(5 * time.Minute).Format(time.RFC3339)
+	overlapDate := now.Add(4 * time.Hour).Format(time.RFC3339)
+	inactiveDate := now.Add(8 * time.Hour).Format(time.RFC3339)
+	nextInactiveDate := now.Add(12 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, transfer.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCertificateConfig_dates(certificate, inactiveDate, activeDate),
+				ExpectError: regexache.MustCompile(`inactive_date .* must be after active_date`),
+			},
+			{
+				Config: testAccCertificateConfig_dates(certificate, activeDate, inactiveDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &current),
+					resource.TestCheckResourceAttr(resourceName, "active_date", activeDate),
+					resource.TestCheckResourceAttr(resourceName, "inactive_date", inactiveDate),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key", "certificate", "certificate_chain"},
+			},
+			{
+				Config: testAccCertificateConfig_rotation(certificate, nextCertificate, activeDate, inactiveDate, overlapDate, nextInactiveDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &current),
+					testAccCheckCertificateExists(ctx, nextResourceName, &next),
+					resource.TestCheckResourceAttr(resourceName, "active_date", activeDate),
+					resource.TestCheckResourceAttr(resourceName, "inactive_date", inactiveDate),
+					resource.TestCheckResourceAttr(nextResourceName, "active_date", overlapDate),
+					resource.TestCheckResourceAttr(nextResourceName, "inactive_date", nextInactiveDate),
+				),
+			},
+			{
+				Config: testAccCertificateConfig_rotation(certificate, nextCertificate, activeDate, overlapDate, overlapDate, nextInactiveDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &current),
+					testAccCheckCertificateExists(ctx, nextResourceName, &next),
+					resource.TestCheckResourceAttr(resourceName, "inactive_date", overlapDate),
+					resource.TestCheckResourceAttr(nextResourceName, "active_date", overlapDate),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCertificateExists(ctx context.Context, n string, v *transfer.DescribedCertificate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -423,3 +492,25 @@ resource "aws_transfer_certificate" "test" {
 }
 `, certificate, description)
 }
+
+func testAccCertificateConfig_dates(certificate, activeDate, inactiveDate string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_certificate" "test" {
+  certificate   = %[1]q
+  usage         = "SIGNING"
+  active_date   = %[2]q
+  inactive_date = %[3]q
+}
+`, certificate, activeDate, inactiveDate)
+}
+
+func testAccCertificateConfig_rotation(certificate, nextCertificate, activeDate, inactiveDate, nextActiveDate, nextInactiveDate string) string {
+	return acctest.ConfigCompose(testAccCertificateConfig_dates(certificate, activeDate, inactiveDate), fmt.Sprintf(`
+resource "aws_transfer_certificate" "next" {
+  certificate   = %[1]q
+  usage         = "SIGNING"
+  active_date   = %[2]q
+  inactive_date = %[3]q
+}
+`, nextCertificate, nextActiveDate, nextInactiveDate))
+}
@@ -124,6 +124,12 @@ func ResourceConnector() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"test_connection_triggers": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"sftp_config"},
+			},
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -164,6 +170,14 @@ func resourceConnectorCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.SetId(aws.StringValue(output.ConnectorId))
 
+	if v, ok := d.GetOk("test_connection_triggers"); ok && len(v.(map[string]interface{})) > 0 {
+		diags = append(diags, testConnectorConnection(ctx, conn, d.Id())...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	return append(diags, resourceConnectorRead(ctx, d, meta)...)
 }
 
@@ -203,7 +217,7 @@ func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).TransferConn(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("tags", "tags_all", "test_connection_triggers") {
 		input := &transfer.UpdateConnectorInput{
 			ConnectorId: aws.String(d.Id()),
 		}
@@ -235,6 +249,14 @@ func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	if v, ok := d.GetOk("test_connection_triggers"); ok && len(v.(map[string]interface{})) > 0 && d.HasChanges("access_role", "sftp_config", "test_connection_triggers", "url") {
+		diags = append(diags, testConnectorConnection(ctx, conn, d.Id())...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	return append(diags, resourceConnectorRead(ctx, d, meta)...)
 }
 
@@ -258,6 +280,26 @@ func resourceConnectorDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// testConnectorConnection tests the connection between an SFTP connector and its remote server.
+// A failed connection test is reported as a warning so that the connector itself is still managed.
+func testConnectorConnection(ctx context.Context, conn *transfer.Transfer, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output, err := conn.TestConnectionWithContext(ctx, &transfer.TestConnectionInput{
+		ConnectorId: aws.String(id),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing Transfer Connector (%s) connection: %s", id, err)
+	}
+
+	if status := aws.StringValue(output.Status); status != connectorTestConnectionStatusOK {
+		return sdkdiag.AppendWarningf(diags, "Transfer Connector (%s) connection test returned status %s: %s", id, status, aws.StringValue(output.StatusMessage))
+	}
+
+	return diags
+}
+
 func expandAs2Config(pUser []interface{}) *transfer.As2ConnectorConfig {
 	if len(pUser) < 1 || pUser[0] == nil {
 		return nil
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_transfer_connector_test_connection")
+func DataSourceConnectorTestConnection() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"connector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		ReadWithoutTimeout: dataSourceConnectorTestConnectionRead,
+	}
+}
+
+func dataSourceConnectorTestConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	connectorID := d.Get("connector_id").(string)
+
+	output, err := conn.TestConnectionWithContext(ctx, &transfer.TestConnectionInput{
+		ConnectorId: aws.String(connectorID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing Transfer Connector (%s) connection: %s", connectorID, err)
+	}
+
+	d.SetId(connectorID)
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+
+	return diags
+}
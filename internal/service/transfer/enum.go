@@ -22,3 +22,7 @@ func SecurityPolicyName_Values() []string {
 		SecurityPolicyName2023_05,
 	}
 }
+
+const (
+	connectorTestConnectionStatusOK = "OK"
+)
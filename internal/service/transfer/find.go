@@ -165,6 +165,56 @@ func FindServerByID(ctx context.Context, conn *transfer.Transfer, id string) (*t
 	return output.Server, nil
 }
 
+func FindWebAppByID(ctx context.Context, conn *transfer.Transfer, id string) (*describedWebApp, error) {
+	input := &describeWebAppInput{
+		WebAppId: aws.String(id),
+	}
+
+	output, err := describeWebApp(ctx, conn, input)
+
+	if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WebApp == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WebApp, nil
+}
+
+func FindWebAppCustomizationByID(ctx context.Context, conn *transfer.Transfer, id string) (*describedWebAppCustomization, error) {
+	input := &describeWebAppCustomizationInput{
+		WebAppId: aws.String(id),
+	}
+
+	output, err := describeWebAppCustomization(ctx, conn, input)
+
+	if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WebAppCustomization == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WebAppCustomization, nil
+}
+
 func FindWorkflowByID(ctx context.Context, conn *transfer.Transfer, id string) (*transfer.DescribedWorkflow, error) {
 	input := &transfer.DescribeWorkflowInput{
 		WorkflowId: aws.String(id),
@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceConnectorTestConnection,
+			TypeName: "aws_transfer_connector_test_connection",
+		},
 		{
 			Factory:  DataSourceServer,
 			TypeName: "aws_transfer_server",
@@ -94,6 +98,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceWebApp,
+			TypeName: "aws_transfer_web_app",
+			Name:     "Web App",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceWebAppCustomization,
+			TypeName: "aws_transfer_web_app_customization",
+			Name:     "Web App Customization",
+		},
 		{
 			Factory:  ResourceWorkflow,
 			TypeName: "aws_transfer_workflow",
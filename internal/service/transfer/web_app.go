@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_transfer_web_app", name="Web App")
+// @Tags(identifierAttribute="arn")
+func ResourceWebApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWebAppCreate,
+		ReadWithoutTimeout:   resourceWebAppRead,
+		UpdateWithoutTimeout: resourceWebAppUpdate,
+		DeleteWithoutTimeout: resourceWebAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_provider_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_center_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"instance_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"role": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"web_app_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_app_units": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provisioned": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceWebAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	input := &createWebAppInput{
+		IdentityProviderDetails: expandWebAppIdentityProviderDetails(d.Get("identity_provider_details").([]interface{})),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("access_endpoint"); ok {
+		input.AccessEndpoint = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("web_app_units"); ok {
+		input.WebAppUnits = expandWebAppUnits(v.([]interface{}))
+	}
+
+	output, err := createWebApp(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Transfer Web App: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.WebAppId))
+
+	return append(diags, resourceWebAppRead(ctx, d, meta)...)
+}
+
+func resourceWebAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	output, err := FindWebAppByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transfer Web App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Web App (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_endpoint", output.AccessEndpoint)
+	d.Set("arn", output.Arn)
+	if err := d.Set("identity_provider_details", flattenWebAppIdentityProviderDetails(output.DescribedIdentityProviderDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting identity_provider_details: %s", err)
+	}
+	d.Set("web_app_endpoint", output.WebAppEndpoint)
+	d.Set("web_app_id", output.WebAppId)
+	if err := d.Set("web_app_units", flattenWebAppUnits(output.WebAppUnits)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting web_app_units: %s", err)
+	}
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceWebAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &updateWebAppInput{
+			WebAppId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_endpoint") {
+			input.AccessEndpoint = aws.String(d.Get("access_endpoint").(string))
+		}
+
+		if d.HasChange("identity_provider_details") {
+			// Only the IAM Identity Center role can be updated.
+			if v := expandWebAppIdentityProviderDetails(d.Get("identity_provider_details").([]interface{})); v != nil && v.IdentityCenterConfig != nil {
+				input.IdentityProviderDetails = &webAppIdentityProviderDetails{
+					IdentityCenterConfig: &identityCenterConfig{
+						Role: v.IdentityCenterConfig.Role,
+					},
+				}
+			}
+		}
+
+		if d.HasChange("web_app_units") {
+			input.WebAppUnits = expandWebAppUnits(d.Get("web_app_units").([]interface{}))
+		}
+
+		if err := updateWebApp(ctx, conn, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Transfer Web App (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceWebAppRead(ctx, d, meta)...)
+}
+
+func resourceWebAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Transfer Web App: %s", d.Id())
+	err := deleteWebApp(ctx, conn, &deleteWebAppInput{
+		WebAppId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Transfer Web App (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandWebAppIdentityProviderDetails(tfList []interface{}) *webAppIdentityProviderDetails {
+	if len(tfList) < 1 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &webAppIdentityProviderDetails{}
+
+	if v, ok := tfMap["identity_center_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.IdentityCenterConfig = &identityCenterConfig{}
+
+		if v, ok := tfMap["instance_arn"].(string); ok && v != "" {
+			apiObject.IdentityCenterConfig.InstanceArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["role"].(string); ok && v != "" {
+			apiObject.IdentityCenterConfig.Role = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandWebAppUnits(tfList []interface{}) *webAppUnits {
+	if len(tfList) < 1 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &webAppUnits{}
+
+	if v, ok := tfMap["provisioned"].(int); ok && v != 0 {
+		apiObject.Provisioned = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenWebAppIdentityProviderDetails(apiObject *webAppIdentityProviderDetails) []interface{} {
+	if apiObject == nil || apiObject.IdentityCenterConfig == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"identity_center_config": []interface{}{map[string]interface{}{
+			"application_arn": aws.StringValue(apiObject.IdentityCenterConfig.ApplicationArn),
+			"instance_arn":    aws.StringValue(apiObject.IdentityCenterConfig.InstanceArn),
+			"role":            aws.StringValue(apiObject.IdentityCenterConfig.Role),
+		}},
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenWebAppUnits(apiObject *webAppUnits) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"provisioned": aws.Int64Value(apiObject.Provisioned),
+	}
+
+	return []interface{}{tfMap}
+}
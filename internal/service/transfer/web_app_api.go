@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/transfer"
+)
+
+// The Transfer Family web app operations are not modeled by AWS SDK for Go v1,
+// so requests are made using the client's JSON protocol handlers and the shapes below.
+
+type identityCenterConfig struct {
+	ApplicationArn *string `type:"string"`
+	InstanceArn    *string `type:"string"`
+	Role           *string `type:"string"`
+}
+
+type webAppIdentityProviderDetails struct {
+	IdentityCenterConfig *identityCenterConfig `type:"structure"`
+}
+
+type webAppUnits struct {
+	Provisioned *int64 `type:"integer"`
+}
+
+type createWebAppInput struct {
+	AccessEndpoint          *string                        `type:"string"`
+	IdentityProviderDetails *webAppIdentityProviderDetails `type:"structure"`
+	Tags                    []*transfer.Tag                `type:"list"`
+	WebAppUnits             *webAppUnits                   `type:"structure"`
+}
+
+type createWebAppOutput struct {
+	WebAppId *string `type:"string"`
+}
+
+type describeWebAppInput struct {
+	WebAppId *string `type:"string"`
+}
+
+type describedWebApp struct {
+	AccessEndpoint                   *string                        `type:"string"`
+	Arn                              *string                        `type:"string"`
+	DescribedIdentityProviderDetails *webAppIdentityProviderDetails `type:"structure"`
+	Tags                             []*transfer.Tag                `type:"list"`
+	WebAppEndpoint                   *string                        `type:"string"`
+	WebAppId                         *string                        `type:"string"`
+	WebAppUnits                      *webAppUnits                   `type:"structure"`
+}
+
+type describeWebAppOutput struct {
+	WebApp *describedWebApp `type:"structure"`
+}
+
+type updateWebAppInput struct {
+	AccessEndpoint          *string                        `type:"string"`
+	IdentityProviderDetails *webAppIdentityProviderDetails `type:"structure"`
+	WebAppId                *string                        `type:"string"`
+	WebAppUnits             *webAppUnits                   `type:"structure"`
+}
+
+type deleteWebAppInput struct {
+	WebAppId *string `type:"string"`
+}
+
+type updateWebAppCustomizationInput struct {
+	FaviconFile []byte  `type:"blob"`
+	LogoFile    []byte  `type:"blob"`
+	Title       *string `type:"string"`
+	WebAppId    *string `type:"string"`
+}
+
+type describeWebAppCustomizationInput struct {
+	WebAppId *string `type:"string"`
+}
+
+type describedWebAppCustomization struct {
+	Arn         *string `type:"string"`
+	FaviconFile []byte  `type:"blob"`
+	LogoFile    []byte  `type:"blob"`
+	Title       *string `type:"string"`
+	WebAppId    *string `type:"string"`
+}
+
+type describeWebAppCustomizationOutput struct {
+	WebAppCustomization *describedWebAppCustomization `type:"structure"`
+}
+
+type deleteWebAppCustomizationInput struct {
+	WebAppId *string `type:"string"`
+}
+
+type webAppEmptyOutput struct{}
+
+func sendWebAppRequest(ctx context.Context, conn *transfer.Transfer, operation string, input, output interface{}) error {
+	req := conn.NewRequest(&request.Operation{
+		Name:       operation,
+		HTTPMethod: http.MethodPost,
+		HTTPPath:   "/",
+	}, input, output)
+	req.SetContext(ctx)
+
+	return req.Send()
+}
+
+func createWebApp(ctx context.Context, conn *transfer.Transfer, input *createWebAppInput) (*createWebAppOutput, error) {
+	output := &createWebAppOutput{}
+
+	if err := sendWebAppRequest(ctx, conn, "CreateWebApp", input, output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func describeWebApp(ctx context.Context, conn *transfer.Transfer, input *describeWebAppInput) (*describeWebAppOutput, error) {
+	output := &describeWebAppOutput{}
+
+	if err := sendWebAppRequest(ctx, conn, "DescribeWebApp", input, output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func updateWebApp(ctx context.Context, conn *transfer.Transfer, input *updateWebAppInput) error {
+	return sendWebAppRequest(ctx, conn, "UpdateWebApp", input, &webAppEmptyOutput{})
+}
+
+func deleteWebApp(ctx context.Context, conn *transfer.Transfer, input *deleteWebAppInput) error {
+	return sendWebAppRequest(ctx, conn, "DeleteWebApp", input, &webAppEmptyOutput{})
+}
+
+func updateWebAppCustomization(ctx context.Context, conn *transfer.Transfer, input *updateWebAppCustomizationInput) error {
+	return sendWebAppRequest(ctx, conn, "UpdateWebAppCustomization", input, &webAppEmptyOutput{})
+}
+
+func describeWebAppCustomization(ctx context.Context, conn *transfer.Transfer, input *describeWebAppCustomizationInput) (*describeWebAppCustomizationOutput, error) {
+	output := &describeWebAppCustomizationOutput{}
+
+	if err := sendWebAppRequest(ctx, conn, "DescribeWebAppCustomization", input, output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func deleteWebAppCustomization(ctx context.Context, conn *transfer.Transfer, input *deleteWebAppCustomizationInput) error {
+	return sendWebAppRequest(ctx, conn, "DeleteWebAppCustomization", input, &webAppEmptyOutput{})
+}
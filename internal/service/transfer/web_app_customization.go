@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"encoding/base64"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_transfer_web_app_customization", name="Web App Customization")
+func ResourceWebAppCustomization() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWebAppCustomizationPut,
+		ReadWithoutTimeout:   resourceWebAppCustomizationRead,
+		UpdateWithoutTimeout: resourceWebAppCustomizationPut,
+		DeleteWithoutTimeout: resourceWebAppCustomizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"favicon_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"logo_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"web_app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWebAppCustomizationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	webAppID := d.Get("web_app_id").(string)
+	input := &updateWebAppCustomizationInput{
+		Title:    aws.String(d.Get("title").(string)),
+		WebAppId: aws.String(webAppID),
+	}
+
+	if v, ok := d.GetOk("favicon_file"); ok {
+		v, err := base64.StdEncoding.DecodeString(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "decoding favicon_file: %s", err)
+		}
+
+		input.FaviconFile = v
+	}
+
+	if v, ok := d.GetOk("logo_file"); ok {
+		v, err := base64.StdEncoding.DecodeString(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "decoding logo_file: %s", err)
+		}
+
+		input.LogoFile = v
+	}
+
+	if err := updateWebAppCustomization(ctx, conn, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Transfer Web App Customization (%s): %s", webAppID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(webAppID)
+	}
+
+	return append(diags, resourceWebAppCustomizationRead(ctx, d, meta)...)
+}
+
+func resourceWebAppCustomizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	output, err := FindWebAppCustomizationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transfer Web App Customization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Web App Customization (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if v := output.FaviconFile; len(v) > 0 {
+		d.Set("favicon_file", base64.StdEncoding.EncodeToString(v))
+	} else {
+		d.Set("favicon_file", nil)
+	}
+	if v := output.LogoFile; len(v) > 0 {
+		d.Set("logo_file", base64.StdEncoding.EncodeToString(v))
+	} else {
+		d.Set("logo_file", nil)
+	}
+	d.Set("title", output.Title)
+	d.Set("web_app_id", output.WebAppId)
+
+	return diags
+}
+
+func resourceWebAppCustomizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Transfer Web App Customization: %s", d.Id())
+	err := deleteWebAppCustomization(ctx, conn, &deleteWebAppCustomizationInput{
+		WebAppId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Transfer Web App Customization (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftransfer "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTransferWebAppCustomization_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_transfer_web_app_customization.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, transfer.EndpointsID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebAppCustomizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebAppCustomizationConfig_basic(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebAppCustomizationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "logo_file"),
+					resource.TestCheckResourceAttr(resourceName, "title", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "web_app_id", "aws_transfer_web_app.test", "web_app_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebAppCustomizationConfig_basic(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebAppCustomizationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWebAppCustomizationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer Web App Customization ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn(ctx)
+
+		_, err := tftransfer.FindWebAppCustomizationByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckWebAppCustomizationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_transfer_web_app_customization" {
+				continue
+			}
+
+			output, err := tftransfer.FindWebAppCustomizationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Deleting a customization resets it to the defaults.
+			if output.Title == nil && len(output.LogoFile) == 0 && len(output.FaviconFile) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("Transfer Web App Customization %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccWebAppCustomizationConfig_basic(rName, title string) string {
+	return acctest.ConfigCompose(testAccWebAppConfig_basic(rName, 1), fmt.Sprintf(`
+resource "aws_transfer_web_app_customization" "test" {
+  web_app_id = aws_transfer_web_app.test.web_app_id
+  logo_file  = filebase64("test-fixtures/terraform_logo.png")
+  title      = %[1]q
+}
+`, title))
+}
@@ -0,0 +1,26 @@
+// Code generated by internal/generate/servicepackage/main.go; DO NOT EDIT.
+
+package verifiedpermissions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Finders for use in disappears tests only.
+
+// FindPolicyStoreForDisappears reads the Policy Store with the specified Terraform state.
+func FindPolicyStoreForDisappears(ctx context.Context, client *conns.AWSClient, is *terraform.InstanceState) error {
+	_, err := findPolicyStoreByID(ctx, client.VerifiedPermissionsClient(ctx), is.ID)
+
+	return err
+}
+
+// FindSchemaForDisappears reads the Schema with the specified Terraform state.
+func FindSchemaForDisappears(ctx context.Context, client *conns.AWSClient, is *terraform.InstanceState) error {
+	_, err := findSchemaByPolicyStoreID(ctx, client.VerifiedPermissionsClient(ctx), is.ID)
+
+	return err
+}
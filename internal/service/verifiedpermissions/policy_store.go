@@ -30,6 +30,7 @@ import (
 )
 
 // @FrameworkResource(name="Policy Store")
+// @Testing(disappearsFinder="findPolicyStoreByID")
 func newResourcePolicyStore(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourcePolicyStore{}
 
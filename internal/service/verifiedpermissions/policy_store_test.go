@@ -117,7 +117,7 @@ func TestAccVerifiedPermissionsPolicyStore_disappears(t *testing.T) {
 				Config: testAccPolicyStoreConfig_basic("OFF"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPolicyStoreExists(ctx, resourceName, &policystore),
-					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourcePolicyStore, resourceName),
+					acctest.CheckFrameworkResourceDisappearsWithFinder(ctx, acctest.Provider, tfverifiedpermissions.ResourcePolicyStore, tfverifiedpermissions.FindPolicyStoreForDisappears, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
 			},
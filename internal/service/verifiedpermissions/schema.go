@@ -32,6 +32,7 @@ import (
 )
 
 // @FrameworkResource(name="Schema")
+// @Testing(disappearsFinder="findSchemaByPolicyStoreID")
 func newResourceSchema(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceSchema{}
 
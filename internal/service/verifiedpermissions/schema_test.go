@@ -115,7 +115,7 @@ func TestAccVerifiedPermissionsSchema_disappears(t *testing.T) {
 				Config: testAccSchemaConfig_basic("NAMESPACE"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckSchemaExists(ctx, resourceName, &schema),
-					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourceSchema, resourceName),
+					acctest.CheckFrameworkResourceDisappearsWithFinder(ctx, acctest.Provider, tfverifiedpermissions.ResourceSchema, tfverifiedpermissions.FindSchemaForDisappears, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
 			},
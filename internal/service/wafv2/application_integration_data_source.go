@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_wafv2_application_integration")
+func DataSourceApplicationIntegration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceApplicationIntegrationRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"api_keys": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"api_key": {
+								Type:      schema.TypeString,
+								Computed:  true,
+								Sensitive: true,
+							},
+							"creation_timestamp": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"token_domains": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"version": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"scope": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(wafv2.Scope_Values(), false),
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceApplicationIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Conn(ctx)
+
+	input := &wafv2.ListAPIKeysInput{
+		Scope: aws.String(d.Get("scope").(string)),
+		Limit: aws.Int64(100),
+	}
+
+	var url string
+	var apiKeys []*wafv2.APIKeySummary
+
+	for {
+		resp, err := conn.ListAPIKeysWithContext(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading WAFv2 API keys: %s", err)
+		}
+
+		if resp == nil {
+			return sdkdiag.AppendErrorf(diags, "reading WAFv2 API keys")
+		}
+
+		url = aws.StringValue(resp.ApplicationIntegrationURL)
+		apiKeys = append(apiKeys, resp.APIKeySummaries...)
+
+		if resp.NextMarker == nil {
+			break
+		}
+		input.NextMarker = resp.NextMarker
+	}
+
+	d.SetId(url)
+	if err := d.Set("api_keys", flattenAPIKeySummaries(apiKeys)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting api_keys: %s", err)
+	}
+	d.Set("url", url)
+
+	return diags
+}
+
+func flattenAPIKeySummaries(apiObjects []*wafv2.APIKeySummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"api_key":       aws.StringValue(apiObject.APIKey),
+			"token_domains": aws.StringValueSlice(apiObject.TokenDomains),
+			"version":       aws.Int64Value(apiObject.Version),
+		}
+
+		if v := apiObject.CreationTimestamp; v != nil {
+			tfMap["creation_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_wafv2_managed_rule_group")
+func DataSourceManagedRuleGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceManagedRuleGroupRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"available_labels": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"consumed_labels": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"current_default_version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"label_namespace": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"scope": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(wafv2.Scope_Values(), false),
+				},
+				"sns_topic_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"vendor_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"version_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"versions": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"last_update_timestamp": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			}
+		},
+	}
+}
+
+func dataSourceManagedRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Conn(ctx)
+	name := d.Get("name").(string)
+	scope := d.Get("scope").(string)
+	vendorName := d.Get("vendor_name").(string)
+
+	input := &wafv2.DescribeManagedRuleGroupInput{
+		Name:       aws.String(name),
+		Scope:      aws.String(scope),
+		VendorName: aws.String(vendorName),
+	}
+
+	if v, ok := d.GetOk("version_name"); ok {
+		input.VersionName = aws.String(v.(string))
+	}
+
+	output, err := conn.DescribeManagedRuleGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAFv2 Managed Rule Group (%s/%s): %s", vendorName, name, err)
+	}
+
+	versionsInput := &wafv2.ListAvailableManagedRuleGroupVersionsInput{
+		Limit:      aws.Int64(100),
+		Name:       aws.String(name),
+		Scope:      aws.String(scope),
+		VendorName: aws.String(vendorName),
+	}
+
+	var currentDefaultVersion string
+	var versions []*wafv2.ManagedRuleGroupVersion
+
+	for {
+		resp, err := conn.ListAvailableManagedRuleGroupVersionsWithContext(ctx, versionsInput)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading WAFv2 Managed Rule Group (%s/%s) versions: %s", vendorName, name, err)
+		}
+
+		if resp == nil {
+			return sdkdiag.AppendErrorf(diags, "reading WAFv2 Managed Rule Group (%s/%s) versions", vendorName, name)
+		}
+
+		currentDefaultVersion = aws.StringValue(resp.CurrentDefaultVersion)
+		versions = append(versions, resp.Versions...)
+
+		if resp.NextMarker == nil {
+			break
+		}
+		versionsInput.NextMarker = resp.NextMarker
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", vendorName, name, scope))
+	d.Set("available_labels", flattenLabelSummaries(output.AvailableLabels))
+	d.Set("capacity", output.Capacity)
+	d.Set("consumed_labels", flattenLabelSummaries(output.ConsumedLabels))
+	d.Set("current_default_version", currentDefaultVersion)
+	d.Set("label_namespace", output.LabelNamespace)
+	d.Set("sns_topic_arn", output.SnsTopicArn)
+	d.Set("version_name", output.VersionName)
+	if err := d.Set("versions", flattenManagedRuleGroupVersions(versions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting versions: %s", err)
+	}
+
+	return diags
+}
+
+func flattenLabelSummaries(apiObjects []*wafv2.LabelSummary) []string {
+	names := make([]string, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		names = append(names, aws.StringValue(apiObject.Name))
+	}
+
+	return names
+}
+
+func flattenManagedRuleGroupVersions(apiObjects []*wafv2.ManagedRuleGroupVersion) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.LastUpdateTimestamp; v != nil {
+			tfMap["last_update_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccWAFV2ManagedRuleGroupDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_wafv2_managed_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, wafv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccManagedRuleGroupDataSourceConfig_basic("AWSManagedRulesDoesNotExist"),
+				ExpectError: regexache.MustCompile(`reading WAFv2 Managed Rule Group`),
+			},
+			{
+				Config: testAccManagedRuleGroupDataSourceConfig_basic("AWSManagedRulesCommonRuleSet"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "available_labels.#"),
+					resource.TestCheckResourceAttrSet(datasourceName, "capacity"),
+					resource.TestCheckResourceAttrSet(datasourceName, "current_default_version"),
+					resource.TestCheckResourceAttr(datasourceName, "label_namespace", "awswaf:managed:aws:core-rule-set:"),
+					resource.TestCheckResourceAttr(datasourceName, "name", "AWSManagedRulesCommonRuleSet"),
+					resource.TestCheckResourceAttr(datasourceName, "vendor_name", "AWS"),
+					resource.TestCheckResourceAttrSet(datasourceName, "versions.#"),
+				),
+			},
+			{
+				Config: testAccManagedRuleGroupDataSourceConfig_versionName("AWSManagedRulesCommonRuleSet"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "version_name", "data.aws_wafv2_managed_rule_group.latest", "versions.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccManagedRuleGroupDataSourceConfig_basic(name string) string {
+	return fmt.Sprintf(`
+data "aws_wafv2_managed_rule_group" "test" {
+  name        = %[1]q
+  scope       = "REGIONAL"
+  vendor_name = "AWS"
+}
+`, name)
+}
+
+func testAccManagedRuleGroupDataSourceConfig_versionName(name string) string {
+	return fmt.Sprintf(`
+data "aws_wafv2_managed_rule_group" "latest" {
+  name        = %[1]q
+  scope       = "REGIONAL"
+  vendor_name = "AWS"
+}
+
+data "aws_wafv2_managed_rule_group" "test" {
+  name         = %[1]q
+  scope        = "REGIONAL"
+  vendor_name  = "AWS"
+  version_name = data.aws_wafv2_managed_rule_group.latest.versions[0].name
+}
+`, name)
+}
@@ -25,10 +25,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceApplicationIntegration,
+			TypeName: "aws_wafv2_application_integration",
+		},
 		{
 			Factory:  DataSourceIPSet,
 			TypeName: "aws_wafv2_ip_set",
 		},
+		{
+			Factory:  DataSourceManagedRuleGroup,
+			TypeName: "aws_wafv2_managed_rule_group",
+		},
 		{
 			Factory:  DataSourceRegexPatternSet,
 			TypeName: "aws_wafv2_regex_pattern_set",
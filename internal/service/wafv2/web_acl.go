@@ -4,19 +4,24 @@
 package wafv2
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -100,8 +105,9 @@ func ResourceWebACL() *schema.Resource {
 					),
 				},
 				"rule": {
-					Type:     schema.TypeSet,
-					Optional: true,
+					Type:          schema.TypeSet,
+					Optional:      true,
+					ConflictsWith: []string{"rules_json"},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"action": {
@@ -145,6 +151,24 @@ func ResourceWebACL() *schema.Resource {
 						},
 					},
 				},
+				"rules_json": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"rule"},
+					ValidateFunc:  validation.StringIsJSON,
+					StateFunc: func(v interface{}) string {
+						json, _ := normalizeWebACLRulesJSON(v.(string))
+						return json
+					},
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						if verify.SuppressEquivalentJSONDiffs(k, old, new, d) {
+							return true
+						}
+
+						equal, _ := webACLRulesJSONAreEquivalent(old, new)
+						return equal
+					},
+				},
 				"scope": {
 					Type:         schema.TypeString,
 					Required:     true,
@@ -176,12 +200,17 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).WAFV2Conn(ctx)
 
 	name := d.Get("name").(string)
+	rules, err := expandWebACLRulesFromConfig(d)
+	if err != nil {
+		return diag.Errorf("creating WAFv2 WebACL (%s): %s", name, err)
+	}
+
 	input := &wafv2.CreateWebACLInput{
 		AssociationConfig: expandAssociationConfig(d.Get("association_config").([]interface{})),
 		CaptchaConfig:     expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
 		DefaultAction:     expandDefaultAction(d.Get("default_action").([]interface{})),
 		Name:              aws.String(name),
-		Rules:             expandWebACLRules(d.Get("rule").(*schema.Set).List()),
+		Rules:             rules,
 		Scope:             aws.String(d.Get("scope").(string)),
 		Tags:              getTagsIn(ctx),
 		VisibilityConfig:  expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
@@ -248,8 +277,18 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("description", webACL.Description)
 	d.Set("lock_token", output.LockToken)
 	d.Set("name", webACL.Name)
-	rules := filterWebACLRules(webACL.Rules, expandWebACLRules(d.Get("rule").(*schema.Set).List()))
-	if err := d.Set("rule", flattenWebACLRules(rules)); err != nil {
+	configRules, err := expandWebACLRulesFromConfig(d)
+	if err != nil {
+		return diag.Errorf("reading WAFv2 WebACL (%s): %s", d.Id(), err)
+	}
+	rules := filterWebACLRules(webACL.Rules, configRules)
+	if _, ok := d.GetOk("rules_json"); ok {
+		rulesJSON, err := flattenWebACLRulesJSON(rules)
+		if err != nil {
+			return diag.Errorf("setting rules_json: %s", err)
+		}
+		d.Set("rules_json", rulesJSON)
+	} else if err := d.Set("rule", flattenWebACLRules(rules)); err != nil {
 		return diag.Errorf("setting rule: %s", err)
 	}
 	d.Set("token_domains", aws.StringValueSlice(webACL.TokenDomains))
@@ -268,15 +307,30 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		aclName := d.Get("name").(string)
 		aclScope := d.Get("scope").(string)
 		aclLockToken := d.Get("lock_token").(string)
+		rules, err := expandWebACLRulesFromConfig(d)
+		if err != nil {
+			return diag.Errorf("updating WAFv2 WebACL (%s): %s", aclID, err)
+		}
 		// Find the AWS managed ShieldMitigationRuleGroup group rule if existent and add it into the set of rules to update
 		// so that the provider will not remove the Shield rule when changes are applied to the WebACL.
-		rules := expandWebACLRules(d.Get("rule").(*schema.Set).List())
-		if sr := findShieldRule(rules); len(sr) == 0 {
+		if sr := findShieldRule(rules); len(sr) == 0 || d.HasChange("rules_json") {
 			output, err := FindWebACLByThreePartKey(ctx, conn, aclID, aclName, aclScope)
 			if err != nil {
 				return diag.Errorf("reading WAFv2 WebACL (%s): %s", aclID, err)
 			}
-			rules = append(rules, findShieldRule(output.WebACL.Rules)...)
+			// Rules that are unchanged in rules_json are sent back as currently stored in AWS,
+			// so that only the changed rules are replaced.
+			if d.HasChange("rules_json") {
+				o, _ := d.GetChange("rules_json")
+				oldRules, err := expandWebACLRulesJSON(o.(string))
+				if err != nil {
+					return diag.Errorf("updating WAFv2 WebACL (%s): %s", aclID, err)
+				}
+				rules = mergeUnchangedWebACLRules(rules, oldRules, output.WebACL.Rules)
+			}
+			if len(sr) == 0 {
+				rules = append(rules, findShieldRule(output.WebACL.Rules)...)
+			}
 		}
 
 		input := &wafv2.UpdateWebACLInput{
@@ -303,7 +357,7 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.TokenDomains = flex.ExpandStringSet(v.(*schema.Set))
 		}
 
-		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, webACLUpdateTimeout, func() (interface{}, error) {
+		_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, webACLUpdateTimeout, func() (interface{}, error) {
 			return conn.UpdateWebACLWithContext(ctx, input)
 		}, wafv2.ErrCodeWAFUnavailableEntityException)
 
@@ -466,3 +520,140 @@ func findShieldRule(rules []*wafv2.Rule) []*wafv2.Rule {
 	}
 	return sr
 }
+
+// expandWebACLRulesFromConfig returns the rules configured either as rule blocks or as rules_json.
+func expandWebACLRulesFromConfig(d *schema.ResourceData) ([]*wafv2.Rule, error) {
+	if v, ok := d.GetOk("rules_json"); ok {
+		return expandWebACLRulesJSON(v.(string))
+	}
+
+	return expandWebACLRules(d.Get("rule").(*schema.Set).List()), nil
+}
+
+func expandWebACLRulesJSON(rawRules string) ([]*wafv2.Rule, error) {
+	if rawRules == "" {
+		return nil, nil
+	}
+
+	var rawList []json.RawMessage
+
+	if err := json.Unmarshal([]byte(rawRules), &rawList); err != nil {
+		return nil, fmt.Errorf("decoding rules_json: %w", err)
+	}
+
+	// Rules are decoded with the same serializer used to flatten them, so that field names,
+	// blobs and empty values are handled identically in both directions.
+	rules := make([]*wafv2.Rule, 0, len(rawList))
+	for i, raw := range rawList {
+		if string(bytes.TrimSpace(raw)) == "null" {
+			return nil, fmt.Errorf("invalid rule supplied in rules_json at index (%d)", i)
+		}
+
+		rule := &wafv2.Rule{}
+		if err := jsonutil.UnmarshalJSON(rule, bytes.NewReader(raw)); err != nil {
+			return nil, fmt.Errorf("decoding rules_json at index (%d): %w", i, err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func flattenWebACLRulesJSON(rules []*wafv2.Rule) (string, error) {
+	if rules == nil {
+		rules = []*wafv2.Rule{}
+	}
+
+	b, err := jsonutil.BuildJSON(rules)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// sortWebACLRules orders rules by priority, the order in which WAF evaluates them.
+func sortWebACLRules(rules []*wafv2.Rule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		return aws.Int64Value(rules[i].Priority) < aws.Int64Value(rules[j].Priority)
+	})
+}
+
+// normalizeWebACLRulesJSON returns the canonical form of a rules_json value.
+func normalizeWebACLRulesJSON(rawRules string) (string, error) {
+	rules, err := expandWebACLRulesJSON(rawRules)
+	if err != nil {
+		return rawRules, err
+	}
+
+	sortWebACLRules(rules)
+
+	v, err := flattenWebACLRulesJSON(rules)
+	if err != nil {
+		return rawRules, err
+	}
+
+	return structure.NormalizeJsonString(v)
+}
+
+// webACLRulesJSONAreEquivalent determines whether two rules_json values describe the same rules.
+func webACLRulesJSONAreEquivalent(rules1, rules2 string) (bool, error) {
+	json1, err := normalizeWebACLRulesJSON(rules1)
+	if err != nil {
+		return false, err
+	}
+
+	json2, err := normalizeWebACLRulesJSON(rules2)
+	if err != nil {
+		return false, err
+	}
+
+	return json1 == json2, nil
+}
+
+func webACLRulesAreEquivalent(rule1, rule2 *wafv2.Rule) bool {
+	json1, err := jsonutil.BuildJSON(rule1)
+	if err != nil {
+		return false
+	}
+
+	json2, err := jsonutil.BuildJSON(rule2)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(json1, json2)
+}
+
+// mergeUnchangedWebACLRules replaces each configured rule that is unchanged since the previous apply
+// with the rule as currently stored in AWS.
+func mergeUnchangedWebACLRules(configRules, oldRules, remoteRules []*wafv2.Rule) []*wafv2.Rule {
+	oldRulesByName := make(map[string]*wafv2.Rule, len(oldRules))
+	for _, r := range oldRules {
+		oldRulesByName[aws.StringValue(r.Name)] = r
+	}
+	remoteRulesByName := make(map[string]*wafv2.Rule, len(remoteRules))
+	for _, r := range remoteRules {
+		remoteRulesByName[aws.StringValue(r.Name)] = r
+	}
+
+	var changed int
+	rules := make([]*wafv2.Rule, 0, len(configRules))
+	for _, r := range configRules {
+		name := aws.StringValue(r.Name)
+		if old, ok := oldRulesByName[name]; ok && webACLRulesAreEquivalent(r, old) {
+			if remote, ok := remoteRulesByName[name]; ok {
+				rules = append(rules, remote)
+				continue
+			}
+		}
+
+		changed++
+		rules = append(rules, r)
+	}
+
+	log.Printf("[DEBUG] WAFv2 WebACL rules_json: %d of %d rules changed", changed, len(configRules))
+
+	return rules
+}
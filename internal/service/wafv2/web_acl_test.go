@@ -2778,6 +2778,57 @@ func TestAccWAFV2WebACL_tokenDomains(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACL_rulesJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v wafv2.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, wafv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLConfig_rulesJSON(webACLName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules_json"),
+					testAccCheckWebACLRuleCount(&v, 2),
+				),
+			},
+			{
+				Config:   testAccWebACLConfig_rulesJSON(webACLName, 2),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccWebACLConfig_rulesJSON(webACLName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+					testAccCheckWebACLRuleCount(&v, 3),
+				),
+			},
+			{
+				Config:   testAccWebACLConfig_rulesJSON(webACLName, 3),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWebACLRuleCount(v *wafv2.WebACL, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(v.Rules); got != want {
+			return fmt.Errorf("WAFv2 WebACL rule count = %d, want %d", got, want)
+		}
+
+		return nil
+	}
+}
+
 func TestAccWAFV2WebACL_associationConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v wafv2.WebACL
@@ -5717,6 +5768,49 @@ resource "aws_wafv2_web_acl" "test" {
 `, rName, domain1, domain2)
 }
 
+func testAccWebACLConfig_rulesJSON(rName string, ruleCount int) string {
+	return fmt.Sprintf(`
+locals {
+  # Keys are deliberately unordered to exercise semantic diffing.
+  rules = [for i in range(%[2]d) : {
+    Priority = i + 1
+    Name     = "rule-${i + 1}"
+    Action = {
+      Count = {}
+    }
+    VisibilityConfig = {
+      SampledRequestsEnabled   = false
+      CloudWatchMetricsEnabled = false
+      MetricName               = "rule-${i + 1}"
+    }
+    Statement = {
+      GeoMatchStatement = {
+        CountryCodes = ["US", "NL"]
+      }
+    }
+  }]
+}
+
+resource "aws_wafv2_web_acl" "test" {
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rules_json = jsonencode(local.rules)
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName, ruleCount)
+}
+
 func testAccWebACLConfig_CloudFrontScope(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {
@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/costestimation"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -41,4 +42,9 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	// Terraform shuts the provider down at the end of each graph walk.
+	if err := costestimation.WriteAll(); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
 }
@@ -4,14 +4,15 @@
 package names
 
 const (
-	AttrARN         = "arn"
-	AttrDescription = "description"
-	AttrEnabled     = "enabled"
-	AttrID          = "id" // Should be explicitly declared only for Framework resources
-	AttrKMSKeyARN   = "kms_key_arn"
-	AttrName        = "name"
-	AttrTags        = "tags"
-	AttrTagsAll     = "tags_all"
-	AttrTimeouts    = "timeouts" // Should be explicitly declared only for Framework resources
-	AttrType        = "type"
+	AttrAdoptExisting = "adopt_existing"
+	AttrARN           = "arn"
+	AttrDescription   = "description"
+	AttrEnabled       = "enabled"
+	AttrID            = "id" // Should be explicitly declared only for Framework resources
+	AttrKMSKeyARN     = "kms_key_arn"
+	AttrName          = "name"
+	AttrTags          = "tags"
+	AttrTagsAll       = "tags_all"
+	AttrTimeouts      = "timeouts" // Should be explicitly declared only for Framework resources
+	AttrType          = "type"
 )
@@ -188,6 +188,7 @@ const (
 	SSM                          = "ssm"
 	SSMContacts                  = "ssmcontacts"
 	SSMIncidents                 = "ssmincidents"
+	SSMQuickSetup                = "ssmquicksetup"
 	SSMSAP                       = "ssmsap"
 	SSO                          = "sso"
 	SSOAdmin                     = "ssoadmin"
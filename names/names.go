@@ -43,6 +43,7 @@ const (
 	CodeGuruProfilerEndpointID           = "codeguru-profiler"
 	CodeStarConnectionsEndpointID        = "codestar-connections"
 	CodeStarNotificationsEndpointID      = "codestar-notifications"
+	CognitoIDPEndpointID                 = "cognito-idp"
 	ComprehendEndpointID                 = "comprehend"
 	ComputeOptimizerEndpointID           = "computeoptimizer"
 	DocDBElasticEndpointID               = "docdb-elastic"
@@ -66,6 +67,7 @@ const (
 	MediaLiveEndpointID                  = "medialive"
 	ObservabilityAccessManagerEndpointID = "oam"
 	OpenSearchServerlessEndpointID       = "aoss"
+	PCAConnectorADEndpointID             = "pca-connector-ad"
 	PipesEndpointID                      = "pipes"
 	PollyEndpointID                      = "polly"
 	PricingEndpointID                    = "pricing"
@@ -78,6 +80,7 @@ const (
 	Route53DomainsEndpointID             = "route53domains"
 	SchedulerEndpointID                  = "scheduler"
 	SecurityLakeEndpointID               = "securitylake"
+	ServiceCatalogAppRegistryEndpointID  = "servicecatalog-appregistry"
 	ServiceQuotasEndpointID              = "servicequotas"
 	S3EndpointID                         = "s3"
 	S3ControlEndpointID                  = "s3-control"
@@ -88,6 +91,7 @@ const (
 	SSMEndpointID                        = "ssm"
 	SSMContactsEndpointID                = "ssm-contacts"
 	SSMIncidentsEndpointID               = "ssm-incidents"
+	SSMQuickSetupEndpointID              = "ssm-quicksetup"
 	SSOAdminEndpointID                   = "sso"
 	STSEndpointID                        = "sts"
 	SWFEndpointID                        = "swf"
@@ -327,7 +331,8 @@ func Endpoints() []Endpoint {
 		if len(v.Aliases) > 1 {
 			idx := slices.Index(v.Aliases, k)
 			if idx != -1 {
-				aliases := slices.Delete(v.Aliases, idx, idx+1)
+				// Delete from a copy so that the service data's aliases are not modified.
+				aliases := slices.Delete(slices.Clone(v.Aliases), idx, idx+1)
 				ep.Aliases = aliases
 			}
 		}